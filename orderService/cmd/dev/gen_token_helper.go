@@ -2,6 +2,8 @@ package main
 
 import (
 	"context"
+	"errors"
+	"flag"
 	"fmt"
 	"log"
 	"os"
@@ -12,6 +14,7 @@ import (
 	"github.com/joho/godotenv"
 	"github.com/redis/go-redis/v9"
 
+	domainModels "github.com/nastyazhadan/spot-order-grpc/orderService/internal/domain/models"
 	authStore "github.com/nastyazhadan/spot-order-grpc/orderService/internal/infrastructure/redis/auth"
 	authjwt "github.com/nastyazhadan/spot-order-grpc/shared/auth/jwt"
 	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/cache"
@@ -25,6 +28,7 @@ const (
 	defaultRedisHost       = "localhost"
 	defaultRedisPort       = "6379"
 	defaultRedisTimeout    = 3 * time.Second
+	defaultMaxSessions     = 5
 )
 
 func main() {
@@ -34,14 +38,23 @@ func main() {
 		}
 	}
 
+	userIDFlag := flag.String("user-id", defaultUserID, "token subject, should exist in users for RefreshToken")
+	rolesFlag := flag.String("roles", models.UserRoleUser.String(), "comma separated roles")
+	flag.Parse()
+
+	roles, err := parseRoles(*rolesFlag)
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	secret := strings.TrimSpace(os.Getenv("JWT_SECRET"))
 	if secret == "" {
 		log.Fatal("JWT_SECRET environment variable must be set")
 	}
 
-	userID, err := uuid.Parse(defaultUserID)
+	userID, err := uuid.Parse(*userIDFlag)
 	if err != nil {
-		log.Fatalf("invalid default user id: %v", err)
+		log.Fatalf("invalid user id: %v", err)
 	}
 
 	jwtManager := authjwt.NewManager(
@@ -52,14 +65,12 @@ func main() {
 
 	refreshJTI := uuid.NewString()
 	sessionID := uuid.NewString()
-	role := []models.UserRole{models.UserRoleUser}
-
-	accessToken, err := jwtManager.GenerateAccessToken(userID, role, sessionID)
+	accessToken, err := jwtManager.GenerateAccessToken(userID, roles, sessionID)
 	if err != nil {
 		log.Fatalf("failed to generate access token: %v", err)
 	}
 
-	refreshToken, err := jwtManager.GenerateRefreshToken(userID, role, refreshJTI, sessionID)
+	refreshToken, err := jwtManager.GenerateRefreshToken(userID, roles, refreshJTI, sessionID)
 	if err != nil {
 		log.Fatalf("failed to generate refresh token: %v", err)
 	}
@@ -87,9 +98,40 @@ func replaceSession(ctx context.Context, userID uuid.UUID, jti, sessionID string
 	}()
 
 	store := cache.New(client)
-	tokenStore := authStore.New(store, ttl)
+	tokenStore := authStore.New(store, ttl, defaultMaxSessions)
+
+	_, err := tokenStore.Create(ctx, userID, jti, domainModels.Session{
+		ID:        sessionID,
+		Device:    "gen_token_helper",
+		CreatedAt: time.Now().UTC(),
+	})
+	return err
+}
+
+// RefreshToken берёт роли из users, поэтому -roles действует только до первого обновления
+func parseRoles(list string) ([]models.UserRole, error) {
+	var roles []models.UserRole
+	for _, value := range strings.Split(list, ",") {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+
+		role, ok := models.ParseUserRole(value)
+		if !ok {
+			return nil, fmt.Errorf("unknown role %q", value)
+		}
+		if role == models.UserRoleService {
+			return nil, fmt.Errorf("role %s is reserved for service tokens", role)
+		}
+		roles = append(roles, role)
+	}
+
+	if len(roles) == 0 {
+		return nil, errors.New("-roles must contain at least one role")
+	}
 
-	return tokenStore.Replace(ctx, userID, jti, sessionID)
+	return roles, nil
 }
 
 func redisAddress() string {
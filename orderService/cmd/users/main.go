@@ -0,0 +1,164 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/nastyazhadan/spot-order-grpc/orderService/config"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/orderService/internal/domain/models"
+	userStore "github.com/nastyazhadan/spot-order-grpc/orderService/internal/infrastructure/postgres/user"
+	authService "github.com/nastyazhadan/spot-order-grpc/orderService/internal/services/auth"
+	"github.com/nastyazhadan/spot-order-grpc/shared/auth/password"
+	sharedConfig "github.com/nastyazhadan/spot-order-grpc/shared/config"
+	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/db"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+)
+
+// Имя сервиса в метриках запросов к БД
+const serviceName = "order-users-cli"
+
+const usage = `usage:
+  users create -username <name> [-roles ROLE_USER[,ROLE_ADMIN,...]] < password`
+
+func main() {
+	if len(os.Args) < 2 {
+		log.Fatal(usage)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	var err error
+	switch os.Args[1] {
+	case "create":
+		err = runCreate(ctx, os.Args[2:])
+	default:
+		log.Fatal(usage)
+	}
+	if err != nil {
+		log.Fatalf("users %s: %v", os.Args[1], err)
+	}
+}
+
+// Пароль читается из stdin, чтобы не оставлять его в истории shell и списке процессов
+func runCreate(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("create", flag.ExitOnError)
+	username := flags.String("username", "", "login name, stored in lower case")
+	rolesList := flags.String("roles", models.UserRoleUser.String(), "comma separated roles")
+	timeout := flags.Duration("timeout", 30*time.Second, "create timeout")
+	_ = flags.Parse(args)
+
+	name := authService.NormalizeUsername(*username)
+	if name == "" {
+		return errors.New("-username is required")
+	}
+
+	roles, err := parseRoles(*rolesList)
+	if err != nil {
+		return err
+	}
+
+	plainPassword, err := readPassword()
+	if err != nil {
+		return err
+	}
+
+	hash, err := password.Hash(plainPassword)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, *timeout)
+	defer cancel()
+
+	pool, err := openPool(ctx)
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+
+	var orderConfig sharedConfig.OrderConfig
+	orderConfig.Service.Name = serviceName
+
+	now := time.Now().UTC()
+	user := domainModels.User{
+		ID:           uuid.New(),
+		Username:     name,
+		PasswordHash: hash,
+		Roles:        roles,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+
+	if err = userStore.New(pool, orderConfig).CreateUser(ctx, user); err != nil {
+		return err
+	}
+
+	fmt.Printf("created user %s (id=%s roles=%s)\n", user.Username, user.ID, *rolesList)
+	return nil
+}
+
+func parseRoles(list string) ([]models.UserRole, error) {
+	var roles []models.UserRole
+	for _, value := range strings.Split(list, ",") {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+
+		role, ok := models.ParseUserRole(value)
+		if !ok {
+			return nil, fmt.Errorf("unknown role %q", value)
+		}
+		if role == models.UserRoleService {
+			return nil, fmt.Errorf("role %s is reserved for service tokens", role)
+		}
+		roles = append(roles, role)
+	}
+
+	if len(roles) == 0 {
+		return nil, errors.New("-roles must contain at least one role")
+	}
+
+	return roles, nil
+}
+
+func readPassword() (string, error) {
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && line == "" {
+		return "", fmt.Errorf("read password from stdin: %w", err)
+	}
+
+	plainPassword := strings.TrimRight(line, "\r\n")
+	if plainPassword == "" {
+		return "", errors.New("password is empty")
+	}
+
+	return plainPassword, nil
+}
+
+func openPool(ctx context.Context) (*pgxpool.Pool, error) {
+	cfg, err := config.LoadMigrate()
+	if err != nil {
+		return nil, fmt.Errorf("load config: %w", err)
+	}
+
+	return db.OpenPostgres(ctx, cfg.DBURI, db.PoolConfig{
+		MaxConnections:  cfg.PostgresPool.MaxConnections,
+		MinConnections:  cfg.PostgresPool.MinConnections,
+		MaxConnLifetime: cfg.PostgresPool.MaxConnLifetime,
+		MaxConnIdleTime: cfg.PostgresPool.MaxConnIdleTime,
+	})
+}
@@ -8,6 +8,9 @@ import (
 	"github.com/nastyazhadan/spot-order-grpc/shared/config"
 )
 
+// Pepper — ключ HMAC для вывода секретов API-ключей, короче 256 бит не принимается
+const minAPIKeyPepperLen = 32
+
 func Load() (*config.OrderConfig, error) {
 	viper, err := config.NewViper(resolveConfigDir())
 	if err != nil {
@@ -25,10 +28,15 @@ func Load() (*config.OrderConfig, error) {
 	}
 
 	cfg.AuthVerifier.JWTSecret = os.Getenv("JWT_SECRET")
-	if cfg.AuthVerifier.JWTSecret == "" {
+	if cfg.AuthVerifier.JWTSecret == "" && cfg.AuthIssuer.Signing.IsSymmetric() {
 		return nil, errors.New("JWT_SECRET is required")
 	}
 
+	cfg.AuthIssuer.APIKeys.Pepper = os.Getenv("API_KEY_PEPPER")
+	if len(cfg.AuthIssuer.APIKeys.Pepper) < minAPIKeyPepperLen {
+		return nil, fmt.Errorf("API_KEY_PEPPER is required and must be at least %d bytes", minAPIKeyPepperLen)
+	}
+
 	cfg.Kafka.Producer.Compression = config.NormalizeKafkaCompression(cfg.Kafka.Producer.Compression)
 
 	if err = validateOrderConfig(cfg); err != nil {
@@ -75,6 +83,9 @@ func validateOrderConfig(cfg config.OrderConfig) error {
 	if err := validateOrderKafka(cfg); err != nil {
 		return err
 	}
+	if err := validateOrderMarketReplica(cfg); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -225,6 +236,64 @@ func validateOrderAuth(cfg config.OrderConfig) error {
 		)
 	}
 
+	switch cfg.AuthIssuer.Signing.Algorithm {
+	case "HS256":
+	case "RS256", "EdDSA":
+		if cfg.AuthIssuer.Signing.KeysDir == "" {
+			return errors.New("auth.signing.keys_dir is required for asymmetric signing")
+		}
+		if cfg.AuthIssuer.Signing.ActiveKeyID == "" {
+			return errors.New("auth.signing.active_key_id is required for asymmetric signing")
+		}
+	default:
+		return fmt.Errorf(
+			"auth.signing.algorithm must be one of HS256, RS256, EdDSA, got %q",
+			cfg.AuthIssuer.Signing.Algorithm,
+		)
+	}
+
+	if cfg.AuthIssuer.MaxSessions <= 0 {
+		return fmt.Errorf(
+			"auth.max_sessions must be greater than 0, got %d",
+			cfg.AuthIssuer.MaxSessions,
+		)
+	}
+
+	if cfg.AuthIssuer.Login.MaxFailedAttempts <= 0 {
+		return fmt.Errorf(
+			"auth.login.max_failed_attempts must be greater than 0, got %d",
+			cfg.AuthIssuer.Login.MaxFailedAttempts,
+		)
+	}
+
+	if cfg.AuthIssuer.Login.Lockout <= 0 {
+		return fmt.Errorf(
+			"auth.login.lockout must be greater than 0, got %s",
+			cfg.AuthIssuer.Login.Lockout,
+		)
+	}
+
+	if cfg.AuthIssuer.APIKeys.ReplayWindow <= 0 {
+		return fmt.Errorf(
+			"auth.api_keys.replay_window must be greater than 0, got %s",
+			cfg.AuthIssuer.APIKeys.ReplayWindow,
+		)
+	}
+
+	if cfg.AuthIssuer.APIKeys.MaxPerUser <= 0 {
+		return fmt.Errorf(
+			"auth.api_keys.max_per_user must be greater than 0, got %d",
+			cfg.AuthIssuer.APIKeys.MaxPerUser,
+		)
+	}
+
+	if cfg.AuthIssuer.ServiceTokenTTL <= 0 {
+		return fmt.Errorf(
+			"auth.service_token_ttl must be greater than 0, got %s",
+			cfg.AuthIssuer.ServiceTokenTTL,
+		)
+	}
+
 	return nil
 }
 
@@ -278,10 +347,18 @@ func validateOrderKafka(cfg config.OrderConfig) error {
 		return errors.New("kafka.topics.order_status_updated is required")
 	}
 
+	if cfg.Kafka.Topics.OrderFilled == "" {
+		return errors.New("kafka.topics.order_filled is required")
+	}
+
 	if cfg.Kafka.Topics.MarketStateChanged == "" {
 		return errors.New("kafka.topics.market_state_changed is required")
 	}
 
+	if cfg.Kafka.Topics.AuthSecurity == "" {
+		return errors.New("kafka.topics.auth_security is required")
+	}
+
 	if cfg.Kafka.Consumer.DLQEnabled && cfg.Kafka.Topics.MarketStateChangedDLQ == "" {
 		return errors.New("kafka.topics.market_state_changed_dlq is required when dlq_enabled=true")
 	}
@@ -323,6 +400,13 @@ func validateOrderRateLimits(cfg config.OrderConfig) error {
 		)
 	}
 
+	if cfg.GRPCRateLimit.Login <= 0 {
+		return fmt.Errorf(
+			"grpc_rate_limit.login must be greater than 0, got %d",
+			cfg.GRPCRateLimit.Login,
+		)
+	}
+
 	if cfg.RateLimitByUser.CreateOrder <= 0 {
 		return fmt.Errorf(
 			"rate_limit_by_user.create_order must be greater than 0, got %d",
@@ -346,3 +430,26 @@ func validateOrderRateLimits(cfg config.OrderConfig) error {
 
 	return nil
 }
+
+func validateOrderMarketReplica(cfg config.OrderConfig) error {
+	if cfg.MarketReplica.ResyncInterval <= 0 {
+		return fmt.Errorf(
+			"market_replica.resync_interval must be greater than 0, got %s",
+			cfg.MarketReplica.ResyncInterval,
+		)
+	}
+
+	if cfg.MarketReplica.MaxLag <= cfg.MarketReplica.ResyncInterval {
+		return fmt.Errorf(
+			"market_replica.max_lag (%s) must be greater than market_replica.resync_interval (%s)",
+			cfg.MarketReplica.MaxLag,
+			cfg.MarketReplica.ResyncInterval,
+		)
+	}
+
+	if cfg.MarketReplica.PageSize == 0 {
+		return errors.New("market_replica.page_size must be greater than 0")
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package inbound
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/nastyazhadan/spot-order-grpc/orderService/internal/domain/models"
+	proto "github.com/nastyazhadan/spot-order-grpc/protos/gen/go/auth/v1"
+)
+
+func APIKeyToProto(key models.APIKey) *proto.APIKey {
+	scopes := make([]proto.APIKeyScope, 0, len(key.Scopes))
+	for _, scope := range key.Scopes {
+		scopes = append(scopes, apiKeyScopeToProto(scope))
+	}
+
+	result := &proto.APIKey{
+		KeyId:      key.ID.String(),
+		Name:       key.Name,
+		Scopes:     scopes,
+		AllowedIps: make([]string, 0, len(key.AllowedIPs)),
+		CreatedAt:  timestamppb.New(key.CreatedAt),
+	}
+	for _, prefix := range key.AllowedIPs {
+		result.AllowedIps = append(result.AllowedIps, prefix.String())
+	}
+	if key.LastUsedAt != nil {
+		result.LastUsedAt = timestamppb.New(*key.LastUsedAt)
+	}
+
+	return result
+}
+
+func APIKeysToProto(keys []models.APIKey) []*proto.APIKey {
+	result := make([]*proto.APIKey, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, APIKeyToProto(key))
+	}
+
+	return result
+}
+
+func APIKeyScopesFromProto(scopes []proto.APIKeyScope) ([]models.APIKeyScope, error) {
+	result := make([]models.APIKeyScope, 0, len(scopes))
+	for _, scope := range scopes {
+		switch scope {
+		case proto.APIKeyScope_API_KEY_SCOPE_ORDERS_READ:
+			result = append(result, models.APIKeyScopeOrdersRead)
+		case proto.APIKeyScope_API_KEY_SCOPE_ORDERS_WRITE:
+			result = append(result, models.APIKeyScopeOrdersWrite)
+		default:
+			return nil, fmt.Errorf("unsupported api key scope %s", scope)
+		}
+	}
+
+	return result, nil
+}
+
+// ParseAllowedIPs принимает адреса и CIDR-подсети; одиночный адрес становится /32 или /128.
+func ParseAllowedIPs(values []string) ([]netip.Prefix, error) {
+	result := make([]netip.Prefix, 0, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+
+		if strings.Contains(value, "/") {
+			prefix, err := netip.ParsePrefix(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid allowed ip %q", value)
+			}
+			result = append(result, prefix.Masked())
+			continue
+		}
+
+		addr, err := netip.ParseAddr(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid allowed ip %q", value)
+		}
+		addr = addr.Unmap()
+		result = append(result, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+
+	return result, nil
+}
+
+func apiKeyScopeToProto(scope models.APIKeyScope) proto.APIKeyScope {
+	switch scope {
+	case models.APIKeyScopeOrdersRead:
+		return proto.APIKeyScope_API_KEY_SCOPE_ORDERS_READ
+	case models.APIKeyScopeOrdersWrite:
+		return proto.APIKeyScope_API_KEY_SCOPE_ORDERS_WRITE
+	default:
+		return proto.APIKeyScope_API_KEY_SCOPE_UNSPECIFIED
+	}
+}
@@ -12,46 +12,89 @@ import (
 	"github.com/nastyazhadan/spot-order-grpc/shared/models"
 )
 
-func UnmarshalMarketStateChanged(data []byte) (models.MarketStateChangedEvent, error) {
-	var protobuf protoEvent.MarketStateChangedEvent
+func UnmarshalMarketUpdated(data []byte) (models.MarketUpdatedEvent, error) {
+	var protobuf protoEvent.MarketUpdatedEvent
 	if err := proto.Unmarshal(data, &protobuf); err != nil {
-		return models.MarketStateChangedEvent{}, fmt.Errorf("proto.UnmarshalMarketStateChanged: %w", err)
+		return models.MarketUpdatedEvent{}, fmt.Errorf("proto.UnmarshalMarketUpdated: %w", err)
 	}
 
-	return FromProtoMarketStateChanged(&protobuf)
+	return FromProtoMarketUpdated(&protobuf)
 }
 
-func FromProtoMarketStateChanged(msg *protoEvent.MarketStateChangedEvent) (models.MarketStateChangedEvent, error) {
+func FromProtoMarketUpdated(msg *protoEvent.MarketUpdatedEvent) (models.MarketUpdatedEvent, error) {
 	if msg == nil {
-		return models.MarketStateChangedEvent{}, fmt.Errorf("market state changed event is nil")
+		return models.MarketUpdatedEvent{}, fmt.Errorf("market updated event is nil")
 	}
 
 	eventID, err := parseUUIDRequired("event_id", msg.GetEventId())
 	if err != nil {
-		return models.MarketStateChangedEvent{}, err
+		return models.MarketUpdatedEvent{}, err
 	}
 
 	marketID, err := parseUUIDRequired("market_id", msg.GetMarketId())
 	if err != nil {
-		return models.MarketStateChangedEvent{}, err
+		return models.MarketUpdatedEvent{}, err
 	}
 
 	updatedAt, err := fromProtoTimestamp("updated_at", msg.GetUpdatedAt())
 	if err != nil {
-		return models.MarketStateChangedEvent{}, err
+		return models.MarketUpdatedEvent{}, err
 	}
 
 	deletedAt, err := fromProtoTimestampOptional("deleted_at", msg.GetDeletedAt())
 	if err != nil {
-		return models.MarketStateChangedEvent{}, err
+		return models.MarketUpdatedEvent{}, err
 	}
 
-	return models.MarketStateChangedEvent{
-		EventID:   eventID,
-		MarketID:  marketID,
-		Enabled:   msg.GetEnabled(),
-		DeletedAt: deletedAt,
-		UpdatedAt: updatedAt,
+	changedFields := make([]models.MarketField, 0, len(msg.GetChangedFields()))
+	for _, field := range msg.GetChangedFields() {
+		changedFields = append(changedFields, models.MarketField(field))
+	}
+
+	previous, err := fromProtoPreviousValues(msg.GetPrevious())
+	if err != nil {
+		return models.MarketUpdatedEvent{}, err
+	}
+
+	return models.MarketUpdatedEvent{
+		EventID:       eventID,
+		MarketID:      marketID,
+		Version:       msg.GetVersion(),
+		Name:          msg.GetName(),
+		BaseAsset:     msg.GetBaseAsset(),
+		QuoteAsset:    msg.GetQuoteAsset(),
+		Enabled:       msg.GetEnabled(),
+		DeletedAt:     deletedAt,
+		UpdatedAt:     updatedAt,
+		Restricted:    msg.GetRestricted(),
+		ChangedFields: changedFields,
+		Previous:      previous,
+
+		PublicToAllRoles: msg.GetPublicToAllRoles(),
+	}, nil
+}
+
+func fromProtoPreviousValues(msg *protoEvent.MarketPreviousValues) (models.MarketPreviousValues, error) {
+	if msg == nil {
+		return models.MarketPreviousValues{}, nil
+	}
+
+	var deletedAt *models.OptionalTime
+	if msg.GetDeletedAt() != nil {
+		value, err := fromProtoTimestampOptional("previous.deleted_at", msg.GetDeletedAt().GetValue())
+		if err != nil {
+			return models.MarketPreviousValues{}, err
+		}
+		deletedAt = &models.OptionalTime{Value: value}
+	}
+
+	return models.MarketPreviousValues{
+		Name:       msg.Name,
+		BaseAsset:  msg.BaseAsset,
+		QuoteAsset: msg.QuoteAsset,
+		Enabled:    msg.Enabled,
+		DeletedAt:  deletedAt,
+		Restricted: msg.Restricted,
 	}, nil
 }
 
@@ -0,0 +1,24 @@
+package inbound
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/nastyazhadan/spot-order-grpc/orderService/internal/domain/models"
+	proto "github.com/nastyazhadan/spot-order-grpc/protos/gen/go/auth/v1"
+)
+
+func SessionsToProto(sessions []models.Session, currentSessionID string) []*proto.Session {
+	result := make([]*proto.Session, 0, len(sessions))
+	for _, session := range sessions {
+		result = append(result, &proto.Session{
+			SessionId:     session.ID,
+			Device:        session.Device,
+			Ip:            session.IP,
+			CreatedAt:     timestamppb.New(session.CreatedAt),
+			LastRefreshAt: timestamppb.New(session.LastRefreshAt),
+			Current:       session.ID == currentSessionID,
+		})
+	}
+
+	return result
+}
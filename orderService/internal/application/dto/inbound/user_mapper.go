@@ -0,0 +1,66 @@
+package inbound
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/nastyazhadan/spot-order-grpc/orderService/internal/domain/models"
+	proto "github.com/nastyazhadan/spot-order-grpc/protos/gen/go/auth/v1"
+	sharedModels "github.com/nastyazhadan/spot-order-grpc/shared/models"
+)
+
+func UserToProto(user models.User) *proto.User {
+	roles := make([]proto.UserRole, 0, len(user.Roles))
+	for _, role := range user.Roles {
+		roles = append(roles, userRoleToProto(role))
+	}
+
+	return &proto.User{
+		UserId:    user.ID.String(),
+		Username:  user.Username,
+		Roles:     roles,
+		Disabled:  user.Disabled,
+		CreatedAt: timestamppb.New(user.CreatedAt),
+		UpdatedAt: timestamppb.New(user.UpdatedAt),
+	}
+}
+
+func UserRoleFromProto(role proto.UserRole) (sharedModels.UserRole, error) {
+	switch role {
+	case proto.UserRole_USER_ROLE_USER:
+		return sharedModels.UserRoleUser, nil
+	case proto.UserRole_USER_ROLE_ADMIN:
+		return sharedModels.UserRoleAdmin, nil
+	case proto.UserRole_USER_ROLE_VIEWER:
+		return sharedModels.UserRoleViewer, nil
+	default:
+		return sharedModels.UserRoleUnspecified, fmt.Errorf("unsupported user role %s", role)
+	}
+}
+
+func UserRolesFromProto(roles []proto.UserRole) ([]sharedModels.UserRole, error) {
+	result := make([]sharedModels.UserRole, 0, len(roles))
+	for _, value := range roles {
+		role, err := UserRoleFromProto(value)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, role)
+	}
+
+	return result, nil
+}
+
+func userRoleToProto(role sharedModels.UserRole) proto.UserRole {
+	switch role {
+	case sharedModels.UserRoleUser:
+		return proto.UserRole_USER_ROLE_USER
+	case sharedModels.UserRoleAdmin:
+		return proto.UserRole_USER_ROLE_ADMIN
+	case sharedModels.UserRoleViewer:
+		return proto.UserRole_USER_ROLE_VIEWER
+	default:
+		return proto.UserRole_USER_ROLE_UNSPECIFIED
+	}
+}
@@ -36,6 +36,15 @@ func MarshalOrderStatusUpdated(event models.OrderStatusUpdatedEvent) ([]byte, er
 	return data, nil
 }
 
+func MarshalOrderFilled(event models.OrderFilledEvent) ([]byte, error) {
+	data, err := proto.Marshal(ToProtoOrderFilled(event))
+	if err != nil {
+		return nil, fmt.Errorf("proto.MarshalOrderFilled: %w", err)
+	}
+
+	return data, nil
+}
+
 func ToProtoOrderCreated(event models.OrderCreatedEvent) *protoEvent.OrderCreatedEvent {
 	return &protoEvent.OrderCreatedEvent{
 		EventId:   event.EventID.String(),
@@ -61,6 +70,17 @@ func ToProtoOrderStatusUpdated(event models.OrderStatusUpdatedEvent) *protoEvent
 	}
 }
 
+func ToProtoOrderFilled(event models.OrderFilledEvent) *protoEvent.OrderFilledEvent {
+	return &protoEvent.OrderFilledEvent{
+		EventId:  event.EventID.String(),
+		OrderId:  event.OrderID.String(),
+		MarketId: event.MarketID.String(),
+		Price:    toProtoDecimal(event.Price),
+		Quantity: event.Quantity,
+		FilledAt: timestamppb.New(event.FilledAt.UTC()),
+	}
+}
+
 // OrderCreatedKey - Использование order_id как ключа гарантирует, что все сообщения
 // для одной заявки попадают в одну партицию (ordering per order)
 func OrderCreatedKey(orderID uuid.UUID) []byte {
@@ -0,0 +1,32 @@
+package kafka
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/nastyazhadan/spot-order-grpc/orderService/internal/domain/models"
+	protoEvent "github.com/nastyazhadan/spot-order-grpc/protos/gen/go/events/v1"
+)
+
+func MarshalSecurityEvent(event models.SecurityEvent) ([]byte, error) {
+	data, err := proto.Marshal(ToProtoSecurityEvent(event))
+	if err != nil {
+		return nil, fmt.Errorf("proto.MarshalSecurityEvent: %w", err)
+	}
+
+	return data, nil
+}
+
+func ToProtoSecurityEvent(event models.SecurityEvent) *protoEvent.SecurityEvent {
+	return &protoEvent.SecurityEvent{
+		EventId:    event.EventID.String(),
+		EventType:  event.Type,
+		UserId:     event.UserID.String(),
+		SessionId:  event.SessionID,
+		Ip:         event.Client.IP,
+		Device:     event.Client.Device,
+		OccurredAt: timestamppb.New(event.OccurredAt.UTC()),
+	}
+}
@@ -0,0 +1,98 @@
+package postgres
+
+import (
+	"fmt"
+	"net/netip"
+	"time"
+
+	"github.com/google/uuid"
+
+	domainModels "github.com/nastyazhadan/spot-order-grpc/orderService/internal/domain/models"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+)
+
+type APIKey struct {
+	ID         uuid.UUID  `db:"id"`
+	UserID     uuid.UUID  `db:"user_id"`
+	Name       string     `db:"name"`
+	Scopes     []string   `db:"scopes"`
+	AllowedIPs []string   `db:"allowed_ips"`
+	CreatedAt  time.Time  `db:"created_at"`
+	LastUsedAt *time.Time `db:"last_used_at"`
+}
+
+type APIKeyCredential struct {
+	APIKey
+	OwnerRoles    []string `db:"owner_roles"`
+	OwnerDisabled bool     `db:"owner_disabled"`
+}
+
+func (k APIKey) ToDomain() (domainModels.APIKey, error) {
+	scopes := make([]domainModels.APIKeyScope, 0, len(k.Scopes))
+	for _, value := range k.Scopes {
+		scope, ok := domainModels.ParseAPIKeyScope(value)
+		if !ok {
+			return domainModels.APIKey{}, fmt.Errorf("unknown scope %q of api key %s", value, k.ID)
+		}
+		scopes = append(scopes, scope)
+	}
+
+	allowedIPs := make([]netip.Prefix, 0, len(k.AllowedIPs))
+	for _, value := range k.AllowedIPs {
+		prefix, err := netip.ParsePrefix(value)
+		if err != nil {
+			return domainModels.APIKey{}, fmt.Errorf("invalid allowed ip %q of api key %s: %w", value, k.ID, err)
+		}
+		allowedIPs = append(allowedIPs, prefix)
+	}
+
+	return domainModels.APIKey{
+		ID:         k.ID,
+		UserID:     k.UserID,
+		Name:       k.Name,
+		Scopes:     scopes,
+		AllowedIPs: allowedIPs,
+		CreatedAt:  k.CreatedAt,
+		LastUsedAt: k.LastUsedAt,
+	}, nil
+}
+
+func (c APIKeyCredential) ToDomain() (domainModels.APIKeyCredential, error) {
+	key, err := c.APIKey.ToDomain()
+	if err != nil {
+		return domainModels.APIKeyCredential{}, err
+	}
+
+	roles := make([]models.UserRole, 0, len(c.OwnerRoles))
+	for _, value := range c.OwnerRoles {
+		role, ok := models.ParseUserRole(value)
+		if !ok {
+			return domainModels.APIKeyCredential{}, fmt.Errorf("unknown role %q of user %s", value, c.UserID)
+		}
+		roles = append(roles, role)
+	}
+
+	return domainModels.APIKeyCredential{
+		Key:           key,
+		OwnerRoles:    roles,
+		OwnerDisabled: c.OwnerDisabled,
+	}, nil
+}
+
+func APIKeyScopesToStrings(scopes []domainModels.APIKeyScope) []string {
+	result := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		result = append(result, string(scope))
+	}
+
+	return result
+}
+
+func PrefixesToStrings(prefixes []netip.Prefix) []string {
+	result := make([]string, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		result = append(result, prefix.String())
+	}
+
+	return result
+}
@@ -0,0 +1,41 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+)
+
+// ReplicaMarket — строка markets. SyncedAt — момент, на который spot подтвердил её состояние.
+type ReplicaMarket struct {
+	ID         uuid.UUID  `db:"id"`
+	Name       string     `db:"name"`
+	BaseAsset  string     `db:"base_asset"`
+	QuoteAsset string     `db:"quote_asset"`
+	Enabled    bool       `db:"enabled"`
+	DeletedAt  *time.Time `db:"deleted_at"`
+	UpdatedAt  time.Time  `db:"updated_at"`
+	Version    int64      `db:"version"`
+	Restricted bool       `db:"restricted"`
+	SyncedAt   time.Time  `db:"synced_at"`
+
+	PublicToAllRoles bool `db:"public_to_all_roles"`
+}
+
+func (m ReplicaMarket) ToDomain() models.Market {
+	return models.Market{
+		ID:         m.ID,
+		Name:       m.Name,
+		BaseAsset:  m.BaseAsset,
+		QuoteAsset: m.QuoteAsset,
+		Enabled:    m.Enabled,
+		DeletedAt:  m.DeletedAt,
+		UpdatedAt:  m.UpdatedAt,
+		Version:    m.Version,
+		Restricted: m.Restricted,
+
+		PublicToAllRoles: m.PublicToAllRoles,
+	}
+}
@@ -0,0 +1,51 @@
+package postgres
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	domainModels "github.com/nastyazhadan/spot-order-grpc/orderService/internal/domain/models"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+)
+
+type User struct {
+	ID           uuid.UUID `db:"id"`
+	Username     string    `db:"username"`
+	PasswordHash string    `db:"password_hash"`
+	Roles        []string  `db:"roles"`
+	Disabled     bool      `db:"disabled"`
+	CreatedAt    time.Time `db:"created_at"`
+	UpdatedAt    time.Time `db:"updated_at"`
+}
+
+func (u User) ToDomain() (domainModels.User, error) {
+	roles := make([]models.UserRole, 0, len(u.Roles))
+	for _, value := range u.Roles {
+		role, ok := models.ParseUserRole(value)
+		if !ok {
+			return domainModels.User{}, fmt.Errorf("unknown role %q of user %s", value, u.ID)
+		}
+		roles = append(roles, role)
+	}
+
+	return domainModels.User{
+		ID:           u.ID,
+		Username:     u.Username,
+		PasswordHash: u.PasswordHash,
+		Roles:        roles,
+		Disabled:     u.Disabled,
+		CreatedAt:    u.CreatedAt,
+		UpdatedAt:    u.UpdatedAt,
+	}, nil
+}
+
+func UserRolesToStrings(roles []models.UserRole) []string {
+	result := make([]string, 0, len(roles))
+	for _, role := range roles {
+		result = append(result, role.String())
+	}
+
+	return result
+}
@@ -18,6 +18,7 @@ import (
 	grpcAuth "github.com/nastyazhadan/spot-order-grpc/orderService/internal/grpc/auth"
 	grpcOrder "github.com/nastyazhadan/spot-order-grpc/orderService/internal/grpc/order"
 	orderService "github.com/nastyazhadan/spot-order-grpc/orderService/internal/services/order"
+	authjwt "github.com/nastyazhadan/spot-order-grpc/shared/auth/jwt"
 	grpcClient "github.com/nastyazhadan/spot-order-grpc/shared/client/grpc"
 	"github.com/nastyazhadan/spot-order-grpc/shared/config"
 	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/health"
@@ -49,12 +50,13 @@ var GRPCProviders = fx.Options(
 
 func provideClientConnection(
 	cfg config.OrderConfig,
+	serviceTokens *authjwt.ServiceTokenSource,
 ) (*grpc.ClientConn, error) {
 	connection, err := grpc.NewClient(
 		cfg.SpotAddress,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithChainUnaryInterceptor(
-			auth.UnaryClientAuthInterceptor(),
+			auth.UnaryClientAuthInterceptor(serviceTokens),
 			tracing.UnaryClientInterceptor(),
 			retry.UnaryClientInterceptor(
 				retry.WithMax(cfg.Retry.MaxAttempts),
@@ -124,11 +126,22 @@ func provideGRPCServer(
 	recoverer := recovery.UnaryServerInterceptor(appLogger)
 	tracer := tracing.UnaryServerInterceptor()
 	logger := logInterceptor.UnaryServerInterceptor(appLogger)
-	authenticator := auth.UnaryServerInterceptor(container.JWTManager, cfg.AuthVerifier)
+	// Запросы с metadata api-key проверяются по подписи HMAC, остальные — по JWT
+	authenticator := auth.UnaryAPIKeyServerInterceptor(
+		container.APIKeyService,
+		auth.UnaryServerInterceptor(container.JWTManager, container.SessionStore, auth.UserTokenTypes, cfg.AuthVerifier),
+	)
+	permissions := auth.MethodPermissions()
+	authorizer := auth.UnaryPermissionServerInterceptor(permissions, cfg.Service.Name, appLogger)
 	errorsMapper := grpcErrors.UnaryServerInterceptor(appLogger)
 	rateLimiter := ratelimit.OrderUnaryServerInterceptor(cfg, appLogger)
 	meter := metricInterceptor.UnaryServerInterceptor(cfg.Service.Name)
 
+	streamValidator, err := validate.StreamServerInterceptor()
+	if err != nil {
+		return nil, err
+	}
+
 	grpcServer := grpc.NewServer(
 		grpc.MaxRecvMsgSize(cfg.Service.MaxRecvMsgSize),
 		grpc.KeepaliveParams(keepalive.ServerParameters{
@@ -141,13 +154,28 @@ func provideGRPCServer(
 		}),
 		// logger до auth для логов auth, errorsMapper до auth, validator в начало
 		grpc.ChainUnaryInterceptor(
-			validator, recoverer, tracer, meter, logger, errorsMapper, authenticator, rateLimiter,
+			validator, recoverer, tracer, meter, logger, errorsMapper, authenticator, authorizer, rateLimiter,
+		),
+		grpc.ChainStreamInterceptor(
+			streamValidator,
+			recovery.StreamServerInterceptor(appLogger),
+			tracing.StreamServerInterceptor(),
+			metricInterceptor.StreamServerInterceptor(cfg.Service.Name),
+			logInterceptor.StreamServerInterceptor(appLogger),
+			grpcErrors.StreamServerInterceptor(appLogger),
+			auth.StreamAPIKeyServerInterceptor(
+				container.APIKeyService,
+				auth.StreamServerInterceptor(container.JWTManager, container.SessionStore, auth.UserTokenTypes, cfg.AuthVerifier),
+			),
+			auth.StreamPermissionServerInterceptor(permissions, cfg.Service.Name, appLogger),
 		),
 	)
 
 	reflection.Register(grpcServer)
 	health.RegisterService(grpcServer, healthServer)
 	grpcAuth.Register(grpcServer, container.AuthService)
+	grpcAuth.RegisterAPIKeys(grpcServer, container.APIKeyService)
+	grpcAuth.RegisterUserAdmin(grpcServer, container.UserAdminService)
 	grpcOrder.Register(grpcServer, container.OrderService, appLogger)
 
 	return grpcServer, nil
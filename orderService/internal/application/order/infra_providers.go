@@ -9,9 +9,12 @@ import (
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/fx"
 
+	apiKeyStore "github.com/nastyazhadan/spot-order-grpc/orderService/internal/infrastructure/postgres/apikey"
 	inboxStore "github.com/nastyazhadan/spot-order-grpc/orderService/internal/infrastructure/postgres/inbox"
+	replicaStore "github.com/nastyazhadan/spot-order-grpc/orderService/internal/infrastructure/postgres/market"
 	orderStore "github.com/nastyazhadan/spot-order-grpc/orderService/internal/infrastructure/postgres/order"
 	outboxStore "github.com/nastyazhadan/spot-order-grpc/orderService/internal/infrastructure/postgres/outbox"
+	userStore "github.com/nastyazhadan/spot-order-grpc/orderService/internal/infrastructure/postgres/user"
 	blockStore "github.com/nastyazhadan/spot-order-grpc/orderService/internal/infrastructure/redis/market"
 	"github.com/nastyazhadan/spot-order-grpc/shared/config"
 	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/cache"
@@ -31,6 +34,9 @@ var InfraProviders = fx.Options(
 		provideOutboxStore,
 		provideInboxStore,
 		provideBlockStore,
+		provideMarketReplicaStore,
+		provideUserStore,
+		provideAPIKeyStore,
 
 		provideSaramaAsyncProducer,
 		provideConsumerGroup,
@@ -109,6 +115,18 @@ func provideInboxStore(pool *pgxpool.Pool, cfg config.OrderConfig) *inboxStore.I
 	return inboxStore.New(pool, cfg)
 }
 
+func provideMarketReplicaStore(pool *pgxpool.Pool, cfg config.OrderConfig) *replicaStore.MarketReplicaStore {
+	return replicaStore.NewReplicaStore(pool, cfg)
+}
+
+func provideUserStore(pool *pgxpool.Pool, cfg config.OrderConfig) *userStore.UserStore {
+	return userStore.New(pool, cfg)
+}
+
+func provideAPIKeyStore(pool *pgxpool.Pool, cfg config.OrderConfig) *apiKeyStore.APIKeyStore {
+	return apiKeyStore.New(pool, cfg)
+}
+
 func provideSaramaAsyncProducer(cfg config.OrderConfig) (sarama.AsyncProducer, error) {
 	saramaCfg := sarama.NewConfig()
 	saramaCfg.ClientID = cfg.Service.Name
@@ -20,8 +20,10 @@ import (
 
 	outbox "github.com/nastyazhadan/spot-order-grpc/orderService/internal/infrastructure/kafka"
 	"github.com/nastyazhadan/spot-order-grpc/orderService/internal/services/consumer"
+	"github.com/nastyazhadan/spot-order-grpc/orderService/internal/services/replica"
 	authv1 "github.com/nastyazhadan/spot-order-grpc/protos/gen/go/auth/v1"
 	orderv1 "github.com/nastyazhadan/spot-order-grpc/protos/gen/go/order/v1"
+	authjwt "github.com/nastyazhadan/spot-order-grpc/shared/auth/jwt"
 	"github.com/nastyazhadan/spot-order-grpc/shared/config"
 	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/health"
 	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/kafka/producer"
@@ -44,6 +46,7 @@ var Lifecycle = fx.Options(
 		registerKafkaProducer,
 		registerOutboxWorker,
 		registerKafkaConsumer,
+		registerMarketSyncer,
 
 		registerReadiness,
 	),
@@ -104,17 +107,30 @@ func registerMetrics(
 	in appCtxIn,
 	lifeCycle fx.Lifecycle,
 	cfg config.OrderConfig,
+	signingKeys *authjwt.KeySet,
 	logger *zapLogger.Logger,
-) {
+) error {
 	appCtx := in.AppCtx
 	var listener net.Listener
 
+	mux := http.NewServeMux()
+	mux.Handle("/", promhttp.HandlerFor(
+		prometheus.DefaultGatherer,
+		promhttp.HandlerOpts{EnableOpenMetrics: true},
+	))
+
+	// JWKS публикуется рядом с метриками, чтобы spot-service мог проверять токены без секрета
+	if signingKeys != nil {
+		jwksHandler, err := authjwt.JWKSHandler(signingKeys)
+		if err != nil {
+			return fmt.Errorf("build jwks handler: %w", err)
+		}
+		mux.Handle(authjwt.JWKSPath, jwksHandler)
+	}
+
 	httpServer := &http.Server{
-		Addr: cfg.Metrics.HTTPAddress,
-		Handler: promhttp.HandlerFor(
-			prometheus.DefaultGatherer,
-			promhttp.HandlerOpts{EnableOpenMetrics: true},
-		),
+		Addr:         cfg.Metrics.HTTPAddress,
+		Handler:      mux,
 		ReadTimeout:  cfg.Metrics.ReadTimeout,
 		WriteTimeout: cfg.Metrics.WriteTimeout,
 		IdleTimeout:  cfg.Metrics.IdleTimeout,
@@ -154,6 +170,8 @@ func registerMetrics(
 			return shutdownError
 		},
 	})
+
+	return nil
 }
 
 func registerKafkaProducer(
@@ -299,6 +317,58 @@ func registerKafkaConsumer(
 	})
 }
 
+func registerMarketSyncer(
+	in appCtxIn,
+	lifecycle fx.Lifecycle,
+	syncer *replica.MarketSyncer,
+	logger *zapLogger.Logger,
+) {
+	appCtx := in.AppCtx
+
+	var (
+		syncerCtx context.Context
+		cancel    context.CancelFunc
+		done      chan struct{}
+	)
+
+	lifecycle.Append(fx.Hook{
+		OnStart: func(startCtx context.Context) error {
+			syncerCtx, cancel = context.WithCancel(appCtx)
+			done = make(chan struct{})
+
+			logger.Info(startCtx, "Market replica syncer: starting")
+
+			go func() {
+				defer close(done)
+
+				err := recovery.PanicRecoveryHandler(syncerCtx, logger, "Market replica syncer",
+					func() error {
+						return syncer.Run(syncerCtx)
+					},
+				)
+				if err != nil && syncerCtx.Err() == nil {
+					logger.Error(syncerCtx, "Market replica syncer stopped with error", zap.Error(err))
+				}
+			}()
+
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			logger.Info(stopCtx, "Market replica syncer: stopping")
+			cancel()
+
+			select {
+			case <-done:
+				logger.Info(stopCtx, "Market replica syncer: stopped")
+				return nil
+			case <-stopCtx.Done():
+				logger.Warn(stopCtx, "Market replica syncer: stop timeout exceeded", zap.Error(stopCtx.Err()))
+				return stopCtx.Err()
+			}
+		},
+	})
+}
+
 func registerReadiness(
 	in appCtxIn,
 	lifecycle fx.Lifecycle,
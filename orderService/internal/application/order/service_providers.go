@@ -2,25 +2,33 @@ package order
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/IBM/sarama"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"go.uber.org/fx"
 
 	outbox "github.com/nastyazhadan/spot-order-grpc/orderService/internal/infrastructure/kafka"
+	apiKeyStore "github.com/nastyazhadan/spot-order-grpc/orderService/internal/infrastructure/postgres/apikey"
 	inboxStore "github.com/nastyazhadan/spot-order-grpc/orderService/internal/infrastructure/postgres/inbox"
+	replicaStore "github.com/nastyazhadan/spot-order-grpc/orderService/internal/infrastructure/postgres/market"
 	orderStore "github.com/nastyazhadan/spot-order-grpc/orderService/internal/infrastructure/postgres/order"
 	outboxStore "github.com/nastyazhadan/spot-order-grpc/orderService/internal/infrastructure/postgres/outbox"
+	userStore "github.com/nastyazhadan/spot-order-grpc/orderService/internal/infrastructure/postgres/user"
 	authStore "github.com/nastyazhadan/spot-order-grpc/orderService/internal/infrastructure/redis/auth"
 	idemStore "github.com/nastyazhadan/spot-order-grpc/orderService/internal/infrastructure/redis/idempotency"
 	blockStore "github.com/nastyazhadan/spot-order-grpc/orderService/internal/infrastructure/redis/market"
 	orderCache "github.com/nastyazhadan/spot-order-grpc/orderService/internal/infrastructure/redis/order"
+	apiKeyService "github.com/nastyazhadan/spot-order-grpc/orderService/internal/services/apikey"
 	authService "github.com/nastyazhadan/spot-order-grpc/orderService/internal/services/auth"
 	"github.com/nastyazhadan/spot-order-grpc/orderService/internal/services/consumer"
 	orderService "github.com/nastyazhadan/spot-order-grpc/orderService/internal/services/order"
 	"github.com/nastyazhadan/spot-order-grpc/orderService/internal/services/producer"
+	"github.com/nastyazhadan/spot-order-grpc/orderService/internal/services/replica"
+	userAdminService "github.com/nastyazhadan/spot-order-grpc/orderService/internal/services/useradmin"
 	authjwt "github.com/nastyazhadan/spot-order-grpc/shared/auth/jwt"
 	authsession "github.com/nastyazhadan/spot-order-grpc/shared/auth/session"
+	grpcClient "github.com/nastyazhadan/spot-order-grpc/shared/client/grpc"
 	"github.com/nastyazhadan/spot-order-grpc/shared/config"
 	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/cache"
 	sharedConsumer "github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/kafka/consumer"
@@ -38,10 +46,16 @@ var ServiceProviders = fx.Options(
 	fx.Provide(
 		provideRateLimiters,
 
+		provideSigningKeySet,
 		provideJWTManager,
+		provideServiceTokenSource,
 		provideRefreshTokenStore,
 		provideSessionStore,
+		provideLoginAttemptStore,
+		provideSecurityEventProducer,
 		provideAuthService,
+		provideAPIKeyService,
+		provideUserAdminService,
 
 		provideKafkaClient,
 		provideKafkaPublisher,
@@ -50,7 +64,9 @@ var ServiceProviders = fx.Options(
 
 		provideOutboxWorker,
 		provideCompensationService,
+		provideFillService,
 		provideConsumerService,
+		provideMarketSyncer,
 
 		provideIdempotencyService,
 		provideOrderService,
@@ -63,7 +79,10 @@ type container struct {
 	SessionStore      *authsession.Store
 	RefreshTokenStore *authStore.RefreshTokenStore
 	AuthService       *authService.AuthService
+	APIKeyService     *apiKeyService.APIKeyService
+	UserAdminService  *userAdminService.UserAdminService
 	OrderService      *orderService.OrderService
+	FillService       *orderService.FillService
 }
 
 func provideRateLimiters(store *cache.Store, cfg config.OrderConfig) orderService.RateLimiters {
@@ -83,7 +102,26 @@ func provideRateLimiters(store *cache.Store, cfg config.OrderConfig) orderServic
 	}
 }
 
-func provideJWTManager(cfg config.OrderConfig) *authjwt.Manager {
+// provideSigningKeySet возвращает nil для HS256 — тогда JWKS не публикуется.
+func provideSigningKeySet(cfg config.OrderConfig) (*authjwt.KeySet, error) {
+	signing := cfg.AuthIssuer.Signing
+	if signing.IsSymmetric() {
+		return nil, nil
+	}
+
+	keys, err := authjwt.LoadKeySet(signing.KeysDir, signing.Algorithm, signing.ActiveKeyID)
+	if err != nil {
+		return nil, fmt.Errorf("load signing keys: %w", err)
+	}
+
+	return keys, nil
+}
+
+func provideJWTManager(cfg config.OrderConfig, keys *authjwt.KeySet) *authjwt.Manager {
+	if keys != nil {
+		return authjwt.NewKeySetManager(keys, cfg.AuthIssuer.AccessTokenTTL, cfg.AuthIssuer.RefreshTokenTTL)
+	}
+
 	return authjwt.NewManager(
 		cfg.AuthVerifier.JWTSecret,
 		cfg.AuthIssuer.AccessTokenTTL,
@@ -91,22 +129,49 @@ func provideJWTManager(cfg config.OrderConfig) *authjwt.Manager {
 	)
 }
 
+// provideServiceTokenSource — токен ROLE_SERVICE order-service для вызовов spot из фоновых задач.
+// order-service сам выпускает токены, поэтому обмен client credentials по сети не нужен
+func provideServiceTokenSource(jwtManager *authjwt.Manager, cfg config.OrderConfig) *authjwt.ServiceTokenSource {
+	return authjwt.NewServiceTokenSource(jwtManager, cfg.Service.Name, cfg.AuthIssuer.ServiceTokenTTL)
+}
+
 func provideRefreshTokenStore(store *cache.Store, cfg config.OrderConfig) *authStore.RefreshTokenStore {
-	return authStore.New(store, cfg.AuthIssuer.RefreshTokenTTL)
+	return authStore.New(store, cfg.AuthIssuer.RefreshTokenTTL, cfg.AuthIssuer.MaxSessions)
 }
 
 func provideSessionStore(store *cache.Store) *authsession.Store {
 	return authsession.New(store)
 }
 
+func provideLoginAttemptStore(store *cache.Store, cfg config.OrderConfig) *authStore.LoginAttemptStore {
+	return authStore.NewLoginAttemptStore(
+		store,
+		cfg.AuthIssuer.Login.MaxFailedAttempts,
+		cfg.AuthIssuer.Login.Lockout,
+	)
+}
+
 func provideAuthService(
 	jwtManager *authjwt.Manager,
 	refreshStore *authStore.RefreshTokenStore,
 	sessionStore *authsession.Store,
+	users *userStore.UserStore,
+	attemptStore *authStore.LoginAttemptStore,
+	securityEvents *producer.SecurityProducer,
 	cfg config.OrderConfig,
 	logger *zapLogger.Logger,
 ) *authService.AuthService {
-	return authService.New(jwtManager, refreshStore, sessionStore, cfg.Timeouts.Service, logger)
+	return authService.New(
+		jwtManager,
+		refreshStore,
+		sessionStore,
+		users,
+		attemptStore,
+		securityEvents,
+		cfg.Timeouts.Service,
+		cfg.Service.Name,
+		logger,
+	)
 }
 
 func provideKafkaClient(
@@ -121,6 +186,44 @@ func provideKafkaClient(
 	)
 }
 
+func provideAPIKeyService(
+	keys *apiKeyStore.APIKeyStore,
+	store *cache.Store,
+	jwtManager *authjwt.Manager,
+	cfg config.OrderConfig,
+	logger *zapLogger.Logger,
+) *apiKeyService.APIKeyService {
+	return apiKeyService.New(
+		keys,
+		authStore.NewAPIKeyReplayStore(store, cfg.AuthIssuer.APIKeys.ReplayWindow),
+		jwtManager,
+		[]byte(cfg.AuthIssuer.APIKeys.Pepper),
+		cfg.AuthIssuer.APIKeys.ReplayWindow,
+		cfg.AuthIssuer.APIKeys.MaxPerUser,
+		cfg.Service.Name,
+		logger,
+	)
+}
+
+func provideUserAdminService(
+	users *userStore.UserStore,
+	refreshStore *authStore.RefreshTokenStore,
+	logger *zapLogger.Logger,
+) *userAdminService.UserAdminService {
+	return userAdminService.New(users, refreshStore, logger)
+}
+
+func provideSecurityEventProducer(
+	client *sharedProducer.Client,
+	cfg config.OrderConfig,
+	logger *zapLogger.Logger,
+) *producer.SecurityProducer {
+	return producer.NewSecurityProducer(
+		sharedProducer.New(client, cfg.Kafka.Topics.AuthSecurity),
+		logger,
+	)
+}
+
 func provideKafkaPublisher(
 	client *sharedProducer.Client,
 	cfg config.OrderConfig,
@@ -161,6 +264,7 @@ func provideOrderService(
 	pool *pgxpool.Pool,
 	store *orderStore.OrderStore,
 	marketViewer orderService.MarketViewer,
+	marketReplica *replicaStore.MarketReplicaStore,
 	blockStore *blockStore.MarketBlockStore,
 	rateLimiters orderService.RateLimiters,
 	eventProducer orderService.EventProducer,
@@ -173,6 +277,7 @@ func provideOrderService(
 		store,
 		store,
 		marketViewer,
+		marketReplica,
 		blockStore,
 		rateLimiters,
 		eventProducer,
@@ -198,6 +303,7 @@ func provideCompensationService(
 	orderStore *orderStore.OrderStore,
 	inboxStore *inboxStore.InboxStore,
 	blockStore *blockStore.MarketBlockStore,
+	marketReplica *replicaStore.MarketReplicaStore,
 	eventProducer orderService.EventProducer,
 	logger *zapLogger.Logger,
 	cfg config.OrderConfig,
@@ -207,12 +313,23 @@ func provideCompensationService(
 		inboxStore,
 		orderStore,
 		blockStore,
+		marketReplica,
 		eventProducer,
 		logger,
 		cfg,
 	)
 }
 
+func provideFillService(
+	pool *pgxpool.Pool,
+	orderStore *orderStore.OrderStore,
+	eventProducer orderService.EventProducer,
+	logger *zapLogger.Logger,
+	cfg config.OrderConfig,
+) *orderService.FillService {
+	return orderService.NewFillService(pool, orderStore, eventProducer, logger, cfg)
+}
+
 func provideEventProducer(store *outboxStore.OutboxStore, logger *zapLogger.Logger) orderService.EventProducer {
 	return producer.New(store, logger)
 }
@@ -278,18 +395,42 @@ func provideConsumerService(
 	)
 }
 
+func provideMarketSyncer(
+	client *grpcClient.SpotClient,
+	marketReplica *replicaStore.MarketReplicaStore,
+	serviceTokens *authjwt.ServiceTokenSource,
+	cfg config.OrderConfig,
+	logger *zapLogger.Logger,
+) *replica.MarketSyncer {
+	return replica.NewMarketSyncer(
+		client,
+		marketReplica,
+		serviceTokens,
+		cfg.MarketReplica.ResyncInterval,
+		cfg.MarketReplica.PageSize,
+		cfg.Service.Name,
+		logger,
+	)
+}
+
 func provideContainer(
 	jwtManager *authjwt.Manager,
 	sessionStore *authsession.Store,
 	tokenStore *authStore.RefreshTokenStore,
 	authService *authService.AuthService,
+	apiKeys *apiKeyService.APIKeyService,
+	userAdmin *userAdminService.UserAdminService,
 	orderService *orderService.OrderService,
+	fillService *orderService.FillService,
 ) *container {
 	return &container{
 		JWTManager:        jwtManager,
 		SessionStore:      sessionStore,
 		RefreshTokenStore: tokenStore,
 		AuthService:       authService,
+		APIKeyService:     apiKeys,
+		UserAdminService:  userAdmin,
 		OrderService:      orderService,
+		FillService:       fillService,
 	}
 }
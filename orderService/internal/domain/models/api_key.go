@@ -0,0 +1,45 @@
+package models
+
+import (
+	"net/netip"
+	"time"
+
+	"github.com/google/uuid"
+
+	sharedModels "github.com/nastyazhadan/spot-order-grpc/shared/models"
+)
+
+type APIKeyScope string
+
+const (
+	APIKeyScopeOrdersRead  APIKeyScope = "orders:read"
+	APIKeyScopeOrdersWrite APIKeyScope = "orders:write"
+)
+
+func ParseAPIKeyScope(value string) (APIKeyScope, bool) {
+	switch scope := APIKeyScope(value); scope {
+	case APIKeyScopeOrdersRead, APIKeyScopeOrdersWrite:
+		return scope, true
+	default:
+		return "", false
+	}
+}
+
+// APIKey — ключ для подписи запросов HMAC. Секрет не хранится: он выводится из pepper сервиса и ID.
+// Пустой AllowedIPs снимает ограничение по адресу
+type APIKey struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	Name       string
+	Scopes     []APIKeyScope
+	AllowedIPs []netip.Prefix
+	CreatedAt  time.Time
+	LastUsedAt *time.Time
+}
+
+// APIKeyCredential — неотозванный ключ вместе с текущими ролями и статусом владельца
+type APIKeyCredential struct {
+	Key           APIKey
+	OwnerRoles    []sharedModels.UserRole
+	OwnerDisabled bool
+}
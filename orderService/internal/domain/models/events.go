@@ -24,6 +24,7 @@ const (
 
 	OrderCreatedEventType       = "order.created"
 	OrderStatusUpdatedEventType = "order.status.updated"
+	OrderFilledEventType        = "order.filled"
 )
 
 // OrderCreatedEvent публикуется в Kafka через Transactional Outbox
@@ -50,6 +51,17 @@ type OrderStatusUpdatedEvent struct {
 	UpdatedAt     time.Time
 }
 
+// OrderFilledEvent публикуется в Kafka через Transactional Outbox
+// в одной транзакции с переводом ордера в FILLED
+type OrderFilledEvent struct {
+	EventID  uuid.UUID
+	OrderID  uuid.UUID
+	MarketID uuid.UUID
+	Price    shared.Decimal
+	Quantity int64
+	FilledAt time.Time
+}
+
 // InboxEvent используется для дедупликации
 type InboxEvent struct {
 	ID            uuid.UUID        `db:"id"`
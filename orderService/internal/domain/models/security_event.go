@@ -0,0 +1,19 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const SecurityEventRefreshTokenReuse = "refresh_token_reuse"
+
+// SecurityEvent публикуется в Kafka напрямую, без outbox: к нему не привязана транзакция в БД
+type SecurityEvent struct {
+	EventID    uuid.UUID
+	Type       string
+	UserID     uuid.UUID
+	SessionID  string
+	Client     SessionClient
+	OccurredAt time.Time
+}
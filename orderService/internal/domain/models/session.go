@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// Session — refresh-сессия пользователя, одна на устройство.
+type Session struct {
+	ID            string
+	Device        string
+	IP            string
+	CreatedAt     time.Time
+	LastRefreshAt time.Time
+}
+
+// SessionClient — откуда открыта сессия: user-agent и адрес клиента из входящего запроса.
+type SessionClient struct {
+	Device string
+	IP     string
+}
@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	sharedModels "github.com/nastyazhadan/spot-order-grpc/shared/models"
+)
+
+// User — учётная запись для Login. Username хранится в нижнем регистре.
+type User struct {
+	ID           uuid.UUID
+	Username     string
+	PasswordHash string
+	Roles        []sharedModels.UserRole
+	Disabled     bool
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
@@ -0,0 +1,105 @@
+package auth
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nastyazhadan/spot-order-grpc/orderService/internal/application/dto/inbound"
+	"github.com/nastyazhadan/spot-order-grpc/orderService/internal/domain/models"
+	proto "github.com/nastyazhadan/spot-order-grpc/protos/gen/go/auth/v1"
+	"github.com/nastyazhadan/spot-order-grpc/shared/errors"
+)
+
+type APIKeyService interface {
+	Create(
+		ctx context.Context,
+		name string,
+		scopes []models.APIKeyScope,
+		allowedIPs []netip.Prefix,
+	) (models.APIKey, string, error)
+	List(ctx context.Context) ([]models.APIKey, error)
+	Revoke(ctx context.Context, keyID uuid.UUID) error
+}
+
+type apiKeyServerAPI struct {
+	proto.UnimplementedAPIKeyServiceServer
+	service APIKeyService
+}
+
+func RegisterAPIKeys(server *grpc.Server, service APIKeyService) {
+	proto.RegisterAPIKeyServiceServer(server, &apiKeyServerAPI{
+		service: service,
+	})
+}
+
+func (s *apiKeyServerAPI) CreateAPIKey(
+	ctx context.Context,
+	request *proto.CreateAPIKeyRequest,
+) (*proto.CreateAPIKeyResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, errors.MsgRequestRequired)
+	}
+
+	scopes, err := inbound.APIKeyScopesFromProto(request.GetScopes())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	allowedIPs, err := inbound.ParseAllowedIPs(request.GetAllowedIps())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	key, secret, err := s.service.Create(ctx, request.GetName(), scopes, allowedIPs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.CreateAPIKeyResponse{
+		ApiKey: inbound.APIKeyToProto(key),
+		Secret: secret,
+	}, nil
+}
+
+func (s *apiKeyServerAPI) ListAPIKeys(
+	ctx context.Context,
+	request *proto.ListAPIKeysRequest,
+) (*proto.ListAPIKeysResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, errors.MsgRequestRequired)
+	}
+
+	keys, err := s.service.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.ListAPIKeysResponse{
+		ApiKeys: inbound.APIKeysToProto(keys),
+	}, nil
+}
+
+func (s *apiKeyServerAPI) RevokeAPIKey(
+	ctx context.Context,
+	request *proto.RevokeAPIKeyRequest,
+) (*proto.RevokeAPIKeyResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, errors.MsgRequestRequired)
+	}
+
+	keyID, err := uuid.Parse(request.GetKeyId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid key_id")
+	}
+
+	if err = s.service.Revoke(ctx, keyID); err != nil {
+		return nil, err
+	}
+
+	return &proto.RevokeAPIKeyResponse{}, nil
+}
@@ -2,17 +2,41 @@ package auth
 
 import (
 	"context"
+	"net"
 
+	"github.com/google/uuid"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 
+	"github.com/nastyazhadan/spot-order-grpc/orderService/internal/application/dto/inbound"
+	"github.com/nastyazhadan/spot-order-grpc/orderService/internal/domain/models"
 	proto "github.com/nastyazhadan/spot-order-grpc/protos/gen/go/auth/v1"
 	"github.com/nastyazhadan/spot-order-grpc/shared/errors"
 )
 
+const (
+	userAgentHeader = "user-agent"
+	maxDeviceLength = 256
+)
+
 type AuthService interface {
-	Refresh(ctx context.Context, refreshToken string) (newAccessToken, newRefreshToken string, err error)
+	Login(
+		ctx context.Context,
+		username, password string,
+		client models.SessionClient,
+	) (accessToken, refreshToken string, err error)
+	Refresh(
+		ctx context.Context,
+		refreshToken string,
+		client models.SessionClient,
+	) (newAccessToken, newRefreshToken string, err error)
+	Logout(ctx context.Context) error
+	RevokeUserSessions(ctx context.Context, userID uuid.UUID) error
+	ListMySessions(ctx context.Context) ([]models.Session, string, error)
+	RevokeSession(ctx context.Context, sessionID string) error
 }
 
 type serverAPI struct {
@@ -26,6 +50,30 @@ func Register(server *grpc.Server, service AuthService) {
 	})
 }
 
+func (s *serverAPI) Login(
+	ctx context.Context,
+	request *proto.LoginRequest,
+) (*proto.LoginResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, errors.MsgRequestRequired)
+	}
+
+	accessToken, refreshToken, err := s.service.Login(
+		ctx,
+		request.GetUsername(),
+		request.GetPassword(),
+		sessionClientFromContext(ctx),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.LoginResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
 func (s *serverAPI) RefreshToken(
 	ctx context.Context,
 	request *proto.RefreshTokenRequest,
@@ -38,7 +86,11 @@ func (s *serverAPI) RefreshToken(
 		return nil, status.Error(codes.InvalidArgument, "refresh_token is required")
 	}
 
-	accessToken, refreshToken, err := s.service.Refresh(ctx, request.GetRefreshToken())
+	accessToken, refreshToken, err := s.service.Refresh(
+		ctx,
+		request.GetRefreshToken(),
+		sessionClientFromContext(ctx),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -48,3 +100,100 @@ func (s *serverAPI) RefreshToken(
 		RefreshToken: refreshToken,
 	}, nil
 }
+
+func (s *serverAPI) Logout(
+	ctx context.Context,
+	request *proto.LogoutRequest,
+) (*proto.LogoutResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, errors.MsgRequestRequired)
+	}
+
+	if err := s.service.Logout(ctx); err != nil {
+		return nil, err
+	}
+
+	return &proto.LogoutResponse{}, nil
+}
+
+func (s *serverAPI) RevokeUserSessions(
+	ctx context.Context,
+	request *proto.RevokeUserSessionsRequest,
+) (*proto.RevokeUserSessionsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, errors.MsgRequestRequired)
+	}
+
+	userID, err := uuid.Parse(request.GetUserId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	if err = s.service.RevokeUserSessions(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	return &proto.RevokeUserSessionsResponse{}, nil
+}
+
+func (s *serverAPI) ListMySessions(
+	ctx context.Context,
+	request *proto.ListMySessionsRequest,
+) (*proto.ListMySessionsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, errors.MsgRequestRequired)
+	}
+
+	sessions, currentSessionID, err := s.service.ListMySessions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.ListMySessionsResponse{
+		Sessions: inbound.SessionsToProto(sessions, currentSessionID),
+	}, nil
+}
+
+func (s *serverAPI) RevokeSession(
+	ctx context.Context,
+	request *proto.RevokeSessionRequest,
+) (*proto.RevokeSessionResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, errors.MsgRequestRequired)
+	}
+
+	sessionID, err := uuid.Parse(request.GetSessionId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid session_id")
+	}
+
+	if err = s.service.RevokeSession(ctx, sessionID.String()); err != nil {
+		return nil, err
+	}
+
+	return &proto.RevokeSessionResponse{}, nil
+}
+
+// sessionClientFromContext берёт user-agent из metadata и адрес из peer соединения.
+// За прокси это адрес прокси: X-Forwarded-For без доверенного списка не учитываем
+func sessionClientFromContext(ctx context.Context) models.SessionClient {
+	var client models.SessionClient
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(userAgentHeader); len(values) > 0 {
+			client.Device = values[0]
+			if len(client.Device) > maxDeviceLength {
+				client.Device = client.Device[:maxDeviceLength]
+			}
+		}
+	}
+
+	if remote, ok := peer.FromContext(ctx); ok && remote.Addr != nil {
+		client.IP = remote.Addr.String()
+		if host, _, err := net.SplitHostPort(client.IP); err == nil {
+			client.IP = host
+		}
+	}
+
+	return client
+}
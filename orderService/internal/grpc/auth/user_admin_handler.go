@@ -0,0 +1,144 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nastyazhadan/spot-order-grpc/orderService/internal/application/dto/inbound"
+	"github.com/nastyazhadan/spot-order-grpc/orderService/internal/domain/models"
+	proto "github.com/nastyazhadan/spot-order-grpc/protos/gen/go/auth/v1"
+	"github.com/nastyazhadan/spot-order-grpc/shared/errors"
+	sharedModels "github.com/nastyazhadan/spot-order-grpc/shared/models"
+)
+
+type UserAdminService interface {
+	CreateUser(
+		ctx context.Context,
+		username, password string,
+		roles []sharedModels.UserRole,
+	) (models.User, error)
+	AssignRole(ctx context.Context, userID uuid.UUID, role sharedModels.UserRole) (models.User, error)
+	RemoveRole(ctx context.Context, userID uuid.UUID, role sharedModels.UserRole) (models.User, error)
+	DisableUser(ctx context.Context, userID uuid.UUID) (models.User, error)
+}
+
+type userAdminServerAPI struct {
+	proto.UnimplementedUserAdminServiceServer
+	service UserAdminService
+}
+
+func RegisterUserAdmin(server *grpc.Server, service UserAdminService) {
+	proto.RegisterUserAdminServiceServer(server, &userAdminServerAPI{
+		service: service,
+	})
+}
+
+func (s *userAdminServerAPI) CreateUser(
+	ctx context.Context,
+	request *proto.CreateUserRequest,
+) (*proto.CreateUserResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, errors.MsgRequestRequired)
+	}
+
+	roles, err := inbound.UserRolesFromProto(request.GetRoles())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	user, err := s.service.CreateUser(ctx, request.GetUsername(), request.GetPassword(), roles)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.CreateUserResponse{
+		User: inbound.UserToProto(user),
+	}, nil
+}
+
+func (s *userAdminServerAPI) AssignUserRole(
+	ctx context.Context,
+	request *proto.AssignUserRoleRequest,
+) (*proto.AssignUserRoleResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, errors.MsgRequestRequired)
+	}
+
+	userID, role, err := parseUserRole(request.GetUserId(), request.GetRole())
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.service.AssignRole(ctx, userID, role)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.AssignUserRoleResponse{
+		User: inbound.UserToProto(user),
+	}, nil
+}
+
+func (s *userAdminServerAPI) RemoveUserRole(
+	ctx context.Context,
+	request *proto.RemoveUserRoleRequest,
+) (*proto.RemoveUserRoleResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, errors.MsgRequestRequired)
+	}
+
+	userID, role, err := parseUserRole(request.GetUserId(), request.GetRole())
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.service.RemoveRole(ctx, userID, role)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.RemoveUserRoleResponse{
+		User: inbound.UserToProto(user),
+	}, nil
+}
+
+func (s *userAdminServerAPI) DisableUser(
+	ctx context.Context,
+	request *proto.DisableUserRequest,
+) (*proto.DisableUserResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, errors.MsgRequestRequired)
+	}
+
+	userID, err := uuid.Parse(request.GetUserId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	user, err := s.service.DisableUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.DisableUserResponse{
+		User: inbound.UserToProto(user),
+	}, nil
+}
+
+func parseUserRole(rawUserID string, rawRole proto.UserRole) (uuid.UUID, sharedModels.UserRole, error) {
+	userID, err := uuid.Parse(rawUserID)
+	if err != nil {
+		return uuid.Nil, sharedModels.UserRoleUnspecified, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	role, err := inbound.UserRoleFromProto(rawRole)
+	if err != nil {
+		return uuid.Nil, sharedModels.UserRoleUnspecified, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return userID, role, nil
+}
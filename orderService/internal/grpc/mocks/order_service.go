@@ -5,11 +5,11 @@ package mocks
 import (
 	context "context"
 
-	mock "github.com/stretchr/testify/mock"
+	uuid "github.com/google/uuid"
 
 	shared "github.com/nastyazhadan/spot-order-grpc/orderService/internal/domain/models/shared"
 
-	uuid "github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // OrderService is an autogenerated mock type for the OrderService type
@@ -34,9 +34,7 @@ func (_m *OrderService) CreateOrder(ctx context.Context, userID uuid.UUID, marke
 	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, shared.OrderType, shared.Decimal, int64) uuid.UUID); ok {
 		r0 = rf(ctx, userID, marketID, orderType, price, quantity)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(uuid.UUID)
-		}
+		r0 = ret.Get(0).(uuid.UUID)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, shared.OrderType, shared.Decimal, int64) shared.OrderStatus); ok {
@@ -82,6 +80,34 @@ func (_m *OrderService) GetOrderStatus(ctx context.Context, orderID uuid.UUID, u
 	return r0, r1
 }
 
+// ResolveMarketSymbol provides a mock function with given fields: ctx, symbol
+func (_m *OrderService) ResolveMarketSymbol(ctx context.Context, symbol string) (uuid.UUID, error) {
+	ret := _m.Called(ctx, symbol)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveMarketSymbol")
+	}
+
+	var r0 uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (uuid.UUID, error)); ok {
+		return rf(ctx, symbol)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) uuid.UUID); ok {
+		r0 = rf(ctx, symbol)
+	} else {
+		r0 = ret.Get(0).(uuid.UUID)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, symbol)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewOrderService creates a new instance of OrderService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewOrderService(t interface {
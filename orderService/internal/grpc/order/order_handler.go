@@ -36,6 +36,8 @@ type OrderService interface {
 	GetOrderStatus(ctx context.Context,
 		orderID, userID uuid.UUID,
 	) (shared.OrderStatus, error)
+
+	ResolveMarketSymbol(ctx context.Context, symbol string) (uuid.UUID, error)
 }
 
 type serverAPI struct {
@@ -63,11 +65,11 @@ func (s *serverAPI) CreateOrder(
 	if !ok {
 		return nil, status.Error(codes.Unauthenticated, "user_id not found in token")
 	}
-	marketID, err := uuid.Parse(request.GetMarketId())
+	orderPrice, err := validatePrice(request)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "market_id must be a valid UUID")
+		return nil, err
 	}
-	orderPrice, err := validatePrice(request)
+	marketID, err := s.resolveMarketID(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -127,13 +129,29 @@ func (s *serverAPI) GetOrderStatus(
 	}, nil
 }
 
+func (s *serverAPI) resolveMarketID(
+	ctx context.Context,
+	request *proto.CreateOrderRequest,
+) (uuid.UUID, error) {
+	if symbol := request.GetMarketSymbol(); symbol != "" {
+		return s.service.ResolveMarketSymbol(ctx, symbol)
+	}
+
+	marketID, err := uuid.Parse(request.GetMarketId())
+	if err != nil {
+		return uuid.Nil, status.Error(codes.InvalidArgument, "market_id must be a valid UUID")
+	}
+
+	return marketID, nil
+}
+
 func validateCreateRequest(request *proto.CreateOrderRequest) error {
 	if request == nil {
 		return status.Error(codes.InvalidArgument, errors.MsgRequestRequired)
 	}
 
-	if request.GetMarketId() == "" {
-		return status.Error(codes.InvalidArgument, "market_id is required")
+	if request.GetMarketId() == "" && request.GetMarketSymbol() == "" {
+		return status.Error(codes.InvalidArgument, "market_id or market_symbol is required")
 	}
 
 	if request.GetOrderType() == protoCommon.OrderType_TYPE_UNSPECIFIED {
@@ -70,10 +70,9 @@ func TestCreateOrder(t *testing.T) {
 			},
 		},
 		{
-			name: "market_id пустой — InvalidArgument",
+			name: "ни market_id, ни market_symbol — InvalidArgument",
 			ctx:  ctxWithUserID(validUserID),
 			request: &proto.CreateOrderRequest{
-				MarketId:  "",
 				OrderType: protoCommon.OrderType_TYPE_LIMIT,
 				Price:     dec("100.00"),
 				Quantity:  10,
@@ -87,7 +86,7 @@ func TestCreateOrder(t *testing.T) {
 			name: "order_type UNSPECIFIED — InvalidArgument",
 			ctx:  ctxWithUserID(validUserID),
 			request: &proto.CreateOrderRequest{
-				MarketId:  validMarketID.String(),
+				Market:    &proto.CreateOrderRequest_MarketId{MarketId: validMarketID.String()},
 				OrderType: protoCommon.OrderType_TYPE_UNSPECIFIED,
 				Price:     dec("100.00"),
 				Quantity:  10,
@@ -101,7 +100,7 @@ func TestCreateOrder(t *testing.T) {
 			name: "quantity=0 — InvalidArgument",
 			ctx:  ctxWithUserID(validUserID),
 			request: &proto.CreateOrderRequest{
-				MarketId:  validMarketID.String(),
+				Market:    &proto.CreateOrderRequest_MarketId{MarketId: validMarketID.String()},
 				OrderType: protoCommon.OrderType_TYPE_LIMIT,
 				Price:     dec("100.00"),
 				Quantity:  0,
@@ -115,7 +114,7 @@ func TestCreateOrder(t *testing.T) {
 			name: "quantity отрицательный — InvalidArgument",
 			ctx:  ctxWithUserID(validUserID),
 			request: &proto.CreateOrderRequest{
-				MarketId:  validMarketID.String(),
+				Market:    &proto.CreateOrderRequest_MarketId{MarketId: validMarketID.String()},
 				OrderType: protoCommon.OrderType_TYPE_LIMIT,
 				Price:     dec("100.00"),
 				Quantity:  -5,
@@ -129,7 +128,7 @@ func TestCreateOrder(t *testing.T) {
 			name: "price nil — InvalidArgument",
 			ctx:  ctxWithUserID(validUserID),
 			request: &proto.CreateOrderRequest{
-				MarketId:  validMarketID.String(),
+				Market:    &proto.CreateOrderRequest_MarketId{MarketId: validMarketID.String()},
 				OrderType: protoCommon.OrderType_TYPE_LIMIT,
 				Price:     nil,
 				Quantity:  10,
@@ -143,7 +142,7 @@ func TestCreateOrder(t *testing.T) {
 			name: "price — невалидная строка — InvalidArgument",
 			ctx:  ctxWithUserID(validUserID),
 			request: &proto.CreateOrderRequest{
-				MarketId:  validMarketID.String(),
+				Market:    &proto.CreateOrderRequest_MarketId{MarketId: validMarketID.String()},
 				OrderType: protoCommon.OrderType_TYPE_LIMIT,
 				Price:     dec("not-a-number"),
 				Quantity:  10,
@@ -157,7 +156,7 @@ func TestCreateOrder(t *testing.T) {
 			name: "price=0 — InvalidArgument (не позитивная)",
 			ctx:  ctxWithUserID(validUserID),
 			request: &proto.CreateOrderRequest{
-				MarketId:  validMarketID.String(),
+				Market:    &proto.CreateOrderRequest_MarketId{MarketId: validMarketID.String()},
 				OrderType: protoCommon.OrderType_TYPE_LIMIT,
 				Price:     dec("0"),
 				Quantity:  10,
@@ -171,7 +170,7 @@ func TestCreateOrder(t *testing.T) {
 			name: "price отрицательная — InvalidArgument",
 			ctx:  ctxWithUserID(validUserID),
 			request: &proto.CreateOrderRequest{
-				MarketId:  validMarketID.String(),
+				Market:    &proto.CreateOrderRequest_MarketId{MarketId: validMarketID.String()},
 				OrderType: protoCommon.OrderType_TYPE_LIMIT,
 				Price:     dec("-1.00"),
 				Quantity:  10,
@@ -185,7 +184,7 @@ func TestCreateOrder(t *testing.T) {
 			name: "price превышает допустимую precision — InvalidArgument",
 			ctx:  ctxWithUserID(validUserID),
 			request: &proto.CreateOrderRequest{
-				MarketId:  validMarketID.String(),
+				Market:    &proto.CreateOrderRequest_MarketId{MarketId: validMarketID.String()},
 				OrderType: protoCommon.OrderType_TYPE_LIMIT,
 				Price:     dec("1234567890.123456789"),
 				Quantity:  10,
@@ -199,7 +198,7 @@ func TestCreateOrder(t *testing.T) {
 			name: "price на границе допустимой precision — OK",
 			ctx:  ctxWithUserID(validUserID),
 			request: &proto.CreateOrderRequest{
-				MarketId:  validMarketID.String(),
+				Market:    &proto.CreateOrderRequest_MarketId{MarketId: validMarketID.String()},
 				OrderType: protoCommon.OrderType_TYPE_LIMIT,
 				Price:     dec("1234567890.12345678"),
 				Quantity:  10,
@@ -219,7 +218,7 @@ func TestCreateOrder(t *testing.T) {
 			name: "market_id невалидный UUID — InvalidArgument",
 			ctx:  ctxWithUserID(validUserID),
 			request: &proto.CreateOrderRequest{
-				MarketId:  "not-a-uuid",
+				Market:    &proto.CreateOrderRequest_MarketId{MarketId: "not-a-uuid"},
 				OrderType: protoCommon.OrderType_TYPE_LIMIT,
 				Price:     dec("100.00"),
 				Quantity:  10,
@@ -233,7 +232,7 @@ func TestCreateOrder(t *testing.T) {
 			name: "нет user_id в контексте — Unauthenticated",
 			ctx:  context.Background(),
 			request: &proto.CreateOrderRequest{
-				MarketId:  validMarketID.String(),
+				Market:    &proto.CreateOrderRequest_MarketId{MarketId: validMarketID.String()},
 				OrderType: protoCommon.OrderType_TYPE_LIMIT,
 				Price:     dec("100.00"),
 				Quantity:  10,
@@ -247,7 +246,7 @@ func TestCreateOrder(t *testing.T) {
 			name: "TYPE_LIMIT — маппится в OrderTypeLimit",
 			ctx:  ctxWithUserID(validUserID),
 			request: &proto.CreateOrderRequest{
-				MarketId:  validMarketID.String(),
+				Market:    &proto.CreateOrderRequest_MarketId{MarketId: validMarketID.String()},
 				OrderType: protoCommon.OrderType_TYPE_LIMIT,
 				Price:     dec("100.00"),
 				Quantity:  5,
@@ -266,7 +265,7 @@ func TestCreateOrder(t *testing.T) {
 			name: "TYPE_MARKET — маппится в OrderTypeMarket",
 			ctx:  ctxWithUserID(validUserID),
 			request: &proto.CreateOrderRequest{
-				MarketId:  validMarketID.String(),
+				Market:    &proto.CreateOrderRequest_MarketId{MarketId: validMarketID.String()},
 				OrderType: protoCommon.OrderType_TYPE_MARKET,
 				Price:     dec("50.00"),
 				Quantity:  3,
@@ -286,7 +285,7 @@ func TestCreateOrder(t *testing.T) {
 			name: "сервис возвращает StatusCreated — ответ STATUS_CREATED",
 			ctx:  ctxWithUserID(validUserID),
 			request: &proto.CreateOrderRequest{
-				MarketId:  validMarketID.String(),
+				Market:    &proto.CreateOrderRequest_MarketId{MarketId: validMarketID.String()},
 				OrderType: protoCommon.OrderType_TYPE_LIMIT,
 				Price:     dec("100.00"),
 				Quantity:  10,
@@ -307,7 +306,7 @@ func TestCreateOrder(t *testing.T) {
 			name: "сервис возвращает StatusPending — ответ STATUS_PENDING",
 			ctx:  ctxWithUserID(validUserID),
 			request: &proto.CreateOrderRequest{
-				MarketId:  validMarketID.String(),
+				Market:    &proto.CreateOrderRequest_MarketId{MarketId: validMarketID.String()},
 				OrderType: protoCommon.OrderType_TYPE_MARKET,
 				Price:     dec("100.00"),
 				Quantity:  10,
@@ -322,11 +321,53 @@ func TestCreateOrder(t *testing.T) {
 				assert.Equal(t, protoCommon.OrderStatus_STATUS_PENDING, resp.GetStatus())
 			},
 		},
+		{
+			name: "market_symbol — резолвится в market_id и передаётся в сервис",
+			ctx:  ctxWithUserID(validUserID),
+			request: &proto.CreateOrderRequest{
+				Market:    &proto.CreateOrderRequest_MarketSymbol{MarketSymbol: "BTC-USDT"},
+				OrderType: protoCommon.OrderType_TYPE_LIMIT,
+				Price:     dec("100.00"),
+				Quantity:  10,
+			},
+			setupMocks: func(svc *mocks.OrderService) {
+				price, _ := shared.NewDecimal("100.00")
+				svc.On("ResolveMarketSymbol", mock.Anything, "BTC-USDT").
+					Return(validMarketID, nil).Once()
+				svc.On("CreateOrder", mock.Anything, validUserID, validMarketID,
+					shared.OrderTypeLimit, price, int64(10),
+				).Return(validOrderID, shared.OrderStatusCreated, nil)
+			},
+			checkResp: func(t *testing.T, resp *proto.CreateOrderResponse) {
+				require.NotNil(t, resp)
+				assert.Equal(t, validOrderID.String(), resp.GetOrderId())
+			},
+		},
+		{
+			name: "market_symbol не найден — ошибка пробрасывается, заказ не создаётся",
+			ctx:  ctxWithUserID(validUserID),
+			request: &proto.CreateOrderRequest{
+				Market:    &proto.CreateOrderRequest_MarketSymbol{MarketSymbol: "UNKNOWN"},
+				OrderType: protoCommon.OrderType_TYPE_LIMIT,
+				Price:     dec("100.00"),
+				Quantity:  10,
+			},
+			setupMocks: func(svc *mocks.OrderService) {
+				svc.On("ResolveMarketSymbol", mock.Anything, "UNKNOWN").
+					Return(uuid.Nil, sharedErrors.ErrMarketSymbolNotFound{Symbol: "UNKNOWN"}).Once()
+			},
+			checkErr: func(t *testing.T, err error) {
+				require.Error(t, err)
+				var notFound sharedErrors.ErrMarketSymbolNotFound
+				require.ErrorAs(t, err, &notFound)
+				assert.Equal(t, "UNKNOWN", notFound.Symbol)
+			},
+		},
 		{
 			name: "сервис возвращает serviceErrors.ErrMarketNotFound — пробрасывается",
 			ctx:  ctxWithUserID(validUserID),
 			request: &proto.CreateOrderRequest{
-				MarketId:  validMarketID.String(),
+				Market:    &proto.CreateOrderRequest_MarketId{MarketId: validMarketID.String()},
 				OrderType: protoCommon.OrderType_TYPE_LIMIT,
 				Price:     dec("100.00"),
 				Quantity:  10,
@@ -348,7 +389,7 @@ func TestCreateOrder(t *testing.T) {
 			name: "сервис возвращает serviceErrors.ErrMarketDisabled — пробрасывается",
 			ctx:  ctxWithUserID(validUserID),
 			request: &proto.CreateOrderRequest{
-				MarketId:  validMarketID.String(),
+				Market:    &proto.CreateOrderRequest_MarketId{MarketId: validMarketID.String()},
 				OrderType: protoCommon.OrderType_TYPE_LIMIT,
 				Price:     dec("100.00"),
 				Quantity:  10,
@@ -371,7 +412,7 @@ func TestCreateOrder(t *testing.T) {
 			name: "сервис возвращает serviceErrors.ErrRateLimitExceeded — пробрасывается",
 			ctx:  ctxWithUserID(validUserID),
 			request: &proto.CreateOrderRequest{
-				MarketId:  validMarketID.String(),
+				Market:    &proto.CreateOrderRequest_MarketId{MarketId: validMarketID.String()},
 				OrderType: protoCommon.OrderType_TYPE_LIMIT,
 				Price:     dec("100.00"),
 				Quantity:  10,
@@ -391,7 +432,7 @@ func TestCreateOrder(t *testing.T) {
 			name: "сервис возвращает serviceErrors.ErrOrderAlreadyExists — пробрасывается",
 			ctx:  ctxWithUserID(validUserID),
 			request: &proto.CreateOrderRequest{
-				MarketId:  validMarketID.String(),
+				Market:    &proto.CreateOrderRequest_MarketId{MarketId: validMarketID.String()},
 				OrderType: protoCommon.OrderType_TYPE_LIMIT,
 				Price:     dec("100.00"),
 				Quantity:  10,
@@ -411,7 +452,7 @@ func TestCreateOrder(t *testing.T) {
 			name: "сервис возвращает gRPC status error — пробрасывается как есть",
 			ctx:  ctxWithUserID(validUserID),
 			request: &proto.CreateOrderRequest{
-				MarketId:  validMarketID.String(),
+				Market:    &proto.CreateOrderRequest_MarketId{MarketId: validMarketID.String()},
 				OrderType: protoCommon.OrderType_TYPE_LIMIT,
 				Price:     dec("100.00"),
 				Quantity:  10,
@@ -431,7 +472,7 @@ func TestCreateOrder(t *testing.T) {
 			name: "сервис возвращает неизвестную ошибку — пробрасывается",
 			ctx:  ctxWithUserID(validUserID),
 			request: &proto.CreateOrderRequest{
-				MarketId:  validMarketID.String(),
+				Market:    &proto.CreateOrderRequest_MarketId{MarketId: validMarketID.String()},
 				OrderType: protoCommon.OrderType_TYPE_LIMIT,
 				Price:     dec("100.00"),
 				Quantity:  10,
@@ -451,7 +492,7 @@ func TestCreateOrder(t *testing.T) {
 			name: "userID из контекста передаётся в сервис без изменений",
 			ctx:  ctxWithUserID(validUserID),
 			request: &proto.CreateOrderRequest{
-				MarketId:  validMarketID.String(),
+				Market:    &proto.CreateOrderRequest_MarketId{MarketId: validMarketID.String()},
 				OrderType: protoCommon.OrderType_TYPE_LIMIT,
 				Price:     dec("1.00"),
 				Quantity:  1,
@@ -472,7 +513,7 @@ func TestCreateOrder(t *testing.T) {
 			name: "price с ведущими нулями — корректно парсится",
 			ctx:  ctxWithUserID(validUserID),
 			request: &proto.CreateOrderRequest{
-				MarketId:  validMarketID.String(),
+				Market:    &proto.CreateOrderRequest_MarketId{MarketId: validMarketID.String()},
 				OrderType: protoCommon.OrderType_TYPE_LIMIT,
 				Price:     dec("0.00100000"),
 				Quantity:  1,
@@ -491,7 +532,7 @@ func TestCreateOrder(t *testing.T) {
 			name: "price целое число — OK",
 			ctx:  ctxWithUserID(validUserID),
 			request: &proto.CreateOrderRequest{
-				MarketId:  validMarketID.String(),
+				Market:    &proto.CreateOrderRequest_MarketId{MarketId: validMarketID.String()},
 				OrderType: protoCommon.OrderType_TYPE_LIMIT,
 				Price:     dec("100"),
 				Quantity:  1,
@@ -736,37 +777,44 @@ func TestValidateCreateRequest(t *testing.T) {
 	}{
 		{name: "nil request — InvalidArgument", request: nil, wantErr: true, wantCode: codes.InvalidArgument},
 		{
-			name: "market_id пустой — InvalidArgument",
+			name: "ни market_id, ни market_symbol — InvalidArgument",
 			request: &proto.CreateOrderRequest{
-				MarketId: "", OrderType: protoCommon.OrderType_TYPE_LIMIT, Quantity: 1,
+				OrderType: protoCommon.OrderType_TYPE_LIMIT, Quantity: 1,
 			},
 			wantErr: true, wantCode: codes.InvalidArgument,
 		},
 		{
 			name: "order_type UNSPECIFIED — InvalidArgument",
 			request: &proto.CreateOrderRequest{
-				MarketId: validMarketID, OrderType: protoCommon.OrderType_TYPE_UNSPECIFIED, Quantity: 1,
+				Market: &proto.CreateOrderRequest_MarketId{MarketId: validMarketID}, OrderType: protoCommon.OrderType_TYPE_UNSPECIFIED, Quantity: 1,
 			},
 			wantErr: true, wantCode: codes.InvalidArgument,
 		},
 		{
 			name: "quantity=0 — InvalidArgument",
 			request: &proto.CreateOrderRequest{
-				MarketId: validMarketID, OrderType: protoCommon.OrderType_TYPE_LIMIT, Quantity: 0,
+				Market: &proto.CreateOrderRequest_MarketId{MarketId: validMarketID}, OrderType: protoCommon.OrderType_TYPE_LIMIT, Quantity: 0,
 			},
 			wantErr: true, wantCode: codes.InvalidArgument,
 		},
 		{
 			name: "quantity отрицательный — InvalidArgument",
 			request: &proto.CreateOrderRequest{
-				MarketId: validMarketID, OrderType: protoCommon.OrderType_TYPE_LIMIT, Quantity: -1,
+				Market: &proto.CreateOrderRequest_MarketId{MarketId: validMarketID}, OrderType: protoCommon.OrderType_TYPE_LIMIT, Quantity: -1,
 			},
 			wantErr: true, wantCode: codes.InvalidArgument,
 		},
+		{
+			name: "market_symbol вместо market_id — OK",
+			request: &proto.CreateOrderRequest{
+				Market: &proto.CreateOrderRequest_MarketSymbol{MarketSymbol: "BTC-USDT"}, OrderType: protoCommon.OrderType_TYPE_LIMIT, Quantity: 1,
+			},
+			wantErr: false,
+		},
 		{
 			name: "всё валидно — OK",
 			request: &proto.CreateOrderRequest{
-				MarketId: validMarketID, OrderType: protoCommon.OrderType_TYPE_MARKET, Quantity: 100,
+				Market: &proto.CreateOrderRequest_MarketId{MarketId: validMarketID}, OrderType: protoCommon.OrderType_TYPE_MARKET, Quantity: 100,
 			},
 			wantErr: false,
 		},
@@ -310,6 +310,8 @@ func (w *Worker) messageTopic(event models.OutboxEvent) string {
 		return w.cfg.Kafka.Topics.OrderCreated
 	case models.OrderStatusUpdatedEventType:
 		return w.cfg.Kafka.Topics.OrderStatusUpdated
+	case models.OrderFilledEventType:
+		return w.cfg.Kafka.Topics.OrderFilled
 	default:
 		return ""
 	}
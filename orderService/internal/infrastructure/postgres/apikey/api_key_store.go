@@ -0,0 +1,251 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/trace"
+
+	mapper "github.com/nastyazhadan/spot-order-grpc/orderService/internal/application/dto/outbound/postgres"
+	"github.com/nastyazhadan/spot-order-grpc/orderService/internal/domain/models"
+	"github.com/nastyazhadan/spot-order-grpc/shared/config"
+	repositoryErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/repository"
+	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/otel/attributes"
+	"github.com/nastyazhadan/spot-order-grpc/shared/interceptors/tracing"
+	"github.com/nastyazhadan/spot-order-grpc/shared/metrics"
+)
+
+const (
+	databaseName = "postgresql"
+
+	// last_used_at обновляется не чаще раза в минуту, чтобы не писать в БД на каждый запрос бота
+	lastUsedPrecision = time.Minute
+)
+
+type APIKeyStore struct {
+	pool   *pgxpool.Pool
+	config config.OrderConfig
+}
+
+func New(pool *pgxpool.Pool, cfg config.OrderConfig) *APIKeyStore {
+	return &APIKeyStore{
+		pool:   pool,
+		config: cfg,
+	}
+}
+
+// CreateAPIKey сохраняет ключ, если у пользователя меньше maxActive неотозванных ключей,
+// иначе возвращает ErrAPIKeyLimitExceeded. Строка владельца блокируется до конца транзакции:
+// параллельные создания одного пользователя выполняются по очереди, и каждое INSERT видит
+// ключи, закоммиченные до него
+func (s *APIKeyStore) CreateAPIKey(ctx context.Context, key models.APIKey, maxActive int) error {
+	const op = "infrastructure.APIKeyStore.CreateAPIKey"
+
+	ctx, span := s.startSpan(ctx, "postgres.create_api_key", key.UserID)
+	defer span.End()
+
+	start := time.Now()
+	err := pgx.BeginFunc(ctx, s.pool, func(transaction pgx.Tx) error {
+		var ownerID uuid.UUID
+		lockError := transaction.QueryRow(ctx,
+			`SELECT id FROM users WHERE id = $1 FOR NO KEY UPDATE`,
+			key.UserID,
+		).Scan(&ownerID)
+		if lockError != nil {
+			if errors.Is(lockError, pgx.ErrNoRows) {
+				return repositoryErrors.ErrUserNotFound
+			}
+			return lockError
+		}
+
+		tag, insertError := transaction.Exec(ctx,
+			`INSERT INTO api_keys (id, user_id, name, scopes, allowed_ips, created_at)
+			 SELECT $1, $2, $3, $4, $5, $6
+			 WHERE (SELECT count(*) FROM api_keys WHERE user_id = $2 AND revoked_at IS NULL) < $7`,
+			key.ID, key.UserID, key.Name,
+			mapper.APIKeyScopesToStrings(key.Scopes), mapper.PrefixesToStrings(key.AllowedIPs), key.CreatedAt,
+			maxActive,
+		)
+		if insertError != nil {
+			return insertError
+		}
+		if tag.RowsAffected() == 0 {
+			return repositoryErrors.ErrAPIKeyLimitExceeded
+		}
+
+		return nil
+	})
+	s.observe(ctx, "create_api_key", start)
+
+	if err != nil {
+		if !errors.Is(err, repositoryErrors.ErrAPIKeyLimitExceeded) {
+			tracing.RecordError(span, err)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// ListAPIKeys возвращает неотозванные ключи пользователя в порядке создания.
+func (s *APIKeyStore) ListAPIKeys(ctx context.Context, userID uuid.UUID) ([]models.APIKey, error) {
+	const op = "infrastructure.APIKeyStore.ListAPIKeys"
+
+	ctx, span := s.startSpan(ctx, "postgres.list_api_keys", userID)
+	defer span.End()
+
+	start := time.Now()
+	defer s.observe(ctx, "list_api_keys", start)
+
+	rows, err := s.pool.Query(ctx,
+		`SELECT id, user_id, name, scopes, allowed_ips, created_at, last_used_at
+		 FROM api_keys
+		 WHERE user_id = $1 AND revoked_at IS NULL
+		 ORDER BY created_at, id`,
+		userID,
+	)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	keyDTOs, err := pgx.CollectRows(rows, pgx.RowToStructByName[mapper.APIKey])
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	keys := make([]models.APIKey, 0, len(keyDTOs))
+	for _, keyDTO := range keyDTOs {
+		key, mapErr := keyDTO.ToDomain()
+		if mapErr != nil {
+			tracing.RecordError(span, mapErr)
+			return nil, fmt.Errorf("%s: %w", op, mapErr)
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// RevokeAPIKey отзывает ключ пользователя; чужой или уже отозванный ключ — ErrAPIKeyNotFound.
+func (s *APIKeyStore) RevokeAPIKey(ctx context.Context, userID, keyID uuid.UUID) error {
+	const op = "infrastructure.APIKeyStore.RevokeAPIKey"
+
+	ctx, span := s.startSpan(ctx, "postgres.revoke_api_key", userID)
+	defer span.End()
+
+	start := time.Now()
+	tag, err := s.pool.Exec(ctx,
+		`UPDATE api_keys SET revoked_at = NOW()
+		 WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL`,
+		keyID, userID,
+	)
+	s.observe(ctx, "revoke_api_key", start)
+
+	if err != nil {
+		tracing.RecordError(span, err)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, repositoryErrors.ErrAPIKeyNotFound)
+	}
+
+	return nil
+}
+
+// GetAPIKeyCredential ищет неотозванный ключ вместе с текущими ролями владельца.
+func (s *APIKeyStore) GetAPIKeyCredential(ctx context.Context, keyID uuid.UUID) (models.APIKeyCredential, error) {
+	const op = "infrastructure.APIKeyStore.GetAPIKeyCredential"
+
+	ctx, span := tracing.StartSpan(ctx, "postgres.get_api_key_credential",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attributes.DBSystemValue(databaseName),
+		),
+	)
+	defer span.End()
+
+	start := time.Now()
+	defer s.observe(ctx, "get_api_key_credential", start)
+
+	rows, err := s.pool.Query(ctx,
+		`SELECT k.id, k.user_id, k.name, k.scopes, k.allowed_ips, k.created_at, k.last_used_at,
+		        u.roles AS owner_roles, u.disabled AS owner_disabled
+		 FROM api_keys k
+		 JOIN users u ON u.id = k.user_id
+		 WHERE k.id = $1 AND k.revoked_at IS NULL`,
+		keyID,
+	)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return models.APIKeyCredential{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	credentialDTO, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[mapper.APIKeyCredential])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.APIKeyCredential{}, fmt.Errorf("%s: %w", op, repositoryErrors.ErrAPIKeyNotFound)
+		}
+
+		tracing.RecordError(span, err)
+		return models.APIKeyCredential{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	credential, err := credentialDTO.ToDomain()
+	if err != nil {
+		tracing.RecordError(span, err)
+		return models.APIKeyCredential{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return credential, nil
+}
+
+func (s *APIKeyStore) TouchAPIKey(ctx context.Context, keyID uuid.UUID, usedAt time.Time) error {
+	const op = "infrastructure.APIKeyStore.TouchAPIKey"
+
+	ctx, span := tracing.StartSpan(ctx, "postgres.touch_api_key",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attributes.DBSystemValue(databaseName),
+		),
+	)
+	defer span.End()
+
+	start := time.Now()
+	_, err := s.pool.Exec(ctx,
+		`UPDATE api_keys SET last_used_at = $2
+		 WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < $3)`,
+		keyID, usedAt, usedAt.Add(-lastUsedPrecision),
+	)
+	s.observe(ctx, "touch_api_key", start)
+
+	if err != nil {
+		tracing.RecordError(span, err)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *APIKeyStore) startSpan(ctx context.Context, name string, userID uuid.UUID) (context.Context, trace.Span) {
+	return tracing.StartSpan(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attributes.DBSystemValue(databaseName),
+			attributes.UserIDValue(userID.String()),
+		),
+	)
+}
+
+func (s *APIKeyStore) observe(ctx context.Context, query string, start time.Time) {
+	metrics.ObserveWithTrace(ctx,
+		metrics.DBQueryDuration.WithLabelValues(s.config.Service.Name, query),
+		time.Since(start).Seconds(),
+	)
+}
@@ -0,0 +1,237 @@
+package market
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/trace"
+
+	mapper "github.com/nastyazhadan/spot-order-grpc/orderService/internal/application/dto/outbound/postgres"
+	"github.com/nastyazhadan/spot-order-grpc/shared/config"
+	repositoryErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/repository"
+	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/otel/attributes"
+	"github.com/nastyazhadan/spot-order-grpc/shared/interceptors/tracing"
+	"github.com/nastyazhadan/spot-order-grpc/shared/metrics"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+)
+
+const databaseName = "postgresql"
+
+// Версия не даёт старому событию или снимку затереть более новое состояние рынка.
+// Равная версия допускается: события до появления версий приходят с version = 0.
+// synced_at — момент, на который spot подтвердил состояние строки; он только растёт.
+// public_to_all_roles зависит от политик spot, а не от версии: равная версия снимка освежает и его
+const upsertMarketQuery = `
+	INSERT INTO markets (id, name, base_asset, quote_asset, enabled, deleted_at, updated_at, version, restricted,
+	                     public_to_all_roles, synced_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	ON CONFLICT (id) DO UPDATE
+	SET name                = EXCLUDED.name,
+	    base_asset          = EXCLUDED.base_asset,
+	    quote_asset         = EXCLUDED.quote_asset,
+	    enabled             = EXCLUDED.enabled,
+	    deleted_at          = EXCLUDED.deleted_at,
+	    updated_at          = EXCLUDED.updated_at,
+	    version             = EXCLUDED.version,
+	    restricted          = EXCLUDED.restricted,
+	    public_to_all_roles = EXCLUDED.public_to_all_roles,
+	    synced_at           = GREATEST(markets.synced_at, EXCLUDED.synced_at)
+	WHERE markets.version <= EXCLUDED.version`
+
+type MarketReplicaStore struct {
+	pool   *pgxpool.Pool
+	config config.OrderConfig
+}
+
+func NewReplicaStore(pool *pgxpool.Pool, cfg config.OrderConfig) *MarketReplicaStore {
+	return &MarketReplicaStore{
+		pool:   pool,
+		config: cfg,
+	}
+}
+
+// ApplyMarket применяет состояние рынка в транзакции обработки события.
+// Событие подтверждает состояние на момент изменения в spot, поэтому synced_at строки — updated_at рынка.
+// Возвращает false, если в реплике уже более новая версия.
+func (s *MarketReplicaStore) ApplyMarket(
+	ctx context.Context,
+	transaction pgx.Tx,
+	market models.Market,
+) (bool, error) {
+	const op = "MarketReplicaStore.ApplyMarket"
+
+	ctx, span := tracing.StartSpan(ctx, "postgres.apply_replica_market",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attributes.DBSystemValue(databaseName),
+			attributes.MarketIDValue(market.ID.String()),
+		),
+	)
+	defer span.End()
+
+	start := time.Now()
+	tag, err := transaction.Exec(ctx, upsertMarketQuery,
+		market.ID, market.Name, market.BaseAsset, market.QuoteAsset,
+		market.Enabled, market.DeletedAt, market.UpdatedAt, market.Version, market.Restricted,
+		market.PublicToAllRoles, market.UpdatedAt.UTC(),
+	)
+	metrics.ObserveWithTrace(ctx,
+		metrics.DBQueryDuration.WithLabelValues(s.config.Service.Name, "market_replica.apply_market"),
+		time.Since(start).Seconds(),
+	)
+
+	if err != nil {
+		tracing.RecordError(span, err)
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// ApplySnapshot применяет полный снимок рынков из spot и сдвигает synced_at каждой его строки.
+// syncedAt — время начала выгрузки: всё, что изменилось позже, придёт событиями.
+// Строки, которых нет в снимке, не освежаются и со временем уходят в fallback на spot.
+func (s *MarketReplicaStore) ApplySnapshot(
+	ctx context.Context,
+	markets []models.Market,
+	syncedAt time.Time,
+) error {
+	const op = "MarketReplicaStore.ApplySnapshot"
+
+	ctx, span := tracing.StartSpan(ctx, "postgres.apply_replica_snapshot",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attributes.DBSystemValue(databaseName),
+			attributes.MarketsCountValue(len(markets)),
+		),
+	)
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		metrics.ObserveWithTrace(ctx,
+			metrics.DBQueryDuration.WithLabelValues(s.config.Service.Name, "market_replica.apply_snapshot"),
+			time.Since(start).Seconds(),
+		)
+	}()
+
+	err := pgx.BeginFunc(ctx, s.pool, func(transaction pgx.Tx) error {
+		batch := &pgx.Batch{}
+		for _, market := range markets {
+			batch.Queue(upsertMarketQuery,
+				market.ID, market.Name, market.BaseAsset, market.QuoteAsset,
+				market.Enabled, market.DeletedAt, market.UpdatedAt, market.Version, market.Restricted,
+				market.PublicToAllRoles, syncedAt.UTC(),
+			)
+		}
+
+		return transaction.SendBatch(ctx, batch).Close()
+	})
+	if err != nil {
+		tracing.RecordError(span, err)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// GetMarket возвращает рынок из реплики и момент, на который spot подтвердил его состояние.
+func (s *MarketReplicaStore) GetMarket(
+	ctx context.Context,
+	id uuid.UUID,
+) (models.Market, time.Time, error) {
+	const op = "MarketReplicaStore.GetMarket"
+
+	ctx, span := tracing.StartSpan(ctx, "postgres.get_replica_market",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attributes.DBSystemValue(databaseName),
+			attributes.MarketIDValue(id.String()),
+		),
+	)
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		metrics.ObserveWithTrace(ctx,
+			metrics.DBQueryDuration.WithLabelValues(s.config.Service.Name, "market_replica.get_market"),
+			time.Since(start).Seconds(),
+		)
+	}()
+
+	market, syncedAt, err := s.getMarket(ctx, `WHERE id = $1`, id)
+	if err != nil {
+		if !errors.Is(err, repositoryErrors.ErrMarketNotFound) {
+			tracing.RecordError(span, err)
+		}
+		return models.Market{}, time.Time{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return market, syncedAt, nil
+}
+
+// GetMarketBySymbol ищет неудалённый рынок по имени. Имена в реплике не уникальны:
+// события переименования разных рынков могут прийти не по порядку, и тогда
+// совпадение нескольких строк считается промахом — имя разрешит spot.
+func (s *MarketReplicaStore) GetMarketBySymbol(
+	ctx context.Context,
+	symbol string,
+) (models.Market, time.Time, error) {
+	const op = "MarketReplicaStore.GetMarketBySymbol"
+
+	ctx, span := tracing.StartSpan(ctx, "postgres.get_replica_market_by_symbol",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attributes.DBSystemValue(databaseName)),
+	)
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		metrics.ObserveWithTrace(ctx,
+			metrics.DBQueryDuration.WithLabelValues(s.config.Service.Name, "market_replica.get_market_by_symbol"),
+			time.Since(start).Seconds(),
+		)
+	}()
+
+	market, syncedAt, err := s.getMarket(ctx, `WHERE name = $1 AND deleted_at IS NULL`, symbol)
+	if err != nil {
+		if !errors.Is(err, repositoryErrors.ErrMarketNotFound) {
+			tracing.RecordError(span, err)
+		}
+		return models.Market{}, time.Time{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return market, syncedAt, nil
+}
+
+func (s *MarketReplicaStore) getMarket(
+	ctx context.Context,
+	where string,
+	arg any,
+) (models.Market, time.Time, error) {
+	rows, err := s.pool.Query(ctx, `
+		SELECT id, name, base_asset, quote_asset, enabled, deleted_at, updated_at, version, restricted,
+		       public_to_all_roles, synced_at
+		FROM markets
+		`+where+`
+		LIMIT 2
+	`, arg)
+	if err != nil {
+		return models.Market{}, time.Time{}, err
+	}
+
+	marketDTO, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[mapper.ReplicaMarket])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) || errors.Is(err, pgx.ErrTooManyRows) {
+			return models.Market{}, time.Time{}, repositoryErrors.ErrMarketNotFound
+		}
+		return models.Market{}, time.Time{}, err
+	}
+
+	return marketDTO.ToDomain(), marketDTO.SyncedAt.UTC(), nil
+}
@@ -191,6 +191,62 @@ func (o *OrderStore) CancelActiveOrdersByMarket(
 	return cancelledIDs, nil
 }
 
+// FillOrder переводит ордер в FILLED, только если он ещё CREATED или PENDING
+func (o *OrderStore) FillOrder(ctx context.Context, transaction pgx.Tx, id uuid.UUID) (models.Order, error) {
+	const op = "OrderStore.FillOrder"
+
+	ctx, span := tracing.StartSpan(ctx, "postgres.fill_order",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attributes.DBSystemValue(databaseName),
+			attributes.OrderIDValue(id.String()),
+		),
+	)
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		metrics.ObserveWithTrace(ctx,
+			metrics.DBQueryDuration.WithLabelValues(o.config.Service.Name, "fill_order"),
+			time.Since(start).Seconds(),
+		)
+	}()
+
+	rows, err := transaction.Query(ctx, `
+		UPDATE orders
+		SET status = $2
+		WHERE id = $1 AND status IN ($3, $4)
+		RETURNING id, user_id, market_id, type, price, quantity, status, created_at
+	`,
+		id,
+		int16(shared.OrderStatusFilled),
+		int16(shared.OrderStatusCreated),
+		int16(shared.OrderStatusPending),
+	)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return models.Order{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	orderDTO, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[mapper.Order])
+	if err != nil {
+		tracing.RecordError(span, err)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Order{}, fmt.Errorf("%s: %w", op, repositoryErrors.ErrOrderNotActive)
+		}
+
+		return models.Order{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	order, err := orderDTO.ToDomain()
+	if err != nil {
+		tracing.RecordError(span, err)
+		return models.Order{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return order, nil
+}
+
 func (o *OrderStore) FindOrderForIdempotencyRecovery(
 	ctx context.Context,
 	userID uuid.UUID,
@@ -0,0 +1,302 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/trace"
+
+	mapper "github.com/nastyazhadan/spot-order-grpc/orderService/internal/application/dto/outbound/postgres"
+	"github.com/nastyazhadan/spot-order-grpc/orderService/internal/domain/models"
+	"github.com/nastyazhadan/spot-order-grpc/shared/config"
+	repositoryErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/repository"
+	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/otel/attributes"
+	"github.com/nastyazhadan/spot-order-grpc/shared/interceptors/tracing"
+	"github.com/nastyazhadan/spot-order-grpc/shared/metrics"
+	sharedModels "github.com/nastyazhadan/spot-order-grpc/shared/models"
+)
+
+const (
+	databaseName        = "postgresql"
+	uniqueViolationCode = "23505"
+	checkViolationCode  = "23514"
+	constraintName      = "uq_users_username"
+	rolesConstraintName = "chk_users_roles_not_empty"
+
+	userColumns = `id, username, password_hash, roles, disabled, created_at, updated_at`
+)
+
+type UserStore struct {
+	pool   *pgxpool.Pool
+	config config.OrderConfig
+}
+
+func New(pool *pgxpool.Pool, cfg config.OrderConfig) *UserStore {
+	return &UserStore{
+		pool:   pool,
+		config: cfg,
+	}
+}
+
+// GetUserByUsername ищет пользователя по уже нормализованному (нижний регистр) имени.
+func (s *UserStore) GetUserByUsername(ctx context.Context, username string) (models.User, error) {
+	const op = "infrastructure.UserStore.GetUserByUsername"
+
+	ctx, span := tracing.StartSpan(ctx, "postgres.get_user_by_username",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attributes.DBSystemValue(databaseName),
+		),
+	)
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		metrics.ObserveWithTrace(ctx,
+			metrics.DBQueryDuration.WithLabelValues(s.config.Service.Name, "get_user_by_username"),
+			time.Since(start).Seconds(),
+		)
+	}()
+
+	rows, err := s.pool.Query(ctx,
+		`SELECT `+userColumns+`
+		 FROM users
+		 WHERE username = $1`,
+		username,
+	)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return models.User{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	user, err := collectUser(rows)
+	if err != nil {
+		if !errors.Is(err, repositoryErrors.ErrUserNotFound) {
+			tracing.RecordError(span, err)
+		}
+		return models.User{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return user, nil
+}
+
+func (s *UserStore) GetUserByID(ctx context.Context, userID uuid.UUID) (models.User, error) {
+	const op = "infrastructure.UserStore.GetUserByID"
+
+	ctx, span := tracing.StartSpan(ctx, "postgres.get_user_by_id",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attributes.DBSystemValue(databaseName),
+			attributes.UserIDValue(userID.String()),
+		),
+	)
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		metrics.ObserveWithTrace(ctx,
+			metrics.DBQueryDuration.WithLabelValues(s.config.Service.Name, "get_user_by_id"),
+			time.Since(start).Seconds(),
+		)
+	}()
+
+	rows, err := s.pool.Query(ctx,
+		`SELECT `+userColumns+`
+		 FROM users
+		 WHERE id = $1`,
+		userID,
+	)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return models.User{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	user, err := collectUser(rows)
+	if err != nil {
+		if !errors.Is(err, repositoryErrors.ErrUserNotFound) {
+			tracing.RecordError(span, err)
+		}
+		return models.User{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return user, nil
+}
+
+func (s *UserStore) CreateUser(ctx context.Context, user models.User) error {
+	const op = "infrastructure.UserStore.CreateUser"
+
+	ctx, span := tracing.StartSpan(ctx, "postgres.create_user",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attributes.DBSystemValue(databaseName),
+			attributes.UserIDValue(user.ID.String()),
+		),
+	)
+	defer span.End()
+
+	start := time.Now()
+	_, err := s.pool.Exec(ctx,
+		`INSERT INTO users (id, username, password_hash, roles, disabled, created_at, updated_at)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		user.ID, user.Username, user.PasswordHash, mapper.UserRolesToStrings(user.Roles),
+		user.Disabled, user.CreatedAt, user.UpdatedAt,
+	)
+	metrics.ObserveWithTrace(ctx,
+		metrics.DBQueryDuration.WithLabelValues(s.config.Service.Name, "create_user"),
+		time.Since(start).Seconds(),
+	)
+
+	if err != nil {
+		tracing.RecordError(span, err)
+		if isUsernameViolation(err) {
+			return fmt.Errorf("%s: %w", op, repositoryErrors.ErrUserAlreadyExists)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// AddUserRole добавляет роль, если её ещё нет. changed=false — роль уже была назначена
+func (s *UserStore) AddUserRole(
+	ctx context.Context,
+	userID uuid.UUID,
+	role sharedModels.UserRole,
+) (user models.User, changed bool, err error) {
+	const op = "infrastructure.UserStore.AddUserRole"
+
+	user, changed, err = s.updateUser(ctx, userID, "add_user_role",
+		`UPDATE users
+		 SET roles = array_append(roles, $2::text), updated_at = NOW()
+		 WHERE id = $1 AND NOT ($2::text = ANY (roles))
+		 RETURNING `+userColumns,
+		userID, role.String(),
+	)
+	if err != nil {
+		return models.User{}, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return user, changed, nil
+}
+
+// RemoveUserRole снимает роль, если она назначена. Последнюю роль снять нельзя: это запрещает ограничение таблицы
+func (s *UserStore) RemoveUserRole(
+	ctx context.Context,
+	userID uuid.UUID,
+	role sharedModels.UserRole,
+) (user models.User, changed bool, err error) {
+	const op = "infrastructure.UserStore.RemoveUserRole"
+
+	user, changed, err = s.updateUser(ctx, userID, "remove_user_role",
+		`UPDATE users
+		 SET roles = array_remove(roles, $2::text), updated_at = NOW()
+		 WHERE id = $1 AND $2::text = ANY (roles)
+		 RETURNING `+userColumns,
+		userID, role.String(),
+	)
+	if err != nil {
+		return models.User{}, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return user, changed, nil
+}
+
+// DisableUser отключает учётную запись. changed=false — она уже была отключена
+func (s *UserStore) DisableUser(ctx context.Context, userID uuid.UUID) (user models.User, changed bool, err error) {
+	const op = "infrastructure.UserStore.DisableUser"
+
+	user, changed, err = s.updateUser(ctx, userID, "disable_user",
+		`UPDATE users
+		 SET disabled = TRUE, updated_at = NOW()
+		 WHERE id = $1 AND NOT disabled
+		 RETURNING `+userColumns,
+		userID,
+	)
+	if err != nil {
+		return models.User{}, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return user, changed, nil
+}
+
+// updateUser выполняет условный UPDATE. Если условие не выполнилось, возвращается текущая запись с changed=false
+func (s *UserStore) updateUser(
+	ctx context.Context,
+	userID uuid.UUID,
+	queryName, query string,
+	args ...any,
+) (models.User, bool, error) {
+	ctx, span := tracing.StartSpan(ctx, "postgres."+queryName,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attributes.DBSystemValue(databaseName),
+			attributes.UserIDValue(userID.String()),
+		),
+	)
+	defer span.End()
+
+	start := time.Now()
+	rows, err := s.pool.Query(ctx, query, args...)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return models.User{}, false, err
+	}
+
+	user, err := collectUser(rows)
+	metrics.ObserveWithTrace(ctx,
+		metrics.DBQueryDuration.WithLabelValues(s.config.Service.Name, queryName),
+		time.Since(start).Seconds(),
+	)
+
+	switch {
+	case err == nil:
+		return user, true, nil
+	case errors.Is(err, repositoryErrors.ErrUserNotFound):
+		user, err = s.GetUserByID(ctx, userID)
+		if err != nil {
+			return models.User{}, false, err
+		}
+		return user, false, nil
+	case isRolesViolation(err):
+		return models.User{}, false, repositoryErrors.ErrUserRolesRequired
+	default:
+		tracing.RecordError(span, err)
+		return models.User{}, false, err
+	}
+}
+
+func collectUser(rows pgx.Rows) (models.User, error) {
+	userDTO, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[mapper.User])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.User{}, repositoryErrors.ErrUserNotFound
+		}
+		return models.User{}, err
+	}
+
+	return userDTO.ToDomain()
+}
+
+func isUsernameViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == uniqueViolationCode && pgErr.ConstraintName == constraintName
+	}
+
+	return false
+}
+
+func isRolesViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == checkViolationCode && pgErr.ConstraintName == rolesConstraintName
+	}
+
+	return false
+}
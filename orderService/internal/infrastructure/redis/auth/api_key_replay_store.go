@@ -0,0 +1,54 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	redisGo "github.com/redis/go-redis/v9"
+
+	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/cache"
+)
+
+const apiKeyNoncesPrefix = "api_key_nonce:"
+
+var rememberNonceScript = redisGo.NewScript(`
+	if redis.call('SET', KEYS[1], '1', 'NX', 'PX', ARGV[1]) then
+		return 1
+	end
+	return 0
+`)
+
+// APIKeyReplayStore запоминает nonce принятых запросов. Запрос принимается, пока его
+// timestamp отличается от текущего времени не больше чем на window в любую сторону,
+// поэтому nonce хранится 2*window — дольше повтор и так отклоняется по времени.
+// Ключ — nonce, а не подпись: два разных запроса с одним nonce тоже считаются повтором
+type APIKeyReplayStore struct {
+	store *cache.Store
+	ttl   time.Duration
+}
+
+func NewAPIKeyReplayStore(store *cache.Store, window time.Duration) *APIKeyReplayStore {
+	return &APIKeyReplayStore{
+		store: store,
+		ttl:   2 * window,
+	}
+}
+
+// Remember возвращает false, если такой nonce ключа уже встречался.
+func (s *APIKeyReplayStore) Remember(ctx context.Context, keyID uuid.UUID, nonce string) (bool, error) {
+	const op = "APIKeyReplayStore.Remember"
+
+	result, err := rememberNonceScript.Run(
+		ctx,
+		s.store.ScriptRunner(),
+		[]string{fmt.Sprintf("%s%s:%s", apiKeyNoncesPrefix, keyID, nonce)},
+		s.ttl.Milliseconds(),
+	).Int()
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result == 1, nil
+}
@@ -0,0 +1,85 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	redisGo "github.com/redis/go-redis/v9"
+
+	sharedErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors"
+	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/cache"
+)
+
+const loginAttemptsPrefix = "auth_login_failures:"
+
+// Окно блокировки отсчитывается от первой неудачной попытки, как и в OrderRateLimiter
+var loginFailureScript = redisGo.NewScript(`
+	local count = redis.call('INCR', KEYS[1])
+	if count == 1 then
+		redis.call('PEXPIRE', KEYS[1], ARGV[1])
+	end
+	return count
+`)
+
+// LoginAttemptStore считает неудачные попытки входа по username.
+type LoginAttemptStore struct {
+	store       *cache.Store
+	maxAttempts int64
+	lockout     time.Duration
+}
+
+func NewLoginAttemptStore(store *cache.Store, maxAttempts int64, lockout time.Duration) *LoginAttemptStore {
+	return &LoginAttemptStore{
+		store:       store,
+		maxAttempts: maxAttempts,
+		lockout:     lockout,
+	}
+}
+
+func (s *LoginAttemptStore) IsLocked(ctx context.Context, username string) (bool, error) {
+	const op = "LoginAttemptStore.IsLocked"
+
+	raw, err := s.store.Get(ctx, loginAttemptsPrefix+username)
+	if err != nil {
+		if errors.Is(err, sharedErrors.ErrCacheNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	count, err := strconv.ParseInt(string(raw), 10, 64)
+	if err != nil {
+		return false, fmt.Errorf("%s: parse attempts counter: %w", op, err)
+	}
+
+	return count >= s.maxAttempts, nil
+}
+
+func (s *LoginAttemptStore) RegisterFailure(ctx context.Context, username string) error {
+	const op = "LoginAttemptStore.RegisterFailure"
+
+	err := loginFailureScript.Run(
+		ctx,
+		s.store.ScriptRunner(),
+		[]string{loginAttemptsPrefix + username},
+		s.lockout.Milliseconds(),
+	).Err()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *LoginAttemptStore) Reset(ctx context.Context, username string) error {
+	const op = "LoginAttemptStore.Reset"
+
+	if err := s.store.Delete(ctx, loginAttemptsPrefix+username); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
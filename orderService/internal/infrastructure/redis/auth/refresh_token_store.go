@@ -3,110 +3,230 @@ package auth
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
 	redisGo "github.com/redis/go-redis/v9"
 
+	"github.com/nastyazhadan/spot-order-grpc/orderService/internal/domain/models"
 	auth "github.com/nastyazhadan/spot-order-grpc/shared/auth/session"
+	repositoryErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/repository"
 	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/cache"
 )
 
 const (
-	refreshPrefix        = "refresh"
-	currentRefreshPrefix = "auth_refresh"
+	refreshPrefix     = "refresh"
+	rotatedJTIsPrefix = "refresh_rotated"
+
+	rotateResultReused = -1
 )
 
-// Атомарно валидирует активную refresh/session-связку,
-// ротирует refresh token, обновляет указатель на текущий refresh key
+// Сессия — hash auth_session:{<userID>}:<sessionID> с полями refresh (ключ текущего
+// refresh token), device, ip, created_at, last_refresh_at (unix ms).
+// auth_sessions:{<userID>} — sorted set session_id по created_at; записи об истёкших
+// по TTL сессиях вычищаются при создании и чтении списка.
+// refresh_rotated:{<userID>}:<sessionID> — set уже ротированных jti сессии, живёт вместе с ней.
+// Ключи других сессий скрипты собирают по префиксу и читают из поля refresh, поэтому
+// заранее передать их в KEYS нельзя; все ключи пользователя несут hash tag {<userID>}
+// и лежат в одном слоте с ключами из KEYS.
+
+// Открывает сессию, предварительно вытесняя самые старые сверх лимита.
+// Возвращает число вытесненных сессий
+var createScript = redisGo.NewScript(`
+	local sessionKey = KEYS[1]
+	local indexKey = KEYS[2]
+	local refreshKey = KEYS[3]
+	local ttlMs = ARGV[1]
+	local sessionID = ARGV[2]
+	local nowMs = ARGV[3]
+	local device = ARGV[4]
+	local ip = ARGV[5]
+	local maxSessions = tonumber(ARGV[6])
+	local sessionPrefix = ARGV[7]
+
+	for _, id in ipairs(redis.call("ZRANGE", indexKey, 0, -1)) do
+		if redis.call("EXISTS", sessionPrefix .. id) == 0 then
+			redis.call("ZREM", indexKey, id)
+		end
+	end
+
+	local evicted = 0
+	while redis.call("ZCARD", indexKey) >= maxSessions do
+		local oldest = redis.call("ZPOPMIN", indexKey)
+		local oldSessionKey = sessionPrefix .. oldest[1]
+		local oldRefreshKey = redis.call("HGET", oldSessionKey, "refresh")
+		if oldRefreshKey then
+			redis.call("DEL", oldRefreshKey)
+		end
+		redis.call("DEL", oldSessionKey)
+		evicted = evicted + 1
+	end
+
+	redis.call("HSET", sessionKey,
+		"refresh", refreshKey,
+		"device", device,
+		"ip", ip,
+		"created_at", nowMs,
+		"last_refresh_at", nowMs)
+	redis.call("PEXPIRE", sessionKey, ttlMs)
+	redis.call("PSETEX", refreshKey, ttlMs, sessionID)
+	redis.call("ZADD", indexKey, nowMs, sessionID)
+	redis.call("PEXPIRE", indexKey, ttlMs)
+
+	return evicted
+`)
+
+// Атомарно проверяет, что старый refresh token — текущий для сессии,
+// и заменяет его новым, продлевая сессию. Повторное предъявление уже
+// ротированного jti завершает всю сессию и возвращает -1
 var rotateScript = redisGo.NewScript(`
 	local oldRefreshKey = KEYS[1]
 	local newRefreshKey = KEYS[2]
 	local sessionKey = KEYS[3]
-	local currentRefreshPointerKey = KEYS[4]
+	local indexKey = KEYS[4]
+	local rotatedKey = KEYS[5]
 	local ttlMs = ARGV[1]
-	local oldSessionID = ARGV[2]
-	local newSessionID = ARGV[3]
+	local sessionID = ARGV[2]
+	local nowMs = ARGV[3]
+	local oldJTI = ARGV[4]
 
-	if redis.call("GET", sessionKey) ~= oldSessionID then
-    	return 0
+	if redis.call("SISMEMBER", rotatedKey, oldJTI) == 1 then
+		local currentRefreshKey = redis.call("HGET", sessionKey, "refresh")
+		if currentRefreshKey then
+			redis.call("DEL", currentRefreshKey)
+		end
+		redis.call("DEL", sessionKey, rotatedKey)
+		redis.call("ZREM", indexKey, sessionID)
+		return -1
 	end
 
-	if redis.call("EXISTS", oldRefreshKey) == 0 then
-    	return 0
+	if redis.call("HGET", sessionKey, "refresh") ~= oldRefreshKey then
+		return 0
 	end
 
-	local trackedRefreshKey = redis.call("GET", currentRefreshPointerKey)
-	if trackedRefreshKey and trackedRefreshKey ~= "" and trackedRefreshKey ~= oldRefreshKey then
+	if redis.call("EXISTS", oldRefreshKey) == 0 then
 		return 0
 	end
 
-	redis.call("PSETEX", newRefreshKey, ttlMs, "1")
-	redis.call("PSETEX", sessionKey, ttlMs, newSessionID)
-	redis.call("PSETEX", currentRefreshPointerKey, ttlMs, newRefreshKey)
+	redis.call("PSETEX", newRefreshKey, ttlMs, sessionID)
+	redis.call("HSET", sessionKey, "refresh", newRefreshKey, "last_refresh_at", nowMs)
+	redis.call("PEXPIRE", sessionKey, ttlMs)
+	redis.call("PEXPIRE", indexKey, ttlMs)
+	redis.call("SADD", rotatedKey, oldJTI)
+	redis.call("PEXPIRE", rotatedKey, ttlMs)
 	redis.call("DEL", oldRefreshKey)
 	return 1
 `)
 
-var replaceScript = redisGo.NewScript(`
-	local newRefreshKey = KEYS[1]
-	local sessionKey = KEYS[2]
-	local currentRefreshKey = KEYS[3]
-	local ttlMs = ARGV[1]
-	local sessionID = ARGV[2]
+var revokeSessionScript = redisGo.NewScript(`
+	local sessionKey = KEYS[1]
+	local indexKey = KEYS[2]
+	local sessionID = ARGV[1]
+
+	redis.call("ZREM", indexKey, sessionID)
 
-	local oldRefreshKey = redis.call("GET", currentRefreshKey)
-	if oldRefreshKey and oldRefreshKey ~= "" then
-		redis.call("DEL", oldRefreshKey)
+	if redis.call("EXISTS", sessionKey) == 0 then
+		return 0
 	end
 
-	redis.call("PSETEX", newRefreshKey, ttlMs, "1")
-	redis.call("PSETEX", sessionKey, ttlMs, sessionID)
-	redis.call("PSETEX", currentRefreshKey, ttlMs, newRefreshKey)
+	local refreshKey = redis.call("HGET", sessionKey, "refresh")
+	if refreshKey then
+		redis.call("DEL", refreshKey)
+	end
 
+	redis.call("DEL", sessionKey)
 	return 1
 `)
 
+var revokeAllScript = redisGo.NewScript(`
+	local indexKey = KEYS[1]
+	local sessionPrefix = ARGV[1]
+
+	local revoked = 0
+	for _, id in ipairs(redis.call("ZRANGE", indexKey, 0, -1)) do
+		local sessionKey = sessionPrefix .. id
+		local refreshKey = redis.call("HGET", sessionKey, "refresh")
+		if refreshKey then
+			redis.call("DEL", refreshKey)
+		end
+		revoked = revoked + redis.call("DEL", sessionKey)
+	end
+
+	redis.call("DEL", indexKey)
+	return revoked
+`)
+
+// Возвращает активные сессии в порядке создания: {id, device, ip, created_at, last_refresh_at}
+var listScript = redisGo.NewScript(`
+	local indexKey = KEYS[1]
+	local sessionPrefix = ARGV[1]
+
+	local result = {}
+	for _, id in ipairs(redis.call("ZRANGE", indexKey, 0, -1)) do
+		local fields = redis.call("HMGET", sessionPrefix .. id, "device", "ip", "created_at", "last_refresh_at")
+		if fields[3] then
+			table.insert(result, {id, fields[1] or "", fields[2] or "", fields[3], fields[4] or fields[3]})
+		else
+			redis.call("ZREM", indexKey, id)
+		end
+	end
+
+	return result
+`)
+
 type RefreshTokenStore struct {
-	store *cache.Store
-	ttl   time.Duration
+	store       *cache.Store
+	ttl         time.Duration
+	maxSessions int
 }
 
-func New(store *cache.Store, ttl time.Duration) *RefreshTokenStore {
+func New(store *cache.Store, ttl time.Duration, maxSessions int) *RefreshTokenStore {
 	return &RefreshTokenStore{
-		store: store,
-		ttl:   ttl,
+		store:       store,
+		ttl:         ttl,
+		maxSessions: maxSessions,
 	}
 }
 
-func (s *RefreshTokenStore) Replace(
+// Create открывает сессию с первым refresh token. Если сессий уже maxSessions,
+// самые старые по времени создания завершаются; возвращается их количество.
+func (s *RefreshTokenStore) Create(
 	ctx context.Context,
 	userID uuid.UUID,
-	newJTI, newSessionID string,
-) error {
-	_, err := replaceScript.Run(
+	jti string,
+	session models.Session,
+) (int, error) {
+	evicted, err := createScript.Run(
 		ctx,
 		s.store.ScriptRunner(),
 		[]string{
-			refreshKey(userID, newJTI),
-			auth.SessionKey(userID),
-			currentRefreshKey(userID),
+			auth.SessionKey(userID, session.ID),
+			auth.SessionIndexKey(userID),
+			refreshKey(userID, jti),
 		},
 		s.ttl.Milliseconds(),
-		newSessionID,
-	).Result()
+		session.ID,
+		session.CreatedAt.UnixMilli(),
+		session.Device,
+		session.IP,
+		s.maxSessions,
+		auth.SessionKeyPrefix(userID),
+	).Int()
 	if err != nil {
-		return fmt.Errorf("replace refresh token: lua script error: %w", err)
+		return 0, fmt.Errorf("create session: lua script error: %w", err)
 	}
 
-	return nil
+	return evicted, nil
 }
 
+// Rotate заменяет oldJTI на newJTI. false — сессия неактивна или токен не текущий;
+// ErrRefreshTokenReused — oldJTI уже был ротирован, сессия завершена.
 func (s *RefreshTokenStore) Rotate(
 	ctx context.Context,
 	userID uuid.UUID,
-	oldJTI, oldSessionID string,
-	newJTI, newSessionID string,
+	sessionID string,
+	oldJTI, newJTI string,
 ) (bool, error) {
 	result, err := rotateScript.Run(
 		ctx,
@@ -114,24 +234,130 @@ func (s *RefreshTokenStore) Rotate(
 		[]string{
 			refreshKey(userID, oldJTI),
 			refreshKey(userID, newJTI),
-			auth.SessionKey(userID),
-			currentRefreshKey(userID),
+			auth.SessionKey(userID, sessionID),
+			auth.SessionIndexKey(userID),
+			rotatedJTIsKey(userID, sessionID),
 		},
 		s.ttl.Milliseconds(),
-		oldSessionID,
-		newSessionID,
+		sessionID,
+		time.Now().UnixMilli(),
+		oldJTI,
 	).Int()
 	if err != nil {
 		return false, fmt.Errorf("rotate refresh token: lua script error: %w", err)
 	}
+	if result == rotateResultReused {
+		return false, repositoryErrors.ErrRefreshTokenReused
+	}
+
+	return result == 1, nil
+}
+
+// RevokeSession завершает сессию sessionID. false — сессия уже неактивна.
+func (s *RefreshTokenStore) RevokeSession(
+	ctx context.Context,
+	userID uuid.UUID,
+	sessionID string,
+) (bool, error) {
+	result, err := revokeSessionScript.Run(
+		ctx,
+		s.store.ScriptRunner(),
+		[]string{
+			auth.SessionKey(userID, sessionID),
+			auth.SessionIndexKey(userID),
+		},
+		sessionID,
+	).Int()
+	if err != nil {
+		return false, fmt.Errorf("revoke session: lua script error: %w", err)
+	}
 
 	return result == 1, nil
 }
 
+func (s *RefreshTokenStore) RevokeAll(ctx context.Context, userID uuid.UUID) error {
+	_, err := revokeAllScript.Run(
+		ctx,
+		s.store.ScriptRunner(),
+		[]string{auth.SessionIndexKey(userID)},
+		auth.SessionKeyPrefix(userID),
+	).Result()
+	if err != nil {
+		return fmt.Errorf("revoke all sessions: lua script error: %w", err)
+	}
+
+	return nil
+}
+
+func (s *RefreshTokenStore) ListSessions(ctx context.Context, userID uuid.UUID) ([]models.Session, error) {
+	raw, err := listScript.Run(
+		ctx,
+		s.store.ScriptRunner(),
+		[]string{auth.SessionIndexKey(userID)},
+		auth.SessionKeyPrefix(userID),
+	).Slice()
+	if err != nil {
+		return nil, fmt.Errorf("list sessions: lua script error: %w", err)
+	}
+
+	sessions := make([]models.Session, 0, len(raw))
+	for _, item := range raw {
+		session, parseErr := parseSession(item)
+		if parseErr != nil {
+			return nil, fmt.Errorf("list sessions: %w", parseErr)
+		}
+		sessions = append(sessions, session)
+	}
+
+	return sessions, nil
+}
+
+func parseSession(item any) (models.Session, error) {
+	fields, ok := item.([]any)
+	if !ok || len(fields) != 5 {
+		return models.Session{}, fmt.Errorf("unexpected session entry %v", item)
+	}
+
+	values := make([]string, len(fields))
+	for i, field := range fields {
+		value, isString := field.(string)
+		if !isString {
+			return models.Session{}, fmt.Errorf("unexpected session field type %T", field)
+		}
+		values[i] = value
+	}
+
+	createdAt, err := parseUnixMilli(values[3])
+	if err != nil {
+		return models.Session{}, err
+	}
+	lastRefreshAt, err := parseUnixMilli(values[4])
+	if err != nil {
+		return models.Session{}, err
+	}
+
+	return models.Session{
+		ID:            values[0],
+		Device:        values[1],
+		IP:            values[2],
+		CreatedAt:     createdAt,
+		LastRefreshAt: lastRefreshAt,
+	}, nil
+}
+
+func parseUnixMilli(value string) (time.Time, error) {
+	ms, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parse session timestamp %q: %w", value, err)
+	}
+
+	return time.UnixMilli(ms).UTC(), nil
+}
+
 func refreshKey(userID uuid.UUID, jti string) string {
-	return fmt.Sprintf("%s:%s:%s", refreshPrefix, userID, jti)
+	return fmt.Sprintf("%s:%s:%s", refreshPrefix, auth.UserHashTag(userID), jti)
 }
 
-func currentRefreshKey(userID uuid.UUID) string {
-	return fmt.Sprintf("%s:%s", currentRefreshPrefix, userID)
+func rotatedJTIsKey(userID uuid.UUID, sessionID string) string {
+	return fmt.Sprintf("%s:%s:%s", rotatedJTIsPrefix, auth.UserHashTag(userID), sessionID)
 }
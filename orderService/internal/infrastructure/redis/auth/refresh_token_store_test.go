@@ -0,0 +1,211 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	redisGo "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nastyazhadan/spot-order-grpc/orderService/internal/domain/models"
+	session "github.com/nastyazhadan/spot-order-grpc/shared/auth/session"
+	repositoryErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/repository"
+	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/cache"
+)
+
+const (
+	testRefreshTTL  = time.Hour
+	testMaxSessions = 2
+)
+
+func newTestRefreshTokenStore(t *testing.T) (*RefreshTokenStore, *miniredis.Miniredis) {
+	t.Helper()
+
+	server := miniredis.RunT(t)
+	client := redisGo.NewClient(&redisGo.Options{Addr: server.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+
+	return New(cache.New(client), testRefreshTTL, testMaxSessions), server
+}
+
+func openSession(
+	t *testing.T,
+	store *RefreshTokenStore,
+	userID uuid.UUID,
+	sessionID, jti string,
+	createdAt time.Time,
+) int {
+	t.Helper()
+
+	evicted, err := store.Create(context.Background(), userID, jti, models.Session{
+		ID:        sessionID,
+		Device:    "curl/8.0",
+		IP:        "10.0.0.1",
+		CreatedAt: createdAt,
+	})
+	require.NoError(t, err)
+
+	return evicted
+}
+
+func TestRefreshTokenStoreCreate(t *testing.T) {
+	store, server := newTestRefreshTokenStore(t)
+	userID := uuid.New()
+	createdAt := time.Now().UTC().Truncate(time.Millisecond)
+
+	assert.Equal(t, 0, openSession(t, store, userID, "s1", "jti-1", createdAt))
+	assert.Equal(t, 0, openSession(t, store, userID, "s2", "jti-2", createdAt.Add(time.Second)))
+	// Лимит достигнут: самая старая сессия вытесняется вместе с refresh token
+	assert.Equal(t, 1, openSession(t, store, userID, "s3", "jti-3", createdAt.Add(2*time.Second)))
+
+	assert.False(t, server.Exists(session.SessionKey(userID, "s1")))
+	assert.False(t, server.Exists(refreshKey(userID, "jti-1")))
+	assert.True(t, server.Exists(refreshKey(userID, "jti-3")))
+
+	sessions, err := store.ListSessions(context.Background(), userID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	assert.Equal(t, "s2", sessions[0].ID)
+	assert.Equal(t, "s3", sessions[1].ID)
+	assert.Equal(t, "curl/8.0", sessions[1].Device)
+	assert.Equal(t, createdAt.Add(2*time.Second), sessions[1].CreatedAt)
+
+	// Все ключи пользователя под одним hash tag — скрипты выполнимы в Redis Cluster
+	for _, key := range server.Keys() {
+		assert.True(t, strings.Contains(key, session.UserHashTag(userID)), key)
+	}
+}
+
+func TestRefreshTokenStoreRotate(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+
+	tests := []struct {
+		name   string
+		rotate func(t *testing.T, store *RefreshTokenStore) (bool, error)
+		want   bool
+		err    error
+		active bool
+	}{
+		{
+			name: "текущий токен ротируется",
+			rotate: func(_ *testing.T, store *RefreshTokenStore) (bool, error) {
+				return store.Rotate(ctx, userID, "s1", "jti-1", "jti-2")
+			},
+			want:   true,
+			active: true,
+		},
+		{
+			name: "неизвестный токен — false, сессия остаётся",
+			rotate: func(_ *testing.T, store *RefreshTokenStore) (bool, error) {
+				return store.Rotate(ctx, userID, "s1", "jti-unknown", "jti-2")
+			},
+			active: true,
+		},
+		{
+			name: "повтор ротированного токена завершает сессию",
+			rotate: func(t *testing.T, store *RefreshTokenStore) (bool, error) {
+				rotated, err := store.Rotate(ctx, userID, "s1", "jti-1", "jti-2")
+				require.NoError(t, err)
+				require.True(t, rotated)
+
+				return store.Rotate(ctx, userID, "s1", "jti-1", "jti-3")
+			},
+			err: repositoryErrors.ErrRefreshTokenReused,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store, server := newTestRefreshTokenStore(t)
+			openSession(t, store, userID, "s1", "jti-1", time.Now())
+
+			rotated, err := tt.rotate(t, store)
+
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				// Последний выданный по цепочке токен тоже недействителен
+				assert.False(t, server.Exists(refreshKey(userID, "jti-2")))
+				assert.False(t, server.Exists(rotatedJTIsKey(userID, "s1")))
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, rotated)
+			}
+
+			assert.Equal(t, tt.active, server.Exists(session.SessionKey(userID, "s1")))
+			if tt.want {
+				assert.False(t, server.Exists(refreshKey(userID, "jti-1")))
+				assert.True(t, server.Exists(refreshKey(userID, "jti-2")))
+			}
+		})
+	}
+}
+
+func TestRefreshTokenStoreRevokeSession(t *testing.T) {
+	ctx := context.Background()
+	store, server := newTestRefreshTokenStore(t)
+	userID := uuid.New()
+	openSession(t, store, userID, "s1", "jti-1", time.Now())
+	openSession(t, store, userID, "s2", "jti-2", time.Now().Add(time.Second))
+
+	revoked, err := store.RevokeSession(ctx, userID, "s1")
+	require.NoError(t, err)
+	assert.True(t, revoked)
+	assert.False(t, server.Exists(refreshKey(userID, "jti-1")))
+
+	revoked, err = store.RevokeSession(ctx, userID, "s1")
+	require.NoError(t, err)
+	assert.False(t, revoked)
+
+	sessions, err := store.ListSessions(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, "s2", sessions[0].ID)
+}
+
+func TestRefreshTokenStoreRevokeAll(t *testing.T) {
+	ctx := context.Background()
+	store, server := newTestRefreshTokenStore(t)
+	userID := uuid.New()
+	other := uuid.New()
+	openSession(t, store, userID, "s1", "jti-1", time.Now())
+	openSession(t, store, userID, "s2", "jti-2", time.Now().Add(time.Second))
+	openSession(t, store, other, "s1", "jti-1", time.Now())
+
+	require.NoError(t, store.RevokeAll(ctx, userID))
+
+	sessions, err := store.ListSessions(ctx, userID)
+	require.NoError(t, err)
+	assert.Empty(t, sessions)
+	assert.False(t, server.Exists(refreshKey(userID, "jti-2")))
+
+	// Сессии других пользователей не затрагиваются
+	sessions, err = store.ListSessions(ctx, other)
+	require.NoError(t, err)
+	assert.Len(t, sessions, 1)
+}
+
+func TestRefreshTokenStoreListSessionsDropsExpired(t *testing.T) {
+	ctx := context.Background()
+	store, server := newTestRefreshTokenStore(t)
+	userID := uuid.New()
+	openSession(t, store, userID, "s1", "jti-1", time.Now())
+	openSession(t, store, userID, "s2", "jti-2", time.Now().Add(time.Second))
+
+	// Hash сессии истёк по TTL, а запись в индексе осталась
+	server.Del(session.SessionKey(userID, "s1"))
+
+	sessions, err := store.ListSessions(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, "s2", sessions[0].ID)
+
+	members, err := server.ZMembers(session.SessionIndexKey(userID))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"s2"}, members)
+}
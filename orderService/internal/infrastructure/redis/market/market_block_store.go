@@ -23,15 +23,18 @@ const (
 	unblockedState = "0"
 )
 
+// Значение ключа — "v<version>:<state>". Старый формат "<updated_at_ms>:<state>" с версиями
+// несравним, поэтому перезаписывается первым же обновлением.
 var synchronizeStateScript = redisGo.NewScript(`
 	local key = KEYS[1]
-	local newTs = tonumber(ARGV[1])
+	local newVersion = tonumber(ARGV[1])
 	local newState = ARGV[2]
 	local ttlMs = tonumber(ARGV[3])
+	local newValue = "v" .. tostring(newVersion) .. ":" .. newState
 
 	local current = redis.call("GET", key)
 	if not current then
-		redis.call("SET", key, tostring(newTs) .. ":" .. newState, "PX", ttlMs)
+		redis.call("SET", key, newValue, "PX", ttlMs)
 		return 1
 	end
 
@@ -40,16 +43,18 @@ var synchronizeStateScript = redisGo.NewScript(`
 		return redis.error_reply("invalid market block state")
 	end
 
-	local currentTs = tonumber(string.sub(current, 1, sep - 1))
-	if not currentTs then
-		return redis.error_reply("invalid market block timestamp")
-	end
+	if string.sub(current, 1, 1) == "v" then
+		local currentVersion = tonumber(string.sub(current, 2, sep - 1))
+		if not currentVersion then
+			return redis.error_reply("invalid market block version")
+		end
 
-	if newTs < currentTs then
-		return 0
+		if newVersion < currentVersion then
+			return 0
+		end
 	end
 
-	redis.call("SET", key, tostring(newTs) .. ":" .. newState, "PX", ttlMs)
+	redis.call("SET", key, newValue, "PX", ttlMs)
 	return 1
 `)
 
@@ -71,7 +76,7 @@ func (s *MarketBlockStore) SynchronizeState(
 	ctx context.Context,
 	marketID uuid.UUID,
 	blocked bool,
-	updatedAt time.Time,
+	version int64,
 ) (bool, error) {
 	const op = "redis.MarketBlockStore.SynchronizeState"
 
@@ -94,7 +99,7 @@ func (s *MarketBlockStore) SynchronizeState(
 		ctx,
 		s.store.ScriptRunner(),
 		[]string{blockKey(marketID)},
-		updatedAt.UTC().UnixMilli(),
+		version,
 		state,
 		ttlMs,
 	).Result()
@@ -175,18 +180,25 @@ func isCorruptedMarketBlockStateError(err error) bool {
 	msg := err.Error()
 
 	return strings.Contains(msg, "invalid market block state") ||
-		strings.Contains(msg, "invalid market block timestamp")
+		strings.Contains(msg, "invalid market block version")
 }
 
-func parseBlockedState(raw string) (bool, time.Time, error) {
+// parseBlockedState возвращает флаг блокировки и версию; для старого формата версия 0.
+func parseBlockedState(raw string) (bool, int64, error) {
 	parts := strings.Split(raw, ":")
 	if len(parts) != 2 {
-		return false, time.Time{}, fmt.Errorf("invalid blocked state format: %q", raw)
+		return false, 0, fmt.Errorf("invalid blocked state format: %q", raw)
 	}
 
-	tsMs, err := strconv.ParseInt(parts[0], 10, 64)
-	if err != nil {
-		return false, time.Time{}, fmt.Errorf("parse blocked state timestamp: %w", err)
+	var version int64
+	if rawVersion, ok := strings.CutPrefix(parts[0], "v"); ok {
+		parsed, err := strconv.ParseInt(rawVersion, 10, 64)
+		if err != nil {
+			return false, 0, fmt.Errorf("parse blocked state version: %w", err)
+		}
+		version = parsed
+	} else if _, err := strconv.ParseInt(parts[0], 10, 64); err != nil {
+		return false, 0, fmt.Errorf("parse blocked state timestamp: %w", err)
 	}
 
 	var blocked bool
@@ -196,10 +208,10 @@ func parseBlockedState(raw string) (bool, time.Time, error) {
 	case unblockedState:
 		blocked = false
 	default:
-		return false, time.Time{}, fmt.Errorf("invalid blocked state flag: %q", parts[1])
+		return false, 0, fmt.Errorf("invalid blocked state flag: %q", parts[1])
 	}
 
-	return blocked, time.UnixMilli(tsMs).UTC(), nil
+	return blocked, version, nil
 }
 
 func blockKey(marketID uuid.UUID) string {
@@ -0,0 +1,297 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	domainModels "github.com/nastyazhadan/spot-order-grpc/orderService/internal/domain/models"
+	"github.com/nastyazhadan/spot-order-grpc/shared/auth/apikey"
+	repositoryErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/repository"
+	authErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/service"
+	zapLogger "github.com/nastyazhadan/spot-order-grpc/shared/interceptors/logging/zap"
+	"github.com/nastyazhadan/spot-order-grpc/shared/metrics"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+	"github.com/nastyazhadan/spot-order-grpc/shared/requestctx"
+)
+
+// Токен проброса нужен только на время запроса
+const forwardTokenTTL = time.Minute
+
+type KeyStore interface {
+	CreateAPIKey(ctx context.Context, key domainModels.APIKey, maxActive int) error
+	ListAPIKeys(ctx context.Context, userID uuid.UUID) ([]domainModels.APIKey, error)
+	RevokeAPIKey(ctx context.Context, userID, keyID uuid.UUID) error
+	GetAPIKeyCredential(ctx context.Context, keyID uuid.UUID) (domainModels.APIKeyCredential, error)
+	TouchAPIKey(ctx context.Context, keyID uuid.UUID, usedAt time.Time) error
+}
+
+type ReplayStore interface {
+	Remember(ctx context.Context, keyID uuid.UUID, nonce string) (bool, error)
+}
+
+type TokenIssuer interface {
+	GenerateAPIKeyToken(
+		userID uuid.UUID,
+		roles []models.UserRole,
+		scopes []models.Permission,
+		keyID uuid.UUID,
+		ttl time.Duration,
+	) (string, error)
+}
+
+type APIKeyService struct {
+	keys         KeyStore
+	replays      ReplayStore
+	tokenIssuer  TokenIssuer
+	pepper       []byte
+	replayWindow time.Duration
+	maxPerUser   int
+	serviceName  string
+	logger       *zapLogger.Logger
+}
+
+func New(
+	keys KeyStore,
+	replays ReplayStore,
+	tokenIssuer TokenIssuer,
+	pepper []byte,
+	replayWindow time.Duration,
+	maxPerUser int,
+	serviceName string,
+	logger *zapLogger.Logger,
+) *APIKeyService {
+	return &APIKeyService{
+		keys:         keys,
+		replays:      replays,
+		tokenIssuer:  tokenIssuer,
+		pepper:       pepper,
+		replayWindow: replayWindow,
+		maxPerUser:   maxPerUser,
+		serviceName:  serviceName,
+		logger:       logger,
+	}
+}
+
+// Create выпускает ключ вызывающему пользователю. Секрет возвращается только здесь.
+func (s *APIKeyService) Create(
+	ctx context.Context,
+	name string,
+	scopes []domainModels.APIKeyScope,
+	allowedIPs []netip.Prefix,
+) (domainModels.APIKey, string, error) {
+	const op = "APIKeyService.Create"
+
+	userID, ok := requestctx.UserIDFromContext(ctx)
+	if !ok {
+		return domainModels.APIKey{}, "", authErrors.ErrInternalAuthContext
+	}
+
+	key := domainModels.APIKey{
+		ID:         uuid.New(),
+		UserID:     userID,
+		Name:       name,
+		Scopes:     scopes,
+		AllowedIPs: allowedIPs,
+		CreatedAt:  time.Now().UTC(),
+	}
+
+	if err := s.keys.CreateAPIKey(ctx, key, s.maxPerUser); err != nil {
+		if errors.Is(err, repositoryErrors.ErrAPIKeyLimitExceeded) {
+			return domainModels.APIKey{}, "", authErrors.ErrAPIKeyLimitExceeded
+		}
+		return domainModels.APIKey{}, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	s.logger.Info(ctx, "api key created",
+		zap.String("key_id", key.ID.String()),
+		zap.String("user_id", userID.String()),
+	)
+
+	return key, apikey.DeriveSecret(s.pepper, key.ID), nil
+}
+
+func (s *APIKeyService) List(ctx context.Context) ([]domainModels.APIKey, error) {
+	const op = "APIKeyService.List"
+
+	userID, ok := requestctx.UserIDFromContext(ctx)
+	if !ok {
+		return nil, authErrors.ErrInternalAuthContext
+	}
+
+	keys, err := s.keys.ListAPIKeys(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return keys, nil
+}
+
+// Revoke отзывает ключ вызывающего пользователя. Чужой ключ неотличим от несуществующего.
+func (s *APIKeyService) Revoke(ctx context.Context, keyID uuid.UUID) error {
+	const op = "APIKeyService.Revoke"
+
+	userID, ok := requestctx.UserIDFromContext(ctx)
+	if !ok {
+		return authErrors.ErrInternalAuthContext
+	}
+
+	if err := s.keys.RevokeAPIKey(ctx, userID, keyID); err != nil {
+		if errors.Is(err, repositoryErrors.ErrAPIKeyNotFound) {
+			return authErrors.ErrAPIKeyNotFound
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	s.logger.Info(ctx, "api key revoked",
+		zap.String("key_id", keyID.String()),
+		zap.String("user_id", userID.String()),
+	)
+
+	return nil
+}
+
+// AuthenticateAPIKey проверяет подпись, окно времени и адрес запроса.
+// Scope ключа возвращается в Principal и проверяется перехватчиком прав на метод.
+// Nonce запоминается последним, чтобы отклонённые запросы не расходовали его
+func (s *APIKeyService) AuthenticateAPIKey(
+	ctx context.Context,
+	request apikey.SignedRequest,
+) (apikey.Principal, error) {
+	principal, err := s.authenticate(ctx, request)
+	metrics.APIKeyAuthTotal.WithLabelValues(s.serviceName, authResult(err)).Inc()
+
+	return principal, err
+}
+
+func (s *APIKeyService) authenticate(
+	ctx context.Context,
+	request apikey.SignedRequest,
+) (apikey.Principal, error) {
+	keyID, err := uuid.Parse(request.KeyID)
+	if err != nil {
+		return apikey.Principal{}, authErrors.ErrInvalidAPIKey
+	}
+
+	timestamp, err := apikey.ParseTimestamp(request.Timestamp)
+	if err != nil || !apikey.ValidNonce(request.Nonce) {
+		return apikey.Principal{}, authErrors.ErrInvalidAPIKey
+	}
+
+	now := time.Now()
+	if skew := now.Sub(time.UnixMilli(timestamp)).Abs(); skew > s.replayWindow {
+		return apikey.Principal{}, authErrors.ErrAPIKeyRequestExpired
+	}
+
+	credential, err := s.keys.GetAPIKeyCredential(ctx, keyID)
+	if err != nil {
+		if errors.Is(err, repositoryErrors.ErrAPIKeyNotFound) {
+			return apikey.Principal{}, authErrors.ErrInvalidAPIKey
+		}
+		return apikey.Principal{}, fmt.Errorf("%w: %w", authErrors.ErrAPIKeyValidationFailed, err)
+	}
+	key := credential.Key
+
+	signingKey := apikey.SigningKey(apikey.DeriveSecret(s.pepper, keyID))
+	if !apikey.Verify(signingKey, request.Method, request.Timestamp, request.Nonce, request.Body, request.Signature) {
+		return apikey.Principal{}, authErrors.ErrInvalidAPIKey
+	}
+
+	if credential.OwnerDisabled {
+		return apikey.Principal{}, authErrors.ErrUserDisabled
+	}
+
+	if !isAllowedAddr(key.AllowedIPs, request.ClientIP) {
+		return apikey.Principal{}, authErrors.ErrAPIKeyIPNotAllowed
+	}
+
+	fresh, err := s.replays.Remember(ctx, keyID, request.Nonce)
+	if err != nil {
+		return apikey.Principal{}, fmt.Errorf("%w: %w", authErrors.ErrAPIKeyValidationFailed, err)
+	}
+	if !fresh {
+		return apikey.Principal{}, authErrors.ErrAPIKeyRequestReplayed
+	}
+
+	scopes := scopePermissions(key.Scopes)
+
+	// Токен только для проброса в spot-service: order-service токены типа api_key не принимает
+	forwardToken, err := s.tokenIssuer.GenerateAPIKeyToken(
+		key.UserID, credential.OwnerRoles, forwardScopes(scopes), keyID, forwardTokenTTL,
+	)
+	if err != nil {
+		return apikey.Principal{}, err
+	}
+
+	if err = s.keys.TouchAPIKey(ctx, keyID, now.UTC()); err != nil {
+		s.logger.Warn(ctx, "failed to update api key last usage",
+			zap.String("key_id", keyID.String()),
+			zap.Error(err),
+		)
+	}
+
+	return apikey.Principal{
+		UserID:       key.UserID,
+		Roles:        credential.OwnerRoles,
+		Scopes:       scopes,
+		KeyID:        keyID,
+		ForwardToken: forwardToken,
+	}, nil
+}
+
+func isAllowedAddr(allowed []netip.Prefix, addr netip.Addr) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	if !addr.IsValid() {
+		return false
+	}
+
+	for _, prefix := range allowed {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func scopePermissions(scopes []domainModels.APIKeyScope) []models.Permission {
+	permissions := make([]models.Permission, 0, len(scopes))
+	for _, scope := range scopes {
+		permissions = append(permissions, models.Permission(scope))
+	}
+
+	return permissions
+}
+
+// forwardScopes — scope токена проброса: права ключа и чтение рынков, без которого заказ не проверить.
+// Админские методы spot-service такому токену недоступны, какие бы роли ни были у владельца
+func forwardScopes(scopes []models.Permission) []models.Permission {
+	return append(slices.Clone(scopes), models.PermissionMarketsRead)
+}
+
+func authResult(err error) string {
+	switch {
+	case err == nil:
+		return "success"
+	case errors.Is(err, authErrors.ErrInvalidAPIKey):
+		return "invalid"
+	case errors.Is(err, authErrors.ErrAPIKeyRequestExpired):
+		return "expired"
+	case errors.Is(err, authErrors.ErrAPIKeyRequestReplayed):
+		return "replayed"
+	case errors.Is(err, authErrors.ErrAPIKeyIPNotAllowed):
+		return "ip_denied"
+	case errors.Is(err, authErrors.ErrUserDisabled):
+		return "disabled"
+	default:
+		return "error"
+	}
+}
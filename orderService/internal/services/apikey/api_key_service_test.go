@@ -0,0 +1,310 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	domainModels "github.com/nastyazhadan/spot-order-grpc/orderService/internal/domain/models"
+	"github.com/nastyazhadan/spot-order-grpc/orderService/internal/services/mocks"
+	"github.com/nastyazhadan/spot-order-grpc/shared/auth/apikey"
+	repositoryErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/repository"
+	authErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/service"
+	zapLogger "github.com/nastyazhadan/spot-order-grpc/shared/interceptors/logging/zap"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+	"github.com/nastyazhadan/spot-order-grpc/shared/requestctx"
+)
+
+const (
+	testPepper       = "test-pepper-of-at-least-32-bytes"
+	testMethod       = "/order.v1.OrderService/CreateOrder"
+	testForwardToken = "forward-token"
+	testReplayWindow = 30 * time.Second
+)
+
+type apiKeyDeps struct {
+	keys    *mocks.KeyStore
+	replays *mocks.ReplayStore
+	issuer  *mocks.TokenIssuer
+}
+
+func newAPIKeyDeps(t *testing.T) *apiKeyDeps {
+	return &apiKeyDeps{
+		keys:    mocks.NewKeyStore(t),
+		replays: mocks.NewReplayStore(t),
+		issuer:  mocks.NewTokenIssuer(t),
+	}
+}
+
+func (d *apiKeyDeps) service() *APIKeyService {
+	return New(
+		d.keys, d.replays, d.issuer,
+		[]byte(testPepper),
+		testReplayWindow,
+		10,
+		"order-service-test",
+		zapLogger.NewNop(),
+	)
+}
+
+func signedRequest(keyID uuid.UUID, secret string, at time.Time) apikey.SignedRequest {
+	return signedRequestWithNonce(keyID, secret, at, apikey.NewNonce())
+}
+
+func signedRequestWithNonce(keyID uuid.UUID, secret string, at time.Time, nonce string) apikey.SignedRequest {
+	timestamp := apikey.FormatTimestamp(at.UnixMilli())
+	body := []byte("payload")
+
+	return apikey.SignedRequest{
+		KeyID:     keyID.String(),
+		Timestamp: timestamp,
+		Nonce:     nonce,
+		Signature: apikey.Sign(apikey.SigningKey(secret), testMethod, timestamp, nonce, body),
+		Method:    testMethod,
+		Body:      body,
+		ClientIP:  netip.MustParseAddr("10.0.0.7"),
+	}
+}
+
+func TestAPIKeyServiceAuthenticate(t *testing.T) {
+	keyID := uuid.New()
+	userID := uuid.New()
+	roles := []models.UserRole{models.UserRoleUser}
+	testSecret := apikey.DeriveSecret([]byte(testPepper), keyID)
+
+	credential := func(modify func(*domainModels.APIKeyCredential)) domainModels.APIKeyCredential {
+		value := domainModels.APIKeyCredential{
+			Key: domainModels.APIKey{
+				ID:         keyID,
+				UserID:     userID,
+				Scopes:     []domainModels.APIKeyScope{domainModels.APIKeyScopeOrdersWrite},
+				AllowedIPs: []netip.Prefix{netip.MustParsePrefix("10.0.0.0/24")},
+			},
+			OwnerRoles: roles,
+		}
+		if modify != nil {
+			modify(&value)
+		}
+		return value
+	}
+
+	tests := []struct {
+		name        string
+		request     func() apikey.SignedRequest
+		setupMocks  func(d *apiKeyDeps, request apikey.SignedRequest)
+		expectedErr error
+	}{
+		{
+			name: "Успешная проверка подписи",
+			request: func() apikey.SignedRequest {
+				return signedRequest(keyID, testSecret, time.Now())
+			},
+			setupMocks: func(d *apiKeyDeps, request apikey.SignedRequest) {
+				d.keys.On("GetAPIKeyCredential", mock.Anything, keyID).Return(credential(nil), nil)
+				d.replays.On("Remember", mock.Anything, keyID, request.Nonce).Return(true, nil)
+				d.issuer.On("GenerateAPIKeyToken", userID, roles,
+					[]models.Permission{models.PermissionOrdersWrite, models.PermissionMarketsRead}, keyID, forwardTokenTTL).
+					Return(testForwardToken, nil)
+				d.keys.On("TouchAPIKey", mock.Anything, keyID, mock.AnythingOfType("time.Time")).Return(nil)
+			},
+		},
+		{
+			name: "Просроченная метка времени",
+			request: func() apikey.SignedRequest {
+				return signedRequest(keyID, testSecret, time.Now().Add(-2*testReplayWindow))
+			},
+			setupMocks:  func(*apiKeyDeps, apikey.SignedRequest) {},
+			expectedErr: authErrors.ErrAPIKeyRequestExpired,
+		},
+		{
+			name: "Метка времени в секундах вместо миллисекунд",
+			request: func() apikey.SignedRequest {
+				request := signedRequest(keyID, testSecret, time.Now())
+				request.Timestamp = apikey.FormatTimestamp(time.Now().Unix())
+				request.Signature = apikey.Sign(apikey.SigningKey(testSecret), testMethod, request.Timestamp, request.Nonce, request.Body)
+				return request
+			},
+			setupMocks:  func(*apiKeyDeps, apikey.SignedRequest) {},
+			expectedErr: authErrors.ErrAPIKeyRequestExpired,
+		},
+		{
+			name: "Слишком короткий nonce",
+			request: func() apikey.SignedRequest {
+				return signedRequestWithNonce(keyID, testSecret, time.Now(), "short")
+			},
+			setupMocks:  func(*apiKeyDeps, apikey.SignedRequest) {},
+			expectedErr: authErrors.ErrInvalidAPIKey,
+		},
+		{
+			name: "Nonce с недопустимыми символами",
+			request: func() apikey.SignedRequest {
+				return signedRequestWithNonce(keyID, testSecret, time.Now(), "nonce:with:colons:0123")
+			},
+			setupMocks:  func(*apiKeyDeps, apikey.SignedRequest) {},
+			expectedErr: authErrors.ErrInvalidAPIKey,
+		},
+		{
+			name: "Nonce не совпадает с подписанным",
+			request: func() apikey.SignedRequest {
+				request := signedRequest(keyID, testSecret, time.Now())
+				request.Nonce = apikey.NewNonce()
+				return request
+			},
+			setupMocks: func(d *apiKeyDeps, _ apikey.SignedRequest) {
+				d.keys.On("GetAPIKeyCredential", mock.Anything, keyID).Return(credential(nil), nil)
+			},
+			expectedErr: authErrors.ErrInvalidAPIKey,
+		},
+		{
+			name: "Неизвестный ключ",
+			request: func() apikey.SignedRequest {
+				return signedRequest(keyID, testSecret, time.Now())
+			},
+			setupMocks: func(d *apiKeyDeps, _ apikey.SignedRequest) {
+				d.keys.On("GetAPIKeyCredential", mock.Anything, keyID).
+					Return(domainModels.APIKeyCredential{}, repositoryErrors.ErrAPIKeyNotFound)
+			},
+			expectedErr: authErrors.ErrInvalidAPIKey,
+		},
+		{
+			name: "Неверная подпись",
+			request: func() apikey.SignedRequest {
+				return signedRequest(keyID, "other-secret", time.Now())
+			},
+			setupMocks: func(d *apiKeyDeps, _ apikey.SignedRequest) {
+				d.keys.On("GetAPIKeyCredential", mock.Anything, keyID).Return(credential(nil), nil)
+			},
+			expectedErr: authErrors.ErrInvalidAPIKey,
+		},
+		{
+			name: "Секрет выведен другим pepper",
+			request: func() apikey.SignedRequest {
+				return signedRequest(keyID, apikey.DeriveSecret([]byte("leaked-database-only"), keyID), time.Now())
+			},
+			setupMocks: func(d *apiKeyDeps, _ apikey.SignedRequest) {
+				d.keys.On("GetAPIKeyCredential", mock.Anything, keyID).Return(credential(nil), nil)
+			},
+			expectedErr: authErrors.ErrInvalidAPIKey,
+		},
+		{
+			name: "Владелец ключа отключён",
+			request: func() apikey.SignedRequest {
+				return signedRequest(keyID, testSecret, time.Now())
+			},
+			setupMocks: func(d *apiKeyDeps, _ apikey.SignedRequest) {
+				d.keys.On("GetAPIKeyCredential", mock.Anything, keyID).
+					Return(credential(func(c *domainModels.APIKeyCredential) { c.OwnerDisabled = true }), nil)
+			},
+			expectedErr: authErrors.ErrUserDisabled,
+		},
+		{
+			name: "Адрес клиента вне списка",
+			request: func() apikey.SignedRequest {
+				request := signedRequest(keyID, testSecret, time.Now())
+				request.ClientIP = netip.MustParseAddr("192.168.1.1")
+				return request
+			},
+			setupMocks: func(d *apiKeyDeps, _ apikey.SignedRequest) {
+				d.keys.On("GetAPIKeyCredential", mock.Anything, keyID).Return(credential(nil), nil)
+			},
+			expectedErr: authErrors.ErrAPIKeyIPNotAllowed,
+		},
+		{
+			name: "Повтор подписанного запроса",
+			request: func() apikey.SignedRequest {
+				return signedRequest(keyID, testSecret, time.Now())
+			},
+			setupMocks: func(d *apiKeyDeps, request apikey.SignedRequest) {
+				d.keys.On("GetAPIKeyCredential", mock.Anything, keyID).Return(credential(nil), nil)
+				d.replays.On("Remember", mock.Anything, keyID, request.Nonce).Return(false, nil)
+			},
+			expectedErr: authErrors.ErrAPIKeyRequestReplayed,
+		},
+		{
+			name: "Другой запрос с уже использованным nonce",
+			request: func() apikey.SignedRequest {
+				// Подпись отличается от первой (другое время), но nonce тот же
+				return signedRequestWithNonce(keyID, testSecret, time.Now().Add(time.Millisecond), "reused-nonce-0123456789")
+			},
+			setupMocks: func(d *apiKeyDeps, _ apikey.SignedRequest) {
+				d.keys.On("GetAPIKeyCredential", mock.Anything, keyID).Return(credential(nil), nil)
+				d.replays.On("Remember", mock.Anything, keyID, "reused-nonce-0123456789").Return(false, nil)
+			},
+			expectedErr: authErrors.ErrAPIKeyRequestReplayed,
+		},
+		{
+			name: "Ошибка хранилища nonce",
+			request: func() apikey.SignedRequest {
+				return signedRequest(keyID, testSecret, time.Now())
+			},
+			setupMocks: func(d *apiKeyDeps, request apikey.SignedRequest) {
+				d.keys.On("GetAPIKeyCredential", mock.Anything, keyID).Return(credential(nil), nil)
+				d.replays.On("Remember", mock.Anything, keyID, request.Nonce).
+					Return(false, errors.New("redis unavailable"))
+			},
+			expectedErr: authErrors.ErrAPIKeyValidationFailed,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			deps := newAPIKeyDeps(t)
+			request := test.request()
+			test.setupMocks(deps, request)
+
+			principal, err := deps.service().AuthenticateAPIKey(context.Background(), request)
+
+			if test.expectedErr != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, userID, principal.UserID)
+			assert.Equal(t, keyID, principal.KeyID)
+			assert.Equal(t, roles, principal.Roles)
+			assert.Equal(t, []models.Permission{models.PermissionOrdersWrite}, principal.Scopes)
+			assert.Equal(t, testForwardToken, principal.ForwardToken)
+		})
+	}
+}
+
+func TestAPIKeyServiceCreate(t *testing.T) {
+	userID := uuid.New()
+	ctx, _ := requestctx.ContextWithUserID(context.Background(), userID)
+
+	deps := newAPIKeyDeps(t)
+	deps.keys.On("CreateAPIKey", mock.Anything, mock.MatchedBy(func(key domainModels.APIKey) bool {
+		return key.UserID == userID && key.Name == "bot"
+	}), 10).Return(nil)
+
+	key, secret, err := deps.service().Create(ctx, "bot", []domainModels.APIKeyScope{domainModels.APIKeyScopeOrdersRead}, nil)
+	require.NoError(t, err)
+
+	// Секрет воспроизводим только с pepper сервиса
+	assert.Equal(t, apikey.DeriveSecret([]byte(testPepper), key.ID), secret)
+	assert.NotEqual(t, apikey.DeriveSecret([]byte("other-pepper"), key.ID), secret)
+}
+
+func TestAPIKeyServiceCreateLimitExceeded(t *testing.T) {
+	userID := uuid.New()
+	ctx, _ := requestctx.ContextWithUserID(context.Background(), userID)
+
+	// Лимит проверяет хранилище в той же транзакции, что и вставка
+	deps := newAPIKeyDeps(t)
+	deps.keys.On("CreateAPIKey", mock.Anything, mock.AnythingOfType("models.APIKey"), 10).
+		Return(fmt.Errorf("store: %w", repositoryErrors.ErrAPIKeyLimitExceeded))
+
+	_, secret, err := deps.service().Create(ctx, "bot", []domainModels.APIKeyScope{domainModels.APIKeyScopeOrdersRead}, nil)
+	require.ErrorIs(t, err, authErrors.ErrAPIKeyLimitExceeded)
+	assert.Empty(t, secret)
+}
@@ -2,121 +2,419 @@ package auth
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 
+	domainModels "github.com/nastyazhadan/spot-order-grpc/orderService/internal/domain/models"
 	authjwt "github.com/nastyazhadan/spot-order-grpc/shared/auth/jwt"
+	"github.com/nastyazhadan/spot-order-grpc/shared/auth/password"
+	repositoryErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/repository"
 	authErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/service"
 	zapLogger "github.com/nastyazhadan/spot-order-grpc/shared/interceptors/logging/zap"
+	"github.com/nastyazhadan/spot-order-grpc/shared/metrics"
 	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+	"github.com/nastyazhadan/spot-order-grpc/shared/requestctx"
 )
 
 type JWTManager interface {
 	GenerateAccessToken(userID uuid.UUID, roles []models.UserRole, sessionID string) (string, error)
 	GenerateRefreshToken(userID uuid.UUID, roles []models.UserRole, jti, sessionID string) (string, error)
-	ParseToken(tokenString string, expectedType authjwt.TokenType) (*authjwt.Claims, error)
+	ParseToken(tokenString string, expectedTypes ...authjwt.TokenType) (*authjwt.Claims, error)
 }
 
 type RefreshTokenStore interface {
-	Rotate(ctx context.Context, userID uuid.UUID, oldJTI, oldSessionID, newJTI, newSessionID string) (bool, error)
-	Replace(ctx context.Context, userID uuid.UUID, newJTI, newSessionID string) error
+	Create(ctx context.Context, userID uuid.UUID, jti string, session domainModels.Session) (int, error)
+	Rotate(ctx context.Context, userID uuid.UUID, sessionID, oldJTI, newJTI string) (bool, error)
+	RevokeSession(ctx context.Context, userID uuid.UUID, sessionID string) (bool, error)
+	RevokeAll(ctx context.Context, userID uuid.UUID) error
+	ListSessions(ctx context.Context, userID uuid.UUID) ([]domainModels.Session, error)
 }
 
 type SessionStore interface {
 	IsSessionActive(ctx context.Context, userID uuid.UUID, sessionID string) (bool, error)
 }
 
+type UserReader interface {
+	GetUserByUsername(ctx context.Context, username string) (domainModels.User, error)
+	GetUserByID(ctx context.Context, userID uuid.UUID) (domainModels.User, error)
+}
+
+type LoginAttemptStore interface {
+	IsLocked(ctx context.Context, username string) (bool, error)
+	RegisterFailure(ctx context.Context, username string) error
+	Reset(ctx context.Context, username string) error
+}
+
+type SecurityEventProducer interface {
+	ProduceSecurityEvent(ctx context.Context, event domainModels.SecurityEvent) error
+}
+
 type AuthService struct {
-	jwtManager   JWTManager
-	refreshStore RefreshTokenStore
-	sessionStore SessionStore
-	timeout      time.Duration
-	logger       *zapLogger.Logger
+	jwtManager     JWTManager
+	refreshStore   RefreshTokenStore
+	sessionStore   SessionStore
+	userStore      UserReader
+	attemptStore   LoginAttemptStore
+	securityEvents SecurityEventProducer
+	timeout        time.Duration
+	serviceName    string
+	logger         *zapLogger.Logger
 }
 
 func New(
 	jwtManager JWTManager,
 	refreshStore RefreshTokenStore,
 	sessionStore SessionStore,
+	userStore UserReader,
+	attemptStore LoginAttemptStore,
+	securityEvents SecurityEventProducer,
 	timeout time.Duration,
+	serviceName string,
 	logger *zapLogger.Logger,
 ) *AuthService {
 	return &AuthService{
-		jwtManager:   jwtManager,
-		refreshStore: refreshStore,
-		sessionStore: sessionStore,
-		timeout:      timeout,
-		logger:       logger,
+		jwtManager:     jwtManager,
+		refreshStore:   refreshStore,
+		sessionStore:   sessionStore,
+		userStore:      userStore,
+		attemptStore:   attemptStore,
+		securityEvents: securityEvents,
+		timeout:        timeout,
+		serviceName:    serviceName,
+		logger:         logger,
+	}
+}
+
+// Login проверяет пароль и открывает новую сессию; сверх лимита сессий вытесняются самые старые.
+// Для неизвестного пользователя и неверного пароля возвращается одна и та же ошибка.
+func (s *AuthService) Login(
+	ctx context.Context,
+	username, plainPassword string,
+	client domainModels.SessionClient,
+) (accessToken, refreshToken string, err error) {
+	ctx, cancel := contextWithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	username = NormalizeUsername(username)
+
+	user, err := s.authenticate(ctx, username, plainPassword)
+	if err != nil {
+		metrics.LoginAttemptsTotal.WithLabelValues(s.serviceName, loginResult(err)).Inc()
+		return "", "", err
+	}
+
+	if err = s.attemptStore.Reset(ctx, username); err != nil {
+		s.logger.Warn(ctx, "failed to reset login attempts", zap.Error(err))
+	}
+
+	refreshJTI := uuid.NewString()
+	sessionID := uuid.NewString()
+
+	accessToken, refreshToken, err = s.generateTokenPair(user.ID, user.Roles, refreshJTI, sessionID)
+	if err != nil {
+		metrics.LoginAttemptsTotal.WithLabelValues(s.serviceName, "error").Inc()
+		return "", "", err
+	}
+
+	session := domainModels.Session{
+		ID:        sessionID,
+		Device:    client.Device,
+		IP:        client.IP,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	evicted, err := s.refreshStore.Create(ctx, user.ID, refreshJTI, session)
+	if err != nil {
+		s.logger.Error(ctx, "failed to register login session", zap.Error(err))
+		metrics.LoginAttemptsTotal.WithLabelValues(s.serviceName, "error").Inc()
+		return "", "", authErrors.ErrSaveTokenFailed
+	}
+	if evicted > 0 {
+		s.logger.Info(ctx, "oldest sessions evicted by session limit",
+			zap.String("user_id", user.ID.String()),
+			zap.Int("evicted", evicted),
+		)
+	}
+
+	metrics.LoginAttemptsTotal.WithLabelValues(s.serviceName, "success").Inc()
+	return accessToken, refreshToken, nil
+}
+
+// Logout завершает сессию, которой принадлежит access token запроса.
+// Повторный вызов для уже завершённой сессии не считается ошибкой.
+func (s *AuthService) Logout(ctx context.Context) error {
+	ctx, cancel := contextWithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	userID, ok := requestctx.UserIDFromContext(ctx)
+	if !ok {
+		return authErrors.ErrInternalAuthContext
+	}
+	sessionID, ok := requestctx.SessionIDFromContext(ctx)
+	if !ok {
+		return authErrors.ErrInternalAuthContext
+	}
+
+	if _, err := s.refreshStore.RevokeSession(ctx, userID, sessionID); err != nil {
+		s.logger.Error(ctx, "failed to revoke session", zap.Error(err))
+		return authErrors.ErrRevokeTokenFailed
+	}
+
+	return nil
+}
+
+// ListMySessions возвращает активные сессии вызывающего пользователя и id текущей.
+func (s *AuthService) ListMySessions(ctx context.Context) ([]domainModels.Session, string, error) {
+	ctx, cancel := contextWithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	userID, ok := requestctx.UserIDFromContext(ctx)
+	if !ok {
+		return nil, "", authErrors.ErrInternalAuthContext
+	}
+	currentSessionID, _ := requestctx.SessionIDFromContext(ctx)
+
+	sessions, err := s.refreshStore.ListSessions(ctx, userID)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list sessions", zap.Error(err))
+		return nil, "", authErrors.ErrSessionValidationFailed
+	}
+
+	return sessions, currentSessionID, nil
+}
+
+// RevokeSession завершает одну из сессий вызывающего пользователя, например на потерянном устройстве.
+func (s *AuthService) RevokeSession(ctx context.Context, sessionID string) error {
+	ctx, cancel := contextWithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	userID, ok := requestctx.UserIDFromContext(ctx)
+	if !ok {
+		return authErrors.ErrInternalAuthContext
+	}
+
+	revoked, err := s.refreshStore.RevokeSession(ctx, userID, sessionID)
+	if err != nil {
+		s.logger.Error(ctx, "failed to revoke session", zap.Error(err))
+		return authErrors.ErrRevokeTokenFailed
+	}
+	if !revoked {
+		return authErrors.ErrSessionNotFound
+	}
+
+	return nil
+}
+
+// RevokeUserSessions завершает все сессии пользователя. Право sessions:admin проверяет перехватчик authz
+func (s *AuthService) RevokeUserSessions(ctx context.Context, userID uuid.UUID) error {
+	ctx, cancel := contextWithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	if err := s.refreshStore.RevokeAll(ctx, userID); err != nil {
+		s.logger.Error(ctx, "failed to revoke user sessions",
+			zap.String("target_user_id", userID.String()),
+			zap.Error(err),
+		)
+		return authErrors.ErrRevokeTokenFailed
+	}
+
+	s.logger.Info(ctx, "user sessions revoked", zap.String("target_user_id", userID.String()))
+	return nil
+}
+
+func (s *AuthService) authenticate(
+	ctx context.Context,
+	username, plainPassword string,
+) (domainModels.User, error) {
+	locked, err := s.attemptStore.IsLocked(ctx, username)
+	if err != nil {
+		s.logger.Error(ctx, "failed to check login lockout", zap.Error(err))
+		return domainModels.User{}, authErrors.ErrLoginFailed
+	}
+	if locked {
+		return domainModels.User{}, authErrors.ErrLoginLocked
 	}
+
+	user, err := s.userStore.GetUserByUsername(ctx, username)
+	if err != nil {
+		if !errors.Is(err, repositoryErrors.ErrUserNotFound) {
+			s.logger.Error(ctx, "failed to load user", zap.Error(err))
+			return domainModels.User{}, authErrors.ErrLoginFailed
+		}
+
+		password.VerifyDummy(plainPassword)
+		s.registerFailure(ctx, username)
+		return domainModels.User{}, authErrors.ErrInvalidCredentials
+	}
+
+	if !password.Verify(user.PasswordHash, plainPassword) {
+		s.registerFailure(ctx, username)
+		return domainModels.User{}, authErrors.ErrInvalidCredentials
+	}
+
+	// Статус раскрываем только после верного пароля
+	if user.Disabled {
+		return domainModels.User{}, authErrors.ErrUserDisabled
+	}
+
+	return user, nil
+}
+
+func (s *AuthService) registerFailure(ctx context.Context, username string) {
+	if err := s.attemptStore.RegisterFailure(ctx, username); err != nil {
+		s.logger.Warn(ctx, "failed to register failed login attempt", zap.Error(err))
+	}
+}
+
+// NormalizeUsername приводит имя к виду, в котором оно хранится в users.
+func NormalizeUsername(username string) string {
+	return strings.ToLower(strings.TrimSpace(username))
 }
 
+func loginResult(err error) string {
+	switch {
+	case errors.Is(err, authErrors.ErrInvalidCredentials):
+		return "invalid_credentials"
+	case errors.Is(err, authErrors.ErrUserDisabled):
+		return "disabled"
+	case errors.Is(err, authErrors.ErrLoginLocked):
+		return "locked"
+	default:
+		return "error"
+	}
+}
+
+// Refresh ротирует refresh token сессии. Роли берутся из users, а не из старого токена.
+// Повторное предъявление уже ротированного токена считается утечкой: сессия завершается целиком, и нужен новый Login.
 func (s *AuthService) Refresh(
 	ctx context.Context,
 	refreshToken string,
+	client domainModels.SessionClient,
 ) (newAccessToken, newRefreshToken string, err error) {
 	ctx, cancel := contextWithTimeout(ctx, s.timeout)
 	defer cancel()
 
-	userID, roles, oldJTI, oldSessionID, err := s.validateRefreshToken(ctx, refreshToken)
+	userID, oldJTI, oldSessionID, err := s.validateRefreshToken(ctx, refreshToken)
+	if err != nil {
+		return "", "", err
+	}
+
+	roles, err := s.currentRoles(ctx, userID)
 	if err != nil {
 		return "", "", err
 	}
 
-	return s.rotateTokens(ctx, userID, roles, oldJTI, oldSessionID)
+	newAccessToken, newRefreshToken, err = s.rotateTokens(ctx, userID, roles, oldJTI, oldSessionID)
+	if errors.Is(err, authErrors.ErrRefreshTokenReused) {
+		s.reportRefreshTokenReuse(ctx, userID, oldSessionID, oldJTI, client)
+	}
+
+	return newAccessToken, newRefreshToken, err
+}
+
+// reportRefreshTokenReuse не влияет на ответ: сессия к этому моменту уже завершена
+func (s *AuthService) reportRefreshTokenReuse(
+	ctx context.Context,
+	userID uuid.UUID,
+	sessionID, jti string,
+	client domainModels.SessionClient,
+) {
+	metrics.SecurityEventsTotal.WithLabelValues(s.serviceName, domainModels.SecurityEventRefreshTokenReuse).Inc()
+
+	s.logger.Warn(ctx, "refresh token reuse detected, session revoked",
+		zap.String("user_id", userID.String()),
+		zap.String("session_id", sessionID),
+		zap.String("jti", jti),
+		zap.String("ip", client.IP),
+		zap.String("device", client.Device),
+	)
+
+	event := domainModels.SecurityEvent{
+		EventID:    uuid.New(),
+		Type:       domainModels.SecurityEventRefreshTokenReuse,
+		UserID:     userID,
+		SessionID:  sessionID,
+		Client:     client,
+		OccurredAt: time.Now().UTC(),
+	}
+	if err := s.securityEvents.ProduceSecurityEvent(ctx, event); err != nil {
+		s.logger.Error(ctx, "failed to publish security event",
+			zap.String("event_id", event.EventID.String()),
+			zap.Error(err),
+		)
+	}
 }
 
 func (s *AuthService) validateRefreshToken(
 	ctx context.Context,
 	refreshToken string,
-) (uuid.UUID, []models.UserRole, string, string, error) {
+) (uuid.UUID, string, string, error) {
 	claims, err := s.jwtManager.ParseToken(refreshToken, authjwt.TokenTypeRefresh)
 	if err != nil {
-		return uuid.Nil, nil, "", "", err
+		return uuid.Nil, "", "", err
 	}
 
 	userID, err := uuid.Parse(claims.Subject)
 	if err != nil {
-		return uuid.Nil, nil, "", "", authErrors.ErrInvalidSubject
+		return uuid.Nil, "", "", authErrors.ErrInvalidSubject
 	}
 
 	if claims.ID == "" {
-		return uuid.Nil, nil, "", "", authErrors.ErrInvalidJTI
-	}
-
-	roles, err := authjwt.ParseUserRolesClaims(claims.UserRoles)
-	if err != nil {
-		return uuid.Nil, nil, "", "", err
+		return uuid.Nil, "", "", authErrors.ErrInvalidJTI
 	}
 
 	active, err := s.sessionStore.IsSessionActive(ctx, userID, claims.SessionID)
 	if err != nil {
 		s.logger.Error(ctx, "failed to validate refresh token session", zap.Error(err))
-		return uuid.Nil, nil, "", "", authErrors.ErrSessionValidationFailed
+		return uuid.Nil, "", "", authErrors.ErrSessionValidationFailed
 	}
 	if !active {
-		return uuid.Nil, nil, "", "", authErrors.ErrTokenRevoked
+		return uuid.Nil, "", "", authErrors.ErrTokenRevoked
 	}
 
-	return userID, roles, claims.ID, claims.SessionID, nil
+	return userID, claims.ID, claims.SessionID, nil
+}
+
+// currentRoles читает актуальные роли пользователя: удалённый или отключённый пользователь сессию не продлевает
+func (s *AuthService) currentRoles(ctx context.Context, userID uuid.UUID) ([]models.UserRole, error) {
+	user, err := s.userStore.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repositoryErrors.ErrUserNotFound) {
+			return nil, authErrors.ErrTokenRevoked
+		}
+
+		s.logger.Error(ctx, "failed to load user for refresh", zap.Error(err))
+		return nil, authErrors.ErrSessionValidationFailed
+	}
+
+	if user.Disabled {
+		return nil, authErrors.ErrUserDisabled
+	}
+
+	return user.Roles, nil
 }
 
 func (s *AuthService) rotateTokens(
 	ctx context.Context,
 	userID uuid.UUID,
 	roles []models.UserRole,
-	oldJTI, oldSessionID string,
+	oldJTI, sessionID string,
 ) (newAccessToken, newRefreshToken string, err error) {
 	newJTI := uuid.NewString()
-	newSessionID := oldSessionID
 
-	newAccessToken, newRefreshToken, err = s.generateTokenPair(userID, roles, newJTI, newSessionID)
+	newAccessToken, newRefreshToken, err = s.generateTokenPair(userID, roles, newJTI, sessionID)
 	if err != nil {
 		return "", "", err
 	}
 
-	rotated, err := s.refreshStore.Rotate(ctx, userID, oldJTI, oldSessionID, newJTI, newSessionID)
+	rotated, err := s.refreshStore.Rotate(ctx, userID, sessionID, oldJTI, newJTI)
+	if errors.Is(err, repositoryErrors.ErrRefreshTokenReused) {
+		return "", "", authErrors.ErrRefreshTokenReused
+	}
 	if err != nil {
 		s.logger.Error(ctx, "failed to rotate refresh token", zap.Error(err))
 		return "", "", authErrors.ErrSaveTokenFailed
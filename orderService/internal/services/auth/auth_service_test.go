@@ -0,0 +1,406 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	domainModels "github.com/nastyazhadan/spot-order-grpc/orderService/internal/domain/models"
+	"github.com/nastyazhadan/spot-order-grpc/orderService/internal/services/mocks"
+	authjwt "github.com/nastyazhadan/spot-order-grpc/shared/auth/jwt"
+	"github.com/nastyazhadan/spot-order-grpc/shared/auth/password"
+	repositoryErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/repository"
+	authErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/service"
+	zapLogger "github.com/nastyazhadan/spot-order-grpc/shared/interceptors/logging/zap"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+	"github.com/nastyazhadan/spot-order-grpc/shared/requestctx"
+)
+
+const (
+	testPassword = "secret-password"
+	testTokenTTL = time.Minute
+)
+
+var testClient = domainModels.SessionClient{Device: "curl/8.0", IP: "10.0.0.1"}
+
+type authDeps struct {
+	jwt      *authjwt.Manager
+	refresh  *mocks.RefreshTokenStore
+	sessions *mocks.SessionStore
+	users    *mocks.UserReader
+	attempts *mocks.LoginAttemptStore
+	events   *mocks.SecurityEventProducer
+}
+
+func newAuthDeps(t *testing.T) *authDeps {
+	return &authDeps{
+		jwt:      authjwt.NewManager("test-secret", testTokenTTL, testTokenTTL),
+		refresh:  mocks.NewRefreshTokenStore(t),
+		sessions: mocks.NewSessionStore(t),
+		users:    mocks.NewUserReader(t),
+		attempts: mocks.NewLoginAttemptStore(t),
+		events:   mocks.NewSecurityEventProducer(t),
+	}
+}
+
+func (d *authDeps) service() *AuthService {
+	return New(d.jwt, d.refresh, d.sessions, d.users, d.attempts, d.events, time.Second, "order-service", zapLogger.NewNop())
+}
+
+func newTestUser(t *testing.T, disabled bool) domainModels.User {
+	t.Helper()
+
+	hash, err := password.Hash(testPassword)
+	require.NoError(t, err)
+
+	return domainModels.User{
+		ID:           uuid.New(),
+		Username:     "alice",
+		PasswordHash: hash,
+		Roles:        []models.UserRole{models.UserRoleUser},
+		Disabled:     disabled,
+	}
+}
+
+func TestAuthServiceLogin(t *testing.T) {
+	user := newTestUser(t, false)
+	disabled := newTestUser(t, true)
+
+	tests := []struct {
+		name        string
+		password    string
+		setupMocks  func(d *authDeps)
+		expectedErr error
+	}{
+		{
+			name:     "Успешный вход открывает сессию",
+			password: testPassword,
+			setupMocks: func(d *authDeps) {
+				d.attempts.On("IsLocked", mock.Anything, "alice").Return(false, nil)
+				d.users.On("GetUserByUsername", mock.Anything, "alice").Return(user, nil)
+				d.attempts.On("Reset", mock.Anything, "alice").Return(nil)
+				d.refresh.On("Create", mock.Anything, user.ID, mock.Anything, mock.MatchedBy(func(session domainModels.Session) bool {
+					return session.ID != "" && session.Device == testClient.Device && session.IP == testClient.IP
+				})).Return(1, nil)
+			},
+		},
+		{
+			name:     "Неверный пароль засчитывается как неудача",
+			password: "wrong-password",
+			setupMocks: func(d *authDeps) {
+				d.attempts.On("IsLocked", mock.Anything, "alice").Return(false, nil)
+				d.users.On("GetUserByUsername", mock.Anything, "alice").Return(user, nil)
+				d.attempts.On("RegisterFailure", mock.Anything, "alice").Return(nil)
+			},
+			expectedErr: authErrors.ErrInvalidCredentials,
+		},
+		{
+			name:     "Неизвестный пользователь неотличим от неверного пароля",
+			password: testPassword,
+			setupMocks: func(d *authDeps) {
+				d.attempts.On("IsLocked", mock.Anything, "alice").Return(false, nil)
+				d.users.On("GetUserByUsername", mock.Anything, "alice").Return(domainModels.User{}, repositoryErrors.ErrUserNotFound)
+				d.attempts.On("RegisterFailure", mock.Anything, "alice").Return(nil)
+			},
+			expectedErr: authErrors.ErrInvalidCredentials,
+		},
+		{
+			name:     "Вход заблокирован после серии неудач",
+			password: testPassword,
+			setupMocks: func(d *authDeps) {
+				d.attempts.On("IsLocked", mock.Anything, "alice").Return(true, nil)
+			},
+			expectedErr: authErrors.ErrLoginLocked,
+		},
+		{
+			name:     "Ошибка проверки блокировки",
+			password: testPassword,
+			setupMocks: func(d *authDeps) {
+				d.attempts.On("IsLocked", mock.Anything, "alice").Return(false, errors.New("redis down"))
+			},
+			expectedErr: authErrors.ErrLoginFailed,
+		},
+		{
+			name:     "Отключённый пользователь с верным паролем",
+			password: testPassword,
+			setupMocks: func(d *authDeps) {
+				d.attempts.On("IsLocked", mock.Anything, "alice").Return(false, nil)
+				d.users.On("GetUserByUsername", mock.Anything, "alice").Return(disabled, nil)
+			},
+			expectedErr: authErrors.ErrUserDisabled,
+		},
+		{
+			name:     "Ошибка сохранения сессии",
+			password: testPassword,
+			setupMocks: func(d *authDeps) {
+				d.attempts.On("IsLocked", mock.Anything, "alice").Return(false, nil)
+				d.users.On("GetUserByUsername", mock.Anything, "alice").Return(user, nil)
+				d.attempts.On("Reset", mock.Anything, "alice").Return(nil)
+				d.refresh.On("Create", mock.Anything, user.ID, mock.Anything, mock.Anything).Return(0, errors.New("redis down"))
+			},
+			expectedErr: authErrors.ErrSaveTokenFailed,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			deps := newAuthDeps(t)
+			test.setupMocks(deps)
+
+			accessToken, refreshToken, err := deps.service().Login(context.Background(), "  Alice ", test.password, testClient)
+
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+				assert.Empty(t, accessToken)
+				assert.Empty(t, refreshToken)
+				return
+			}
+
+			require.NoError(t, err)
+
+			access, err := deps.jwt.ParseToken(accessToken, authjwt.TokenTypeAccess)
+			require.NoError(t, err)
+			refresh, err := deps.jwt.ParseToken(refreshToken, authjwt.TokenTypeRefresh)
+			require.NoError(t, err)
+
+			assert.Equal(t, user.ID.String(), access.Subject)
+			assert.Equal(t, []string{models.UserRoleUser.String()}, access.UserRoles)
+			assert.Equal(t, access.SessionID, refresh.SessionID)
+			assert.NotEmpty(t, refresh.ID)
+		})
+	}
+}
+
+func contextWithUser(userID uuid.UUID, sessionID string, roles ...models.UserRole) context.Context {
+	ctx, _ := requestctx.ContextWithUserID(context.Background(), userID)
+	ctx, _ = requestctx.ContextWithSessionID(ctx, sessionID)
+	ctx, _ = requestctx.ContextWithUserRoles(ctx, roles)
+	return ctx
+}
+
+func TestAuthServiceLogout(t *testing.T) {
+	userID := uuid.New()
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		setupMocks  func(d *authDeps)
+		expectedErr error
+	}{
+		{
+			name: "Завершается сессия текущего токена",
+			ctx:  contextWithUser(userID, "s1", models.UserRoleUser),
+			setupMocks: func(d *authDeps) {
+				d.refresh.On("RevokeSession", mock.Anything, userID, "s1").Return(true, nil)
+			},
+		},
+		{
+			name: "Повторный Logout не ошибка",
+			ctx:  contextWithUser(userID, "s1", models.UserRoleUser),
+			setupMocks: func(d *authDeps) {
+				d.refresh.On("RevokeSession", mock.Anything, userID, "s1").Return(false, nil)
+			},
+		},
+		{
+			name:        "Нет пользователя в контексте",
+			ctx:         context.Background(),
+			setupMocks:  func(*authDeps) {},
+			expectedErr: authErrors.ErrInternalAuthContext,
+		},
+		{
+			name: "Ошибка хранилища",
+			ctx:  contextWithUser(userID, "s1", models.UserRoleUser),
+			setupMocks: func(d *authDeps) {
+				d.refresh.On("RevokeSession", mock.Anything, userID, "s1").Return(false, errors.New("redis down"))
+			},
+			expectedErr: authErrors.ErrRevokeTokenFailed,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			deps := newAuthDeps(t)
+			test.setupMocks(deps)
+
+			err := deps.service().Logout(test.ctx)
+
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAuthServiceRevokeSession(t *testing.T) {
+	userID := uuid.New()
+
+	tests := []struct {
+		name        string
+		setupMocks  func(d *authDeps)
+		expectedErr error
+	}{
+		{
+			name: "Завершается другая сессия пользователя",
+			setupMocks: func(d *authDeps) {
+				d.refresh.On("RevokeSession", mock.Anything, userID, "s2").Return(true, nil)
+			},
+		},
+		{
+			name: "Сессии нет",
+			setupMocks: func(d *authDeps) {
+				d.refresh.On("RevokeSession", mock.Anything, userID, "s2").Return(false, nil)
+			},
+			expectedErr: authErrors.ErrSessionNotFound,
+		},
+		{
+			name: "Ошибка хранилища",
+			setupMocks: func(d *authDeps) {
+				d.refresh.On("RevokeSession", mock.Anything, userID, "s2").Return(false, errors.New("redis down"))
+			},
+			expectedErr: authErrors.ErrRevokeTokenFailed,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			deps := newAuthDeps(t)
+			test.setupMocks(deps)
+
+			err := deps.service().RevokeSession(contextWithUser(userID, "s1", models.UserRoleUser), "s2")
+
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAuthServiceRevokeUserSessions(t *testing.T) {
+	targetID := uuid.New()
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		setupMocks  func(d *authDeps)
+		expectedErr error
+	}{
+		{
+			name: "Администратор завершает все сессии пользователя",
+			ctx:  contextWithUser(uuid.New(), "s1", models.UserRoleAdmin),
+			setupMocks: func(d *authDeps) {
+				d.refresh.On("RevokeAll", mock.Anything, targetID).Return(nil)
+			},
+		},
+		{
+			name: "Ошибка хранилища",
+			ctx:  contextWithUser(uuid.New(), "s1", models.UserRoleAdmin),
+			setupMocks: func(d *authDeps) {
+				d.refresh.On("RevokeAll", mock.Anything, targetID).Return(errors.New("redis down"))
+			},
+			expectedErr: authErrors.ErrRevokeTokenFailed,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			deps := newAuthDeps(t)
+			test.setupMocks(deps)
+
+			err := deps.service().RevokeUserSessions(test.ctx, targetID)
+
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAuthServiceRefresh(t *testing.T) {
+	user := newTestUser(t, false)
+
+	tests := []struct {
+		name        string
+		setupMocks  func(d *authDeps)
+		expectedErr error
+	}{
+		{
+			name: "Токен ротируется в рамках сессии",
+			setupMocks: func(d *authDeps) {
+				d.sessions.On("IsSessionActive", mock.Anything, user.ID, "s1").Return(true, nil)
+				d.users.On("GetUserByID", mock.Anything, user.ID).Return(user, nil)
+				d.refresh.On("Rotate", mock.Anything, user.ID, "s1", "jti-1", mock.Anything).Return(true, nil)
+			},
+		},
+		{
+			name: "Сессия уже завершена",
+			setupMocks: func(d *authDeps) {
+				d.sessions.On("IsSessionActive", mock.Anything, user.ID, "s1").Return(false, nil)
+			},
+			expectedErr: authErrors.ErrTokenRevoked,
+		},
+		{
+			name: "Повтор ротированного токена завершает сессию и публикует событие",
+			setupMocks: func(d *authDeps) {
+				d.sessions.On("IsSessionActive", mock.Anything, user.ID, "s1").Return(true, nil)
+				d.users.On("GetUserByID", mock.Anything, user.ID).Return(user, nil)
+				d.refresh.On("Rotate", mock.Anything, user.ID, "s1", "jti-1", mock.Anything).
+					Return(false, repositoryErrors.ErrRefreshTokenReused)
+				d.events.On("ProduceSecurityEvent", mock.Anything, mock.MatchedBy(func(event domainModels.SecurityEvent) bool {
+					return event.Type == domainModels.SecurityEventRefreshTokenReuse &&
+						event.UserID == user.ID && event.SessionID == "s1" && event.Client == testClient
+				})).Return(nil)
+			},
+			expectedErr: authErrors.ErrRefreshTokenReused,
+		},
+		{
+			name: "Ошибка публикации события не меняет ответ",
+			setupMocks: func(d *authDeps) {
+				d.sessions.On("IsSessionActive", mock.Anything, user.ID, "s1").Return(true, nil)
+				d.users.On("GetUserByID", mock.Anything, user.ID).Return(user, nil)
+				d.refresh.On("Rotate", mock.Anything, user.ID, "s1", "jti-1", mock.Anything).
+					Return(false, repositoryErrors.ErrRefreshTokenReused)
+				d.events.On("ProduceSecurityEvent", mock.Anything, mock.Anything).Return(errors.New("kafka down"))
+			},
+			expectedErr: authErrors.ErrRefreshTokenReused,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			deps := newAuthDeps(t)
+			test.setupMocks(deps)
+
+			refreshToken, err := deps.jwt.GenerateRefreshToken(user.ID, user.Roles, "jti-1", "s1")
+			require.NoError(t, err)
+
+			accessToken, newRefreshToken, err := deps.service().Refresh(context.Background(), refreshToken, testClient)
+
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+				assert.Empty(t, accessToken)
+				assert.Empty(t, newRefreshToken)
+				return
+			}
+
+			require.NoError(t, err)
+
+			refresh, err := deps.jwt.ParseToken(newRefreshToken, authjwt.TokenTypeRefresh)
+			require.NoError(t, err)
+			assert.Equal(t, "s1", refresh.SessionID)
+			assert.NotEqual(t, "jti-1", refresh.ID)
+			deps.refresh.AssertCalled(t, "Rotate", mock.Anything, user.ID, "s1", "jti-1", refresh.ID)
+		})
+	}
+}
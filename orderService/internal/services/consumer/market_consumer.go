@@ -23,12 +23,12 @@ type Consumer interface {
 }
 
 type MarketEventProcessor interface {
-	ProcessMarketStateChanged(
+	ProcessMarketUpdated(
 		ctx context.Context,
 		topic string,
 		consumerGroup string,
 		rawPayload []byte,
-		event models.MarketStateChangedEvent,
+		event models.MarketUpdatedEvent,
 	) error
 }
 
@@ -54,13 +54,13 @@ func NewMarketConsumer(
 }
 
 func (c *MarketConsumer) Run(ctx context.Context) error {
-	return c.consumer.Consume(ctx, c.handleMarketStateChanged)
+	return c.consumer.Consume(ctx, c.handleMarketUpdated)
 }
 
-func (c *MarketConsumer) handleMarketStateChanged(ctx context.Context, msg kafka.Message) error {
-	const op = "MarketConsumer.handleMarketStateChanged"
+func (c *MarketConsumer) handleMarketUpdated(ctx context.Context, msg kafka.Message) error {
+	const op = "MarketConsumer.handleMarketUpdated"
 
-	ctx, span := tracing.StartSpan(ctx, "market_consumer.handle_market_state_changed",
+	ctx, span := tracing.StartSpan(ctx, "market_consumer.handle_market_updated",
 		trace.WithAttributes(
 			attributes.MessagingSystemValue(messagingSystem),
 			attributes.MessagingDestinationValue(msg.Topic),
@@ -69,11 +69,11 @@ func (c *MarketConsumer) handleMarketStateChanged(ctx context.Context, msg kafka
 	)
 	defer span.End()
 
-	event, err := mapper.UnmarshalMarketStateChanged(msg.Value)
+	event, err := mapper.UnmarshalMarketUpdated(msg.Value)
 	if err != nil {
 		tracing.RecordError(span, err)
 
-		c.logger.Error(ctx, "Failed to unmarshal MarketStateChangedEvent",
+		c.logger.Error(ctx, "Failed to unmarshal MarketUpdatedEvent",
 			zap.String("topic", msg.Topic),
 			zap.Int32("partition", msg.Partition),
 			zap.Int64("offset", msg.Offset),
@@ -92,7 +92,7 @@ func (c *MarketConsumer) handleMarketStateChanged(ctx context.Context, msg kafka
 		attributes.MarketDeletedValue(event.DeletedAt != nil),
 	)
 
-	if err = c.processor.ProcessMarketStateChanged(
+	if err = c.processor.ProcessMarketUpdated(
 		ctx,
 		msg.Topic,
 		c.consumerGroup,
@@ -101,7 +101,7 @@ func (c *MarketConsumer) handleMarketStateChanged(ctx context.Context, msg kafka
 	); err != nil {
 		tracing.RecordError(span, err)
 
-		c.logger.Error(ctx, "Failed to process market state changed event",
+		c.logger.Error(ctx, "Failed to process market updated event",
 			zap.String("topic", msg.Topic),
 			zap.Int32("partition", msg.Partition),
 			zap.Int64("offset", msg.Offset),
@@ -113,7 +113,7 @@ func (c *MarketConsumer) handleMarketStateChanged(ctx context.Context, msg kafka
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	c.logger.Info(ctx, "Market state changed event processed",
+	c.logger.Info(ctx, "Market updated event processed",
 		zap.String("topic", msg.Topic),
 		zap.Int32("partition", msg.Partition),
 		zap.Int64("offset", msg.Offset),
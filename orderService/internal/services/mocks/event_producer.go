@@ -34,6 +34,24 @@ func (_m *EventProducer) ProduceOrderCreated(ctx context.Context, transaction pg
 	return r0
 }
 
+// ProduceOrderFilled provides a mock function with given fields: ctx, transaction, event
+func (_m *EventProducer) ProduceOrderFilled(ctx context.Context, transaction pgx.Tx, event models.OrderFilledEvent) error {
+	ret := _m.Called(ctx, transaction, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProduceOrderFilled")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx, models.OrderFilledEvent) error); ok {
+		r0 = rf(ctx, transaction, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ProduceOrderStatusUpdated provides a mock function with given fields: ctx, transaction, event
 func (_m *EventProducer) ProduceOrderStatusUpdated(ctx context.Context, transaction pgx.Tx, event models.OrderStatusUpdatedEvent) error {
 	ret := _m.Called(ctx, transaction, event)
@@ -7,8 +7,6 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
-	time "time"
-
 	uuid "github.com/google/uuid"
 )
 
@@ -45,9 +43,9 @@ func (_m *MarketBlockStore) IsBlocked(ctx context.Context, marketID uuid.UUID) (
 	return r0, r1
 }
 
-// SynchronizeState provides a mock function with given fields: ctx, marketID, blocked, updatedAt
-func (_m *MarketBlockStore) SynchronizeState(ctx context.Context, marketID uuid.UUID, blocked bool, updatedAt time.Time) (bool, error) {
-	ret := _m.Called(ctx, marketID, blocked, updatedAt)
+// SynchronizeState provides a mock function with given fields: ctx, marketID, blocked, version
+func (_m *MarketBlockStore) SynchronizeState(ctx context.Context, marketID uuid.UUID, blocked bool, version int64) (bool, error) {
+	ret := _m.Called(ctx, marketID, blocked, version)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SynchronizeState")
@@ -55,17 +53,17 @@ func (_m *MarketBlockStore) SynchronizeState(ctx context.Context, marketID uuid.
 
 	var r0 bool
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, time.Time) (bool, error)); ok {
-		return rf(ctx, marketID, blocked, updatedAt)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, int64) (bool, error)); ok {
+		return rf(ctx, marketID, blocked, version)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, time.Time) bool); ok {
-		r0 = rf(ctx, marketID, blocked, updatedAt)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, int64) bool); ok {
+		r0 = rf(ctx, marketID, blocked, version)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, bool, time.Time) error); ok {
-		r1 = rf(ctx, marketID, blocked, updatedAt)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, bool, int64) error); ok {
+		r1 = rf(ctx, marketID, blocked, version)
 	} else {
 		r1 = ret.Error(1)
 	}
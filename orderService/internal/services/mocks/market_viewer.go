@@ -5,10 +5,11 @@ package mocks
 import (
 	context "context"
 
+	uuid "github.com/google/uuid"
+
 	models "github.com/nastyazhadan/spot-order-grpc/shared/models"
-	mock "github.com/stretchr/testify/mock"
 
-	uuid "github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // MarketViewer is an autogenerated mock type for the MarketViewer type
@@ -44,6 +45,34 @@ func (_m *MarketViewer) GetMarketByID(ctx context.Context, id uuid.UUID) (models
 	return r0, r1
 }
 
+// GetMarketBySymbol provides a mock function with given fields: ctx, symbol
+func (_m *MarketViewer) GetMarketBySymbol(ctx context.Context, symbol string) (models.Market, error) {
+	ret := _m.Called(ctx, symbol)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMarketBySymbol")
+	}
+
+	var r0 models.Market
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.Market, error)); ok {
+		return rf(ctx, symbol)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.Market); ok {
+		r0 = rf(ctx, symbol)
+	} else {
+		r0 = ret.Get(0).(models.Market)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, symbol)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewMarketViewer creates a new instance of MarketViewer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMarketViewer(t interface {
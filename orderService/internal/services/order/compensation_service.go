@@ -32,6 +32,10 @@ type MarketOrderCanceler interface {
 	CancelActiveOrdersByMarket(ctx context.Context, transaction pgx.Tx, marketID uuid.UUID) ([]uuid.UUID, error)
 }
 
+type MarketReplicaWriter interface {
+	ApplyMarket(ctx context.Context, transaction pgx.Tx, market sharedModels.Market) (bool, error)
+}
+
 type OrderEventProducer interface {
 	ProduceOrderStatusUpdated(ctx context.Context, transaction pgx.Tx, event models.OrderStatusUpdatedEvent) error
 }
@@ -41,6 +45,7 @@ type CompensationService struct {
 	inboxStore         MarketInboxWriter
 	orderStore         MarketOrderCanceler
 	blockStore         MarketBlockStore
+	replicaWriter      MarketReplicaWriter
 	eventProducer      OrderEventProducer
 	logger             *zapLogger.Logger
 	config             config.OrderConfig
@@ -51,6 +56,7 @@ func NewCompensationService(
 	inboxWriter MarketInboxWriter,
 	orderStore MarketOrderCanceler,
 	blockStore MarketBlockStore,
+	replicaWriter MarketReplicaWriter,
 	producer OrderEventProducer,
 	logger *zapLogger.Logger,
 	cfg config.OrderConfig,
@@ -60,20 +66,21 @@ func NewCompensationService(
 		inboxStore:         inboxWriter,
 		orderStore:         orderStore,
 		blockStore:         blockStore,
+		replicaWriter:      replicaWriter,
 		eventProducer:      producer,
 		logger:             logger,
 		config:             cfg,
 	}
 }
 
-func (s *CompensationService) ProcessMarketStateChanged(
+func (s *CompensationService) ProcessMarketUpdated(
 	ctx context.Context,
 	topic string,
 	consumerGroup string,
 	rawPayload []byte,
-	event sharedModels.MarketStateChangedEvent,
+	event sharedModels.MarketUpdatedEvent,
 ) error {
-	const op = "MarketCompensationService.ProcessMarketStateChanged"
+	const op = "MarketCompensationService.ProcessMarketUpdated"
 
 	ctx, cancel := contextWithTimeout(ctx, s.config.Timeouts.Service)
 	defer cancel()
@@ -155,7 +162,7 @@ func (s *CompensationService) handleSkippedEvent(
 	ctx context.Context,
 	span trace.Span,
 	transaction pgx.Tx,
-	event sharedModels.MarketStateChangedEvent,
+	event sharedModels.MarketUpdatedEvent,
 	consumerGroup string,
 	currentStatus models.InboxEventStatus,
 ) (bool, error) {
@@ -180,8 +187,21 @@ func (s *CompensationService) applyCompensationTransaction(
 	ctx context.Context,
 	span trace.Span,
 	transaction pgx.Tx,
-	event sharedModels.MarketStateChangedEvent,
+	event sharedModels.MarketUpdatedEvent,
 ) error {
+	// Реплика обновляется в той же транзакции, что и inbox: повтор события её не меняет
+	applied, err := s.replicaWriter.ApplyMarket(ctx, transaction, marketFromEvent(event))
+	if err != nil {
+		return fmt.Errorf("apply market to replica: %w", err)
+	}
+	if !applied {
+		s.logger.Info(ctx, "Market replica already has a newer version, event skipped for replica",
+			zap.String("event_id", event.EventID.String()),
+			zap.String("market_id", event.MarketID.String()),
+			zap.Int64("version", event.Version),
+		)
+	}
+
 	if event.Enabled && event.DeletedAt == nil {
 		return nil
 	}
@@ -226,13 +246,13 @@ func (s *CompensationService) failProcessing(
 
 func (s *CompensationService) logSkippedEvent(
 	ctx context.Context,
-	event sharedModels.MarketStateChangedEvent,
+	event sharedModels.MarketUpdatedEvent,
 	consumerGroup string,
 	status models.InboxEventStatus,
 ) {
-	message := "Duplicate market state changed event skipped"
+	message := "Duplicate market updated event skipped"
 	if status == models.InboxEventStatusProcessing {
-		message = "Market state changed event is already being processed"
+		message = "Market updated event is already being processed"
 	}
 
 	s.logger.Info(ctx, message,
@@ -246,7 +266,7 @@ func (s *CompensationService) logSkippedEvent(
 func (s *CompensationService) publishCancelledOrderEvents(
 	ctx context.Context,
 	transaction pgx.Tx,
-	marketEvent sharedModels.MarketStateChangedEvent,
+	marketEvent sharedModels.MarketUpdatedEvent,
 	orderIDs []uuid.UUID,
 ) error {
 	for _, orderID := range orderIDs {
@@ -272,7 +292,7 @@ func (s *CompensationService) publishCancelledOrderEvents(
 func (s *CompensationService) trySynchronizeMarketBlockState(
 	ctx context.Context,
 	span trace.Span,
-	event sharedModels.MarketStateChangedEvent,
+	event sharedModels.MarketUpdatedEvent,
 	reason string,
 ) {
 	syncCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), s.config.Timeouts.Service)
@@ -305,14 +325,30 @@ func (s *CompensationService) trySynchronizeMarketBlockState(
 
 func (s *CompensationService) synchronizeMarketBlockState(
 	ctx context.Context,
-	event sharedModels.MarketStateChangedEvent,
+	event sharedModels.MarketUpdatedEvent,
 ) (bool, bool, error) {
 	blocked := !event.Enabled || event.DeletedAt != nil
 
-	updated, err := s.blockStore.SynchronizeState(ctx, event.MarketID, blocked, event.UpdatedAt)
+	updated, err := s.blockStore.SynchronizeState(ctx, event.MarketID, blocked, event.Version)
 	if err != nil {
 		return blocked, false, err
 	}
 
 	return blocked, updated, nil
 }
+
+func marketFromEvent(event sharedModels.MarketUpdatedEvent) sharedModels.Market {
+	return sharedModels.Market{
+		ID:         event.MarketID,
+		Name:       event.Name,
+		BaseAsset:  event.BaseAsset,
+		QuoteAsset: event.QuoteAsset,
+		Enabled:    event.Enabled,
+		DeletedAt:  event.DeletedAt,
+		UpdatedAt:  event.UpdatedAt,
+		Version:    event.Version,
+		Restricted: event.Restricted,
+
+		PublicToAllRoles: event.PublicToAllRoles,
+	}
+}
@@ -38,6 +38,7 @@ type compensationDeps struct {
 	inbox      *mocks.MarketInboxWriter
 	canceler   *mocks.MarketOrderCanceler
 	blockStore *mocks.MarketBlockStore
+	replica    *mocks.MarketReplicaWriter
 	producer   *mocks.OrderEventProducer
 }
 
@@ -47,13 +48,14 @@ func newCompensationDeps(t *testing.T) *compensationDeps {
 		inbox:      mocks.NewMarketInboxWriter(t),
 		canceler:   mocks.NewMarketOrderCanceler(t),
 		blockStore: &mocks.MarketBlockStore{},
+		replica:    mocks.NewMarketReplicaWriter(t),
 		producer:   mocks.NewOrderEventProducer(t),
 	}
 }
 
 func (d *compensationDeps) service() *CompensationService {
 	return NewCompensationService(
-		d.manager, d.inbox, d.canceler, d.blockStore, d.producer,
+		d.manager, d.inbox, d.canceler, d.blockStore, d.replica, d.producer,
 		zapLogger.NewNop(),
 		testCompensationConfig(),
 	)
@@ -73,10 +75,11 @@ func (d *compensationDeps) beginTxWithRollback() *mockTx {
 	return tx
 }
 
-func makeEvent(enabled, deleted bool) sharedModels.MarketStateChangedEvent {
-	e := sharedModels.MarketStateChangedEvent{
+func makeEvent(enabled, deleted bool) sharedModels.MarketUpdatedEvent {
+	e := sharedModels.MarketUpdatedEvent{
 		EventID:   uuid.New(),
 		MarketID:  uuid.New(),
+		Version:   1,
 		Enabled:   enabled,
 		UpdatedAt: time.Now().UTC(),
 	}
@@ -87,28 +90,33 @@ func makeEvent(enabled, deleted bool) sharedModels.MarketStateChangedEvent {
 	return e
 }
 
+func (d *compensationDeps) applyReplica(tx *mockTx) {
+	d.replica.On("ApplyMarket", mock.Anything, tx, mock.AnythingOfType("models.Market")).Return(true, nil)
+}
+
 func (d *compensationDeps) synchronizeBlockMaybe(marketID uuid.UUID, blocked bool) {
 	d.blockStore.On("SynchronizeState", mock.Anything, marketID, blocked, mock.Anything).
 		Return(true, nil).Maybe()
 }
 
-func TestProcessMarketStateChanged(t *testing.T) {
+func TestProcessMarketUpdated(t *testing.T) {
 	payload := []byte(`{"market_id":"test"}`)
 
 	tests := []struct {
 		name           string
-		event          sharedModels.MarketStateChangedEvent
-		setupMocks     func(t *testing.T, d *compensationDeps, event sharedModels.MarketStateChangedEvent)
+		event          sharedModels.MarketUpdatedEvent
+		setupMocks     func(t *testing.T, d *compensationDeps, event sharedModels.MarketUpdatedEvent)
 		expectedErrMsg string
 		checkErr       func(t *testing.T, err error)
 	}{
 		{
 			name:  "enabled рынок — ордера не отменяются, commit, sync block",
 			event: makeEvent(true, false),
-			setupMocks: func(t *testing.T, d *compensationDeps, event sharedModels.MarketStateChangedEvent) {
+			setupMocks: func(t *testing.T, d *compensationDeps, event sharedModels.MarketUpdatedEvent) {
 				tx := d.beginTx(nil)
 				d.inbox.On("BeginProcessing", mock.Anything, tx, mock.AnythingOfType("models.InboxEvent")).
 					Return(true, models.InboxEventStatusProcessing, nil)
+				d.applyReplica(tx)
 				d.inbox.On("MarkProcessed", mock.Anything, tx, event.EventID, testGroup).Return(nil).Maybe()
 				d.inbox.On("MarkProcessed", mock.Anything, tx, mock.Anything, testGroup).Return(nil)
 				d.synchronizeBlockMaybe(event.MarketID, false)
@@ -120,10 +128,11 @@ func TestProcessMarketStateChanged(t *testing.T) {
 		{
 			name:  "enabled рынок — commit вызывается, sync block called",
 			event: makeEvent(true, false),
-			setupMocks: func(t *testing.T, d *compensationDeps, event sharedModels.MarketStateChangedEvent) {
+			setupMocks: func(t *testing.T, d *compensationDeps, event sharedModels.MarketUpdatedEvent) {
 				tx := d.beginTx(nil)
 				d.inbox.On("BeginProcessing", mock.Anything, tx, mock.AnythingOfType("models.InboxEvent")).
 					Return(true, models.InboxEventStatusProcessing, nil)
+				d.applyReplica(tx)
 				d.inbox.On("MarkProcessed", mock.Anything, tx, mock.Anything, testGroup).Return(nil)
 				d.synchronizeBlockMaybe(uuid.Nil, false)
 				d.blockStore.On("SynchronizeState", mock.Anything, mock.Anything, false, mock.Anything).
@@ -133,14 +142,37 @@ func TestProcessMarketStateChanged(t *testing.T) {
 				require.NoError(t, err)
 			},
 		},
+		{
+			name: "в MarketBlockStore передаётся версия события, а не updated_at",
+			event: func() sharedModels.MarketUpdatedEvent {
+				event := makeEvent(false, false)
+				event.Version = 42
+				return event
+			}(),
+			setupMocks: func(t *testing.T, d *compensationDeps, event sharedModels.MarketUpdatedEvent) {
+				tx := d.beginTx(nil)
+				d.inbox.On("BeginProcessing", mock.Anything, tx, mock.AnythingOfType("models.InboxEvent")).
+					Return(true, models.InboxEventStatusProcessing, nil)
+				d.applyReplica(tx)
+				d.canceler.On("CancelActiveOrdersByMarket", mock.Anything, tx, event.MarketID).
+					Return([]uuid.UUID{}, nil)
+				d.inbox.On("MarkProcessed", mock.Anything, tx, event.EventID, testGroup).Return(nil)
+				d.blockStore.On("SynchronizeState", mock.Anything, event.MarketID, true, int64(42)).
+					Return(true, nil).Once()
+			},
+			checkErr: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
 		{
 			name:  "disabled рынок — ордера отменяются, events публикуются",
 			event: makeEvent(false, false),
-			setupMocks: func(t *testing.T, d *compensationDeps, event sharedModels.MarketStateChangedEvent) {
+			setupMocks: func(t *testing.T, d *compensationDeps, event sharedModels.MarketUpdatedEvent) {
 				cancelledIDs := []uuid.UUID{uuid.New(), uuid.New()}
 				tx := d.beginTx(nil)
 				d.inbox.On("BeginProcessing", mock.Anything, tx, mock.AnythingOfType("models.InboxEvent")).
 					Return(true, models.InboxEventStatusProcessing, nil)
+				d.applyReplica(tx)
 				d.canceler.On("CancelActiveOrdersByMarket", mock.Anything, tx, mock.Anything).
 					Return(cancelledIDs, nil)
 				d.producer.On("ProduceOrderStatusUpdated", mock.Anything, tx,
@@ -157,11 +189,12 @@ func TestProcessMarketStateChanged(t *testing.T) {
 		{
 			name:  "deleted рынок — ордера отменяются, events публикуются",
 			event: makeEvent(true, true),
-			setupMocks: func(t *testing.T, d *compensationDeps, event sharedModels.MarketStateChangedEvent) {
+			setupMocks: func(t *testing.T, d *compensationDeps, event sharedModels.MarketUpdatedEvent) {
 				cancelledIDs := []uuid.UUID{uuid.New()}
 				tx := d.beginTx(nil)
 				d.inbox.On("BeginProcessing", mock.Anything, tx, mock.AnythingOfType("models.InboxEvent")).
 					Return(true, models.InboxEventStatusProcessing, nil)
+				d.applyReplica(tx)
 				d.canceler.On("CancelActiveOrdersByMarket", mock.Anything, tx, mock.Anything).
 					Return(cancelledIDs, nil)
 				d.producer.On("ProduceOrderStatusUpdated", mock.Anything, tx,
@@ -178,10 +211,11 @@ func TestProcessMarketStateChanged(t *testing.T) {
 		{
 			name:  "disabled+deleted рынок — ордера отменяются",
 			event: makeEvent(false, true),
-			setupMocks: func(t *testing.T, d *compensationDeps, event sharedModels.MarketStateChangedEvent) {
+			setupMocks: func(t *testing.T, d *compensationDeps, event sharedModels.MarketUpdatedEvent) {
 				tx := d.beginTx(nil)
 				d.inbox.On("BeginProcessing", mock.Anything, tx, mock.AnythingOfType("models.InboxEvent")).
 					Return(true, models.InboxEventStatusProcessing, nil)
+				d.applyReplica(tx)
 				d.canceler.On("CancelActiveOrdersByMarket", mock.Anything, tx, mock.Anything).
 					Return([]uuid.UUID{}, nil)
 				d.inbox.On("MarkProcessed", mock.Anything, tx, mock.Anything, testGroup).Return(nil)
@@ -195,10 +229,11 @@ func TestProcessMarketStateChanged(t *testing.T) {
 		{
 			name:  "нет активных ордеров для отмены — нет событий статуса",
 			event: makeEvent(false, false),
-			setupMocks: func(t *testing.T, d *compensationDeps, event sharedModels.MarketStateChangedEvent) {
+			setupMocks: func(t *testing.T, d *compensationDeps, event sharedModels.MarketUpdatedEvent) {
 				tx := d.beginTx(nil)
 				d.inbox.On("BeginProcessing", mock.Anything, tx, mock.AnythingOfType("models.InboxEvent")).
 					Return(true, models.InboxEventStatusProcessing, nil)
+				d.applyReplica(tx)
 				d.canceler.On("CancelActiveOrdersByMarket", mock.Anything, tx, mock.Anything).
 					Return([]uuid.UUID{}, nil)
 				d.inbox.On("MarkProcessed", mock.Anything, tx, mock.Anything, testGroup).Return(nil)
@@ -212,7 +247,7 @@ func TestProcessMarketStateChanged(t *testing.T) {
 		{
 			name:  "событие уже обработано (InboxEventStatusProcessed) — skip, resync block",
 			event: makeEvent(false, false),
-			setupMocks: func(t *testing.T, d *compensationDeps, event sharedModels.MarketStateChangedEvent) {
+			setupMocks: func(t *testing.T, d *compensationDeps, event sharedModels.MarketUpdatedEvent) {
 				tx := d.beginTx(nil)
 				d.inbox.On("BeginProcessing", mock.Anything, tx, mock.AnythingOfType("models.InboxEvent")).
 					Return(false, models.InboxEventStatusProcessed, nil)
@@ -226,7 +261,7 @@ func TestProcessMarketStateChanged(t *testing.T) {
 		{
 			name:  "событие в процессе (InboxEventStatusProcessing) — skip, resync block",
 			event: makeEvent(true, false),
-			setupMocks: func(t *testing.T, d *compensationDeps, event sharedModels.MarketStateChangedEvent) {
+			setupMocks: func(t *testing.T, d *compensationDeps, event sharedModels.MarketUpdatedEvent) {
 				tx := d.beginTx(nil)
 				d.inbox.On("BeginProcessing", mock.Anything, tx, mock.AnythingOfType("models.InboxEvent")).
 					Return(false, models.InboxEventStatusProcessing, nil)
@@ -240,7 +275,7 @@ func TestProcessMarketStateChanged(t *testing.T) {
 		{
 			name:  "ошибка - Begin транзакции",
 			event: makeEvent(true, false),
-			setupMocks: func(t *testing.T, d *compensationDeps, event sharedModels.MarketStateChangedEvent) {
+			setupMocks: func(t *testing.T, d *compensationDeps, event sharedModels.MarketUpdatedEvent) {
 				d.manager.On("Begin", mock.Anything).Return((*mockTx)(nil), errors.New("pg pool exhausted"))
 			},
 			checkErr: func(t *testing.T, err error) {
@@ -252,7 +287,7 @@ func TestProcessMarketStateChanged(t *testing.T) {
 		{
 			name:  "ошибка - BeginProcessing — rollback, SaveFailed",
 			event: makeEvent(true, false),
-			setupMocks: func(t *testing.T, d *compensationDeps, event sharedModels.MarketStateChangedEvent) {
+			setupMocks: func(t *testing.T, d *compensationDeps, event sharedModels.MarketUpdatedEvent) {
 				tx := d.beginTxWithRollback()
 				d.inbox.On("BeginProcessing", mock.Anything, tx, mock.AnythingOfType("models.InboxEvent")).
 					Return(false, models.InboxEventStatusFailed, errors.New("inbox write failed"))
@@ -268,7 +303,7 @@ func TestProcessMarketStateChanged(t *testing.T) {
 		{
 			name:  "ошибка - BeginProcessing и SaveFailed тоже падает — обе ошибки в сообщении",
 			event: makeEvent(true, false),
-			setupMocks: func(t *testing.T, d *compensationDeps, event sharedModels.MarketStateChangedEvent) {
+			setupMocks: func(t *testing.T, d *compensationDeps, event sharedModels.MarketUpdatedEvent) {
 				tx := d.beginTxWithRollback()
 				d.inbox.On("BeginProcessing", mock.Anything, tx, mock.AnythingOfType("models.InboxEvent")).
 					Return(false, models.InboxEventStatusFailed, errors.New("inbox error"))
@@ -285,10 +320,11 @@ func TestProcessMarketStateChanged(t *testing.T) {
 		{
 			name:  "ошибка - CancelActiveOrdersByMarket — rollback, SaveFailed",
 			event: makeEvent(false, false),
-			setupMocks: func(t *testing.T, d *compensationDeps, event sharedModels.MarketStateChangedEvent) {
+			setupMocks: func(t *testing.T, d *compensationDeps, event sharedModels.MarketUpdatedEvent) {
 				tx := d.beginTxWithRollback()
 				d.inbox.On("BeginProcessing", mock.Anything, tx, mock.AnythingOfType("models.InboxEvent")).
 					Return(true, models.InboxEventStatusProcessing, nil)
+				d.applyReplica(tx)
 				d.canceler.On("CancelActiveOrdersByMarket", mock.Anything, tx, mock.Anything).
 					Return(nil, errors.New("cancel query failed"))
 				d.inbox.On("SaveFailed", mock.Anything,
@@ -300,13 +336,80 @@ func TestProcessMarketStateChanged(t *testing.T) {
 				assert.ErrorContains(t, err, "cancel query failed")
 			},
 		},
+		{
+			name: "в реплику применяется полное состояние рынка из события",
+			event: func() sharedModels.MarketUpdatedEvent {
+				event := makeEvent(true, false)
+				event.Name = "BTC-USDT"
+				event.BaseAsset = "BTC"
+				event.QuoteAsset = "USDT"
+				event.Version = 5
+				return event
+			}(),
+			setupMocks: func(t *testing.T, d *compensationDeps, event sharedModels.MarketUpdatedEvent) {
+				tx := d.beginTx(nil)
+				d.inbox.On("BeginProcessing", mock.Anything, tx, mock.AnythingOfType("models.InboxEvent")).
+					Return(true, models.InboxEventStatusProcessing, nil)
+				d.replica.On("ApplyMarket", mock.Anything, tx, sharedModels.Market{
+					ID:         event.MarketID,
+					Name:       "BTC-USDT",
+					BaseAsset:  "BTC",
+					QuoteAsset: "USDT",
+					Enabled:    true,
+					UpdatedAt:  event.UpdatedAt,
+					Version:    5,
+				}).Return(true, nil).Once()
+				d.inbox.On("MarkProcessed", mock.Anything, tx, event.EventID, testGroup).Return(nil)
+				d.synchronizeBlockMaybe(event.MarketID, false)
+			},
+			checkErr: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:  "в реплике уже более новая версия — компенсация всё равно выполняется",
+			event: makeEvent(false, false),
+			setupMocks: func(t *testing.T, d *compensationDeps, event sharedModels.MarketUpdatedEvent) {
+				tx := d.beginTx(nil)
+				d.inbox.On("BeginProcessing", mock.Anything, tx, mock.AnythingOfType("models.InboxEvent")).
+					Return(true, models.InboxEventStatusProcessing, nil)
+				d.replica.On("ApplyMarket", mock.Anything, tx, mock.AnythingOfType("models.Market")).
+					Return(false, nil)
+				d.canceler.On("CancelActiveOrdersByMarket", mock.Anything, tx, event.MarketID).
+					Return([]uuid.UUID{}, nil)
+				d.inbox.On("MarkProcessed", mock.Anything, tx, event.EventID, testGroup).Return(nil)
+				d.synchronizeBlockMaybe(event.MarketID, true)
+			},
+			checkErr: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:  "ошибка - ApplyMarket в реплику — rollback, SaveFailed",
+			event: makeEvent(false, false),
+			setupMocks: func(t *testing.T, d *compensationDeps, event sharedModels.MarketUpdatedEvent) {
+				tx := d.beginTxWithRollback()
+				d.inbox.On("BeginProcessing", mock.Anything, tx, mock.AnythingOfType("models.InboxEvent")).
+					Return(true, models.InboxEventStatusProcessing, nil)
+				d.replica.On("ApplyMarket", mock.Anything, tx, mock.AnythingOfType("models.Market")).
+					Return(false, errors.New("replica upsert failed"))
+				d.inbox.On("SaveFailed", mock.Anything,
+					mock.AnythingOfType("models.InboxEvent"), mock.AnythingOfType("string"),
+				).Return(nil)
+			},
+			checkErr: func(t *testing.T, err error) {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, "replica upsert failed")
+			},
+		},
 		{
 			name:  "ошибка - ProduceOrderStatusUpdated — rollback, SaveFailed",
 			event: makeEvent(false, false),
-			setupMocks: func(t *testing.T, d *compensationDeps, event sharedModels.MarketStateChangedEvent) {
+			setupMocks: func(t *testing.T, d *compensationDeps, event sharedModels.MarketUpdatedEvent) {
 				tx := d.beginTxWithRollback()
 				d.inbox.On("BeginProcessing", mock.Anything, tx, mock.AnythingOfType("models.InboxEvent")).
 					Return(true, models.InboxEventStatusProcessing, nil)
+				d.applyReplica(tx)
 				d.canceler.On("CancelActiveOrdersByMarket", mock.Anything, tx, mock.Anything).
 					Return([]uuid.UUID{uuid.New()}, nil)
 				d.producer.On("ProduceOrderStatusUpdated", mock.Anything, tx,
@@ -324,10 +427,11 @@ func TestProcessMarketStateChanged(t *testing.T) {
 		{
 			name:  "ошибка - ProduceOrderStatusUpdated для второго ордера — первый уже записан",
 			event: makeEvent(false, false),
-			setupMocks: func(t *testing.T, d *compensationDeps, event sharedModels.MarketStateChangedEvent) {
+			setupMocks: func(t *testing.T, d *compensationDeps, event sharedModels.MarketUpdatedEvent) {
 				tx := d.beginTxWithRollback()
 				d.inbox.On("BeginProcessing", mock.Anything, tx, mock.AnythingOfType("models.InboxEvent")).
 					Return(true, models.InboxEventStatusProcessing, nil)
+				d.applyReplica(tx)
 				cancelledIDs := []uuid.UUID{uuid.New(), uuid.New()}
 				d.canceler.On("CancelActiveOrdersByMarket", mock.Anything, tx, mock.Anything).
 					Return(cancelledIDs, nil)
@@ -349,10 +453,11 @@ func TestProcessMarketStateChanged(t *testing.T) {
 		{
 			name:  "ошибка - MarkProcessed — rollback, SaveFailed",
 			event: makeEvent(true, false),
-			setupMocks: func(t *testing.T, d *compensationDeps, event sharedModels.MarketStateChangedEvent) {
+			setupMocks: func(t *testing.T, d *compensationDeps, event sharedModels.MarketUpdatedEvent) {
 				tx := d.beginTxWithRollback()
 				d.inbox.On("BeginProcessing", mock.Anything, tx, mock.AnythingOfType("models.InboxEvent")).
 					Return(true, models.InboxEventStatusProcessing, nil)
+				d.applyReplica(tx)
 				d.inbox.On("MarkProcessed", mock.Anything, tx, mock.Anything, testGroup).
 					Return(errors.New("mark failed"))
 				d.inbox.On("SaveFailed", mock.Anything,
@@ -367,13 +472,14 @@ func TestProcessMarketStateChanged(t *testing.T) {
 		{
 			name:  "ошибка - commit транзакции",
 			event: makeEvent(true, false),
-			setupMocks: func(t *testing.T, d *compensationDeps, event sharedModels.MarketStateChangedEvent) {
+			setupMocks: func(t *testing.T, d *compensationDeps, event sharedModels.MarketUpdatedEvent) {
 				tx := &mockTx{}
 				tx.On("Commit", mock.Anything).Return(errors.New("commit failed"))
 				tx.On("Rollback", mock.Anything).Return(pgx.ErrTxClosed)
 				d.manager.On("Begin", mock.Anything).Return(tx, nil)
 				d.inbox.On("BeginProcessing", mock.Anything, tx, mock.AnythingOfType("models.InboxEvent")).
 					Return(true, models.InboxEventStatusProcessing, nil)
+				d.applyReplica(tx)
 				d.inbox.On("MarkProcessed", mock.Anything, tx, mock.Anything, testGroup).Return(nil)
 			},
 			checkErr: func(t *testing.T, err error) {
@@ -384,7 +490,7 @@ func TestProcessMarketStateChanged(t *testing.T) {
 		{
 			name:  "ошибка - commit транзакции skip-пути",
 			event: makeEvent(true, false),
-			setupMocks: func(t *testing.T, d *compensationDeps, event sharedModels.MarketStateChangedEvent) {
+			setupMocks: func(t *testing.T, d *compensationDeps, event sharedModels.MarketUpdatedEvent) {
 				tx := &mockTx{}
 				tx.On("Commit", mock.Anything).Return(errors.New("commit skipped failed"))
 				tx.On("Rollback", mock.Anything).Return(pgx.ErrTxClosed)
@@ -400,10 +506,11 @@ func TestProcessMarketStateChanged(t *testing.T) {
 		{
 			name:  "sync block падает — ошибка не пробрасывается (best effort)",
 			event: makeEvent(false, false),
-			setupMocks: func(t *testing.T, d *compensationDeps, event sharedModels.MarketStateChangedEvent) {
+			setupMocks: func(t *testing.T, d *compensationDeps, event sharedModels.MarketUpdatedEvent) {
 				tx := d.beginTx(nil)
 				d.inbox.On("BeginProcessing", mock.Anything, tx, mock.AnythingOfType("models.InboxEvent")).
 					Return(true, models.InboxEventStatusProcessing, nil)
+				d.applyReplica(tx)
 				d.canceler.On("CancelActiveOrdersByMarket", mock.Anything, tx, mock.Anything).
 					Return([]uuid.UUID{}, nil)
 				d.inbox.On("MarkProcessed", mock.Anything, tx, mock.Anything, testGroup).Return(nil)
@@ -422,7 +529,7 @@ func TestProcessMarketStateChanged(t *testing.T) {
 			tt.setupMocks(t, d, tt.event)
 
 			svc := d.service()
-			err := svc.ProcessMarketStateChanged(
+			err := svc.ProcessMarketUpdated(
 				context.Background(),
 				testTopic, testGroup,
 				payload, tt.event,
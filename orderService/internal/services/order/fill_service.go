@@ -0,0 +1,126 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/nastyazhadan/spot-order-grpc/orderService/internal/domain/models"
+	"github.com/nastyazhadan/spot-order-grpc/orderService/internal/domain/models/shared"
+	"github.com/nastyazhadan/spot-order-grpc/shared/config"
+	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/otel/attributes"
+	zapLogger "github.com/nastyazhadan/spot-order-grpc/shared/interceptors/logging/zap"
+	"github.com/nastyazhadan/spot-order-grpc/shared/interceptors/tracing"
+)
+
+type OrderFiller interface {
+	FillOrder(ctx context.Context, transaction pgx.Tx, id uuid.UUID) (models.Order, error)
+}
+
+type FillEventProducer interface {
+	ProduceOrderStatusUpdated(ctx context.Context, transaction pgx.Tx, event models.OrderStatusUpdatedEvent) error
+	ProduceOrderFilled(ctx context.Context, transaction pgx.Tx, event models.OrderFilledEvent) error
+}
+
+// FillService — точка входа для исполнения ордеров. Движка сопоставления в репозитории нет:
+// FillOrder вызывает внешний исполнитель, ордер исполняется целиком по цене заявки.
+type FillService struct {
+	transactionManager TransactionManager
+	orderStore         OrderFiller
+	eventProducer      FillEventProducer
+	logger             *zapLogger.Logger
+	config             config.OrderConfig
+}
+
+func NewFillService(
+	manager TransactionManager,
+	orderStore OrderFiller,
+	producer FillEventProducer,
+	logger *zapLogger.Logger,
+	cfg config.OrderConfig,
+) *FillService {
+	return &FillService{
+		transactionManager: manager,
+		orderStore:         orderStore,
+		eventProducer:      producer,
+		logger:             logger,
+		config:             cfg,
+	}
+}
+
+// FillOrder в одной транзакции переводит ордер в FILLED и кладёт в outbox
+// order.status.updated и order.filled. Неактивный ордер — ErrOrderNotActive
+func (s *FillService) FillOrder(ctx context.Context, orderID uuid.UUID) (models.Order, error) {
+	const op = "FillService.FillOrder"
+
+	ctx, cancel := contextWithTimeout(ctx, s.config.Timeouts.Service)
+	defer cancel()
+
+	ctx, span := tracing.StartSpan(ctx, "order.fill",
+		trace.WithAttributes(
+			attributes.OrderIDValue(orderID.String()),
+		),
+	)
+	defer span.End()
+
+	transaction, err := s.transactionManager.Begin(ctx)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return models.Order{}, fmt.Errorf("%s: begin transaction: %w", op, err)
+	}
+	defer rollbackTransaction(ctx, transaction, s.logger, "Fill order transaction rollback failed", s.config.Timeouts.Service)
+
+	order, err := s.orderStore.FillOrder(ctx, transaction, orderID)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return models.Order{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	now := time.Now().UTC()
+
+	filledEvent := models.OrderFilledEvent{
+		EventID:  uuid.New(),
+		OrderID:  order.ID,
+		MarketID: order.MarketID,
+		Price:    order.Price,
+		Quantity: order.Quantity,
+		FilledAt: now,
+	}
+
+	statusEvent := models.OrderStatusUpdatedEvent{
+		EventID:       uuid.New(),
+		OrderID:       order.ID,
+		NewStatus:     shared.OrderStatusFilled,
+		Reason:        "order filled",
+		CorrelationID: filledEvent.EventID,
+		UpdatedAt:     now,
+	}
+
+	if err = s.eventProducer.ProduceOrderStatusUpdated(ctx, transaction, statusEvent); err != nil {
+		tracing.RecordError(span, err)
+		return models.Order{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = s.eventProducer.ProduceOrderFilled(ctx, transaction, filledEvent); err != nil {
+		tracing.RecordError(span, err)
+		return models.Order{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = commitTransaction(ctx, transaction, s.config.Timeouts.Service); err != nil {
+		tracing.RecordError(span, err)
+		return models.Order{}, fmt.Errorf("%s: commit transaction: %w", op, err)
+	}
+
+	s.logger.Info(ctx, "Order filled",
+		zap.String("order_id", order.ID.String()),
+		zap.String("market_id", order.MarketID.String()),
+		zap.Int64("quantity", order.Quantity),
+	)
+
+	return order, nil
+}
@@ -0,0 +1,115 @@
+package order
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nastyazhadan/spot-order-grpc/orderService/internal/domain/models"
+	"github.com/nastyazhadan/spot-order-grpc/orderService/internal/domain/models/shared"
+	"github.com/nastyazhadan/spot-order-grpc/orderService/internal/services/mocks"
+	repositoryErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/repository"
+	zapLogger "github.com/nastyazhadan/spot-order-grpc/shared/interceptors/logging/zap"
+)
+
+func TestFillOrder(t *testing.T) {
+	order := models.Order{
+		ID:        uuid.New(),
+		UserID:    uuid.New(),
+		MarketID:  uuid.New(),
+		Type:      shared.OrderTypeLimit,
+		Price:     mustDecimal(t, "101.5"),
+		Quantity:  3,
+		Status:    shared.OrderStatusFilled,
+		CreatedAt: time.Now().UTC(),
+	}
+	storeErr := errors.New("db down")
+
+	tests := []struct {
+		name       string
+		fillErr    error
+		produceErr error
+		commitErr  error
+		wantErr    error
+	}{
+		{
+			name: "ордер исполнен — статус и сделка в одной транзакции",
+		},
+		{
+			name:    "неактивный ордер — ErrOrderNotActive, событий нет",
+			fillErr: repositoryErrors.ErrOrderNotActive,
+			wantErr: repositoryErrors.ErrOrderNotActive,
+		},
+		{
+			name:       "ошибка outbox — транзакция откатывается",
+			produceErr: storeErr,
+			wantErr:    storeErr,
+		},
+		{
+			name:      "ошибка commit",
+			commitErr: storeErr,
+			wantErr:   storeErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manager := mocks.NewTransactionManager(t)
+			filler := mocks.NewOrderFiller(t)
+			producer := mocks.NewFillEventProducer(t)
+
+			tx := &mockTx{}
+			tx.On("Rollback", mock.Anything).Return(pgx.ErrTxClosed)
+			manager.On("Begin", mock.Anything).Return(tx, nil)
+
+			if tt.fillErr != nil {
+				filler.On("FillOrder", mock.Anything, tx, order.ID).Return(models.Order{}, tt.fillErr)
+			} else {
+				filler.On("FillOrder", mock.Anything, tx, order.ID).Return(order, nil)
+			}
+
+			var statusEvent models.OrderStatusUpdatedEvent
+			var filledEvent models.OrderFilledEvent
+			if tt.fillErr == nil {
+				producer.On("ProduceOrderStatusUpdated", mock.Anything, tx, mock.AnythingOfType("models.OrderStatusUpdatedEvent")).
+					Run(func(args mock.Arguments) { statusEvent = args.Get(2).(models.OrderStatusUpdatedEvent) }).
+					Return(nil)
+				producer.On("ProduceOrderFilled", mock.Anything, tx, mock.AnythingOfType("models.OrderFilledEvent")).
+					Run(func(args mock.Arguments) { filledEvent = args.Get(2).(models.OrderFilledEvent) }).
+					Return(tt.produceErr)
+			}
+			if tt.fillErr == nil && tt.produceErr == nil {
+				tx.On("Commit", mock.Anything).Return(tt.commitErr)
+			}
+
+			service := NewFillService(manager, filler, producer, zapLogger.NewNop(), testCompensationConfig())
+			got, err := service.FillOrder(t.Context(), order.ID)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				tx.AssertCalled(t, "Rollback", mock.Anything)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, order, got)
+			tx.AssertExpectations(t)
+
+			assert.Equal(t, order.ID, statusEvent.OrderID)
+			assert.Equal(t, shared.OrderStatusFilled, statusEvent.NewStatus)
+			assert.Equal(t, filledEvent.EventID, statusEvent.CorrelationID)
+
+			assert.Equal(t, order.ID, filledEvent.OrderID)
+			assert.Equal(t, order.MarketID, filledEvent.MarketID)
+			assert.Equal(t, order.Price, filledEvent.Price)
+			assert.Equal(t, order.Quantity, filledEvent.Quantity)
+			assert.Equal(t, statusEvent.UpdatedAt, filledEvent.FilledAt)
+		})
+	}
+}
@@ -45,6 +45,7 @@ type OrderService struct {
 	saver              Saver
 	getter             Getter
 	marketViewer       MarketViewer
+	marketReplica      MarketReplica
 	blockStore         MarketBlockStore
 	rateLimiters       RateLimiters
 	eventProducer      EventProducer
@@ -74,7 +75,7 @@ type Saver interface {
 }
 
 type MarketBlockStore interface {
-	SynchronizeState(ctx context.Context, marketID uuid.UUID, blocked bool, updatedAt time.Time) (bool, error)
+	SynchronizeState(ctx context.Context, marketID uuid.UUID, blocked bool, version int64) (bool, error)
 	IsBlocked(ctx context.Context, marketID uuid.UUID) (bool, error)
 }
 
@@ -87,6 +88,14 @@ type Getter interface {
 
 type MarketViewer interface {
 	GetMarketByID(ctx context.Context, id uuid.UUID) (sharedModels.Market, error)
+	GetMarketBySymbol(ctx context.Context, symbol string) (sharedModels.Market, error)
+}
+
+// MarketReplica — локальная реплика рынков. Вместе с рынком возвращает момент, на который
+// spot подтвердил состояние именно этой строки (событием или полной сверкой), по нему оценивается отставание.
+type MarketReplica interface {
+	GetMarket(ctx context.Context, id uuid.UUID) (sharedModels.Market, time.Time, error)
+	GetMarketBySymbol(ctx context.Context, symbol string) (sharedModels.Market, time.Time, error)
 }
 
 type RateLimiter interface {
@@ -98,6 +107,7 @@ type RateLimiter interface {
 type EventProducer interface {
 	ProduceOrderCreated(ctx context.Context, transaction pgx.Tx, event models.OrderCreatedEvent) error
 	ProduceOrderStatusUpdated(ctx context.Context, transaction pgx.Tx, event models.OrderStatusUpdatedEvent) error
+	ProduceOrderFilled(ctx context.Context, transaction pgx.Tx, event models.OrderFilledEvent) error
 }
 
 func New(
@@ -105,6 +115,7 @@ func New(
 	saver Saver,
 	getter Getter,
 	viewer MarketViewer,
+	replica MarketReplica,
 	store MarketBlockStore,
 	limiters RateLimiters,
 	producer EventProducer,
@@ -117,6 +128,7 @@ func New(
 		saver:              saver,
 		getter:             getter,
 		marketViewer:       viewer,
+		marketReplica:      replica,
 		blockStore:         store,
 		rateLimiters:       limiters,
 		eventProducer:      producer,
@@ -220,6 +232,40 @@ func (s *OrderService) CreateOrder(
 	return orderID, orderStatus, nil
 }
 
+// ResolveMarketSymbol переводит имя рынка в market_id до CreateOrder, чтобы
+// идемпотентность и проверки рынка работали по id независимо от формы запроса.
+// Как и lookupMarket, сначала смотрит в реплику и идёт в spot, только если она не подходит.
+func (s *OrderService) ResolveMarketSymbol(
+	ctx context.Context,
+	symbol string,
+) (uuid.UUID, error) {
+	const op = "OrderService.ResolveMarketSymbol"
+
+	ctx, cancel := contextWithTimeout(ctx, s.config.Timeouts.Service)
+	defer cancel()
+
+	ctx, span := tracing.StartSpan(ctx, "order.resolve_market_symbol")
+	defer span.End()
+
+	if market, ok := s.getReplicaMarket(ctx, span, zap.String("market_symbol", symbol),
+		func(ctx context.Context) (sharedModels.Market, time.Time, error) {
+			return s.marketReplica.GetMarketBySymbol(ctx, symbol)
+		},
+	); ok {
+		span.SetAttributes(attributes.MarketIDValue(market.ID.String()))
+		return market.ID, nil
+	}
+
+	market, err := s.marketViewer.GetMarketBySymbol(ctx, symbol)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
+	}
+	span.SetAttributes(attributes.MarketIDValue(market.ID.String()))
+
+	return market.ID, nil
+}
+
 func (s *OrderService) GetOrderStatus(
 	ctx context.Context,
 	orderID, userID uuid.UUID,
@@ -382,16 +428,8 @@ func (s *OrderService) validateMarket(
 	}
 
 	// Еще раз проверяем доступность рынка, т.к. redis может быть неактуальным
-	market, err := s.marketViewer.GetMarketByID(ctx, marketID)
+	market, err := s.lookupMarket(ctx, span, marketID, blocked)
 	if err != nil {
-		tracing.RecordError(span, err)
-		if blocked && errors.Is(err, serviceErrors.ErrMarketUnavailable) {
-			s.logger.Warn(ctx, "Market is blocked locally and recheck failed, failing closed",
-				zap.String("market_id", marketID.String()),
-				zap.Error(err),
-			)
-		}
-
 		return err
 	}
 
@@ -425,6 +463,79 @@ func (s *OrderService) validateMarket(
 	return nil
 }
 
+// lookupMarket берёт рынок из локальной реплики и обращается в spot, только если
+// реплика не знает рынок или его строка не подтверждалась spot дольше market_replica.max_lag
+func (s *OrderService) lookupMarket(
+	ctx context.Context,
+	span trace.Span,
+	marketID uuid.UUID,
+	blocked bool,
+) (sharedModels.Market, error) {
+	if market, ok := s.getReplicaMarket(ctx, span, zap.String("market_id", marketID.String()),
+		func(ctx context.Context) (sharedModels.Market, time.Time, error) {
+			return s.marketReplica.GetMarket(ctx, marketID)
+		},
+	); ok {
+		return market, nil
+	}
+
+	market, err := s.marketViewer.GetMarketByID(ctx, marketID)
+	if err != nil {
+		tracing.RecordError(span, err)
+		if blocked && errors.Is(err, serviceErrors.ErrMarketUnavailable) {
+			s.logger.Warn(ctx, "Market is blocked locally and recheck failed, failing closed",
+				zap.String("market_id", marketID.String()),
+				zap.Error(err),
+			)
+		}
+
+		return sharedModels.Market{}, err
+	}
+
+	return market, nil
+}
+
+func (s *OrderService) getReplicaMarket(
+	ctx context.Context,
+	span trace.Span,
+	key zap.Field,
+	lookup func(ctx context.Context) (sharedModels.Market, time.Time, error),
+) (sharedModels.Market, bool) {
+	if s.marketReplica == nil {
+		return sharedModels.Market{}, false
+	}
+
+	market, syncedAt, err := lookup(ctx)
+
+	var result string
+	switch {
+	case errors.Is(err, repositoryErrors.ErrMarketNotFound):
+		// Рынок мог появиться после последней сверки, а событие о нём ещё не дошло
+		result = "miss"
+	case err != nil:
+		result = "error"
+		s.logger.Warn(ctx, "Market replica lookup failed, falling back to spot",
+			key,
+			zap.Error(err),
+		)
+	case time.Since(syncedAt) > s.config.MarketReplica.MaxLag:
+		result = "stale"
+	case market.Restricted:
+		// Списки доступа есть только в spot: он проверит их по JWT пользователя
+		result = "restricted"
+	case !market.PublicToAllRoles:
+		// Политики видимости (статусы, списки активов, правила ролей) есть только в spot
+		result = "policy"
+	default:
+		result = "hit"
+	}
+
+	metrics.MarketReplicaLookupsTotal.WithLabelValues(s.config.Service.Name, result).Inc()
+	span.SetAttributes(attributes.MarketReplicaResultValue(result))
+
+	return market, result == "hit"
+}
+
 func (s *OrderService) synchronizeMarketBlockAsync(
 	ctx context.Context,
 	market sharedModels.Market,
@@ -491,7 +602,7 @@ func (s *OrderService) synchronizeMarketBlock(
 	blocked bool,
 	reason string,
 ) {
-	updated, err := s.blockStore.SynchronizeState(ctx, market.ID, blocked, market.UpdatedAt)
+	updated, err := s.blockStore.SynchronizeState(ctx, market.ID, blocked, market.Version)
 	if err != nil {
 		metrics.MarketBlockStateSyncTotal.
 			WithLabelValues(s.config.Service.Name, reason, strconv.FormatBool(blocked), "error", "false").
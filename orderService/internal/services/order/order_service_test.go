@@ -24,6 +24,8 @@ import (
 	sharedModels "github.com/nastyazhadan/spot-order-grpc/shared/models"
 )
 
+const testReplicaMaxLag = time.Minute
+
 type mockIdempotencyAdapter struct {
 	mock.Mock
 }
@@ -94,6 +96,7 @@ type deps struct {
 	saver       *mocks.Saver
 	getter      *mocks.Getter
 	viewer      *mocks.MarketViewer
+	replica     *mocks.MarketReplica
 	blockStore  *mocks.MarketBlockStore
 	createLim   *mocks.RateLimiter
 	getLim      *mocks.RateLimiter
@@ -119,6 +122,7 @@ func (d *deps) service(t *testing.T) *OrderService {
 	t.Helper()
 
 	cfg := config.OrderConfig{
+		MarketReplica: config.MarketReplicaConfig{MaxLag: testReplicaMaxLag},
 		Redis: config.RedisConfig{
 			Idempotency: config.IdempotencyConfig{
 				CompleteAttempts:       testCompleteAttempts,
@@ -131,8 +135,14 @@ func (d *deps) service(t *testing.T) *OrderService {
 
 	idem := NewIdempotencyService(d.idemAdapter, zapLogger.NewNop(), cfg)
 
+	// Без реплики валидация рынка всегда идёт в spot
+	var replica MarketReplica
+	if d.replica != nil {
+		replica = d.replica
+	}
+
 	service := New(
-		d.manager, d.saver, d.getter, d.viewer, d.blockStore,
+		d.manager, d.saver, d.getter, d.viewer, replica, d.blockStore,
 		RateLimiters{Create: d.createLim, Get: d.getLim},
 		d.producer,
 		idem,
@@ -771,6 +781,151 @@ func TestCreateOrder(t *testing.T) {
 			expectedStatus: orderModel.OrderStatusUnspecified,
 			expectedErr:    serviceErrors.ErrMarketUnavailable,
 		},
+		{
+			name:      "свежая реплика — рынок берётся из реплики без вызова spot",
+			userID:    userID,
+			marketID:  marketID,
+			orderType: orderModel.OrderTypeLimit,
+			price:     "100.00",
+			quantity:  2,
+			setupMocks: func(t *testing.T, d *deps) {
+				d.idemAcquired(userID)
+				d.allowCreate(userID)
+				d.blockStore.On("IsBlocked", mock.Anything, marketID).Return(false, nil)
+				d.replica = mocks.NewMarketReplica(t)
+				d.replica.On("GetMarket", mock.Anything, marketID).
+					Return(sharedModels.Market{ID: marketID, Enabled: true, PublicToAllRoles: true}, time.Now(), nil)
+				tx := d.beginTx(nil)
+				d.saver.On("SaveOrder", mock.Anything, tx, mock.AnythingOfType("models.Order")).Return(nil)
+				d.producer.On("ProduceOrderCreated", mock.Anything, tx, mock.AnythingOfType("models.OrderCreatedEvent")).Return(nil)
+				d.idemComplete()
+			},
+			expectedStatus: orderModel.OrderStatusCreated,
+			checkResult: func(t *testing.T, orderID uuid.UUID, _ orderModel.OrderStatus) {
+				assert.NotEqual(t, uuid.Nil, orderID)
+			},
+			shortCircuit: func(t *testing.T, d *deps) {
+				d.viewer.AssertNotCalled(t, "GetMarketByID", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name:      "свежая реплика — отключённый рынок отклоняется без вызова spot",
+			userID:    userID,
+			marketID:  marketID,
+			orderType: orderModel.OrderTypeLimit,
+			price:     "100.00",
+			quantity:  1,
+			setupMocks: func(t *testing.T, d *deps) {
+				d.idemAcquired(userID)
+				d.allowCreate(userID)
+				d.blockStore.On("IsBlocked", mock.Anything, marketID).Return(false, nil)
+				d.replica = mocks.NewMarketReplica(t)
+				d.replica.On("GetMarket", mock.Anything, marketID).
+					Return(sharedModels.Market{ID: marketID, Enabled: false, Version: 7, PublicToAllRoles: true}, time.Now(), nil)
+				d.blockStore.On("SynchronizeState", mock.Anything, marketID, true, int64(7)).
+					Return(true, nil).Maybe()
+				d.idemFailCleanup()
+			},
+			expectedStatus: orderModel.OrderStatusUnspecified,
+			expectedErr:    serviceErrors.ErrDisabled{},
+			shortCircuit: func(t *testing.T, d *deps) {
+				d.viewer.AssertNotCalled(t, "GetMarketByID", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name:      "реплика не сверялась дольше max_lag — fallback на spot",
+			userID:    userID,
+			marketID:  marketID,
+			orderType: orderModel.OrderTypeLimit,
+			price:     "100.00",
+			quantity:  2,
+			setupMocks: func(t *testing.T, d *deps) {
+				d.idemAcquired(userID)
+				d.allowCreate(userID)
+				d.blockStore.On("IsBlocked", mock.Anything, marketID).Return(false, nil)
+				d.replica = mocks.NewMarketReplica(t)
+				d.replica.On("GetMarket", mock.Anything, marketID).
+					Return(sharedModels.Market{ID: marketID, Enabled: false}, time.Now().Add(-2*testReplicaMaxLag), nil)
+				d.viewer.On("GetMarketByID", mock.Anything, marketID).
+					Return(sharedModels.Market{ID: marketID, Enabled: true}, nil)
+				tx := d.beginTx(nil)
+				d.saver.On("SaveOrder", mock.Anything, tx, mock.AnythingOfType("models.Order")).Return(nil)
+				d.producer.On("ProduceOrderCreated", mock.Anything, tx, mock.AnythingOfType("models.OrderCreatedEvent")).Return(nil)
+				d.idemComplete()
+			},
+			expectedStatus: orderModel.OrderStatusCreated,
+			checkResult: func(t *testing.T, orderID uuid.UUID, _ orderModel.OrderStatus) {
+				assert.NotEqual(t, uuid.Nil, orderID)
+			},
+		},
+		{
+			name:      "restricted-рынок в реплике — доступ проверяет spot",
+			userID:    userID,
+			marketID:  marketID,
+			orderType: orderModel.OrderTypeLimit,
+			price:     "100.00",
+			quantity:  1,
+			setupMocks: func(t *testing.T, d *deps) {
+				d.idemAcquired(userID)
+				d.allowCreate(userID)
+				d.blockStore.On("IsBlocked", mock.Anything, marketID).Return(false, nil)
+				d.replica = mocks.NewMarketReplica(t)
+				d.replica.On("GetMarket", mock.Anything, marketID).
+					Return(sharedModels.Market{ID: marketID, Enabled: true, Restricted: true, PublicToAllRoles: true}, time.Now(), nil)
+				d.viewer.On("GetMarketByID", mock.Anything, marketID).
+					Return(sharedModels.Market{}, sharedErrors.ErrMarketNotFound{ID: marketID})
+				d.idemFailCleanup()
+			},
+			expectedStatus: orderModel.OrderStatusUnspecified,
+			expectedErr:    sharedErrors.ErrMarketNotFound{},
+		},
+		{
+			name:      "рынок в реплике виден не всем ролям — политики проверяет spot",
+			userID:    userID,
+			marketID:  marketID,
+			orderType: orderModel.OrderTypeLimit,
+			price:     "100.00",
+			quantity:  1,
+			setupMocks: func(t *testing.T, d *deps) {
+				d.idemAcquired(userID)
+				d.allowCreate(userID)
+				d.blockStore.On("IsBlocked", mock.Anything, marketID).Return(false, nil)
+				d.replica = mocks.NewMarketReplica(t)
+				d.replica.On("GetMarket", mock.Anything, marketID).
+					Return(sharedModels.Market{ID: marketID, Enabled: true}, time.Now(), nil)
+				d.viewer.On("GetMarketByID", mock.Anything, marketID).
+					Return(sharedModels.Market{}, sharedErrors.ErrMarketNotFound{ID: marketID})
+				d.idemFailCleanup()
+			},
+			expectedStatus: orderModel.OrderStatusUnspecified,
+			expectedErr:    sharedErrors.ErrMarketNotFound{},
+		},
+		{
+			name:      "рынка нет в реплике — fallback на spot",
+			userID:    userID,
+			marketID:  marketID,
+			orderType: orderModel.OrderTypeLimit,
+			price:     "100.00",
+			quantity:  2,
+			setupMocks: func(t *testing.T, d *deps) {
+				d.idemAcquired(userID)
+				d.allowCreate(userID)
+				d.blockStore.On("IsBlocked", mock.Anything, marketID).Return(false, nil)
+				d.replica = mocks.NewMarketReplica(t)
+				d.replica.On("GetMarket", mock.Anything, marketID).
+					Return(sharedModels.Market{}, time.Time{}, repositoryErrors.ErrMarketNotFound)
+				d.viewer.On("GetMarketByID", mock.Anything, marketID).
+					Return(sharedModels.Market{ID: marketID, Enabled: true}, nil)
+				tx := d.beginTx(nil)
+				d.saver.On("SaveOrder", mock.Anything, tx, mock.AnythingOfType("models.Order")).Return(nil)
+				d.producer.On("ProduceOrderCreated", mock.Anything, tx, mock.AnythingOfType("models.OrderCreatedEvent")).Return(nil)
+				d.idemComplete()
+			},
+			expectedStatus: orderModel.OrderStatusCreated,
+			checkResult: func(t *testing.T, orderID uuid.UUID, _ orderModel.OrderStatus) {
+				assert.NotEqual(t, uuid.Nil, orderID)
+			},
+		},
 		{
 			name:      "ошибка - начало транзакции",
 			userID:    userID,
@@ -1085,3 +1240,111 @@ func TestGetOrderStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestResolveMarketSymbol(t *testing.T) {
+	marketID := uuid.New()
+
+	tests := []struct {
+		name       string
+		setupMocks func(d *deps)
+		wantID     uuid.UUID
+		wantErr    error
+	}{
+		{
+			name: "символ найден — возвращается market_id",
+			setupMocks: func(d *deps) {
+				d.viewer.On("GetMarketBySymbol", mock.Anything, "BTC-USDT").
+					Return(sharedModels.Market{ID: marketID, Name: "BTC-USDT", Enabled: true}, nil).Once()
+			},
+			wantID: marketID,
+		},
+		{
+			name: "символ не найден — ErrMarketSymbolNotFound пробрасывается",
+			setupMocks: func(d *deps) {
+				d.viewer.On("GetMarketBySymbol", mock.Anything, "BTC-USDT").
+					Return(sharedModels.Market{}, sharedErrors.ErrMarketSymbolNotFound{Symbol: "BTC-USDT"}).Once()
+			},
+			wantErr: serviceErrors.ErrMarketSymbolNotFound,
+		},
+		{
+			name: "рынок отключён — ErrMarketDisabled пробрасывается",
+			setupMocks: func(d *deps) {
+				d.viewer.On("GetMarketBySymbol", mock.Anything, "BTC-USDT").
+					Return(sharedModels.Market{}, serviceErrors.ErrMarketDisabled).Once()
+			},
+			wantErr: serviceErrors.ErrMarketDisabled,
+		},
+		{
+			name: "свежая строка реплики — символ разрешается без вызова spot",
+			setupMocks: func(d *deps) {
+				d.replica = mocks.NewMarketReplica(t)
+				d.replica.On("GetMarketBySymbol", mock.Anything, "BTC-USDT").
+					Return(sharedModels.Market{ID: marketID, Name: "BTC-USDT", Enabled: true, PublicToAllRoles: true}, time.Now(), nil).Once()
+			},
+			wantID: marketID,
+		},
+		{
+			name: "строка реплики не подтверждалась дольше max_lag — fallback на spot",
+			setupMocks: func(d *deps) {
+				d.replica = mocks.NewMarketReplica(t)
+				d.replica.On("GetMarketBySymbol", mock.Anything, "BTC-USDT").
+					Return(sharedModels.Market{ID: uuid.New(), Name: "BTC-USDT", Enabled: true}, time.Now().Add(-2*testReplicaMaxLag), nil).Once()
+				d.viewer.On("GetMarketBySymbol", mock.Anything, "BTC-USDT").
+					Return(sharedModels.Market{ID: marketID, Name: "BTC-USDT", Enabled: true}, nil).Once()
+			},
+			wantID: marketID,
+		},
+		{
+			name: "restricted-рынок в реплике — доступ проверяет spot",
+			setupMocks: func(d *deps) {
+				d.replica = mocks.NewMarketReplica(t)
+				d.replica.On("GetMarketBySymbol", mock.Anything, "BTC-USDT").
+					Return(sharedModels.Market{ID: marketID, Name: "BTC-USDT", Enabled: true, Restricted: true, PublicToAllRoles: true}, time.Now(), nil).Once()
+				d.viewer.On("GetMarketBySymbol", mock.Anything, "BTC-USDT").
+					Return(sharedModels.Market{}, sharedErrors.ErrMarketSymbolNotFound{Symbol: "BTC-USDT"}).Once()
+			},
+			wantErr: serviceErrors.ErrMarketSymbolNotFound,
+		},
+		{
+			name: "рынок в реплике виден не всем ролям — политики проверяет spot",
+			setupMocks: func(d *deps) {
+				d.replica = mocks.NewMarketReplica(t)
+				d.replica.On("GetMarketBySymbol", mock.Anything, "BTC-USDT").
+					Return(sharedModels.Market{ID: marketID, Name: "BTC-USDT", Enabled: true}, time.Now(), nil).Once()
+				d.viewer.On("GetMarketBySymbol", mock.Anything, "BTC-USDT").
+					Return(sharedModels.Market{}, sharedErrors.ErrMarketSymbolNotFound{Symbol: "BTC-USDT"}).Once()
+			},
+			wantErr: serviceErrors.ErrMarketSymbolNotFound,
+		},
+		{
+			name: "символа нет в реплике — fallback на spot",
+			setupMocks: func(d *deps) {
+				d.replica = mocks.NewMarketReplica(t)
+				d.replica.On("GetMarketBySymbol", mock.Anything, "BTC-USDT").
+					Return(sharedModels.Market{}, time.Time{}, repositoryErrors.ErrMarketNotFound).Once()
+				d.viewer.On("GetMarketBySymbol", mock.Anything, "BTC-USDT").
+					Return(sharedModels.Market{ID: marketID, Name: "BTC-USDT", Enabled: true}, nil).Once()
+			},
+			wantID: marketID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := newDeps(t)
+			tt.setupMocks(d)
+
+			got, err := d.service(t).ResolveMarketSymbol(context.Background(), "BTC-USDT")
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, uuid.Nil, got)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantID, got)
+		})
+	}
+}
@@ -120,6 +120,57 @@ func (p *OrderProducer) ProduceOrderStatusUpdated(
 	return nil
 }
 
+// ProduceOrderFilled сохраняет событие исполнения в outbox в транзакции смены статуса
+func (p *OrderProducer) ProduceOrderFilled(
+	ctx context.Context,
+	transaction pgx.Tx,
+	event models.OrderFilledEvent,
+) error {
+	const op = "OrderProducer.ProduceOrderFilled"
+
+	ctx, span := tracing.StartSpan(ctx, "producer.produce_order_filled")
+	defer span.End()
+
+	payload, err := mapper.MarshalOrderFilled(event)
+	if err != nil {
+		tracing.RecordError(span, err)
+		p.logger.Error(ctx, "Failed to marshal OrderFilledEvent",
+			zap.String("order_id", event.OrderID.String()),
+			zap.String("event_id", event.EventID.String()),
+			zap.Error(err),
+		)
+		return fmt.Errorf("%s: marshal OrderFilledEvent: %w", op, err)
+	}
+
+	outboxEvent := models.OutboxEvent{
+		ID:          uuid.New(),
+		EventID:     event.EventID,
+		EventType:   models.OrderFilledEventType,
+		AggregateID: event.OrderID,
+		Payload:     payload,
+		Status:      models.OutboxEventStatusPending,
+	}
+
+	if err = p.outboxWriter.SaveOutboxEvent(ctx, transaction, outboxEvent); err != nil {
+		tracing.RecordError(span, err)
+		p.logger.Error(ctx, "Failed to save OrderFilledEvent to outbox",
+			zap.String("order_id", event.OrderID.String()),
+			zap.String("event_id", event.EventID.String()),
+			zap.String("outbox_event_id", outboxEvent.ID.String()),
+			zap.Error(err),
+		)
+		return fmt.Errorf("%s: save OrderFilledEvent to outbox: %w", op, err)
+	}
+
+	p.logger.Info(ctx, "OrderFilledEvent prepared for outbox saving",
+		zap.String("order_id", event.OrderID.String()),
+		zap.String("event_id", event.EventID.String()),
+		zap.String("outbox_event_id", outboxEvent.ID.String()),
+	)
+
+	return nil
+}
+
 func (p *OrderProducer) buildOrderCreatedOutboxEvent(
 	event models.OrderCreatedEvent,
 	payload []byte,
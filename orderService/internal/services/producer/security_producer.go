@@ -0,0 +1,63 @@
+package producer
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	mapper "github.com/nastyazhadan/spot-order-grpc/orderService/internal/application/dto/outbound/kafka"
+	"github.com/nastyazhadan/spot-order-grpc/orderService/internal/domain/models"
+	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/kafka"
+	zapLogger "github.com/nastyazhadan/spot-order-grpc/shared/interceptors/logging/zap"
+	"github.com/nastyazhadan/spot-order-grpc/shared/interceptors/tracing"
+)
+
+type MessagePublisher interface {
+	SendMessage(ctx context.Context, message kafka.Message) error
+}
+
+type SecurityProducer struct {
+	publisher MessagePublisher
+	logger    *zapLogger.Logger
+}
+
+func NewSecurityProducer(publisher MessagePublisher, logger *zapLogger.Logger) *SecurityProducer {
+	return &SecurityProducer{
+		publisher: publisher,
+		logger:    logger,
+	}
+}
+
+// ProduceSecurityEvent публикует событие сразу в Kafka. Ключ — user_id,
+// чтобы события одного пользователя шли в одну партицию по порядку
+func (p *SecurityProducer) ProduceSecurityEvent(ctx context.Context, event models.SecurityEvent) error {
+	const op = "SecurityProducer.ProduceSecurityEvent"
+
+	ctx, span := tracing.StartSpan(ctx, "producer.produce_security_event")
+	defer span.End()
+
+	payload, err := mapper.MarshalSecurityEvent(event)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return fmt.Errorf("%s: marshal SecurityEvent: %w", op, err)
+	}
+
+	message := kafka.Message{
+		Key:   []byte(event.UserID.String()),
+		Value: payload,
+	}
+
+	if err = p.publisher.SendMessage(ctx, message); err != nil {
+		tracing.RecordError(span, err)
+		return fmt.Errorf("%s: publish SecurityEvent: %w", op, err)
+	}
+
+	p.logger.Info(ctx, "SecurityEvent published",
+		zap.String("event_id", event.EventID.String()),
+		zap.String("event_type", event.Type),
+		zap.String("user_id", event.UserID.String()),
+	)
+
+	return nil
+}
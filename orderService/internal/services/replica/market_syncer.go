@@ -0,0 +1,183 @@
+package replica
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+
+	serviceErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/service"
+	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/otel/attributes"
+	zapLogger "github.com/nastyazhadan/spot-order-grpc/shared/interceptors/logging/zap"
+	"github.com/nastyazhadan/spot-order-grpc/shared/interceptors/tracing"
+	"github.com/nastyazhadan/spot-order-grpc/shared/metrics"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+)
+
+const (
+	authorizationHeader = "authorization"
+	bearerPrefix        = "Bearer "
+)
+
+type MarketLister interface {
+	ViewMarkets(ctx context.Context, limit uint64, pageToken string, filter models.MarketFilter) ([]models.Market, string, bool, error)
+}
+
+type SnapshotWriter interface {
+	ApplySnapshot(ctx context.Context, markets []models.Market, syncedAt time.Time) error
+}
+
+// ServiceTokenSource выдаёт токен сервиса с ролью ROLE_SERVICE
+type ServiceTokenSource interface {
+	ServiceToken() (string, error)
+}
+
+// MarketSyncer сверяет локальную реплику рынков со spot: сразу после старта
+// и затем раз в resyncInterval. Между сверками реплику обновляют события market.updated.
+type MarketSyncer struct {
+	lister         MarketLister
+	writer         SnapshotWriter
+	serviceTokens  ServiceTokenSource
+	resyncInterval time.Duration
+	pageSize       uint64
+	serviceName    string
+	logger         *zapLogger.Logger
+}
+
+func NewMarketSyncer(
+	lister MarketLister,
+	writer SnapshotWriter,
+	serviceTokens ServiceTokenSource,
+	resyncInterval time.Duration,
+	pageSize uint64,
+	serviceName string,
+	logger *zapLogger.Logger,
+) *MarketSyncer {
+	return &MarketSyncer{
+		lister:         lister,
+		writer:         writer,
+		serviceTokens:  serviceTokens,
+		resyncInterval: resyncInterval,
+		pageSize:       pageSize,
+		serviceName:    serviceName,
+		logger:         logger,
+	}
+}
+
+// Run блокирует до отмены ctx. Ошибка сверки не останавливает цикл:
+// реплика просто устаревает, и CreateOrder переходит на запросы в spot.
+func (s *MarketSyncer) Run(ctx context.Context) error {
+	if ctx == nil {
+		return errors.New("market replica syncer: nil context")
+	}
+
+	s.logger.Info(ctx, "Market replica syncer started",
+		zap.Duration("resync_interval", s.resyncInterval),
+		zap.Uint64("page_size", s.pageSize),
+	)
+
+	s.syncOnce(ctx)
+
+	ticker := time.NewTicker(s.resyncInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info(ctx, "Market replica syncer stopped")
+			return nil
+		case <-ticker.C:
+			s.syncOnce(ctx)
+		}
+	}
+}
+
+func (s *MarketSyncer) syncOnce(ctx context.Context) {
+	count, err := s.Sync(ctx)
+	if err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+
+		metrics.MarketReplicaSyncsTotal.WithLabelValues(s.serviceName, "error").Inc()
+		s.logger.Warn(ctx, "Market replica sync failed", zap.Error(err))
+		return
+	}
+
+	metrics.MarketReplicaSyncsTotal.WithLabelValues(s.serviceName, "success").Inc()
+	s.logger.Debug(ctx, "Market replica synced", zap.Int("markets_count", count))
+}
+
+// Sync выгружает все рынки из spot от имени администратора и применяет их одним снимком.
+func (s *MarketSyncer) Sync(ctx context.Context) (int, error) {
+	const op = "MarketSyncer.Sync"
+
+	// Сверка должна закончиться до следующего тика
+	ctx, cancel := context.WithTimeout(ctx, s.resyncInterval)
+	defer cancel()
+
+	ctx, span := tracing.StartSpan(ctx, "market_replica.sync")
+	defer span.End()
+
+	startedAt := time.Now().UTC()
+
+	ctx, err := s.withServiceToken(ctx)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	markets, err := s.loadAllMarkets(ctx)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	span.SetAttributes(attributes.MarketsCountValue(len(markets)))
+
+	if err = s.writer.ApplySnapshot(ctx, markets, startedAt); err != nil {
+		tracing.RecordError(span, err)
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return len(markets), nil
+}
+
+// withServiceToken ставит токен сервиса явно: сверка не должна зависеть от того,
+// из какого контекста её запустили
+func (s *MarketSyncer) withServiceToken(ctx context.Context) (context.Context, error) {
+	token, err := s.serviceTokens.ServiceToken()
+	if err != nil {
+		return nil, fmt.Errorf("issue service token: %w", err)
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, authorizationHeader, bearerPrefix+token), nil
+}
+
+func (s *MarketSyncer) loadAllMarkets(ctx context.Context) ([]models.Market, error) {
+	var (
+		markets   []models.Market
+		pageToken string
+	)
+
+	for {
+		page, nextPageToken, hasMore, err := s.lister.ViewMarkets(ctx, s.pageSize, pageToken, models.MarketFilter{})
+		if err != nil {
+			// Пустой market_store в spot — тоже валидный снимок
+			if pageToken == "" && errors.Is(err, serviceErrors.ErrMarketsNotFound) {
+				return nil, nil
+			}
+
+			return nil, fmt.Errorf("view markets: %w", err)
+		}
+
+		markets = append(markets, page...)
+
+		if !hasMore || nextPageToken == "" {
+			return markets, nil
+		}
+		pageToken = nextPageToken
+	}
+}
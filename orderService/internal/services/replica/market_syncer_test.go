@@ -0,0 +1,164 @@
+package replica
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/nastyazhadan/spot-order-grpc/orderService/internal/services/mocks"
+	serviceErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/service"
+	zapLogger "github.com/nastyazhadan/spot-order-grpc/shared/interceptors/logging/zap"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+)
+
+const (
+	testPageSize       = 2
+	testResyncInterval = time.Minute
+	testToken          = "service-token"
+)
+
+type syncerDeps struct {
+	lister *mocks.MarketLister
+	writer *mocks.SnapshotWriter
+	tokens *mocks.ServiceTokenSource
+}
+
+func newSyncerDeps(t *testing.T) *syncerDeps {
+	return &syncerDeps{
+		lister: mocks.NewMarketLister(t),
+		writer: mocks.NewSnapshotWriter(t),
+		tokens: mocks.NewServiceTokenSource(t),
+	}
+}
+
+func (d *syncerDeps) syncer() *MarketSyncer {
+	return NewMarketSyncer(
+		d.lister, d.writer, d.tokens,
+		testResyncInterval,
+		testPageSize,
+		"order-service-test",
+		zapLogger.NewNop(),
+	)
+}
+
+func (d *syncerDeps) issueToken() {
+	d.tokens.On("ServiceToken").Return(testToken, nil)
+}
+
+// withServiceToken проверяет, что в spot уходит токен сервиса, а не токен пользователя
+func withServiceToken(ctx context.Context) bool {
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if !ok {
+		return false
+	}
+
+	values := md.Get(authorizationHeader)
+	return len(values) == 1 && values[0] == bearerPrefix+testToken
+}
+
+func TestMarketSyncerSync(t *testing.T) {
+	first := models.Market{ID: uuid.New(), Name: "BTC-USDT", Enabled: true, Version: 3}
+	second := models.Market{ID: uuid.New(), Name: "ETH-USDT", Enabled: false, Version: 1}
+	third := models.Market{ID: uuid.New(), Name: "SOL-USDT", Enabled: true, Version: 2}
+
+	tests := []struct {
+		name          string
+		setupMocks    func(d *syncerDeps)
+		expectedCount int
+		expectedErr   error
+		errContains   string
+	}{
+		{
+			name: "все страницы собираются в один снимок",
+			setupMocks: func(d *syncerDeps) {
+				d.issueToken()
+				d.lister.On("ViewMarkets", mock.MatchedBy(withServiceToken), uint64(testPageSize), "", models.MarketFilter{}).
+					Return([]models.Market{first, second}, "next", true, nil).Once()
+				d.lister.On("ViewMarkets", mock.MatchedBy(withServiceToken), uint64(testPageSize), "next", models.MarketFilter{}).
+					Return([]models.Market{third}, "", false, nil).Once()
+				d.writer.On("ApplySnapshot", mock.Anything, []models.Market{first, second, third}, mock.AnythingOfType("time.Time")).
+					Return(nil).Once()
+			},
+			expectedCount: 3,
+		},
+		{
+			name: "пустой market_store в spot — применяется пустой снимок",
+			setupMocks: func(d *syncerDeps) {
+				d.issueToken()
+				d.lister.On("ViewMarkets", mock.Anything, uint64(testPageSize), "", models.MarketFilter{}).
+					Return(nil, "", false, serviceErrors.ErrMarketsNotFound).Once()
+				d.writer.On("ApplySnapshot", mock.Anything, []models.Market(nil), mock.AnythingOfType("time.Time")).
+					Return(nil).Once()
+			},
+			expectedCount: 0,
+		},
+		{
+			name: "ошибка - spot недоступен, снимок не применяется",
+			setupMocks: func(d *syncerDeps) {
+				d.issueToken()
+				d.lister.On("ViewMarkets", mock.Anything, uint64(testPageSize), "", models.MarketFilter{}).
+					Return(nil, "", false, serviceErrors.ErrSpotUnavailable).Once()
+			},
+			expectedErr: serviceErrors.ErrSpotUnavailable,
+		},
+		{
+			name: "ошибка на второй странице — частичный снимок не применяется",
+			setupMocks: func(d *syncerDeps) {
+				d.issueToken()
+				d.lister.On("ViewMarkets", mock.Anything, uint64(testPageSize), "", models.MarketFilter{}).
+					Return([]models.Market{first, second}, "next", true, nil).Once()
+				d.lister.On("ViewMarkets", mock.Anything, uint64(testPageSize), "next", models.MarketFilter{}).
+					Return(nil, "", false, serviceErrors.ErrMarketsNotFound).Once()
+			},
+			expectedErr: serviceErrors.ErrMarketsNotFound,
+		},
+		{
+			name: "ошибка - не удалось выпустить токен сервиса",
+			setupMocks: func(d *syncerDeps) {
+				d.tokens.On("ServiceToken").Return("", errors.New("sign failed"))
+			},
+			errContains: "sign failed",
+		},
+		{
+			name: "ошибка - запись снимка в postgres",
+			setupMocks: func(d *syncerDeps) {
+				d.issueToken()
+				d.lister.On("ViewMarkets", mock.Anything, uint64(testPageSize), "", models.MarketFilter{}).
+					Return([]models.Market{first}, "", false, nil).Once()
+				d.writer.On("ApplySnapshot", mock.Anything, []models.Market{first}, mock.AnythingOfType("time.Time")).
+					Return(errors.New("pg down")).Once()
+			},
+			errContains: "pg down",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := newSyncerDeps(t)
+			tt.setupMocks(d)
+
+			count, err := d.syncer().Sync(context.Background())
+
+			if tt.expectedErr != nil || tt.errContains != "" {
+				require.Error(t, err)
+				if tt.expectedErr != nil {
+					assert.ErrorIs(t, err, tt.expectedErr)
+				}
+				if tt.errContains != "" {
+					assert.ErrorContains(t, err, tt.errContains)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedCount, count)
+		})
+	}
+}
@@ -0,0 +1,221 @@
+package useradmin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	domainModels "github.com/nastyazhadan/spot-order-grpc/orderService/internal/domain/models"
+	authService "github.com/nastyazhadan/spot-order-grpc/orderService/internal/services/auth"
+	"github.com/nastyazhadan/spot-order-grpc/shared/auth/password"
+	repositoryErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/repository"
+	authErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/service"
+	zapLogger "github.com/nastyazhadan/spot-order-grpc/shared/interceptors/logging/zap"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+)
+
+type UserStore interface {
+	CreateUser(ctx context.Context, user domainModels.User) error
+	AddUserRole(ctx context.Context, userID uuid.UUID, role models.UserRole) (domainModels.User, bool, error)
+	RemoveUserRole(ctx context.Context, userID uuid.UUID, role models.UserRole) (domainModels.User, bool, error)
+	DisableUser(ctx context.Context, userID uuid.UUID) (domainModels.User, bool, error)
+}
+
+type SessionRevoker interface {
+	RevokeAll(ctx context.Context, userID uuid.UUID) error
+}
+
+// UserAdminService управляет учётными записями. Право users:admin на методах проверяет перехватчик authz
+type UserAdminService struct {
+	users    UserStore
+	sessions SessionRevoker
+	logger   *zapLogger.Logger
+}
+
+func New(users UserStore, sessions SessionRevoker, logger *zapLogger.Logger) *UserAdminService {
+	return &UserAdminService{
+		users:    users,
+		sessions: sessions,
+		logger:   logger,
+	}
+}
+
+func (s *UserAdminService) CreateUser(
+	ctx context.Context,
+	username, plainPassword string,
+	roles []models.UserRole,
+) (domainModels.User, error) {
+	const op = "UserAdminService.CreateUser"
+
+	username = authService.NormalizeUsername(username)
+	if username == "" {
+		return domainModels.User{}, authErrors.ErrInvalidUsername
+	}
+
+	if len(roles) == 0 {
+		return domainModels.User{}, authErrors.ErrUserRolesRequired
+	}
+	for _, role := range roles {
+		if !isAssignable(role) {
+			return domainModels.User{}, authErrors.ErrRoleNotAssignable
+		}
+	}
+
+	hash, err := password.Hash(plainPassword)
+	if err != nil {
+		return domainModels.User{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	now := time.Now().UTC()
+	user := domainModels.User{
+		ID:           uuid.New(),
+		Username:     username,
+		PasswordHash: hash,
+		Roles:        roles,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+
+	if err = s.users.CreateUser(ctx, user); err != nil {
+		if errors.Is(err, repositoryErrors.ErrUserAlreadyExists) {
+			return domainModels.User{}, authErrors.ErrUserAlreadyExists
+		}
+		return domainModels.User{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	s.logger.Info(ctx, "user created",
+		zap.String("target_user_id", user.ID.String()),
+		zap.Strings("roles", rolesToStrings(roles)),
+	)
+
+	return user, nil
+}
+
+// AssignRole назначает роль и завершает все сессии пользователя, чтобы роль попала в токены при следующем Login.
+// Сессии завершаются и при повторном назначении: так повтор запроса после сбоя отзыва доводит дело до конца
+func (s *UserAdminService) AssignRole(
+	ctx context.Context,
+	userID uuid.UUID,
+	role models.UserRole,
+) (domainModels.User, error) {
+	const op = "UserAdminService.AssignRole"
+
+	if !isAssignable(role) {
+		return domainModels.User{}, authErrors.ErrRoleNotAssignable
+	}
+
+	user, changed, err := s.users.AddUserRole(ctx, userID, role)
+	if err != nil {
+		return domainModels.User{}, mapStoreError(op, err)
+	}
+
+	if err = s.revokeSessions(ctx, userID); err != nil {
+		return domainModels.User{}, err
+	}
+
+	s.logger.Info(ctx, "user role assigned",
+		zap.String("target_user_id", userID.String()),
+		zap.String("role", role.String()),
+		zap.Bool("changed", changed),
+	)
+
+	return user, nil
+}
+
+// RemoveRole снимает роль и завершает все сессии пользователя. Последнюю роль снять нельзя
+func (s *UserAdminService) RemoveRole(
+	ctx context.Context,
+	userID uuid.UUID,
+	role models.UserRole,
+) (domainModels.User, error) {
+	const op = "UserAdminService.RemoveRole"
+
+	if !isAssignable(role) {
+		return domainModels.User{}, authErrors.ErrRoleNotAssignable
+	}
+
+	user, changed, err := s.users.RemoveUserRole(ctx, userID, role)
+	if err != nil {
+		return domainModels.User{}, mapStoreError(op, err)
+	}
+
+	if err = s.revokeSessions(ctx, userID); err != nil {
+		return domainModels.User{}, err
+	}
+
+	s.logger.Info(ctx, "user role removed",
+		zap.String("target_user_id", userID.String()),
+		zap.String("role", role.String()),
+		zap.Bool("changed", changed),
+	)
+
+	return user, nil
+}
+
+// DisableUser запрещает Login и завершает все сессии пользователя.
+// API-ключи отключённого пользователя перестают проходить проверку подписи
+func (s *UserAdminService) DisableUser(ctx context.Context, userID uuid.UUID) (domainModels.User, error) {
+	const op = "UserAdminService.DisableUser"
+
+	user, changed, err := s.users.DisableUser(ctx, userID)
+	if err != nil {
+		return domainModels.User{}, mapStoreError(op, err)
+	}
+
+	if err = s.revokeSessions(ctx, userID); err != nil {
+		return domainModels.User{}, err
+	}
+
+	s.logger.Info(ctx, "user disabled",
+		zap.String("target_user_id", userID.String()),
+		zap.Bool("changed", changed),
+	)
+
+	return user, nil
+}
+
+func (s *UserAdminService) revokeSessions(ctx context.Context, userID uuid.UUID) error {
+	if err := s.sessions.RevokeAll(ctx, userID); err != nil {
+		s.logger.Error(ctx, "failed to revoke user sessions",
+			zap.String("target_user_id", userID.String()),
+			zap.Error(err),
+		)
+		return authErrors.ErrRevokeTokenFailed
+	}
+
+	return nil
+}
+
+// ROLE_SERVICE выдаётся только токенам сервисов
+func isAssignable(role models.UserRole) bool {
+	switch role {
+	case models.UserRoleUser, models.UserRoleAdmin, models.UserRoleViewer:
+		return true
+	default:
+		return false
+	}
+}
+
+func mapStoreError(op string, err error) error {
+	switch {
+	case errors.Is(err, repositoryErrors.ErrUserNotFound):
+		return authErrors.ErrUserNotFound
+	case errors.Is(err, repositoryErrors.ErrUserRolesRequired):
+		return authErrors.ErrUserRolesRequired
+	default:
+		return fmt.Errorf("%s: %w", op, err)
+	}
+}
+
+func rolesToStrings(roles []models.UserRole) []string {
+	result := make([]string, 0, len(roles))
+	for _, role := range roles {
+		result = append(result, role.String())
+	}
+
+	return result
+}
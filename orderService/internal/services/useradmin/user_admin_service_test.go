@@ -0,0 +1,226 @@
+package useradmin
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	domainModels "github.com/nastyazhadan/spot-order-grpc/orderService/internal/domain/models"
+	"github.com/nastyazhadan/spot-order-grpc/orderService/internal/services/mocks"
+	"github.com/nastyazhadan/spot-order-grpc/shared/auth/password"
+	repositoryErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/repository"
+	authErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/service"
+	zapLogger "github.com/nastyazhadan/spot-order-grpc/shared/interceptors/logging/zap"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+)
+
+type userAdminDeps struct {
+	users    *mocks.UserStore
+	sessions *mocks.SessionRevoker
+}
+
+func newUserAdminDeps(t *testing.T) *userAdminDeps {
+	return &userAdminDeps{
+		users:    mocks.NewUserStore(t),
+		sessions: mocks.NewSessionRevoker(t),
+	}
+}
+
+func (d *userAdminDeps) service() *UserAdminService {
+	return New(d.users, d.sessions, zapLogger.NewNop())
+}
+
+func TestUserAdminServiceCreateUser(t *testing.T) {
+	tests := []struct {
+		name        string
+		username    string
+		roles       []models.UserRole
+		setupMocks  func(d *userAdminDeps)
+		expectedErr error
+	}{
+		{
+			name:     "Успешное создание",
+			username: "  Alice ",
+			roles:    []models.UserRole{models.UserRoleUser, models.UserRoleViewer},
+			setupMocks: func(d *userAdminDeps) {
+				d.users.On("CreateUser", mock.Anything, mock.MatchedBy(func(user domainModels.User) bool {
+					return user.Username == "alice" && password.Verify(user.PasswordHash, "secret-password")
+				})).Return(nil)
+			},
+		},
+		{
+			name:        "Пустое имя",
+			username:    "   ",
+			roles:       []models.UserRole{models.UserRoleUser},
+			setupMocks:  func(*userAdminDeps) {},
+			expectedErr: authErrors.ErrInvalidUsername,
+		},
+		{
+			name:        "Роль сервиса не выдаётся",
+			username:    "alice",
+			roles:       []models.UserRole{models.UserRoleService},
+			setupMocks:  func(*userAdminDeps) {},
+			expectedErr: authErrors.ErrRoleNotAssignable,
+		},
+		{
+			name:     "Имя уже занято",
+			username: "alice",
+			roles:    []models.UserRole{models.UserRoleUser},
+			setupMocks: func(d *userAdminDeps) {
+				d.users.On("CreateUser", mock.Anything, mock.Anything).Return(repositoryErrors.ErrUserAlreadyExists)
+			},
+			expectedErr: authErrors.ErrUserAlreadyExists,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			deps := newUserAdminDeps(t)
+			test.setupMocks(deps)
+
+			user, err := deps.service().CreateUser(context.Background(), test.username, "secret-password", test.roles)
+
+			if test.expectedErr != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.NotEqual(t, uuid.Nil, user.ID)
+			assert.Equal(t, "alice", user.Username)
+			assert.Equal(t, test.roles, user.Roles)
+		})
+	}
+}
+
+func TestUserAdminServiceRoles(t *testing.T) {
+	userID := uuid.New()
+	updated := domainModels.User{ID: userID, Username: "alice", Roles: []models.UserRole{models.UserRoleAdmin}}
+
+	tests := []struct {
+		name        string
+		role        models.UserRole
+		call        func(s *UserAdminService, ctx context.Context, role models.UserRole) (domainModels.User, error)
+		setupMocks  func(d *userAdminDeps)
+		expectedErr error
+	}{
+		{
+			name: "Назначение роли завершает сессии",
+			role: models.UserRoleAdmin,
+			call: assignRole(userID),
+			setupMocks: func(d *userAdminDeps) {
+				d.users.On("AddUserRole", mock.Anything, userID, models.UserRoleAdmin).Return(updated, true, nil)
+				d.sessions.On("RevokeAll", mock.Anything, userID).Return(nil)
+			},
+		},
+		{
+			name: "Повторное назначение тоже завершает сессии",
+			role: models.UserRoleAdmin,
+			call: assignRole(userID),
+			setupMocks: func(d *userAdminDeps) {
+				d.users.On("AddUserRole", mock.Anything, userID, models.UserRoleAdmin).Return(updated, false, nil)
+				d.sessions.On("RevokeAll", mock.Anything, userID).Return(nil)
+			},
+		},
+		{
+			name: "Снятие роли завершает сессии",
+			role: models.UserRoleUser,
+			call: removeRole(userID),
+			setupMocks: func(d *userAdminDeps) {
+				d.users.On("RemoveUserRole", mock.Anything, userID, models.UserRoleUser).Return(updated, true, nil)
+				d.sessions.On("RevokeAll", mock.Anything, userID).Return(nil)
+			},
+		},
+		{
+			name: "Снятие последней роли",
+			role: models.UserRoleAdmin,
+			call: removeRole(userID),
+			setupMocks: func(d *userAdminDeps) {
+				d.users.On("RemoveUserRole", mock.Anything, userID, models.UserRoleAdmin).
+					Return(domainModels.User{}, false, repositoryErrors.ErrUserRolesRequired)
+			},
+			expectedErr: authErrors.ErrUserRolesRequired,
+		},
+		{
+			name: "Пользователь не найден",
+			role: models.UserRoleViewer,
+			call: assignRole(userID),
+			setupMocks: func(d *userAdminDeps) {
+				d.users.On("AddUserRole", mock.Anything, userID, models.UserRoleViewer).
+					Return(domainModels.User{}, false, repositoryErrors.ErrUserNotFound)
+			},
+			expectedErr: authErrors.ErrUserNotFound,
+		},
+		{
+			name:        "Роль сервиса не назначается",
+			role:        models.UserRoleService,
+			call:        assignRole(userID),
+			setupMocks:  func(*userAdminDeps) {},
+			expectedErr: authErrors.ErrRoleNotAssignable,
+		},
+		{
+			name: "Ошибка отзыва сессий",
+			role: models.UserRoleAdmin,
+			call: assignRole(userID),
+			setupMocks: func(d *userAdminDeps) {
+				d.users.On("AddUserRole", mock.Anything, userID, models.UserRoleAdmin).Return(updated, true, nil)
+				d.sessions.On("RevokeAll", mock.Anything, userID).Return(errors.New("redis unavailable"))
+			},
+			expectedErr: authErrors.ErrRevokeTokenFailed,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			deps := newUserAdminDeps(t)
+			test.setupMocks(deps)
+
+			user, err := test.call(deps.service(), context.Background(), test.role)
+
+			if test.expectedErr != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, updated, user)
+		})
+	}
+}
+
+func TestUserAdminServiceDisableUser(t *testing.T) {
+	userID := uuid.New()
+	disabled := domainModels.User{ID: userID, Username: "alice", Disabled: true}
+
+	deps := newUserAdminDeps(t)
+	deps.users.On("DisableUser", mock.Anything, userID).Return(disabled, true, nil)
+	deps.sessions.On("RevokeAll", mock.Anything, userID).Return(nil)
+
+	user, err := deps.service().DisableUser(context.Background(), userID)
+
+	require.NoError(t, err)
+	assert.True(t, user.Disabled)
+}
+
+func assignRole(
+	userID uuid.UUID,
+) func(*UserAdminService, context.Context, models.UserRole) (domainModels.User, error) {
+	return func(s *UserAdminService, ctx context.Context, role models.UserRole) (domainModels.User, error) {
+		return s.AssignRole(ctx, userID, role)
+	}
+}
+
+func removeRole(
+	userID uuid.UUID,
+) func(*UserAdminService, context.Context, models.UserRole) (domainModels.User, error) {
+	return func(s *UserAdminService, ctx context.Context, role models.UserRole) (domainModels.User, error) {
+		return s.RemoveRole(ctx, userID, role)
+	}
+}
@@ -0,0 +1,29 @@
+-- +goose Up
+-- Локальная реплика market_store из spotService: пополняется событиями market.updated
+-- и периодической полной сверкой через ViewMarkets.
+-- synced_at — момент, на который spot подтвердил состояние рынка (updated_at события или начало сверки).
+-- restricted-рынки валидируются только через spot: доступ проверяется по market_access в spot_db.
+-- public_to_all_roles — spot подтвердил, что включённый рынок виден любой роли по политикам видимости;
+-- остальные рынки тоже валидируются через spot. Флаг освежается сверкой после смены политик
+CREATE TABLE IF NOT EXISTS markets
+(
+    id          UUID PRIMARY KEY,
+    name        TEXT        NOT NULL,
+    base_asset  TEXT        NOT NULL,
+    quote_asset TEXT        NOT NULL,
+    enabled     BOOLEAN     NOT NULL,
+    deleted_at  TIMESTAMPTZ,
+    updated_at  TIMESTAMPTZ NOT NULL,
+    version     BIGINT      NOT NULL DEFAULT 0,
+    synced_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    restricted  BOOLEAN     NOT NULL DEFAULT FALSE,
+    public_to_all_roles BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+-- market_symbol разрешается по реплике; имя не уникально, пока события переименований не сошлись
+CREATE INDEX IF NOT EXISTS idx_markets_active_name
+    ON markets (name)
+    WHERE deleted_at IS NULL;
+
+-- +goose Down
+DROP TABLE IF EXISTS markets;
@@ -0,0 +1,19 @@
+-- +goose Up
+-- Учётные записи для Login. username хранится в нижнем регистре, password_hash — bcrypt
+CREATE TABLE IF NOT EXISTS users
+(
+    id            UUID PRIMARY KEY,
+    username      TEXT        NOT NULL,
+    password_hash TEXT        NOT NULL,
+    roles         TEXT[]      NOT NULL,
+    disabled      BOOLEAN     NOT NULL DEFAULT FALSE,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT uq_users_username UNIQUE (username),
+    CONSTRAINT chk_users_username_lower CHECK (username = lower(username)),
+    CONSTRAINT chk_users_roles_not_empty CHECK (cardinality(roles) > 0)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS users;
@@ -0,0 +1,23 @@
+-- +goose Up
+-- API-ключи для подписи запросов HMAC. Секрет не хранится ни в каком виде: order-service выводит его
+-- из pepper сервиса и id ключа. allowed_ips — CIDR, пустой массив снимает ограничение
+CREATE TABLE IF NOT EXISTS api_keys
+(
+    id           UUID PRIMARY KEY,
+    user_id      UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    name         TEXT        NOT NULL,
+    scopes       TEXT[]      NOT NULL,
+    allowed_ips  TEXT[]      NOT NULL DEFAULT '{}',
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_used_at TIMESTAMPTZ,
+    revoked_at   TIMESTAMPTZ,
+
+    CONSTRAINT chk_api_keys_scopes_not_empty CHECK (cardinality(scopes) > 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_api_keys_user_active
+    ON api_keys (user_id, created_at)
+    WHERE revoked_at IS NULL;
+
+-- +goose Down
+DROP TABLE IF EXISTS api_keys;
@@ -0,0 +1,85 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.4
+// source: common/v1/authz.proto
+
+package commonv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var file_common_v1_authz_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         50001,
+		Name:          "common.v1.required_permission",
+		Tag:           "bytes,50001,opt,name=required_permission",
+		Filename:      "common/v1/authz.proto",
+	},
+}
+
+// Extension fields to descriptorpb.MethodOptions.
+var (
+	// Право, без которого метод не вызывается (например, "orders:write").
+	// Метод без опции доступен любому аутентифицированному пользователю, но не scoped-токену
+	//
+	// optional string required_permission = 50001;
+	E_RequiredPermission = &file_common_v1_authz_proto_extTypes[0]
+)
+
+var File_common_v1_authz_proto protoreflect.FileDescriptor
+
+const file_common_v1_authz_proto_rawDesc = "" +
+	"\n" +
+	"\x15common/v1/authz.proto\x12\tcommon.v1\x1a google/protobuf/descriptor.proto:Q\n" +
+	"\x13required_permission\x12\x1e.google.protobuf.MethodOptions\x18ц\x03 \x01(\tR\x12requiredPermissionBJZHgithub.com/nastyazhadan/spot-order-grpc/protos/gen/go/common/v1;commonv1b\x06proto3"
+
+var file_common_v1_authz_proto_goTypes = []any{
+	(*descriptorpb.MethodOptions)(nil), // 0: google.protobuf.MethodOptions
+}
+var file_common_v1_authz_proto_depIdxs = []int32{
+	0, // 0: common.v1.required_permission:extendee -> google.protobuf.MethodOptions
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	0, // [0:1] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_common_v1_authz_proto_init() }
+func file_common_v1_authz_proto_init() {
+	if File_common_v1_authz_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_common_v1_authz_proto_rawDesc), len(file_common_v1_authz_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 1,
+			NumServices:   0,
+		},
+		GoTypes:           file_common_v1_authz_proto_goTypes,
+		DependencyIndexes: file_common_v1_authz_proto_depIdxs,
+		ExtensionInfos:    file_common_v1_authz_proto_extTypes,
+	}.Build()
+	File_common_v1_authz_proto = out.File
+	file_common_v1_authz_proto_goTypes = nil
+	file_common_v1_authz_proto_depIdxs = nil
+}
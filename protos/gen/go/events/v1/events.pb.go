@@ -216,31 +216,33 @@ func (x *OrderStatusUpdatedEvent) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-type MarketStateChangedEvent struct {
+// Исполнение ордера: сделка по рынку, из которой spot строит тикеры и свечи.
+type OrderFilledEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	MarketId      string                 `protobuf:"bytes,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	Enabled       bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	MarketId      string                 `protobuf:"bytes,3,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Price         *decimal.Decimal       `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity      int64                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	FilledAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=filled_at,json=filledAt,proto3" json:"filled_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MarketStateChangedEvent) Reset() {
-	*x = MarketStateChangedEvent{}
+func (x *OrderFilledEvent) Reset() {
+	*x = OrderFilledEvent{}
 	mi := &file_events_v1_events_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MarketStateChangedEvent) String() string {
+func (x *OrderFilledEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarketStateChangedEvent) ProtoMessage() {}
+func (*OrderFilledEvent) ProtoMessage() {}
 
-func (x *MarketStateChangedEvent) ProtoReflect() protoreflect.Message {
+func (x *OrderFilledEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_events_v1_events_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -252,46 +254,424 @@ func (x *MarketStateChangedEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarketStateChangedEvent.ProtoReflect.Descriptor instead.
-func (*MarketStateChangedEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use OrderFilledEvent.ProtoReflect.Descriptor instead.
+func (*OrderFilledEvent) Descriptor() ([]byte, []int) {
 	return file_events_v1_events_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *MarketStateChangedEvent) GetEventId() string {
+func (x *OrderFilledEvent) GetEventId() string {
 	if x != nil {
 		return x.EventId
 	}
 	return ""
 }
 
-func (x *MarketStateChangedEvent) GetMarketId() string {
+func (x *OrderFilledEvent) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *OrderFilledEvent) GetMarketId() string {
 	if x != nil {
 		return x.MarketId
 	}
 	return ""
 }
 
-func (x *MarketStateChangedEvent) GetEnabled() bool {
+func (x *OrderFilledEvent) GetPrice() *decimal.Decimal {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *OrderFilledEvent) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *OrderFilledEvent) GetFilledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FilledAt
+	}
+	return nil
+}
+
+// Полный снимок рынка после изменения. Поля 1–5 совпадают с прежним MarketStateChangedEvent,
+// поэтому сообщения обоих форматов читаются друг другом; у старых событий version = 0.
+type MarketUpdatedEvent struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	EventId    string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	MarketId   string                 `protobuf:"bytes,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Enabled    bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	DeletedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Version    int64                  `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	Name       string                 `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
+	BaseAsset  string                 `protobuf:"bytes,8,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
+	QuoteAsset string                 `protobuf:"bytes,9,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
+	// Имена изменившихся полей: "name", "base_asset", "quote_asset", "enabled", "deleted_at", "restricted".
+	// Пусто для создания рынка.
+	ChangedFields []string              `protobuf:"bytes,10,rep,name=changed_fields,json=changedFields,proto3" json:"changed_fields,omitempty"`
+	Previous      *MarketPreviousValues `protobuf:"bytes,11,opt,name=previous,proto3" json:"previous,omitempty"`
+	Restricted    bool                  `protobuf:"varint,12,opt,name=restricted,proto3" json:"restricted,omitempty"`
+	// Включённый рынок виден любой роли по политикам видимости spot на момент публикации события.
+	PublicToAllRoles bool `protobuf:"varint,13,opt,name=public_to_all_roles,json=publicToAllRoles,proto3" json:"public_to_all_roles,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MarketUpdatedEvent) Reset() {
+	*x = MarketUpdatedEvent{}
+	mi := &file_events_v1_events_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketUpdatedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketUpdatedEvent) ProtoMessage() {}
+
+func (x *MarketUpdatedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_events_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketUpdatedEvent.ProtoReflect.Descriptor instead.
+func (*MarketUpdatedEvent) Descriptor() ([]byte, []int) {
+	return file_events_v1_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MarketUpdatedEvent) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *MarketUpdatedEvent) GetMarketId() string {
+	if x != nil {
+		return x.MarketId
+	}
+	return ""
+}
+
+func (x *MarketUpdatedEvent) GetEnabled() bool {
 	if x != nil {
 		return x.Enabled
 	}
 	return false
 }
 
-func (x *MarketStateChangedEvent) GetDeletedAt() *timestamppb.Timestamp {
+func (x *MarketUpdatedEvent) GetDeletedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.DeletedAt
 	}
 	return nil
 }
 
-func (x *MarketStateChangedEvent) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *MarketUpdatedEvent) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.UpdatedAt
 	}
 	return nil
 }
 
+func (x *MarketUpdatedEvent) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *MarketUpdatedEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MarketUpdatedEvent) GetBaseAsset() string {
+	if x != nil {
+		return x.BaseAsset
+	}
+	return ""
+}
+
+func (x *MarketUpdatedEvent) GetQuoteAsset() string {
+	if x != nil {
+		return x.QuoteAsset
+	}
+	return ""
+}
+
+func (x *MarketUpdatedEvent) GetChangedFields() []string {
+	if x != nil {
+		return x.ChangedFields
+	}
+	return nil
+}
+
+func (x *MarketUpdatedEvent) GetPrevious() *MarketPreviousValues {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
+func (x *MarketUpdatedEvent) GetRestricted() bool {
+	if x != nil {
+		return x.Restricted
+	}
+	return false
+}
+
+func (x *MarketUpdatedEvent) GetPublicToAllRoles() bool {
+	if x != nil {
+		return x.PublicToAllRoles
+	}
+	return false
+}
+
+// Значения полей до изменения; заполнены только поля из changed_fields.
+type MarketPreviousValues struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Name       *string                `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	BaseAsset  *string                `protobuf:"bytes,2,opt,name=base_asset,json=baseAsset,proto3,oneof" json:"base_asset,omitempty"`
+	QuoteAsset *string                `protobuf:"bytes,3,opt,name=quote_asset,json=quoteAsset,proto3,oneof" json:"quote_asset,omitempty"`
+	Enabled    *bool                  `protobuf:"varint,4,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	// Пустой value — рынок до изменения не был удалён.
+	DeletedAt     *OptionalTimestamp `protobuf:"bytes,5,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	Restricted    *bool              `protobuf:"varint,6,opt,name=restricted,proto3,oneof" json:"restricted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarketPreviousValues) Reset() {
+	*x = MarketPreviousValues{}
+	mi := &file_events_v1_events_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketPreviousValues) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketPreviousValues) ProtoMessage() {}
+
+func (x *MarketPreviousValues) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_events_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketPreviousValues.ProtoReflect.Descriptor instead.
+func (*MarketPreviousValues) Descriptor() ([]byte, []int) {
+	return file_events_v1_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MarketPreviousValues) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *MarketPreviousValues) GetBaseAsset() string {
+	if x != nil && x.BaseAsset != nil {
+		return *x.BaseAsset
+	}
+	return ""
+}
+
+func (x *MarketPreviousValues) GetQuoteAsset() string {
+	if x != nil && x.QuoteAsset != nil {
+		return *x.QuoteAsset
+	}
+	return ""
+}
+
+func (x *MarketPreviousValues) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+func (x *MarketPreviousValues) GetDeletedAt() *OptionalTimestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *MarketPreviousValues) GetRestricted() bool {
+	if x != nil && x.Restricted != nil {
+		return *x.Restricted
+	}
+	return false
+}
+
+// Обёртка, отличающая отсутствие поля от поля без значения.
+type OptionalTimestamp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OptionalTimestamp) Reset() {
+	*x = OptionalTimestamp{}
+	mi := &file_events_v1_events_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OptionalTimestamp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptionalTimestamp) ProtoMessage() {}
+
+func (x *OptionalTimestamp) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_events_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptionalTimestamp.ProtoReflect.Descriptor instead.
+func (*OptionalTimestamp) Descriptor() ([]byte, []int) {
+	return file_events_v1_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *OptionalTimestamp) GetValue() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// Событие безопасности аутентификации, публикуется в auth.security.
+// event_type: "refresh_token_reuse" — повторно предъявлен ротированный refresh token, сессия завершена.
+// ip и device — клиента, предъявившего токен.
+type SecurityEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType     string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SessionId     string                 `protobuf:"bytes,4,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Ip            string                 `protobuf:"bytes,5,opt,name=ip,proto3" json:"ip,omitempty"`
+	Device        string                 `protobuf:"bytes,6,opt,name=device,proto3" json:"device,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecurityEvent) Reset() {
+	*x = SecurityEvent{}
+	mi := &file_events_v1_events_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecurityEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecurityEvent) ProtoMessage() {}
+
+func (x *SecurityEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_events_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecurityEvent.ProtoReflect.Descriptor instead.
+func (*SecurityEvent) Descriptor() ([]byte, []int) {
+	return file_events_v1_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SecurityEvent) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *SecurityEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *SecurityEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SecurityEvent) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SecurityEvent) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *SecurityEvent) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *SecurityEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
 var File_events_v1_events_proto protoreflect.FileDescriptor
 
 const file_events_v1_events_proto_rawDesc = "" +
@@ -317,15 +697,66 @@ const file_events_v1_events_proto_rawDesc = "" +
 	"\x06reason\x18\x04 \x01(\tR\x06reason\x12%\n" +
 	"\x0ecorrelation_id\x18\x05 \x01(\tR\rcorrelationId\x129\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xe1\x01\n" +
-	"\x17MarketStateChangedEvent\x12\x19\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xe6\x01\n" +
+	"\x10OrderFilledEvent\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x1b\n" +
+	"\tmarket_id\x18\x03 \x01(\tR\bmarketId\x12*\n" +
+	"\x05price\x18\x04 \x01(\v2\x14.google.type.DecimalR\x05price\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x03R\bquantity\x127\n" +
+	"\tfilled_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bfilledAt\"\xfd\x03\n" +
+	"\x12MarketUpdatedEvent\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1b\n" +
 	"\tmarket_id\x18\x02 \x01(\tR\bmarketId\x12\x18\n" +
 	"\aenabled\x18\x03 \x01(\bR\aenabled\x129\n" +
 	"\n" +
 	"deleted_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtBJZHgithub.com/nastyazhadan/spot-order-grpc/protos/gen/go/events/v1;eventsv1b\x06proto3"
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x18\n" +
+	"\aversion\x18\x06 \x01(\x03R\aversion\x12\x12\n" +
+	"\x04name\x18\a \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"base_asset\x18\b \x01(\tR\tbaseAsset\x12\x1f\n" +
+	"\vquote_asset\x18\t \x01(\tR\n" +
+	"quoteAsset\x12%\n" +
+	"\x0echanged_fields\x18\n" +
+	" \x03(\tR\rchangedFields\x12;\n" +
+	"\bprevious\x18\v \x01(\v2\x1f.events.v1.MarketPreviousValuesR\bprevious\x12\x1e\n" +
+	"\n" +
+	"restricted\x18\f \x01(\bR\n" +
+	"restricted\x12-\n" +
+	"\x13public_to_all_roles\x18\r \x01(\bR\x10publicToAllRoles\"\xbd\x02\n" +
+	"\x14MarketPreviousValues\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tH\x00R\x04name\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"base_asset\x18\x02 \x01(\tH\x01R\tbaseAsset\x88\x01\x01\x12$\n" +
+	"\vquote_asset\x18\x03 \x01(\tH\x02R\n" +
+	"quoteAsset\x88\x01\x01\x12\x1d\n" +
+	"\aenabled\x18\x04 \x01(\bH\x03R\aenabled\x88\x01\x01\x12;\n" +
+	"\n" +
+	"deleted_at\x18\x05 \x01(\v2\x1c.events.v1.OptionalTimestampR\tdeletedAt\x12#\n" +
+	"\n" +
+	"restricted\x18\x06 \x01(\bH\x04R\n" +
+	"restricted\x88\x01\x01B\a\n" +
+	"\x05_nameB\r\n" +
+	"\v_base_assetB\x0e\n" +
+	"\f_quote_assetB\n" +
+	"\n" +
+	"\b_enabledB\r\n" +
+	"\v_restricted\"E\n" +
+	"\x11OptionalTimestamp\x120\n" +
+	"\x05value\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05value\"\xe6\x01\n" +
+	"\rSecurityEvent\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\tR\teventType\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x04 \x01(\tR\tsessionId\x12\x0e\n" +
+	"\x02ip\x18\x05 \x01(\tR\x02ip\x12\x16\n" +
+	"\x06device\x18\x06 \x01(\tR\x06device\x12;\n" +
+	"\voccurred_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAtBJZHgithub.com/nastyazhadan/spot-order-grpc/protos/gen/go/events/v1;eventsv1b\x06proto3"
 
 var (
 	file_events_v1_events_proto_rawDescOnce sync.Once
@@ -339,30 +770,40 @@ func file_events_v1_events_proto_rawDescGZIP() []byte {
 	return file_events_v1_events_proto_rawDescData
 }
 
-var file_events_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_events_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_events_v1_events_proto_goTypes = []any{
 	(*OrderCreatedEvent)(nil),       // 0: events.v1.OrderCreatedEvent
 	(*OrderStatusUpdatedEvent)(nil), // 1: events.v1.OrderStatusUpdatedEvent
-	(*MarketStateChangedEvent)(nil), // 2: events.v1.MarketStateChangedEvent
-	(v1.OrderType)(0),               // 3: common.v1.OrderType
-	(*decimal.Decimal)(nil),         // 4: google.type.Decimal
-	(v1.OrderStatus)(0),             // 5: common.v1.OrderStatus
-	(*timestamppb.Timestamp)(nil),   // 6: google.protobuf.Timestamp
+	(*OrderFilledEvent)(nil),        // 2: events.v1.OrderFilledEvent
+	(*MarketUpdatedEvent)(nil),      // 3: events.v1.MarketUpdatedEvent
+	(*MarketPreviousValues)(nil),    // 4: events.v1.MarketPreviousValues
+	(*OptionalTimestamp)(nil),       // 5: events.v1.OptionalTimestamp
+	(*SecurityEvent)(nil),           // 6: events.v1.SecurityEvent
+	(v1.OrderType)(0),               // 7: common.v1.OrderType
+	(*decimal.Decimal)(nil),         // 8: google.type.Decimal
+	(v1.OrderStatus)(0),             // 9: common.v1.OrderStatus
+	(*timestamppb.Timestamp)(nil),   // 10: google.protobuf.Timestamp
 }
 var file_events_v1_events_proto_depIdxs = []int32{
-	3, // 0: events.v1.OrderCreatedEvent.order_type:type_name -> common.v1.OrderType
-	4, // 1: events.v1.OrderCreatedEvent.price:type_name -> google.type.Decimal
-	5, // 2: events.v1.OrderCreatedEvent.status:type_name -> common.v1.OrderStatus
-	6, // 3: events.v1.OrderCreatedEvent.created_at:type_name -> google.protobuf.Timestamp
-	5, // 4: events.v1.OrderStatusUpdatedEvent.new_status:type_name -> common.v1.OrderStatus
-	6, // 5: events.v1.OrderStatusUpdatedEvent.updated_at:type_name -> google.protobuf.Timestamp
-	6, // 6: events.v1.MarketStateChangedEvent.deleted_at:type_name -> google.protobuf.Timestamp
-	6, // 7: events.v1.MarketStateChangedEvent.updated_at:type_name -> google.protobuf.Timestamp
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	7,  // 0: events.v1.OrderCreatedEvent.order_type:type_name -> common.v1.OrderType
+	8,  // 1: events.v1.OrderCreatedEvent.price:type_name -> google.type.Decimal
+	9,  // 2: events.v1.OrderCreatedEvent.status:type_name -> common.v1.OrderStatus
+	10, // 3: events.v1.OrderCreatedEvent.created_at:type_name -> google.protobuf.Timestamp
+	9,  // 4: events.v1.OrderStatusUpdatedEvent.new_status:type_name -> common.v1.OrderStatus
+	10, // 5: events.v1.OrderStatusUpdatedEvent.updated_at:type_name -> google.protobuf.Timestamp
+	8,  // 6: events.v1.OrderFilledEvent.price:type_name -> google.type.Decimal
+	10, // 7: events.v1.OrderFilledEvent.filled_at:type_name -> google.protobuf.Timestamp
+	10, // 8: events.v1.MarketUpdatedEvent.deleted_at:type_name -> google.protobuf.Timestamp
+	10, // 9: events.v1.MarketUpdatedEvent.updated_at:type_name -> google.protobuf.Timestamp
+	4,  // 10: events.v1.MarketUpdatedEvent.previous:type_name -> events.v1.MarketPreviousValues
+	5,  // 11: events.v1.MarketPreviousValues.deleted_at:type_name -> events.v1.OptionalTimestamp
+	10, // 12: events.v1.OptionalTimestamp.value:type_name -> google.protobuf.Timestamp
+	10, // 13: events.v1.SecurityEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_events_v1_events_proto_init() }
@@ -370,13 +811,14 @@ func file_events_v1_events_proto_init() {
 	if File_events_v1_events_proto != nil {
 		return
 	}
+	file_events_v1_events_proto_msgTypes[4].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_v1_events_proto_rawDesc), len(file_events_v1_events_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -113,11 +113,15 @@ func (x *GetOrderStatusResponse) GetStatus() v1.OrderStatus {
 }
 
 type CreateOrderRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MarketId      string                 `protobuf:"bytes,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`                              // UUID of the market to create
-	OrderType     v1.OrderType           `protobuf:"varint,3,opt,name=order_type,json=orderType,proto3,enum=common.v1.OrderType" json:"order_type,omitempty"` // Type of the order to create
-	Price         *decimal.Decimal       `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`                                                    // Price of the order
-	Quantity      int64                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`                                             // Quantity of the order
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Market:
+	//
+	//	*CreateOrderRequest_MarketId
+	//	*CreateOrderRequest_MarketSymbol
+	Market        isCreateOrderRequest_Market `protobuf_oneof:"market"`
+	OrderType     v1.OrderType                `protobuf:"varint,3,opt,name=order_type,json=orderType,proto3,enum=common.v1.OrderType" json:"order_type,omitempty"` // Type of the order to create
+	Price         *decimal.Decimal            `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`                                                    // Price of the order
+	Quantity      int64                       `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`                                             // Quantity of the order
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -152,9 +156,27 @@ func (*CreateOrderRequest) Descriptor() ([]byte, []int) {
 	return file_order_v1_order_proto_rawDescGZIP(), []int{2}
 }
 
+func (x *CreateOrderRequest) GetMarket() isCreateOrderRequest_Market {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
 func (x *CreateOrderRequest) GetMarketId() string {
 	if x != nil {
-		return x.MarketId
+		if x, ok := x.Market.(*CreateOrderRequest_MarketId); ok {
+			return x.MarketId
+		}
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetMarketSymbol() string {
+	if x != nil {
+		if x, ok := x.Market.(*CreateOrderRequest_MarketSymbol); ok {
+			return x.MarketSymbol
+		}
 	}
 	return ""
 }
@@ -180,6 +202,22 @@ func (x *CreateOrderRequest) GetQuantity() int64 {
 	return 0
 }
 
+type isCreateOrderRequest_Market interface {
+	isCreateOrderRequest_Market()
+}
+
+type CreateOrderRequest_MarketId struct {
+	MarketId string `protobuf:"bytes,2,opt,name=market_id,json=marketId,proto3,oneof"` // UUID of the market to create
+}
+
+type CreateOrderRequest_MarketSymbol struct {
+	MarketSymbol string `protobuf:"bytes,6,opt,name=market_symbol,json=marketSymbol,proto3,oneof"` // Market name, e.g. BTC-USDT
+}
+
+func (*CreateOrderRequest_MarketId) isCreateOrderRequest_Market() {}
+
+func (*CreateOrderRequest_MarketSymbol) isCreateOrderRequest_Market() {}
+
 type CreateOrderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`            // UUID of the created order
@@ -236,25 +274,27 @@ var File_order_v1_order_proto protoreflect.FileDescriptor
 
 const file_order_v1_order_proto_rawDesc = "" +
 	"\n" +
-	"\x14order/v1/order.proto\x12\border.v1\x1a\x19google/type/decimal.proto\x1a\x1bbuf/validate/validate.proto\x1a\x16common/v1/common.proto\"K\n" +
+	"\x14order/v1/order.proto\x12\border.v1\x1a\x19google/type/decimal.proto\x1a\x1bbuf/validate/validate.proto\x1a\x15common/v1/authz.proto\x1a\x16common/v1/common.proto\"K\n" +
 	"\x15GetOrderStatusRequest\x12#\n" +
 	"\border_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\aorderIdJ\x04\b\x02\x10\x03R\auser_id\"H\n" +
 	"\x16GetOrderStatusResponse\x12.\n" +
-	"\x06status\x18\x01 \x01(\x0e2\x16.common.v1.OrderStatusR\x06status\"\xaf\x02\n" +
-	"\x12CreateOrderRequest\x12%\n" +
-	"\tmarket_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bmarketId\x12?\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x16.common.v1.OrderStatusR\x06status\"\xf4\x02\n" +
+	"\x12CreateOrderRequest\x12'\n" +
+	"\tmarket_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\bmarketId\x120\n" +
+	"\rmarket_symbol\x18\x06 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18@H\x00R\fmarketSymbol\x12?\n" +
 	"\n" +
 	"order_type\x18\x03 \x01(\x0e2\x14.common.v1.OrderTypeB\n" +
 	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\torderType\x12}\n" +
 	"\x05price\x18\x04 \x01(\v2\x14.google.type.DecimalBQ\xbaHN\xba\x01H\n" +
 	"!create_order.price.value.required\x12\x11price is required\x1a\x10this.value != ''\xc8\x01\x01R\x05price\x12#\n" +
-	"\bquantity\x18\x05 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\bquantityJ\x04\b\x01\x10\x02R\auser_id\"`\n" +
+	"\bquantity\x18\x05 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\bquantityB\x0f\n" +
+	"\x06market\x12\x05\xbaH\x02\b\x01J\x04\b\x01\x10\x02R\auser_id\"`\n" +
 	"\x13CreateOrderResponse\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\tR\aorderId\x12.\n" +
-	"\x06status\x18\x02 \x01(\x0e2\x16.common.v1.OrderStatusR\x06status2\xaf\x01\n" +
-	"\fOrderService\x12S\n" +
-	"\x0eGetOrderStatus\x12\x1f.order.v1.GetOrderStatusRequest\x1a .order.v1.GetOrderStatusResponse\x12J\n" +
-	"\vCreateOrder\x12\x1c.order.v1.CreateOrderRequest\x1a\x1d.order.v1.CreateOrderResponseBHZFgithub.com/nastyazhadan/spot-order-grpc/protos/gen/go/order/v1;orderv1b\x06proto3"
+	"\x06status\x18\x02 \x01(\x0e2\x16.common.v1.OrderStatusR\x06status2\xd2\x01\n" +
+	"\fOrderService\x12d\n" +
+	"\x0eGetOrderStatus\x12\x1f.order.v1.GetOrderStatusRequest\x1a .order.v1.GetOrderStatusResponse\"\x0f\x8a\xb5\x18\vorders:read\x12\\\n" +
+	"\vCreateOrder\x12\x1c.order.v1.CreateOrderRequest\x1a\x1d.order.v1.CreateOrderResponse\"\x10\x8a\xb5\x18\forders:writeBHZFgithub.com/nastyazhadan/spot-order-grpc/protos/gen/go/order/v1;orderv1b\x06proto3"
 
 var (
 	file_order_v1_order_proto_rawDescOnce sync.Once
@@ -299,6 +339,10 @@ func file_order_v1_order_proto_init() {
 	if File_order_v1_order_proto != nil {
 		return
 	}
+	file_order_v1_order_proto_msgTypes[2].OneofWrappers = []any{
+		(*CreateOrderRequest_MarketId)(nil),
+		(*CreateOrderRequest_MarketSymbol)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
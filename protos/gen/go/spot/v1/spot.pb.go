@@ -8,6 +8,8 @@ package spotv1
 
 import (
 	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "github.com/nastyazhadan/spot-order-grpc/protos/gen/go/common/v1"
+	decimal "google.golang.org/genproto/googleapis/type/decimal"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -23,15 +25,337 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type MarketStatus int32
+
+const (
+	MarketStatus_MARKET_STATUS_UNSPECIFIED MarketStatus = 0
+	MarketStatus_MARKET_STATUS_ENABLED     MarketStatus = 1
+	MarketStatus_MARKET_STATUS_DISABLED    MarketStatus = 2
+	MarketStatus_MARKET_STATUS_DELETED     MarketStatus = 3
+)
+
+// Enum value maps for MarketStatus.
+var (
+	MarketStatus_name = map[int32]string{
+		0: "MARKET_STATUS_UNSPECIFIED",
+		1: "MARKET_STATUS_ENABLED",
+		2: "MARKET_STATUS_DISABLED",
+		3: "MARKET_STATUS_DELETED",
+	}
+	MarketStatus_value = map[string]int32{
+		"MARKET_STATUS_UNSPECIFIED": 0,
+		"MARKET_STATUS_ENABLED":     1,
+		"MARKET_STATUS_DISABLED":    2,
+		"MARKET_STATUS_DELETED":     3,
+	}
+)
+
+func (x MarketStatus) Enum() *MarketStatus {
+	p := new(MarketStatus)
+	*p = x
+	return p
+}
+
+func (x MarketStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MarketStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_spot_v1_spot_proto_enumTypes[0].Descriptor()
+}
+
+func (MarketStatus) Type() protoreflect.EnumType {
+	return &file_spot_v1_spot_proto_enumTypes[0]
+}
+
+func (x MarketStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MarketStatus.Descriptor instead.
+func (MarketStatus) EnumDescriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{0}
+}
+
+type MarketLookupStatus int32
+
+const (
+	MarketLookupStatus_MARKET_LOOKUP_STATUS_UNSPECIFIED MarketLookupStatus = 0
+	MarketLookupStatus_MARKET_LOOKUP_STATUS_FOUND       MarketLookupStatus = 1
+	// Рынок не существует или скрыт для роли вызывающего.
+	MarketLookupStatus_MARKET_LOOKUP_STATUS_NOT_FOUND MarketLookupStatus = 2
+	// Рынок виден роли, но выключен (аналог FAILED_PRECONDITION в GetMarketByID).
+	MarketLookupStatus_MARKET_LOOKUP_STATUS_DISABLED MarketLookupStatus = 3
+)
+
+// Enum value maps for MarketLookupStatus.
+var (
+	MarketLookupStatus_name = map[int32]string{
+		0: "MARKET_LOOKUP_STATUS_UNSPECIFIED",
+		1: "MARKET_LOOKUP_STATUS_FOUND",
+		2: "MARKET_LOOKUP_STATUS_NOT_FOUND",
+		3: "MARKET_LOOKUP_STATUS_DISABLED",
+	}
+	MarketLookupStatus_value = map[string]int32{
+		"MARKET_LOOKUP_STATUS_UNSPECIFIED": 0,
+		"MARKET_LOOKUP_STATUS_FOUND":       1,
+		"MARKET_LOOKUP_STATUS_NOT_FOUND":   2,
+		"MARKET_LOOKUP_STATUS_DISABLED":    3,
+	}
+)
+
+func (x MarketLookupStatus) Enum() *MarketLookupStatus {
+	p := new(MarketLookupStatus)
+	*p = x
+	return p
+}
+
+func (x MarketLookupStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MarketLookupStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_spot_v1_spot_proto_enumTypes[1].Descriptor()
+}
+
+func (MarketLookupStatus) Type() protoreflect.EnumType {
+	return &file_spot_v1_spot_proto_enumTypes[1]
+}
+
+func (x MarketLookupStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MarketLookupStatus.Descriptor instead.
+func (MarketLookupStatus) EnumDescriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{1}
+}
+
+type MarketChangeType int32
+
+const (
+	MarketChangeType_MARKET_CHANGE_TYPE_UNSPECIFIED MarketChangeType = 0
+	MarketChangeType_MARKET_CHANGE_TYPE_UPSERTED    MarketChangeType = 1
+	// Рынок больше не виден вызывающей роли (выключен или удалён).
+	MarketChangeType_MARKET_CHANGE_TYPE_REMOVED MarketChangeType = 2
+)
+
+// Enum value maps for MarketChangeType.
+var (
+	MarketChangeType_name = map[int32]string{
+		0: "MARKET_CHANGE_TYPE_UNSPECIFIED",
+		1: "MARKET_CHANGE_TYPE_UPSERTED",
+		2: "MARKET_CHANGE_TYPE_REMOVED",
+	}
+	MarketChangeType_value = map[string]int32{
+		"MARKET_CHANGE_TYPE_UNSPECIFIED": 0,
+		"MARKET_CHANGE_TYPE_UPSERTED":    1,
+		"MARKET_CHANGE_TYPE_REMOVED":     2,
+	}
+)
+
+func (x MarketChangeType) Enum() *MarketChangeType {
+	p := new(MarketChangeType)
+	*p = x
+	return p
+}
+
+func (x MarketChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MarketChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_spot_v1_spot_proto_enumTypes[2].Descriptor()
+}
+
+func (MarketChangeType) Type() protoreflect.EnumType {
+	return &file_spot_v1_spot_proto_enumTypes[2]
+}
+
+func (x MarketChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MarketChangeType.Descriptor instead.
+func (MarketChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{2}
+}
+
+type MarketHistoryOperation int32
+
+const (
+	MarketHistoryOperation_MARKET_HISTORY_OPERATION_UNSPECIFIED MarketHistoryOperation = 0
+	MarketHistoryOperation_MARKET_HISTORY_OPERATION_INSERT      MarketHistoryOperation = 1
+	MarketHistoryOperation_MARKET_HISTORY_OPERATION_UPDATE      MarketHistoryOperation = 2
+	MarketHistoryOperation_MARKET_HISTORY_OPERATION_DELETE      MarketHistoryOperation = 3
+)
+
+// Enum value maps for MarketHistoryOperation.
+var (
+	MarketHistoryOperation_name = map[int32]string{
+		0: "MARKET_HISTORY_OPERATION_UNSPECIFIED",
+		1: "MARKET_HISTORY_OPERATION_INSERT",
+		2: "MARKET_HISTORY_OPERATION_UPDATE",
+		3: "MARKET_HISTORY_OPERATION_DELETE",
+	}
+	MarketHistoryOperation_value = map[string]int32{
+		"MARKET_HISTORY_OPERATION_UNSPECIFIED": 0,
+		"MARKET_HISTORY_OPERATION_INSERT":      1,
+		"MARKET_HISTORY_OPERATION_UPDATE":      2,
+		"MARKET_HISTORY_OPERATION_DELETE":      3,
+	}
+)
+
+func (x MarketHistoryOperation) Enum() *MarketHistoryOperation {
+	p := new(MarketHistoryOperation)
+	*p = x
+	return p
+}
+
+func (x MarketHistoryOperation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MarketHistoryOperation) Descriptor() protoreflect.EnumDescriptor {
+	return file_spot_v1_spot_proto_enumTypes[3].Descriptor()
+}
+
+func (MarketHistoryOperation) Type() protoreflect.EnumType {
+	return &file_spot_v1_spot_proto_enumTypes[3]
+}
+
+func (x MarketHistoryOperation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MarketHistoryOperation.Descriptor instead.
+func (MarketHistoryOperation) EnumDescriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{3}
+}
+
+type MarketAccessSubjectType int32
+
+const (
+	MarketAccessSubjectType_MARKET_ACCESS_SUBJECT_TYPE_UNSPECIFIED MarketAccessSubjectType = 0
+	MarketAccessSubjectType_MARKET_ACCESS_SUBJECT_TYPE_USER        MarketAccessSubjectType = 1
+	// Группа пользователей — роль из JWT.
+	MarketAccessSubjectType_MARKET_ACCESS_SUBJECT_TYPE_ROLE MarketAccessSubjectType = 2
+)
+
+// Enum value maps for MarketAccessSubjectType.
+var (
+	MarketAccessSubjectType_name = map[int32]string{
+		0: "MARKET_ACCESS_SUBJECT_TYPE_UNSPECIFIED",
+		1: "MARKET_ACCESS_SUBJECT_TYPE_USER",
+		2: "MARKET_ACCESS_SUBJECT_TYPE_ROLE",
+	}
+	MarketAccessSubjectType_value = map[string]int32{
+		"MARKET_ACCESS_SUBJECT_TYPE_UNSPECIFIED": 0,
+		"MARKET_ACCESS_SUBJECT_TYPE_USER":        1,
+		"MARKET_ACCESS_SUBJECT_TYPE_ROLE":        2,
+	}
+)
+
+func (x MarketAccessSubjectType) Enum() *MarketAccessSubjectType {
+	p := new(MarketAccessSubjectType)
+	*p = x
+	return p
+}
+
+func (x MarketAccessSubjectType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MarketAccessSubjectType) Descriptor() protoreflect.EnumDescriptor {
+	return file_spot_v1_spot_proto_enumTypes[4].Descriptor()
+}
+
+func (MarketAccessSubjectType) Type() protoreflect.EnumType {
+	return &file_spot_v1_spot_proto_enumTypes[4]
+}
+
+func (x MarketAccessSubjectType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MarketAccessSubjectType.Descriptor instead.
+func (MarketAccessSubjectType) EnumDescriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{4}
+}
+
+type CandleInterval int32
+
+const (
+	CandleInterval_CANDLE_INTERVAL_UNSPECIFIED CandleInterval = 0
+	CandleInterval_CANDLE_INTERVAL_1M          CandleInterval = 1
+	CandleInterval_CANDLE_INTERVAL_5M          CandleInterval = 2
+	CandleInterval_CANDLE_INTERVAL_1H          CandleInterval = 3
+	CandleInterval_CANDLE_INTERVAL_1D          CandleInterval = 4
+)
+
+// Enum value maps for CandleInterval.
+var (
+	CandleInterval_name = map[int32]string{
+		0: "CANDLE_INTERVAL_UNSPECIFIED",
+		1: "CANDLE_INTERVAL_1M",
+		2: "CANDLE_INTERVAL_5M",
+		3: "CANDLE_INTERVAL_1H",
+		4: "CANDLE_INTERVAL_1D",
+	}
+	CandleInterval_value = map[string]int32{
+		"CANDLE_INTERVAL_UNSPECIFIED": 0,
+		"CANDLE_INTERVAL_1M":          1,
+		"CANDLE_INTERVAL_5M":          2,
+		"CANDLE_INTERVAL_1H":          3,
+		"CANDLE_INTERVAL_1D":          4,
+	}
+)
+
+func (x CandleInterval) Enum() *CandleInterval {
+	p := new(CandleInterval)
+	*p = x
+	return p
+}
+
+func (x CandleInterval) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CandleInterval) Descriptor() protoreflect.EnumDescriptor {
+	return file_spot_v1_spot_proto_enumTypes[5].Descriptor()
+}
+
+func (CandleInterval) Type() protoreflect.EnumType {
+	return &file_spot_v1_spot_proto_enumTypes[5]
+}
+
+func (x CandleInterval) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CandleInterval.Descriptor instead.
+func (CandleInterval) EnumDescriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{5}
+}
+
 type Market struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Enabled       bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name       string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Enabled    bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	DeletedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	BaseAsset  string                 `protobuf:"bytes,6,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
+	QuoteAsset string                 `protobuf:"bytes,7,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
+	// Растёт на 1 при каждом изменении строки рынка; задаёт порядок состояний одного рынка.
+	Version int64 `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	// Рынок доступен только пользователям и ролям из списка доступа (MarketAccessService).
+	Restricted bool `protobuf:"varint,9,opt,name=restricted,proto3" json:"restricted,omitempty"`
+	// Включённый рынок виден любой роли по политикам видимости spot (статусы и списки активов).
+	// Вычисляется при выдаче рынка, поэтому меняется вместе с конфигурацией политик без новой версии.
+	PublicToAllRoles bool `protobuf:"varint,10,opt,name=public_to_all_roles,json=publicToAllRoles,proto3" json:"public_to_all_roles,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Market) Reset() {
@@ -99,17 +423,127 @@ func (x *Market) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-type ViewMarketsRequest struct {
+func (x *Market) GetBaseAsset() string {
+	if x != nil {
+		return x.BaseAsset
+	}
+	return ""
+}
+
+func (x *Market) GetQuoteAsset() string {
+	if x != nil {
+		return x.QuoteAsset
+	}
+	return ""
+}
+
+func (x *Market) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Market) GetRestricted() bool {
+	if x != nil {
+		return x.Restricted
+	}
+	return false
+}
+
+func (x *Market) GetPublicToAllRoles() bool {
+	if x != nil {
+		return x.PublicToAllRoles
+	}
+	return false
+}
+
+type MarketFilter struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         uint64                 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        uint64                 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	NamePrefix    string                 `protobuf:"bytes,1,opt,name=name_prefix,json=namePrefix,proto3" json:"name_prefix,omitempty"`
+	BaseAsset     string                 `protobuf:"bytes,2,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
+	QuoteAsset    string                 `protobuf:"bytes,3,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
+	Status        MarketStatus           `protobuf:"varint,4,opt,name=status,proto3,enum=spot.v1.MarketStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarketFilter) Reset() {
+	*x = MarketFilter{}
+	mi := &file_spot_v1_spot_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketFilter) ProtoMessage() {}
+
+func (x *MarketFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_spot_v1_spot_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketFilter.ProtoReflect.Descriptor instead.
+func (*MarketFilter) Descriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MarketFilter) GetNamePrefix() string {
+	if x != nil {
+		return x.NamePrefix
+	}
+	return ""
+}
+
+func (x *MarketFilter) GetBaseAsset() string {
+	if x != nil {
+		return x.BaseAsset
+	}
+	return ""
+}
+
+func (x *MarketFilter) GetQuoteAsset() string {
+	if x != nil {
+		return x.QuoteAsset
+	}
+	return ""
+}
+
+func (x *MarketFilter) GetStatus() MarketStatus {
+	if x != nil {
+		return x.Status
+	}
+	return MarketStatus_MARKET_STATUS_UNSPECIFIED
+}
+
+type ViewMarketsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Limit uint64                 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Устарело, используйте page_token; будет удалено в следующем релизе.
+	// Учитывается, только если page_token пуст.
+	//
+	// Deprecated: Marked as deprecated in spot/v1/spot.proto.
+	Offset uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Непрозрачный токен из next_page_token предыдущего ответа; пустой — первая страница.
+	PageToken     string        `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Filter        *MarketFilter `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ViewMarketsRequest) Reset() {
 	*x = ViewMarketsRequest{}
-	mi := &file_spot_v1_spot_proto_msgTypes[1]
+	mi := &file_spot_v1_spot_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -121,7 +555,7 @@ func (x *ViewMarketsRequest) String() string {
 func (*ViewMarketsRequest) ProtoMessage() {}
 
 func (x *ViewMarketsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spot_v1_spot_proto_msgTypes[1]
+	mi := &file_spot_v1_spot_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -134,7 +568,7 @@ func (x *ViewMarketsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ViewMarketsRequest.ProtoReflect.Descriptor instead.
 func (*ViewMarketsRequest) Descriptor() ([]byte, []int) {
-	return file_spot_v1_spot_proto_rawDescGZIP(), []int{1}
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *ViewMarketsRequest) GetLimit() uint64 {
@@ -144,6 +578,7 @@ func (x *ViewMarketsRequest) GetLimit() uint64 {
 	return 0
 }
 
+// Deprecated: Marked as deprecated in spot/v1/spot.proto.
 func (x *ViewMarketsRequest) GetOffset() uint64 {
 	if x != nil {
 		return x.Offset
@@ -151,18 +586,36 @@ func (x *ViewMarketsRequest) GetOffset() uint64 {
 	return 0
 }
 
+func (x *ViewMarketsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ViewMarketsRequest) GetFilter() *MarketFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
 type ViewMarketsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Markets       []*Market              `protobuf:"bytes,1,rep,name=markets,proto3" json:"markets,omitempty"` // Market object
-	NextOffset    uint64                 `protobuf:"varint,2,opt,name=next_offset,json=nextOffset,proto3" json:"next_offset,omitempty"`
-	HasMore       bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Markets []*Market              `protobuf:"bytes,1,rep,name=markets,proto3" json:"markets,omitempty"` // Market object
+	// Устарело, используйте next_page_token. Заполняется, только если запрос пришёл без page_token.
+	//
+	// Deprecated: Marked as deprecated in spot/v1/spot.proto.
+	NextOffset    uint64 `protobuf:"varint,2,opt,name=next_offset,json=nextOffset,proto3" json:"next_offset,omitempty"`
+	HasMore       bool   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	NextPageToken string `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ViewMarketsResponse) Reset() {
 	*x = ViewMarketsResponse{}
-	mi := &file_spot_v1_spot_proto_msgTypes[2]
+	mi := &file_spot_v1_spot_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -174,7 +627,7 @@ func (x *ViewMarketsResponse) String() string {
 func (*ViewMarketsResponse) ProtoMessage() {}
 
 func (x *ViewMarketsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spot_v1_spot_proto_msgTypes[2]
+	mi := &file_spot_v1_spot_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -187,7 +640,7 @@ func (x *ViewMarketsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ViewMarketsResponse.ProtoReflect.Descriptor instead.
 func (*ViewMarketsResponse) Descriptor() ([]byte, []int) {
-	return file_spot_v1_spot_proto_rawDescGZIP(), []int{2}
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ViewMarketsResponse) GetMarkets() []*Market {
@@ -197,6 +650,7 @@ func (x *ViewMarketsResponse) GetMarkets() []*Market {
 	return nil
 }
 
+// Deprecated: Marked as deprecated in spot/v1/spot.proto.
 func (x *ViewMarketsResponse) GetNextOffset() uint64 {
 	if x != nil {
 		return x.NextOffset
@@ -211,6 +665,13 @@ func (x *ViewMarketsResponse) GetHasMore() bool {
 	return false
 }
 
+func (x *ViewMarketsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type GetMarketByIDRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	MarketId      string                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
@@ -220,7 +681,7 @@ type GetMarketByIDRequest struct {
 
 func (x *GetMarketByIDRequest) Reset() {
 	*x = GetMarketByIDRequest{}
-	mi := &file_spot_v1_spot_proto_msgTypes[3]
+	mi := &file_spot_v1_spot_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -232,7 +693,7 @@ func (x *GetMarketByIDRequest) String() string {
 func (*GetMarketByIDRequest) ProtoMessage() {}
 
 func (x *GetMarketByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spot_v1_spot_proto_msgTypes[3]
+	mi := &file_spot_v1_spot_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -245,7 +706,7 @@ func (x *GetMarketByIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMarketByIDRequest.ProtoReflect.Descriptor instead.
 func (*GetMarketByIDRequest) Descriptor() ([]byte, []int) {
-	return file_spot_v1_spot_proto_rawDescGZIP(), []int{3}
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetMarketByIDRequest) GetMarketId() string {
@@ -264,7 +725,7 @@ type GetMarketByIDResponse struct {
 
 func (x *GetMarketByIDResponse) Reset() {
 	*x = GetMarketByIDResponse{}
-	mi := &file_spot_v1_spot_proto_msgTypes[4]
+	mi := &file_spot_v1_spot_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -276,7 +737,7 @@ func (x *GetMarketByIDResponse) String() string {
 func (*GetMarketByIDResponse) ProtoMessage() {}
 
 func (x *GetMarketByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spot_v1_spot_proto_msgTypes[4]
+	mi := &file_spot_v1_spot_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -289,7 +750,7 @@ func (x *GetMarketByIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMarketByIDResponse.ProtoReflect.Descriptor instead.
 func (*GetMarketByIDResponse) Descriptor() ([]byte, []int) {
-	return file_spot_v1_spot_proto_rawDescGZIP(), []int{4}
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetMarketByIDResponse) GetMarket() *Market {
@@ -299,34 +760,2638 @@ func (x *GetMarketByIDResponse) GetMarket() *Market {
 	return nil
 }
 
-var File_spot_v1_spot_proto protoreflect.FileDescriptor
+type GetMarketBySymbolRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Имя рынка, например BTC-USDT. Ищется только среди неудалённых рынков.
+	Symbol        string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_spot_v1_spot_proto_rawDesc = "" +
-	"\n" +
-	"\x12spot/v1/spot.proto\x12\aspot.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\"\xcf\x01\n" +
-	"\x06Market\x12\x18\n" +
-	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x1b\n" +
-	"\x04name\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\x12\x18\n" +
-	"\aenabled\x18\x03 \x01(\bR\aenabled\x129\n" +
+func (x *GetMarketBySymbolRequest) Reset() {
+	*x = GetMarketBySymbolRequest{}
+	mi := &file_spot_v1_spot_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMarketBySymbolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarketBySymbolRequest) ProtoMessage() {}
+
+func (x *GetMarketBySymbolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spot_v1_spot_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarketBySymbolRequest.ProtoReflect.Descriptor instead.
+func (*GetMarketBySymbolRequest) Descriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetMarketBySymbolRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+type GetMarketBySymbolResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Market        *Market                `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMarketBySymbolResponse) Reset() {
+	*x = GetMarketBySymbolResponse{}
+	mi := &file_spot_v1_spot_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMarketBySymbolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarketBySymbolResponse) ProtoMessage() {}
+
+func (x *GetMarketBySymbolResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spot_v1_spot_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarketBySymbolResponse.ProtoReflect.Descriptor instead.
+func (*GetMarketBySymbolResponse) Descriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetMarketBySymbolResponse) GetMarket() *Market {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
+type GetMarketsByIDsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MarketIds     []string               `protobuf:"bytes,1,rep,name=market_ids,json=marketIds,proto3" json:"market_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMarketsByIDsRequest) Reset() {
+	*x = GetMarketsByIDsRequest{}
+	mi := &file_spot_v1_spot_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMarketsByIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarketsByIDsRequest) ProtoMessage() {}
+
+func (x *GetMarketsByIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spot_v1_spot_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarketsByIDsRequest.ProtoReflect.Descriptor instead.
+func (*GetMarketsByIDsRequest) Descriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetMarketsByIDsRequest) GetMarketIds() []string {
+	if x != nil {
+		return x.MarketIds
+	}
+	return nil
+}
+
+type MarketLookupResult struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	MarketId string                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Status   MarketLookupStatus     `protobuf:"varint,2,opt,name=status,proto3,enum=spot.v1.MarketLookupStatus" json:"status,omitempty"`
+	// Заполнено только для MARKET_LOOKUP_STATUS_FOUND.
+	Market        *Market `protobuf:"bytes,3,opt,name=market,proto3" json:"market,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarketLookupResult) Reset() {
+	*x = MarketLookupResult{}
+	mi := &file_spot_v1_spot_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketLookupResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketLookupResult) ProtoMessage() {}
+
+func (x *MarketLookupResult) ProtoReflect() protoreflect.Message {
+	mi := &file_spot_v1_spot_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketLookupResult.ProtoReflect.Descriptor instead.
+func (*MarketLookupResult) Descriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MarketLookupResult) GetMarketId() string {
+	if x != nil {
+		return x.MarketId
+	}
+	return ""
+}
+
+func (x *MarketLookupResult) GetStatus() MarketLookupStatus {
+	if x != nil {
+		return x.Status
+	}
+	return MarketLookupStatus_MARKET_LOOKUP_STATUS_UNSPECIFIED
+}
+
+func (x *MarketLookupResult) GetMarket() *Market {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
+type GetMarketsByIDsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Результаты в порядке market_ids запроса.
+	Results       []*MarketLookupResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMarketsByIDsResponse) Reset() {
+	*x = GetMarketsByIDsResponse{}
+	mi := &file_spot_v1_spot_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMarketsByIDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarketsByIDsResponse) ProtoMessage() {}
+
+func (x *GetMarketsByIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spot_v1_spot_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarketsByIDsResponse.ProtoReflect.Descriptor instead.
+func (*GetMarketsByIDsResponse) Descriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetMarketsByIDsResponse) GetResults() []*MarketLookupResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// Позиция в потоке изменений рынков: seq журнала market_change_log последнего отправленного изменения.
+// Порядок seq совпадает с порядком коммитов, поэтому resume не пропускает изменений,
+// закоммиченных позже с более ранним updated_at.
+type MarketCursor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Seq           int64                  `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarketCursor) Reset() {
+	*x = MarketCursor{}
+	mi := &file_spot_v1_spot_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketCursor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketCursor) ProtoMessage() {}
+
+func (x *MarketCursor) ProtoReflect() protoreflect.Message {
+	mi := &file_spot_v1_spot_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketCursor.ProtoReflect.Descriptor instead.
+func (*MarketCursor) Descriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MarketCursor) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+type WatchMarketsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Если задан, snapshot не отправляется и поток продолжается с изменений после курсора.
+	// Курсор старше окна хранения журнала отклоняется с FAILED_PRECONDITION:
+	// клиент подписывается заново без resume_from и получает snapshot.
+	ResumeFrom    *MarketCursor `protobuf:"bytes,1,opt,name=resume_from,json=resumeFrom,proto3" json:"resume_from,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchMarketsRequest) Reset() {
+	*x = WatchMarketsRequest{}
+	mi := &file_spot_v1_spot_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchMarketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchMarketsRequest) ProtoMessage() {}
+
+func (x *WatchMarketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spot_v1_spot_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchMarketsRequest.ProtoReflect.Descriptor instead.
+func (*WatchMarketsRequest) Descriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *WatchMarketsRequest) GetResumeFrom() *MarketCursor {
+	if x != nil {
+		return x.ResumeFrom
+	}
+	return nil
+}
+
+type MarketChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          MarketChangeType       `protobuf:"varint,1,opt,name=type,proto3,enum=spot.v1.MarketChangeType" json:"type,omitempty"`
+	MarketId      string                 `protobuf:"bytes,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Market        *Market                `protobuf:"bytes,3,opt,name=market,proto3" json:"market,omitempty"` // заполняется только для UPSERTED
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarketChange) Reset() {
+	*x = MarketChange{}
+	mi := &file_spot_v1_spot_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketChange) ProtoMessage() {}
+
+func (x *MarketChange) ProtoReflect() protoreflect.Message {
+	mi := &file_spot_v1_spot_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketChange.ProtoReflect.Descriptor instead.
+func (*MarketChange) Descriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MarketChange) GetType() MarketChangeType {
+	if x != nil {
+		return x.Type
+	}
+	return MarketChangeType_MARKET_CHANGE_TYPE_UNSPECIFIED
+}
+
+func (x *MarketChange) GetMarketId() string {
+	if x != nil {
+		return x.MarketId
+	}
+	return ""
+}
+
+func (x *MarketChange) GetMarket() *Market {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
+type MarketSnapshot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Markets       []*Market              `protobuf:"bytes,1,rep,name=markets,proto3" json:"markets,omitempty"`
+	Last          bool                   `protobuf:"varint,2,opt,name=last,proto3" json:"last,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarketSnapshot) Reset() {
+	*x = MarketSnapshot{}
+	mi := &file_spot_v1_spot_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketSnapshot) ProtoMessage() {}
+
+func (x *MarketSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_spot_v1_spot_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketSnapshot.ProtoReflect.Descriptor instead.
+func (*MarketSnapshot) Descriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MarketSnapshot) GetMarkets() []*Market {
+	if x != nil {
+		return x.Markets
+	}
+	return nil
+}
+
+func (x *MarketSnapshot) GetLast() bool {
+	if x != nil {
+		return x.Last
+	}
+	return false
+}
+
+type MarketChanges struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*MarketChange        `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarketChanges) Reset() {
+	*x = MarketChanges{}
+	mi := &file_spot_v1_spot_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketChanges) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketChanges) ProtoMessage() {}
+
+func (x *MarketChanges) ProtoReflect() protoreflect.Message {
+	mi := &file_spot_v1_spot_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketChanges.ProtoReflect.Descriptor instead.
+func (*MarketChanges) Descriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MarketChanges) GetChanges() []*MarketChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type WatchMarketsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*WatchMarketsResponse_Snapshot
+	//	*WatchMarketsResponse_Changes
+	Payload       isWatchMarketsResponse_Payload `protobuf_oneof:"payload"`
+	Cursor        *MarketCursor                  `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchMarketsResponse) Reset() {
+	*x = WatchMarketsResponse{}
+	mi := &file_spot_v1_spot_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchMarketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchMarketsResponse) ProtoMessage() {}
+
+func (x *WatchMarketsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spot_v1_spot_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchMarketsResponse.ProtoReflect.Descriptor instead.
+func (*WatchMarketsResponse) Descriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *WatchMarketsResponse) GetPayload() isWatchMarketsResponse_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *WatchMarketsResponse) GetSnapshot() *MarketSnapshot {
+	if x != nil {
+		if x, ok := x.Payload.(*WatchMarketsResponse_Snapshot); ok {
+			return x.Snapshot
+		}
+	}
+	return nil
+}
+
+func (x *WatchMarketsResponse) GetChanges() *MarketChanges {
+	if x != nil {
+		if x, ok := x.Payload.(*WatchMarketsResponse_Changes); ok {
+			return x.Changes
+		}
+	}
+	return nil
+}
+
+func (x *WatchMarketsResponse) GetCursor() *MarketCursor {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+type isWatchMarketsResponse_Payload interface {
+	isWatchMarketsResponse_Payload()
+}
+
+type WatchMarketsResponse_Snapshot struct {
+	Snapshot *MarketSnapshot `protobuf:"bytes,1,opt,name=snapshot,proto3,oneof"`
+}
+
+type WatchMarketsResponse_Changes struct {
+	Changes *MarketChanges `protobuf:"bytes,2,opt,name=changes,proto3,oneof"`
+}
+
+func (*WatchMarketsResponse_Snapshot) isWatchMarketsResponse_Payload() {}
+
+func (*WatchMarketsResponse_Changes) isWatchMarketsResponse_Payload() {}
+
+type MarketHistoryEntry struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MarketId  string                 `protobuf:"bytes,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Operation MarketHistoryOperation `protobuf:"varint,3,opt,name=operation,proto3,enum=spot.v1.MarketHistoryOperation" json:"operation,omitempty"`
+	Before    *Market                `protobuf:"bytes,4,opt,name=before,proto3" json:"before,omitempty"` // не заполняется для INSERT
+	After     *Market                `protobuf:"bytes,5,opt,name=after,proto3" json:"after,omitempty"`   // не заполняется для DELETE
+	// user_id из JWT; пусто, если изменение сделано напрямую в БД или миграцией.
+	Actor         string                 `protobuf:"bytes,6,opt,name=actor,proto3" json:"actor,omitempty"`
+	ChangedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarketHistoryEntry) Reset() {
+	*x = MarketHistoryEntry{}
+	mi := &file_spot_v1_spot_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketHistoryEntry) ProtoMessage() {}
+
+func (x *MarketHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_spot_v1_spot_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketHistoryEntry.ProtoReflect.Descriptor instead.
+func (*MarketHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MarketHistoryEntry) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MarketHistoryEntry) GetMarketId() string {
+	if x != nil {
+		return x.MarketId
+	}
+	return ""
+}
+
+func (x *MarketHistoryEntry) GetOperation() MarketHistoryOperation {
+	if x != nil {
+		return x.Operation
+	}
+	return MarketHistoryOperation_MARKET_HISTORY_OPERATION_UNSPECIFIED
+}
+
+func (x *MarketHistoryEntry) GetBefore() *Market {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *MarketHistoryEntry) GetAfter() *Market {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *MarketHistoryEntry) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *MarketHistoryEntry) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+type GetMarketHistoryRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	MarketId string                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Limit    uint64                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Непрозрачный токен из next_page_token предыдущего ответа; пустой — самые новые записи.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMarketHistoryRequest) Reset() {
+	*x = GetMarketHistoryRequest{}
+	mi := &file_spot_v1_spot_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMarketHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarketHistoryRequest) ProtoMessage() {}
+
+func (x *GetMarketHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spot_v1_spot_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarketHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetMarketHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetMarketHistoryRequest) GetMarketId() string {
+	if x != nil {
+		return x.MarketId
+	}
+	return ""
+}
+
+func (x *GetMarketHistoryRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetMarketHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetMarketHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*MarketHistoryEntry  `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMarketHistoryResponse) Reset() {
+	*x = GetMarketHistoryResponse{}
+	mi := &file_spot_v1_spot_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMarketHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarketHistoryResponse) ProtoMessage() {}
+
+func (x *GetMarketHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spot_v1_spot_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarketHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetMarketHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetMarketHistoryResponse) GetEntries() []*MarketHistoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetMarketHistoryResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *GetMarketHistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type Asset struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Code  string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Количество знаков после запятой для сумм в этом активе.
+	Precision     uint32                 `protobuf:"varint,3,opt,name=precision,proto3" json:"precision,omitempty"`
+	Enabled       bool                   `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Asset) Reset() {
+	*x = Asset{}
+	mi := &file_spot_v1_spot_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Asset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Asset) ProtoMessage() {}
+
+func (x *Asset) ProtoReflect() protoreflect.Message {
+	mi := &file_spot_v1_spot_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Asset.ProtoReflect.Descriptor instead.
+func (*Asset) Descriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Asset) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Asset) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Asset) GetPrecision() uint32 {
+	if x != nil {
+		return x.Precision
+	}
+	return 0
+}
+
+func (x *Asset) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Asset) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListAssetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAssetsRequest) Reset() {
+	*x = ListAssetsRequest{}
+	mi := &file_spot_v1_spot_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAssetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAssetsRequest) ProtoMessage() {}
+
+func (x *ListAssetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spot_v1_spot_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAssetsRequest.ProtoReflect.Descriptor instead.
+func (*ListAssetsRequest) Descriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{21}
+}
+
+type ListAssetsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Для ROLE_USER возвращаются только включённые активы.
+	Assets        []*Asset `protobuf:"bytes,1,rep,name=assets,proto3" json:"assets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAssetsResponse) Reset() {
+	*x = ListAssetsResponse{}
+	mi := &file_spot_v1_spot_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAssetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAssetsResponse) ProtoMessage() {}
+
+func (x *ListAssetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spot_v1_spot_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAssetsResponse.ProtoReflect.Descriptor instead.
+func (*ListAssetsResponse) Descriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListAssetsResponse) GetAssets() []*Asset {
+	if x != nil {
+		return x.Assets
+	}
+	return nil
+}
+
+type CreateAssetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Precision     uint32                 `protobuf:"varint,3,opt,name=precision,proto3" json:"precision,omitempty"`
+	Enabled       bool                   `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAssetRequest) Reset() {
+	*x = CreateAssetRequest{}
+	mi := &file_spot_v1_spot_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAssetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAssetRequest) ProtoMessage() {}
+
+func (x *CreateAssetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spot_v1_spot_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAssetRequest.ProtoReflect.Descriptor instead.
+func (*CreateAssetRequest) Descriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateAssetRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateAssetRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAssetRequest) GetPrecision() uint32 {
+	if x != nil {
+		return x.Precision
+	}
+	return 0
+}
+
+func (x *CreateAssetRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type CreateAssetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Asset         *Asset                 `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAssetResponse) Reset() {
+	*x = CreateAssetResponse{}
+	mi := &file_spot_v1_spot_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAssetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAssetResponse) ProtoMessage() {}
+
+func (x *CreateAssetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spot_v1_spot_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAssetResponse.ProtoReflect.Descriptor instead.
+func (*CreateAssetResponse) Descriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateAssetResponse) GetAsset() *Asset {
+	if x != nil {
+		return x.Asset
+	}
+	return nil
+}
+
+type UpdateAssetRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Code      string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name      *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Precision *uint32                `protobuf:"varint,3,opt,name=precision,proto3,oneof" json:"precision,omitempty"`
+	// false выключает все рынки, где актив является base или quote.
+	Enabled       *bool `protobuf:"varint,4,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAssetRequest) Reset() {
+	*x = UpdateAssetRequest{}
+	mi := &file_spot_v1_spot_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAssetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAssetRequest) ProtoMessage() {}
+
+func (x *UpdateAssetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spot_v1_spot_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAssetRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAssetRequest) Descriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UpdateAssetRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *UpdateAssetRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateAssetRequest) GetPrecision() uint32 {
+	if x != nil && x.Precision != nil {
+		return *x.Precision
+	}
+	return 0
+}
+
+func (x *UpdateAssetRequest) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+type UpdateAssetResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Asset *Asset                 `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	// Сколько рынков было выключено вместе с активом.
+	DisabledMarkets uint32 `protobuf:"varint,2,opt,name=disabled_markets,json=disabledMarkets,proto3" json:"disabled_markets,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateAssetResponse) Reset() {
+	*x = UpdateAssetResponse{}
+	mi := &file_spot_v1_spot_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAssetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAssetResponse) ProtoMessage() {}
+
+func (x *UpdateAssetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spot_v1_spot_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAssetResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAssetResponse) Descriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UpdateAssetResponse) GetAsset() *Asset {
+	if x != nil {
+		return x.Asset
+	}
+	return nil
+}
+
+func (x *UpdateAssetResponse) GetDisabledMarkets() uint32 {
+	if x != nil {
+		return x.DisabledMarkets
+	}
+	return 0
+}
+
+type MarketAccessSubject struct {
+	state protoimpl.MessageState  `protogen:"open.v1"`
+	Type  MarketAccessSubjectType `protobuf:"varint,1,opt,name=type,proto3,enum=spot.v1.MarketAccessSubjectType" json:"type,omitempty"`
+	// user_id (UUID) для USER, имя роли (ROLE_USER, ROLE_VIEWER, ...) для ROLE.
+	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarketAccessSubject) Reset() {
+	*x = MarketAccessSubject{}
+	mi := &file_spot_v1_spot_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketAccessSubject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketAccessSubject) ProtoMessage() {}
+
+func (x *MarketAccessSubject) ProtoReflect() protoreflect.Message {
+	mi := &file_spot_v1_spot_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketAccessSubject.ProtoReflect.Descriptor instead.
+func (*MarketAccessSubject) Descriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *MarketAccessSubject) GetType() MarketAccessSubjectType {
+	if x != nil {
+		return x.Type
+	}
+	return MarketAccessSubjectType_MARKET_ACCESS_SUBJECT_TYPE_UNSPECIFIED
+}
+
+func (x *MarketAccessSubject) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type MarketAccessGrant struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	MarketId string                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Subject  *MarketAccessSubject   `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	// user_id администратора, выдавшего доступ.
+	GrantedBy     string                 `protobuf:"bytes,3,opt,name=granted_by,json=grantedBy,proto3" json:"granted_by,omitempty"`
+	GrantedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=granted_at,json=grantedAt,proto3" json:"granted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarketAccessGrant) Reset() {
+	*x = MarketAccessGrant{}
+	mi := &file_spot_v1_spot_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketAccessGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketAccessGrant) ProtoMessage() {}
+
+func (x *MarketAccessGrant) ProtoReflect() protoreflect.Message {
+	mi := &file_spot_v1_spot_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketAccessGrant.ProtoReflect.Descriptor instead.
+func (*MarketAccessGrant) Descriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *MarketAccessGrant) GetMarketId() string {
+	if x != nil {
+		return x.MarketId
+	}
+	return ""
+}
+
+func (x *MarketAccessGrant) GetSubject() *MarketAccessSubject {
+	if x != nil {
+		return x.Subject
+	}
+	return nil
+}
+
+func (x *MarketAccessGrant) GetGrantedBy() string {
+	if x != nil {
+		return x.GrantedBy
+	}
+	return ""
+}
+
+func (x *MarketAccessGrant) GetGrantedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GrantedAt
+	}
+	return nil
+}
+
+type SetMarketRestrictedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MarketId      string                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Restricted    bool                   `protobuf:"varint,2,opt,name=restricted,proto3" json:"restricted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMarketRestrictedRequest) Reset() {
+	*x = SetMarketRestrictedRequest{}
+	mi := &file_spot_v1_spot_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMarketRestrictedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMarketRestrictedRequest) ProtoMessage() {}
+
+func (x *SetMarketRestrictedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spot_v1_spot_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMarketRestrictedRequest.ProtoReflect.Descriptor instead.
+func (*SetMarketRestrictedRequest) Descriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SetMarketRestrictedRequest) GetMarketId() string {
+	if x != nil {
+		return x.MarketId
+	}
+	return ""
+}
+
+func (x *SetMarketRestrictedRequest) GetRestricted() bool {
+	if x != nil {
+		return x.Restricted
+	}
+	return false
+}
+
+type SetMarketRestrictedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Market        *Market                `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMarketRestrictedResponse) Reset() {
+	*x = SetMarketRestrictedResponse{}
+	mi := &file_spot_v1_spot_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMarketRestrictedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMarketRestrictedResponse) ProtoMessage() {}
+
+func (x *SetMarketRestrictedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spot_v1_spot_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMarketRestrictedResponse.ProtoReflect.Descriptor instead.
+func (*SetMarketRestrictedResponse) Descriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SetMarketRestrictedResponse) GetMarket() *Market {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
+type GrantMarketAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MarketId      string                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Subject       *MarketAccessSubject   `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantMarketAccessRequest) Reset() {
+	*x = GrantMarketAccessRequest{}
+	mi := &file_spot_v1_spot_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantMarketAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantMarketAccessRequest) ProtoMessage() {}
+
+func (x *GrantMarketAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spot_v1_spot_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantMarketAccessRequest.ProtoReflect.Descriptor instead.
+func (*GrantMarketAccessRequest) Descriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GrantMarketAccessRequest) GetMarketId() string {
+	if x != nil {
+		return x.MarketId
+	}
+	return ""
+}
+
+func (x *GrantMarketAccessRequest) GetSubject() *MarketAccessSubject {
+	if x != nil {
+		return x.Subject
+	}
+	return nil
+}
+
+type GrantMarketAccessResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Grant         *MarketAccessGrant     `protobuf:"bytes,1,opt,name=grant,proto3" json:"grant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantMarketAccessResponse) Reset() {
+	*x = GrantMarketAccessResponse{}
+	mi := &file_spot_v1_spot_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantMarketAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantMarketAccessResponse) ProtoMessage() {}
+
+func (x *GrantMarketAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spot_v1_spot_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantMarketAccessResponse.ProtoReflect.Descriptor instead.
+func (*GrantMarketAccessResponse) Descriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GrantMarketAccessResponse) GetGrant() *MarketAccessGrant {
+	if x != nil {
+		return x.Grant
+	}
+	return nil
+}
+
+type RevokeMarketAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MarketId      string                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Subject       *MarketAccessSubject   `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeMarketAccessRequest) Reset() {
+	*x = RevokeMarketAccessRequest{}
+	mi := &file_spot_v1_spot_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeMarketAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeMarketAccessRequest) ProtoMessage() {}
+
+func (x *RevokeMarketAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spot_v1_spot_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeMarketAccessRequest.ProtoReflect.Descriptor instead.
+func (*RevokeMarketAccessRequest) Descriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RevokeMarketAccessRequest) GetMarketId() string {
+	if x != nil {
+		return x.MarketId
+	}
+	return ""
+}
+
+func (x *RevokeMarketAccessRequest) GetSubject() *MarketAccessSubject {
+	if x != nil {
+		return x.Subject
+	}
+	return nil
+}
+
+type RevokeMarketAccessResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// false — такой записи не было.
+	Revoked       bool `protobuf:"varint,1,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeMarketAccessResponse) Reset() {
+	*x = RevokeMarketAccessResponse{}
+	mi := &file_spot_v1_spot_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeMarketAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeMarketAccessResponse) ProtoMessage() {}
+
+func (x *RevokeMarketAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spot_v1_spot_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeMarketAccessResponse.ProtoReflect.Descriptor instead.
+func (*RevokeMarketAccessResponse) Descriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RevokeMarketAccessResponse) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
+	}
+	return false
+}
+
+type ListMarketAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MarketId      string                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMarketAccessRequest) Reset() {
+	*x = ListMarketAccessRequest{}
+	mi := &file_spot_v1_spot_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMarketAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMarketAccessRequest) ProtoMessage() {}
+
+func (x *ListMarketAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spot_v1_spot_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMarketAccessRequest.ProtoReflect.Descriptor instead.
+func (*ListMarketAccessRequest) Descriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListMarketAccessRequest) GetMarketId() string {
+	if x != nil {
+		return x.MarketId
+	}
+	return ""
+}
+
+type ListMarketAccessResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Grants        []*MarketAccessGrant   `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMarketAccessResponse) Reset() {
+	*x = ListMarketAccessResponse{}
+	mi := &file_spot_v1_spot_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMarketAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMarketAccessResponse) ProtoMessage() {}
+
+func (x *ListMarketAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spot_v1_spot_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMarketAccessResponse.ProtoReflect.Descriptor instead.
+func (*ListMarketAccessResponse) Descriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListMarketAccessResponse) GetGrants() []*MarketAccessGrant {
+	if x != nil {
+		return x.Grants
+	}
+	return nil
+}
+
+// Сделки — исполнения ордеров из order.filled: цена и количество исполнения.
+// Если сделок в окне не было, trades_count = 0, а цены и объёмы не заданы.
+type Ticker struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	MarketId  string                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	LastPrice *decimal.Decimal       `protobuf:"bytes,2,opt,name=last_price,json=lastPrice,proto3" json:"last_price,omitempty"`
+	OpenPrice *decimal.Decimal       `protobuf:"bytes,3,opt,name=open_price,json=openPrice,proto3" json:"open_price,omitempty"`
+	HighPrice *decimal.Decimal       `protobuf:"bytes,4,opt,name=high_price,json=highPrice,proto3" json:"high_price,omitempty"`
+	LowPrice  *decimal.Decimal       `protobuf:"bytes,5,opt,name=low_price,json=lowPrice,proto3" json:"low_price,omitempty"`
+	// Объём в базовом активе.
+	Volume *decimal.Decimal `protobuf:"bytes,6,opt,name=volume,proto3" json:"volume,omitempty"`
+	// Объём в котируемом активе: сумма price * quantity.
+	QuoteVolume        *decimal.Decimal       `protobuf:"bytes,7,opt,name=quote_volume,json=quoteVolume,proto3" json:"quote_volume,omitempty"`
+	PriceChange        *decimal.Decimal       `protobuf:"bytes,8,opt,name=price_change,json=priceChange,proto3" json:"price_change,omitempty"`
+	PriceChangePercent *decimal.Decimal       `protobuf:"bytes,9,opt,name=price_change_percent,json=priceChangePercent,proto3" json:"price_change_percent,omitempty"`
+	TradesCount        int64                  `protobuf:"varint,10,opt,name=trades_count,json=tradesCount,proto3" json:"trades_count,omitempty"`
+	LastTradeAt        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_trade_at,json=lastTradeAt,proto3" json:"last_trade_at,omitempty"`
+	// Окно [window_start, window_end), сдвигается шагами по минуте.
+	WindowStart   *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	WindowEnd     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Ticker) Reset() {
+	*x = Ticker{}
+	mi := &file_spot_v1_spot_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ticker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ticker) ProtoMessage() {}
+
+func (x *Ticker) ProtoReflect() protoreflect.Message {
+	mi := &file_spot_v1_spot_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ticker.ProtoReflect.Descriptor instead.
+func (*Ticker) Descriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Ticker) GetMarketId() string {
+	if x != nil {
+		return x.MarketId
+	}
+	return ""
+}
+
+func (x *Ticker) GetLastPrice() *decimal.Decimal {
+	if x != nil {
+		return x.LastPrice
+	}
+	return nil
+}
+
+func (x *Ticker) GetOpenPrice() *decimal.Decimal {
+	if x != nil {
+		return x.OpenPrice
+	}
+	return nil
+}
+
+func (x *Ticker) GetHighPrice() *decimal.Decimal {
+	if x != nil {
+		return x.HighPrice
+	}
+	return nil
+}
+
+func (x *Ticker) GetLowPrice() *decimal.Decimal {
+	if x != nil {
+		return x.LowPrice
+	}
+	return nil
+}
+
+func (x *Ticker) GetVolume() *decimal.Decimal {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+func (x *Ticker) GetQuoteVolume() *decimal.Decimal {
+	if x != nil {
+		return x.QuoteVolume
+	}
+	return nil
+}
+
+func (x *Ticker) GetPriceChange() *decimal.Decimal {
+	if x != nil {
+		return x.PriceChange
+	}
+	return nil
+}
+
+func (x *Ticker) GetPriceChangePercent() *decimal.Decimal {
+	if x != nil {
+		return x.PriceChangePercent
+	}
+	return nil
+}
+
+func (x *Ticker) GetTradesCount() int64 {
+	if x != nil {
+		return x.TradesCount
+	}
+	return 0
+}
+
+func (x *Ticker) GetLastTradeAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastTradeAt
+	}
+	return nil
+}
+
+func (x *Ticker) GetWindowStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowStart
+	}
+	return nil
+}
+
+func (x *Ticker) GetWindowEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowEnd
+	}
+	return nil
+}
+
+type GetTickerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MarketId      string                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTickerRequest) Reset() {
+	*x = GetTickerRequest{}
+	mi := &file_spot_v1_spot_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTickerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTickerRequest) ProtoMessage() {}
+
+func (x *GetTickerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spot_v1_spot_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTickerRequest.ProtoReflect.Descriptor instead.
+func (*GetTickerRequest) Descriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetTickerRequest) GetMarketId() string {
+	if x != nil {
+		return x.MarketId
+	}
+	return ""
+}
+
+type GetTickerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ticker        *Ticker                `protobuf:"bytes,1,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTickerResponse) Reset() {
+	*x = GetTickerResponse{}
+	mi := &file_spot_v1_spot_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTickerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTickerResponse) ProtoMessage() {}
+
+func (x *GetTickerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spot_v1_spot_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTickerResponse.ProtoReflect.Descriptor instead.
+func (*GetTickerResponse) Descriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetTickerResponse) GetTicker() *Ticker {
+	if x != nil {
+		return x.Ticker
+	}
+	return nil
+}
+
+type ListTickersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTickersRequest) Reset() {
+	*x = ListTickersRequest{}
+	mi := &file_spot_v1_spot_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTickersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTickersRequest) ProtoMessage() {}
+
+func (x *ListTickersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spot_v1_spot_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTickersRequest.ProtoReflect.Descriptor instead.
+func (*ListTickersRequest) Descriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{40}
+}
+
+type ListTickersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Только рынки со сделками в окне, по имени рынка.
+	Tickers       []*Ticker `protobuf:"bytes,1,rep,name=tickers,proto3" json:"tickers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTickersResponse) Reset() {
+	*x = ListTickersResponse{}
+	mi := &file_spot_v1_spot_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTickersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTickersResponse) ProtoMessage() {}
+
+func (x *ListTickersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spot_v1_spot_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTickersResponse.ProtoReflect.Descriptor instead.
+func (*ListTickersResponse) Descriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListTickersResponse) GetTickers() []*Ticker {
+	if x != nil {
+		return x.Tickers
+	}
+	return nil
+}
+
+type WatchTickersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MarketIds     []string               `protobuf:"bytes,1,rep,name=market_ids,json=marketIds,proto3" json:"market_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchTickersRequest) Reset() {
+	*x = WatchTickersRequest{}
+	mi := &file_spot_v1_spot_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchTickersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchTickersRequest) ProtoMessage() {}
+
+func (x *WatchTickersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spot_v1_spot_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchTickersRequest.ProtoReflect.Descriptor instead.
+func (*WatchTickersRequest) Descriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *WatchTickersRequest) GetMarketIds() []string {
+	if x != nil {
+		return x.MarketIds
+	}
+	return nil
+}
+
+type WatchTickersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ticker        *Ticker                `protobuf:"bytes,1,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchTickersResponse) Reset() {
+	*x = WatchTickersResponse{}
+	mi := &file_spot_v1_spot_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchTickersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchTickersResponse) ProtoMessage() {}
+
+func (x *WatchTickersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spot_v1_spot_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchTickersResponse.ProtoReflect.Descriptor instead.
+func (*WatchTickersResponse) Descriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *WatchTickersResponse) GetTicker() *Ticker {
+	if x != nil {
+		return x.Ticker
+	}
+	return nil
+}
+
+// Свеча [open_time, close_time), границы выровнены по UTC. open и close — цены самой ранней
+// и самой поздней по времени сделки; опоздавшее событие правит и уже закрытую свечу.
+type Candle struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	MarketId  string                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Interval  CandleInterval         `protobuf:"varint,2,opt,name=interval,proto3,enum=spot.v1.CandleInterval" json:"interval,omitempty"`
+	OpenTime  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=open_time,json=openTime,proto3" json:"open_time,omitempty"`
+	CloseTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=close_time,json=closeTime,proto3" json:"close_time,omitempty"`
+	Open      *decimal.Decimal       `protobuf:"bytes,5,opt,name=open,proto3" json:"open,omitempty"`
+	High      *decimal.Decimal       `protobuf:"bytes,6,opt,name=high,proto3" json:"high,omitempty"`
+	Low       *decimal.Decimal       `protobuf:"bytes,7,opt,name=low,proto3" json:"low,omitempty"`
+	Close     *decimal.Decimal       `protobuf:"bytes,8,opt,name=close,proto3" json:"close,omitempty"`
+	// Объём в базовом активе.
+	Volume *decimal.Decimal `protobuf:"bytes,9,opt,name=volume,proto3" json:"volume,omitempty"`
+	// Объём в котируемом активе: сумма price * quantity.
+	QuoteVolume   *decimal.Decimal `protobuf:"bytes,10,opt,name=quote_volume,json=quoteVolume,proto3" json:"quote_volume,omitempty"`
+	TradesCount   int64            `protobuf:"varint,11,opt,name=trades_count,json=tradesCount,proto3" json:"trades_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Candle) Reset() {
+	*x = Candle{}
+	mi := &file_spot_v1_spot_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Candle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Candle) ProtoMessage() {}
+
+func (x *Candle) ProtoReflect() protoreflect.Message {
+	mi := &file_spot_v1_spot_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Candle.ProtoReflect.Descriptor instead.
+func (*Candle) Descriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *Candle) GetMarketId() string {
+	if x != nil {
+		return x.MarketId
+	}
+	return ""
+}
+
+func (x *Candle) GetInterval() CandleInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return CandleInterval_CANDLE_INTERVAL_UNSPECIFIED
+}
+
+func (x *Candle) GetOpenTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OpenTime
+	}
+	return nil
+}
+
+func (x *Candle) GetCloseTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CloseTime
+	}
+	return nil
+}
+
+func (x *Candle) GetOpen() *decimal.Decimal {
+	if x != nil {
+		return x.Open
+	}
+	return nil
+}
+
+func (x *Candle) GetHigh() *decimal.Decimal {
+	if x != nil {
+		return x.High
+	}
+	return nil
+}
+
+func (x *Candle) GetLow() *decimal.Decimal {
+	if x != nil {
+		return x.Low
+	}
+	return nil
+}
+
+func (x *Candle) GetClose() *decimal.Decimal {
+	if x != nil {
+		return x.Close
+	}
+	return nil
+}
+
+func (x *Candle) GetVolume() *decimal.Decimal {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+func (x *Candle) GetQuoteVolume() *decimal.Decimal {
+	if x != nil {
+		return x.QuoteVolume
+	}
+	return nil
+}
+
+func (x *Candle) GetTradesCount() int64 {
+	if x != nil {
+		return x.TradesCount
+	}
+	return 0
+}
+
+type GetCandlesRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	MarketId string                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Interval CandleInterval         `protobuf:"varint,2,opt,name=interval,proto3,enum=spot.v1.CandleInterval" json:"interval,omitempty"`
+	// Свечи с open_time в [from, to); from выравнивается вниз по границе интервала.
+	From  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Limit uint64                 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Непрозрачный токен из next_page_token предыдущего ответа; пустой — первая страница.
+	PageToken     string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCandlesRequest) Reset() {
+	*x = GetCandlesRequest{}
+	mi := &file_spot_v1_spot_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCandlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCandlesRequest) ProtoMessage() {}
+
+func (x *GetCandlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spot_v1_spot_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCandlesRequest.ProtoReflect.Descriptor instead.
+func (*GetCandlesRequest) Descriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetCandlesRequest) GetMarketId() string {
+	if x != nil {
+		return x.MarketId
+	}
+	return ""
+}
+
+func (x *GetCandlesRequest) GetInterval() CandleInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return CandleInterval_CANDLE_INTERVAL_UNSPECIFIED
+}
+
+func (x *GetCandlesRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetCandlesRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetCandlesRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetCandlesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetCandlesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// По возрастанию open_time; интервалы без сделок пропускаются.
+	Candles       []*Candle `protobuf:"bytes,1,rep,name=candles,proto3" json:"candles,omitempty"`
+	HasMore       bool      `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	NextPageToken string    `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCandlesResponse) Reset() {
+	*x = GetCandlesResponse{}
+	mi := &file_spot_v1_spot_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCandlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCandlesResponse) ProtoMessage() {}
+
+func (x *GetCandlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spot_v1_spot_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCandlesResponse.ProtoReflect.Descriptor instead.
+func (*GetCandlesResponse) Descriptor() ([]byte, []int) {
+	return file_spot_v1_spot_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetCandlesResponse) GetCandles() []*Candle {
+	if x != nil {
+		return x.Candles
+	}
+	return nil
+}
+
+func (x *GetCandlesResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *GetCandlesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+var File_spot_v1_spot_proto protoreflect.FileDescriptor
+
+const file_spot_v1_spot_proto_rawDesc = "" +
+	"\n" +
+	"\x12spot/v1/spot.proto\x12\aspot.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19google/type/decimal.proto\x1a\x1bbuf/validate/validate.proto\x1a\x15common/v1/authz.proto\"\xf8\x02\n" +
+	"\x06Market\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x1b\n" +
+	"\x04name\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\x12\x18\n" +
+	"\aenabled\x18\x03 \x01(\bR\aenabled\x129\n" +
 	"\n" +
 	"deleted_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"B\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"base_asset\x18\x06 \x01(\tR\tbaseAsset\x12\x1f\n" +
+	"\vquote_asset\x18\a \x01(\tR\n" +
+	"quoteAsset\x12\x18\n" +
+	"\aversion\x18\b \x01(\x03R\aversion\x12\x1e\n" +
+	"\n" +
+	"restricted\x18\t \x01(\bR\n" +
+	"restricted\x12-\n" +
+	"\x13public_to_all_roles\x18\n" +
+	" \x01(\bR\x10publicToAllRoles\"\xe9\x01\n" +
+	"\fMarketFilter\x12(\n" +
+	"\vname_prefix\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x18@R\n" +
+	"namePrefix\x129\n" +
+	"\n" +
+	"base_asset\x18\x02 \x01(\tB\x1a\xbaH\x17\xd8\x01\x01r\x122\x10^[A-Z0-9]{1,16}$R\tbaseAsset\x12;\n" +
+	"\vquote_asset\x18\x03 \x01(\tB\x1a\xbaH\x17\xd8\x01\x01r\x122\x10^[A-Z0-9]{1,16}$R\n" +
+	"quoteAsset\x127\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x15.spot.v1.MarketStatusB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06status\"\x9e\x01\n" +
 	"\x12ViewMarketsRequest\x12\x14\n" +
-	"\x05limit\x18\x01 \x01(\x04R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x02 \x01(\x04R\x06offset\"|\n" +
+	"\x05limit\x18\x01 \x01(\x04R\x05limit\x12\x1a\n" +
+	"\x06offset\x18\x02 \x01(\x04B\x02\x18\x01R\x06offset\x12'\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bR\tpageToken\x12-\n" +
+	"\x06filter\x18\x04 \x01(\v2\x15.spot.v1.MarketFilterR\x06filter\"\xa8\x01\n" +
 	"\x13ViewMarketsResponse\x12)\n" +
-	"\amarkets\x18\x01 \x03(\v2\x0f.spot.v1.MarketR\amarkets\x12\x1f\n" +
-	"\vnext_offset\x18\x02 \x01(\x04R\n" +
+	"\amarkets\x18\x01 \x03(\v2\x0f.spot.v1.MarketR\amarkets\x12#\n" +
+	"\vnext_offset\x18\x02 \x01(\x04B\x02\x18\x01R\n" +
 	"nextOffset\x12\x19\n" +
-	"\bhas_more\x18\x03 \x01(\bR\ahasMore\"=\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\tR\rnextPageToken\"=\n" +
 	"\x14GetMarketByIDRequest\x12%\n" +
 	"\tmarket_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bmarketId\"@\n" +
 	"\x15GetMarketByIDResponse\x12'\n" +
-	"\x06market\x18\x01 \x01(\v2\x0f.spot.v1.MarketR\x06market2\xb1\x01\n" +
-	"\x15SpotInstrumentService\x12H\n" +
-	"\vViewMarkets\x12\x1b.spot.v1.ViewMarketsRequest\x1a\x1c.spot.v1.ViewMarketsResponse\x12N\n" +
-	"\rGetMarketByID\x12\x1d.spot.v1.GetMarketByIDRequest\x1a\x1e.spot.v1.GetMarketByIDResponseBFZDgithub.com/nastyazhadan/spot-order-grpc/protos/gen/go/spot/v1;spotv1b\x06proto3"
+	"\x06market\x18\x01 \x01(\v2\x0f.spot.v1.MarketR\x06market\"=\n" +
+	"\x18GetMarketBySymbolRequest\x12!\n" +
+	"\x06symbol\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18@R\x06symbol\"D\n" +
+	"\x19GetMarketBySymbolResponse\x12'\n" +
+	"\x06market\x18\x01 \x01(\v2\x0f.spot.v1.MarketR\x06market\"M\n" +
+	"\x16GetMarketsByIDsRequest\x123\n" +
+	"\n" +
+	"market_ids\x18\x01 \x03(\tB\x14\xbaH\x11\x92\x01\x0e\b\x01\x10\xf4\x03\x18\x01\"\x05r\x03\xb0\x01\x01R\tmarketIds\"\x8f\x01\n" +
+	"\x12MarketLookupResult\x12\x1b\n" +
+	"\tmarket_id\x18\x01 \x01(\tR\bmarketId\x123\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1b.spot.v1.MarketLookupStatusR\x06status\x12'\n" +
+	"\x06market\x18\x03 \x01(\v2\x0f.spot.v1.MarketR\x06market\"P\n" +
+	"\x17GetMarketsByIDsResponse\x125\n" +
+	"\aresults\x18\x01 \x03(\v2\x1b.spot.v1.MarketLookupResultR\aresults\"L\n" +
+	"\fMarketCursor\x12\x19\n" +
+	"\x03seq\x18\x03 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x03seqJ\x04\b\x01\x10\x02J\x04\b\x02\x10\x03R\n" +
+	"updated_atR\tmarket_id\"M\n" +
+	"\x13WatchMarketsRequest\x126\n" +
+	"\vresume_from\x18\x01 \x01(\v2\x15.spot.v1.MarketCursorR\n" +
+	"resumeFrom\"\x83\x01\n" +
+	"\fMarketChange\x12-\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x19.spot.v1.MarketChangeTypeR\x04type\x12\x1b\n" +
+	"\tmarket_id\x18\x02 \x01(\tR\bmarketId\x12'\n" +
+	"\x06market\x18\x03 \x01(\v2\x0f.spot.v1.MarketR\x06market\"O\n" +
+	"\x0eMarketSnapshot\x12)\n" +
+	"\amarkets\x18\x01 \x03(\v2\x0f.spot.v1.MarketR\amarkets\x12\x12\n" +
+	"\x04last\x18\x02 \x01(\bR\x04last\"@\n" +
+	"\rMarketChanges\x12/\n" +
+	"\achanges\x18\x01 \x03(\v2\x15.spot.v1.MarketChangeR\achanges\"\xbb\x01\n" +
+	"\x14WatchMarketsResponse\x125\n" +
+	"\bsnapshot\x18\x01 \x01(\v2\x17.spot.v1.MarketSnapshotH\x00R\bsnapshot\x122\n" +
+	"\achanges\x18\x02 \x01(\v2\x16.spot.v1.MarketChangesH\x00R\achanges\x12-\n" +
+	"\x06cursor\x18\x03 \x01(\v2\x15.spot.v1.MarketCursorR\x06cursorB\t\n" +
+	"\apayload\"\xa1\x02\n" +
+	"\x12MarketHistoryEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\tmarket_id\x18\x02 \x01(\tR\bmarketId\x12=\n" +
+	"\toperation\x18\x03 \x01(\x0e2\x1f.spot.v1.MarketHistoryOperationR\toperation\x12'\n" +
+	"\x06before\x18\x04 \x01(\v2\x0f.spot.v1.MarketR\x06before\x12%\n" +
+	"\x05after\x18\x05 \x01(\v2\x0f.spot.v1.MarketR\x05after\x12\x14\n" +
+	"\x05actor\x18\x06 \x01(\tR\x05actor\x129\n" +
+	"\n" +
+	"changed_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\"\x7f\n" +
+	"\x17GetMarketHistoryRequest\x12%\n" +
+	"\tmarket_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bmarketId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x04R\x05limit\x12'\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bR\tpageToken\"\x94\x01\n" +
+	"\x18GetMarketHistoryResponse\x125\n" +
+	"\aentries\x18\x01 \x03(\v2\x1b.spot.v1.MarketHistoryEntryR\aentries\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\xa2\x01\n" +
+	"\x05Asset\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tprecision\x18\x03 \x01(\rR\tprecision\x12\x18\n" +
+	"\aenabled\x18\x04 \x01(\bR\aenabled\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x13\n" +
+	"\x11ListAssetsRequest\"<\n" +
+	"\x12ListAssetsResponse\x12&\n" +
+	"\x06assets\x18\x01 \x03(\v2\x0e.spot.v1.AssetR\x06assets\"\xa1\x01\n" +
+	"\x12CreateAssetRequest\x12+\n" +
+	"\x04code\x18\x01 \x01(\tB\x17\xbaH\x14r\x122\x10^[A-Z0-9]{1,16}$R\x04code\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18@R\x04name\x12%\n" +
+	"\tprecision\x18\x03 \x01(\rB\a\xbaH\x04*\x02\x18\x12R\tprecision\x12\x18\n" +
+	"\aenabled\x18\x04 \x01(\bR\aenabled\";\n" +
+	"\x13CreateAssetResponse\x12$\n" +
+	"\x05asset\x18\x01 \x01(\v2\x0e.spot.v1.AssetR\x05asset\"\xd3\x01\n" +
+	"\x12UpdateAssetRequest\x12+\n" +
+	"\x04code\x18\x01 \x01(\tB\x17\xbaH\x14r\x122\x10^[A-Z0-9]{1,16}$R\x04code\x12\"\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18@H\x00R\x04name\x88\x01\x01\x12*\n" +
+	"\tprecision\x18\x03 \x01(\rB\a\xbaH\x04*\x02\x18\x12H\x01R\tprecision\x88\x01\x01\x12\x1d\n" +
+	"\aenabled\x18\x04 \x01(\bH\x02R\aenabled\x88\x01\x01B\a\n" +
+	"\x05_nameB\f\n" +
+	"\n" +
+	"_precisionB\n" +
+	"\n" +
+	"\b_enabled\"f\n" +
+	"\x13UpdateAssetResponse\x12$\n" +
+	"\x05asset\x18\x01 \x01(\v2\x0e.spot.v1.AssetR\x05asset\x12)\n" +
+	"\x10disabled_markets\x18\x02 \x01(\rR\x0fdisabledMarkets\"r\n" +
+	"\x13MarketAccessSubject\x12@\n" +
+	"\x04type\x18\x01 \x01(\x0e2 .spot.v1.MarketAccessSubjectTypeB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x04type\x12\x19\n" +
+	"\x02id\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18@R\x02id\"\xc2\x01\n" +
+	"\x11MarketAccessGrant\x12\x1b\n" +
+	"\tmarket_id\x18\x01 \x01(\tR\bmarketId\x126\n" +
+	"\asubject\x18\x02 \x01(\v2\x1c.spot.v1.MarketAccessSubjectR\asubject\x12\x1d\n" +
+	"\n" +
+	"granted_by\x18\x03 \x01(\tR\tgrantedBy\x129\n" +
+	"\n" +
+	"granted_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tgrantedAt\"c\n" +
+	"\x1aSetMarketRestrictedRequest\x12%\n" +
+	"\tmarket_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bmarketId\x12\x1e\n" +
+	"\n" +
+	"restricted\x18\x02 \x01(\bR\n" +
+	"restricted\"F\n" +
+	"\x1bSetMarketRestrictedResponse\x12'\n" +
+	"\x06market\x18\x01 \x01(\v2\x0f.spot.v1.MarketR\x06market\"\x81\x01\n" +
+	"\x18GrantMarketAccessRequest\x12%\n" +
+	"\tmarket_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bmarketId\x12>\n" +
+	"\asubject\x18\x02 \x01(\v2\x1c.spot.v1.MarketAccessSubjectB\x06\xbaH\x03\xc8\x01\x01R\asubject\"M\n" +
+	"\x19GrantMarketAccessResponse\x120\n" +
+	"\x05grant\x18\x01 \x01(\v2\x1a.spot.v1.MarketAccessGrantR\x05grant\"\x82\x01\n" +
+	"\x19RevokeMarketAccessRequest\x12%\n" +
+	"\tmarket_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bmarketId\x12>\n" +
+	"\asubject\x18\x02 \x01(\v2\x1c.spot.v1.MarketAccessSubjectB\x06\xbaH\x03\xc8\x01\x01R\asubject\"6\n" +
+	"\x1aRevokeMarketAccessResponse\x12\x18\n" +
+	"\arevoked\x18\x01 \x01(\bR\arevoked\"@\n" +
+	"\x17ListMarketAccessRequest\x12%\n" +
+	"\tmarket_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bmarketId\"N\n" +
+	"\x18ListMarketAccessResponse\x122\n" +
+	"\x06grants\x18\x01 \x03(\v2\x1a.spot.v1.MarketAccessGrantR\x06grants\"\xbc\x05\n" +
+	"\x06Ticker\x12\x1b\n" +
+	"\tmarket_id\x18\x01 \x01(\tR\bmarketId\x123\n" +
+	"\n" +
+	"last_price\x18\x02 \x01(\v2\x14.google.type.DecimalR\tlastPrice\x123\n" +
+	"\n" +
+	"open_price\x18\x03 \x01(\v2\x14.google.type.DecimalR\topenPrice\x123\n" +
+	"\n" +
+	"high_price\x18\x04 \x01(\v2\x14.google.type.DecimalR\thighPrice\x121\n" +
+	"\tlow_price\x18\x05 \x01(\v2\x14.google.type.DecimalR\blowPrice\x12,\n" +
+	"\x06volume\x18\x06 \x01(\v2\x14.google.type.DecimalR\x06volume\x127\n" +
+	"\fquote_volume\x18\a \x01(\v2\x14.google.type.DecimalR\vquoteVolume\x127\n" +
+	"\fprice_change\x18\b \x01(\v2\x14.google.type.DecimalR\vpriceChange\x12F\n" +
+	"\x14price_change_percent\x18\t \x01(\v2\x14.google.type.DecimalR\x12priceChangePercent\x12!\n" +
+	"\ftrades_count\x18\n" +
+	" \x01(\x03R\vtradesCount\x12>\n" +
+	"\rlast_trade_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\vlastTradeAt\x12=\n" +
+	"\fwindow_start\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\vwindowStart\x129\n" +
+	"\n" +
+	"window_end\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\twindowEnd\"9\n" +
+	"\x10GetTickerRequest\x12%\n" +
+	"\tmarket_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bmarketId\"<\n" +
+	"\x11GetTickerResponse\x12'\n" +
+	"\x06ticker\x18\x01 \x01(\v2\x0f.spot.v1.TickerR\x06ticker\"\x14\n" +
+	"\x12ListTickersRequest\"@\n" +
+	"\x13ListTickersResponse\x12)\n" +
+	"\atickers\x18\x01 \x03(\v2\x0f.spot.v1.TickerR\atickers\"I\n" +
+	"\x13WatchTickersRequest\x122\n" +
+	"\n" +
+	"market_ids\x18\x01 \x03(\tB\x13\xbaH\x10\x92\x01\r\b\x01\x10d\x18\x01\"\x05r\x03\xb0\x01\x01R\tmarketIds\"?\n" +
+	"\x14WatchTickersResponse\x12'\n" +
+	"\x06ticker\x18\x01 \x01(\v2\x0f.spot.v1.TickerR\x06ticker\"\x80\x04\n" +
+	"\x06Candle\x12\x1b\n" +
+	"\tmarket_id\x18\x01 \x01(\tR\bmarketId\x123\n" +
+	"\binterval\x18\x02 \x01(\x0e2\x17.spot.v1.CandleIntervalR\binterval\x127\n" +
+	"\topen_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bopenTime\x129\n" +
+	"\n" +
+	"close_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcloseTime\x12(\n" +
+	"\x04open\x18\x05 \x01(\v2\x14.google.type.DecimalR\x04open\x12(\n" +
+	"\x04high\x18\x06 \x01(\v2\x14.google.type.DecimalR\x04high\x12&\n" +
+	"\x03low\x18\a \x01(\v2\x14.google.type.DecimalR\x03low\x12*\n" +
+	"\x05close\x18\b \x01(\v2\x14.google.type.DecimalR\x05close\x12,\n" +
+	"\x06volume\x18\t \x01(\v2\x14.google.type.DecimalR\x06volume\x127\n" +
+	"\fquote_volume\x18\n" +
+	" \x01(\v2\x14.google.type.DecimalR\vquoteVolume\x12!\n" +
+	"\ftrades_count\x18\v \x01(\x03R\vtradesCount\"\xa6\x02\n" +
+	"\x11GetCandlesRequest\x12%\n" +
+	"\tmarket_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bmarketId\x12?\n" +
+	"\binterval\x18\x02 \x01(\x0e2\x17.spot.v1.CandleIntervalB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\binterval\x126\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\x04from\x122\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\x02to\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x04R\x05limit\x12'\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bR\tpageToken\"\x82\x01\n" +
+	"\x12GetCandlesResponse\x12)\n" +
+	"\acandles\x18\x01 \x03(\v2\x0f.spot.v1.CandleR\acandles\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken*\x7f\n" +
+	"\fMarketStatus\x12\x1d\n" +
+	"\x19MARKET_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15MARKET_STATUS_ENABLED\x10\x01\x12\x1a\n" +
+	"\x16MARKET_STATUS_DISABLED\x10\x02\x12\x19\n" +
+	"\x15MARKET_STATUS_DELETED\x10\x03*\xa1\x01\n" +
+	"\x12MarketLookupStatus\x12$\n" +
+	" MARKET_LOOKUP_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aMARKET_LOOKUP_STATUS_FOUND\x10\x01\x12\"\n" +
+	"\x1eMARKET_LOOKUP_STATUS_NOT_FOUND\x10\x02\x12!\n" +
+	"\x1dMARKET_LOOKUP_STATUS_DISABLED\x10\x03*w\n" +
+	"\x10MarketChangeType\x12\"\n" +
+	"\x1eMARKET_CHANGE_TYPE_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bMARKET_CHANGE_TYPE_UPSERTED\x10\x01\x12\x1e\n" +
+	"\x1aMARKET_CHANGE_TYPE_REMOVED\x10\x02*\xb1\x01\n" +
+	"\x16MarketHistoryOperation\x12(\n" +
+	"$MARKET_HISTORY_OPERATION_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fMARKET_HISTORY_OPERATION_INSERT\x10\x01\x12#\n" +
+	"\x1fMARKET_HISTORY_OPERATION_UPDATE\x10\x02\x12#\n" +
+	"\x1fMARKET_HISTORY_OPERATION_DELETE\x10\x03*\x8f\x01\n" +
+	"\x17MarketAccessSubjectType\x12*\n" +
+	"&MARKET_ACCESS_SUBJECT_TYPE_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fMARKET_ACCESS_SUBJECT_TYPE_USER\x10\x01\x12#\n" +
+	"\x1fMARKET_ACCESS_SUBJECT_TYPE_ROLE\x10\x02*\x91\x01\n" +
+	"\x0eCandleInterval\x12\x1f\n" +
+	"\x1bCANDLE_INTERVAL_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12CANDLE_INTERVAL_1M\x10\x01\x12\x16\n" +
+	"\x12CANDLE_INTERVAL_5M\x10\x02\x12\x16\n" +
+	"\x12CANDLE_INTERVAL_1H\x10\x03\x12\x16\n" +
+	"\x12CANDLE_INTERVAL_1D\x10\x042\xf8\x04\n" +
+	"\x15SpotInstrumentService\x12Z\n" +
+	"\vViewMarkets\x12\x1b.spot.v1.ViewMarketsRequest\x1a\x1c.spot.v1.ViewMarketsResponse\"\x10\x8a\xb5\x18\fmarkets:read\x12`\n" +
+	"\rGetMarketByID\x12\x1d.spot.v1.GetMarketByIDRequest\x1a\x1e.spot.v1.GetMarketByIDResponse\"\x10\x8a\xb5\x18\fmarkets:read\x12f\n" +
+	"\x0fGetMarketsByIDs\x12\x1f.spot.v1.GetMarketsByIDsRequest\x1a .spot.v1.GetMarketsByIDsResponse\"\x10\x8a\xb5\x18\fmarkets:read\x12l\n" +
+	"\x11GetMarketBySymbol\x12!.spot.v1.GetMarketBySymbolRequest\x1a\".spot.v1.GetMarketBySymbolResponse\"\x10\x8a\xb5\x18\fmarkets:read\x12_\n" +
+	"\fWatchMarkets\x12\x1c.spot.v1.WatchMarketsRequest\x1a\x1d.spot.v1.WatchMarketsResponse\"\x10\x8a\xb5\x18\fmarkets:read0\x01\x12j\n" +
+	"\x10GetMarketHistory\x12 .spot.v1.GetMarketHistoryRequest\x1a!.spot.v1.GetMarketHistoryResponse\"\x11\x8a\xb5\x18\rmarkets:admin2\xa8\x02\n" +
+	"\x13AssetCatalogService\x12W\n" +
+	"\n" +
+	"ListAssets\x12\x1a.spot.v1.ListAssetsRequest\x1a\x1b.spot.v1.ListAssetsResponse\"\x10\x8a\xb5\x18\fmarkets:read\x12[\n" +
+	"\vCreateAsset\x12\x1b.spot.v1.CreateAssetRequest\x1a\x1c.spot.v1.CreateAssetResponse\"\x11\x8a\xb5\x18\rmarkets:admin\x12[\n" +
+	"\vUpdateAsset\x12\x1b.spot.v1.UpdateAssetRequest\x1a\x1c.spot.v1.UpdateAssetResponse\"\x11\x8a\xb5\x18\rmarkets:admin2\xd7\x03\n" +
+	"\x13MarketAccessService\x12s\n" +
+	"\x13SetMarketRestricted\x12#.spot.v1.SetMarketRestrictedRequest\x1a$.spot.v1.SetMarketRestrictedResponse\"\x11\x8a\xb5\x18\rmarkets:admin\x12m\n" +
+	"\x11GrantMarketAccess\x12!.spot.v1.GrantMarketAccessRequest\x1a\".spot.v1.GrantMarketAccessResponse\"\x11\x8a\xb5\x18\rmarkets:admin\x12p\n" +
+	"\x12RevokeMarketAccess\x12\".spot.v1.RevokeMarketAccessRequest\x1a#.spot.v1.RevokeMarketAccessResponse\"\x11\x8a\xb5\x18\rmarkets:admin\x12j\n" +
+	"\x10ListMarketAccess\x12 .spot.v1.ListMarketAccessRequest\x1a!.spot.v1.ListMarketAccessResponse\"\x11\x8a\xb5\x18\rmarkets:admin2\xa2\x02\n" +
+	"\rTickerService\x12T\n" +
+	"\tGetTicker\x12\x19.spot.v1.GetTickerRequest\x1a\x1a.spot.v1.GetTickerResponse\"\x10\x8a\xb5\x18\fmarkets:read\x12Z\n" +
+	"\vListTickers\x12\x1b.spot.v1.ListTickersRequest\x1a\x1c.spot.v1.ListTickersResponse\"\x10\x8a\xb5\x18\fmarkets:read\x12_\n" +
+	"\fWatchTickers\x12\x1c.spot.v1.WatchTickersRequest\x1a\x1d.spot.v1.WatchTickersResponse\"\x10\x8a\xb5\x18\fmarkets:read0\x012h\n" +
+	"\rCandleService\x12W\n" +
+	"\n" +
+	"GetCandles\x12\x1a.spot.v1.GetCandlesRequest\x1a\x1b.spot.v1.GetCandlesResponse\"\x10\x8a\xb5\x18\fmarkets:readBFZDgithub.com/nastyazhadan/spot-order-grpc/protos/gen/go/spot/v1;spotv1b\x06proto3"
 
 var (
 	file_spot_v1_spot_proto_rawDescOnce sync.Once
@@ -340,29 +3405,167 @@ func file_spot_v1_spot_proto_rawDescGZIP() []byte {
 	return file_spot_v1_spot_proto_rawDescData
 }
 
-var file_spot_v1_spot_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_spot_v1_spot_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_spot_v1_spot_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
 var file_spot_v1_spot_proto_goTypes = []any{
-	(*Market)(nil),                // 0: spot.v1.Market
-	(*ViewMarketsRequest)(nil),    // 1: spot.v1.ViewMarketsRequest
-	(*ViewMarketsResponse)(nil),   // 2: spot.v1.ViewMarketsResponse
-	(*GetMarketByIDRequest)(nil),  // 3: spot.v1.GetMarketByIDRequest
-	(*GetMarketByIDResponse)(nil), // 4: spot.v1.GetMarketByIDResponse
-	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+	(MarketStatus)(0),                   // 0: spot.v1.MarketStatus
+	(MarketLookupStatus)(0),             // 1: spot.v1.MarketLookupStatus
+	(MarketChangeType)(0),               // 2: spot.v1.MarketChangeType
+	(MarketHistoryOperation)(0),         // 3: spot.v1.MarketHistoryOperation
+	(MarketAccessSubjectType)(0),        // 4: spot.v1.MarketAccessSubjectType
+	(CandleInterval)(0),                 // 5: spot.v1.CandleInterval
+	(*Market)(nil),                      // 6: spot.v1.Market
+	(*MarketFilter)(nil),                // 7: spot.v1.MarketFilter
+	(*ViewMarketsRequest)(nil),          // 8: spot.v1.ViewMarketsRequest
+	(*ViewMarketsResponse)(nil),         // 9: spot.v1.ViewMarketsResponse
+	(*GetMarketByIDRequest)(nil),        // 10: spot.v1.GetMarketByIDRequest
+	(*GetMarketByIDResponse)(nil),       // 11: spot.v1.GetMarketByIDResponse
+	(*GetMarketBySymbolRequest)(nil),    // 12: spot.v1.GetMarketBySymbolRequest
+	(*GetMarketBySymbolResponse)(nil),   // 13: spot.v1.GetMarketBySymbolResponse
+	(*GetMarketsByIDsRequest)(nil),      // 14: spot.v1.GetMarketsByIDsRequest
+	(*MarketLookupResult)(nil),          // 15: spot.v1.MarketLookupResult
+	(*GetMarketsByIDsResponse)(nil),     // 16: spot.v1.GetMarketsByIDsResponse
+	(*MarketCursor)(nil),                // 17: spot.v1.MarketCursor
+	(*WatchMarketsRequest)(nil),         // 18: spot.v1.WatchMarketsRequest
+	(*MarketChange)(nil),                // 19: spot.v1.MarketChange
+	(*MarketSnapshot)(nil),              // 20: spot.v1.MarketSnapshot
+	(*MarketChanges)(nil),               // 21: spot.v1.MarketChanges
+	(*WatchMarketsResponse)(nil),        // 22: spot.v1.WatchMarketsResponse
+	(*MarketHistoryEntry)(nil),          // 23: spot.v1.MarketHistoryEntry
+	(*GetMarketHistoryRequest)(nil),     // 24: spot.v1.GetMarketHistoryRequest
+	(*GetMarketHistoryResponse)(nil),    // 25: spot.v1.GetMarketHistoryResponse
+	(*Asset)(nil),                       // 26: spot.v1.Asset
+	(*ListAssetsRequest)(nil),           // 27: spot.v1.ListAssetsRequest
+	(*ListAssetsResponse)(nil),          // 28: spot.v1.ListAssetsResponse
+	(*CreateAssetRequest)(nil),          // 29: spot.v1.CreateAssetRequest
+	(*CreateAssetResponse)(nil),         // 30: spot.v1.CreateAssetResponse
+	(*UpdateAssetRequest)(nil),          // 31: spot.v1.UpdateAssetRequest
+	(*UpdateAssetResponse)(nil),         // 32: spot.v1.UpdateAssetResponse
+	(*MarketAccessSubject)(nil),         // 33: spot.v1.MarketAccessSubject
+	(*MarketAccessGrant)(nil),           // 34: spot.v1.MarketAccessGrant
+	(*SetMarketRestrictedRequest)(nil),  // 35: spot.v1.SetMarketRestrictedRequest
+	(*SetMarketRestrictedResponse)(nil), // 36: spot.v1.SetMarketRestrictedResponse
+	(*GrantMarketAccessRequest)(nil),    // 37: spot.v1.GrantMarketAccessRequest
+	(*GrantMarketAccessResponse)(nil),   // 38: spot.v1.GrantMarketAccessResponse
+	(*RevokeMarketAccessRequest)(nil),   // 39: spot.v1.RevokeMarketAccessRequest
+	(*RevokeMarketAccessResponse)(nil),  // 40: spot.v1.RevokeMarketAccessResponse
+	(*ListMarketAccessRequest)(nil),     // 41: spot.v1.ListMarketAccessRequest
+	(*ListMarketAccessResponse)(nil),    // 42: spot.v1.ListMarketAccessResponse
+	(*Ticker)(nil),                      // 43: spot.v1.Ticker
+	(*GetTickerRequest)(nil),            // 44: spot.v1.GetTickerRequest
+	(*GetTickerResponse)(nil),           // 45: spot.v1.GetTickerResponse
+	(*ListTickersRequest)(nil),          // 46: spot.v1.ListTickersRequest
+	(*ListTickersResponse)(nil),         // 47: spot.v1.ListTickersResponse
+	(*WatchTickersRequest)(nil),         // 48: spot.v1.WatchTickersRequest
+	(*WatchTickersResponse)(nil),        // 49: spot.v1.WatchTickersResponse
+	(*Candle)(nil),                      // 50: spot.v1.Candle
+	(*GetCandlesRequest)(nil),           // 51: spot.v1.GetCandlesRequest
+	(*GetCandlesResponse)(nil),          // 52: spot.v1.GetCandlesResponse
+	(*timestamppb.Timestamp)(nil),       // 53: google.protobuf.Timestamp
+	(*decimal.Decimal)(nil),             // 54: google.type.Decimal
 }
 var file_spot_v1_spot_proto_depIdxs = []int32{
-	5, // 0: spot.v1.Market.deleted_at:type_name -> google.protobuf.Timestamp
-	5, // 1: spot.v1.Market.updated_at:type_name -> google.protobuf.Timestamp
-	0, // 2: spot.v1.ViewMarketsResponse.markets:type_name -> spot.v1.Market
-	0, // 3: spot.v1.GetMarketByIDResponse.market:type_name -> spot.v1.Market
-	1, // 4: spot.v1.SpotInstrumentService.ViewMarkets:input_type -> spot.v1.ViewMarketsRequest
-	3, // 5: spot.v1.SpotInstrumentService.GetMarketByID:input_type -> spot.v1.GetMarketByIDRequest
-	2, // 6: spot.v1.SpotInstrumentService.ViewMarkets:output_type -> spot.v1.ViewMarketsResponse
-	4, // 7: spot.v1.SpotInstrumentService.GetMarketByID:output_type -> spot.v1.GetMarketByIDResponse
-	6, // [6:8] is the sub-list for method output_type
-	4, // [4:6] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	53, // 0: spot.v1.Market.deleted_at:type_name -> google.protobuf.Timestamp
+	53, // 1: spot.v1.Market.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: spot.v1.MarketFilter.status:type_name -> spot.v1.MarketStatus
+	7,  // 3: spot.v1.ViewMarketsRequest.filter:type_name -> spot.v1.MarketFilter
+	6,  // 4: spot.v1.ViewMarketsResponse.markets:type_name -> spot.v1.Market
+	6,  // 5: spot.v1.GetMarketByIDResponse.market:type_name -> spot.v1.Market
+	6,  // 6: spot.v1.GetMarketBySymbolResponse.market:type_name -> spot.v1.Market
+	1,  // 7: spot.v1.MarketLookupResult.status:type_name -> spot.v1.MarketLookupStatus
+	6,  // 8: spot.v1.MarketLookupResult.market:type_name -> spot.v1.Market
+	15, // 9: spot.v1.GetMarketsByIDsResponse.results:type_name -> spot.v1.MarketLookupResult
+	17, // 10: spot.v1.WatchMarketsRequest.resume_from:type_name -> spot.v1.MarketCursor
+	2,  // 11: spot.v1.MarketChange.type:type_name -> spot.v1.MarketChangeType
+	6,  // 12: spot.v1.MarketChange.market:type_name -> spot.v1.Market
+	6,  // 13: spot.v1.MarketSnapshot.markets:type_name -> spot.v1.Market
+	19, // 14: spot.v1.MarketChanges.changes:type_name -> spot.v1.MarketChange
+	20, // 15: spot.v1.WatchMarketsResponse.snapshot:type_name -> spot.v1.MarketSnapshot
+	21, // 16: spot.v1.WatchMarketsResponse.changes:type_name -> spot.v1.MarketChanges
+	17, // 17: spot.v1.WatchMarketsResponse.cursor:type_name -> spot.v1.MarketCursor
+	3,  // 18: spot.v1.MarketHistoryEntry.operation:type_name -> spot.v1.MarketHistoryOperation
+	6,  // 19: spot.v1.MarketHistoryEntry.before:type_name -> spot.v1.Market
+	6,  // 20: spot.v1.MarketHistoryEntry.after:type_name -> spot.v1.Market
+	53, // 21: spot.v1.MarketHistoryEntry.changed_at:type_name -> google.protobuf.Timestamp
+	23, // 22: spot.v1.GetMarketHistoryResponse.entries:type_name -> spot.v1.MarketHistoryEntry
+	53, // 23: spot.v1.Asset.updated_at:type_name -> google.protobuf.Timestamp
+	26, // 24: spot.v1.ListAssetsResponse.assets:type_name -> spot.v1.Asset
+	26, // 25: spot.v1.CreateAssetResponse.asset:type_name -> spot.v1.Asset
+	26, // 26: spot.v1.UpdateAssetResponse.asset:type_name -> spot.v1.Asset
+	4,  // 27: spot.v1.MarketAccessSubject.type:type_name -> spot.v1.MarketAccessSubjectType
+	33, // 28: spot.v1.MarketAccessGrant.subject:type_name -> spot.v1.MarketAccessSubject
+	53, // 29: spot.v1.MarketAccessGrant.granted_at:type_name -> google.protobuf.Timestamp
+	6,  // 30: spot.v1.SetMarketRestrictedResponse.market:type_name -> spot.v1.Market
+	33, // 31: spot.v1.GrantMarketAccessRequest.subject:type_name -> spot.v1.MarketAccessSubject
+	34, // 32: spot.v1.GrantMarketAccessResponse.grant:type_name -> spot.v1.MarketAccessGrant
+	33, // 33: spot.v1.RevokeMarketAccessRequest.subject:type_name -> spot.v1.MarketAccessSubject
+	34, // 34: spot.v1.ListMarketAccessResponse.grants:type_name -> spot.v1.MarketAccessGrant
+	54, // 35: spot.v1.Ticker.last_price:type_name -> google.type.Decimal
+	54, // 36: spot.v1.Ticker.open_price:type_name -> google.type.Decimal
+	54, // 37: spot.v1.Ticker.high_price:type_name -> google.type.Decimal
+	54, // 38: spot.v1.Ticker.low_price:type_name -> google.type.Decimal
+	54, // 39: spot.v1.Ticker.volume:type_name -> google.type.Decimal
+	54, // 40: spot.v1.Ticker.quote_volume:type_name -> google.type.Decimal
+	54, // 41: spot.v1.Ticker.price_change:type_name -> google.type.Decimal
+	54, // 42: spot.v1.Ticker.price_change_percent:type_name -> google.type.Decimal
+	53, // 43: spot.v1.Ticker.last_trade_at:type_name -> google.protobuf.Timestamp
+	53, // 44: spot.v1.Ticker.window_start:type_name -> google.protobuf.Timestamp
+	53, // 45: spot.v1.Ticker.window_end:type_name -> google.protobuf.Timestamp
+	43, // 46: spot.v1.GetTickerResponse.ticker:type_name -> spot.v1.Ticker
+	43, // 47: spot.v1.ListTickersResponse.tickers:type_name -> spot.v1.Ticker
+	43, // 48: spot.v1.WatchTickersResponse.ticker:type_name -> spot.v1.Ticker
+	5,  // 49: spot.v1.Candle.interval:type_name -> spot.v1.CandleInterval
+	53, // 50: spot.v1.Candle.open_time:type_name -> google.protobuf.Timestamp
+	53, // 51: spot.v1.Candle.close_time:type_name -> google.protobuf.Timestamp
+	54, // 52: spot.v1.Candle.open:type_name -> google.type.Decimal
+	54, // 53: spot.v1.Candle.high:type_name -> google.type.Decimal
+	54, // 54: spot.v1.Candle.low:type_name -> google.type.Decimal
+	54, // 55: spot.v1.Candle.close:type_name -> google.type.Decimal
+	54, // 56: spot.v1.Candle.volume:type_name -> google.type.Decimal
+	54, // 57: spot.v1.Candle.quote_volume:type_name -> google.type.Decimal
+	5,  // 58: spot.v1.GetCandlesRequest.interval:type_name -> spot.v1.CandleInterval
+	53, // 59: spot.v1.GetCandlesRequest.from:type_name -> google.protobuf.Timestamp
+	53, // 60: spot.v1.GetCandlesRequest.to:type_name -> google.protobuf.Timestamp
+	50, // 61: spot.v1.GetCandlesResponse.candles:type_name -> spot.v1.Candle
+	8,  // 62: spot.v1.SpotInstrumentService.ViewMarkets:input_type -> spot.v1.ViewMarketsRequest
+	10, // 63: spot.v1.SpotInstrumentService.GetMarketByID:input_type -> spot.v1.GetMarketByIDRequest
+	14, // 64: spot.v1.SpotInstrumentService.GetMarketsByIDs:input_type -> spot.v1.GetMarketsByIDsRequest
+	12, // 65: spot.v1.SpotInstrumentService.GetMarketBySymbol:input_type -> spot.v1.GetMarketBySymbolRequest
+	18, // 66: spot.v1.SpotInstrumentService.WatchMarkets:input_type -> spot.v1.WatchMarketsRequest
+	24, // 67: spot.v1.SpotInstrumentService.GetMarketHistory:input_type -> spot.v1.GetMarketHistoryRequest
+	27, // 68: spot.v1.AssetCatalogService.ListAssets:input_type -> spot.v1.ListAssetsRequest
+	29, // 69: spot.v1.AssetCatalogService.CreateAsset:input_type -> spot.v1.CreateAssetRequest
+	31, // 70: spot.v1.AssetCatalogService.UpdateAsset:input_type -> spot.v1.UpdateAssetRequest
+	35, // 71: spot.v1.MarketAccessService.SetMarketRestricted:input_type -> spot.v1.SetMarketRestrictedRequest
+	37, // 72: spot.v1.MarketAccessService.GrantMarketAccess:input_type -> spot.v1.GrantMarketAccessRequest
+	39, // 73: spot.v1.MarketAccessService.RevokeMarketAccess:input_type -> spot.v1.RevokeMarketAccessRequest
+	41, // 74: spot.v1.MarketAccessService.ListMarketAccess:input_type -> spot.v1.ListMarketAccessRequest
+	44, // 75: spot.v1.TickerService.GetTicker:input_type -> spot.v1.GetTickerRequest
+	46, // 76: spot.v1.TickerService.ListTickers:input_type -> spot.v1.ListTickersRequest
+	48, // 77: spot.v1.TickerService.WatchTickers:input_type -> spot.v1.WatchTickersRequest
+	51, // 78: spot.v1.CandleService.GetCandles:input_type -> spot.v1.GetCandlesRequest
+	9,  // 79: spot.v1.SpotInstrumentService.ViewMarkets:output_type -> spot.v1.ViewMarketsResponse
+	11, // 80: spot.v1.SpotInstrumentService.GetMarketByID:output_type -> spot.v1.GetMarketByIDResponse
+	16, // 81: spot.v1.SpotInstrumentService.GetMarketsByIDs:output_type -> spot.v1.GetMarketsByIDsResponse
+	13, // 82: spot.v1.SpotInstrumentService.GetMarketBySymbol:output_type -> spot.v1.GetMarketBySymbolResponse
+	22, // 83: spot.v1.SpotInstrumentService.WatchMarkets:output_type -> spot.v1.WatchMarketsResponse
+	25, // 84: spot.v1.SpotInstrumentService.GetMarketHistory:output_type -> spot.v1.GetMarketHistoryResponse
+	28, // 85: spot.v1.AssetCatalogService.ListAssets:output_type -> spot.v1.ListAssetsResponse
+	30, // 86: spot.v1.AssetCatalogService.CreateAsset:output_type -> spot.v1.CreateAssetResponse
+	32, // 87: spot.v1.AssetCatalogService.UpdateAsset:output_type -> spot.v1.UpdateAssetResponse
+	36, // 88: spot.v1.MarketAccessService.SetMarketRestricted:output_type -> spot.v1.SetMarketRestrictedResponse
+	38, // 89: spot.v1.MarketAccessService.GrantMarketAccess:output_type -> spot.v1.GrantMarketAccessResponse
+	40, // 90: spot.v1.MarketAccessService.RevokeMarketAccess:output_type -> spot.v1.RevokeMarketAccessResponse
+	42, // 91: spot.v1.MarketAccessService.ListMarketAccess:output_type -> spot.v1.ListMarketAccessResponse
+	45, // 92: spot.v1.TickerService.GetTicker:output_type -> spot.v1.GetTickerResponse
+	47, // 93: spot.v1.TickerService.ListTickers:output_type -> spot.v1.ListTickersResponse
+	49, // 94: spot.v1.TickerService.WatchTickers:output_type -> spot.v1.WatchTickersResponse
+	52, // 95: spot.v1.CandleService.GetCandles:output_type -> spot.v1.GetCandlesResponse
+	79, // [79:96] is the sub-list for method output_type
+	62, // [62:79] is the sub-list for method input_type
+	62, // [62:62] is the sub-list for extension type_name
+	62, // [62:62] is the sub-list for extension extendee
+	0,  // [0:62] is the sub-list for field type_name
 }
 
 func init() { file_spot_v1_spot_proto_init() }
@@ -370,18 +3573,24 @@ func file_spot_v1_spot_proto_init() {
 	if File_spot_v1_spot_proto != nil {
 		return
 	}
+	file_spot_v1_spot_proto_msgTypes[16].OneofWrappers = []any{
+		(*WatchMarketsResponse_Snapshot)(nil),
+		(*WatchMarketsResponse_Changes)(nil),
+	}
+	file_spot_v1_spot_proto_msgTypes[25].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_spot_v1_spot_proto_rawDesc), len(file_spot_v1_spot_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   5,
+			NumEnums:      6,
+			NumMessages:   47,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   5,
 		},
 		GoTypes:           file_spot_v1_spot_proto_goTypes,
 		DependencyIndexes: file_spot_v1_spot_proto_depIdxs,
+		EnumInfos:         file_spot_v1_spot_proto_enumTypes,
 		MessageInfos:      file_spot_v1_spot_proto_msgTypes,
 	}.Build()
 	File_spot_v1_spot_proto = out.File
@@ -19,8 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	SpotInstrumentService_ViewMarkets_FullMethodName   = "/spot.v1.SpotInstrumentService/ViewMarkets"
-	SpotInstrumentService_GetMarketByID_FullMethodName = "/spot.v1.SpotInstrumentService/GetMarketByID"
+	SpotInstrumentService_ViewMarkets_FullMethodName       = "/spot.v1.SpotInstrumentService/ViewMarkets"
+	SpotInstrumentService_GetMarketByID_FullMethodName     = "/spot.v1.SpotInstrumentService/GetMarketByID"
+	SpotInstrumentService_GetMarketsByIDs_FullMethodName   = "/spot.v1.SpotInstrumentService/GetMarketsByIDs"
+	SpotInstrumentService_GetMarketBySymbol_FullMethodName = "/spot.v1.SpotInstrumentService/GetMarketBySymbol"
+	SpotInstrumentService_WatchMarkets_FullMethodName      = "/spot.v1.SpotInstrumentService/WatchMarkets"
+	SpotInstrumentService_GetMarketHistory_FullMethodName  = "/spot.v1.SpotInstrumentService/GetMarketHistory"
 )
 
 // SpotInstrumentServiceClient is the client API for SpotInstrumentService service.
@@ -29,6 +33,11 @@ const (
 type SpotInstrumentServiceClient interface {
 	ViewMarkets(ctx context.Context, in *ViewMarketsRequest, opts ...grpc.CallOption) (*ViewMarketsResponse, error)
 	GetMarketByID(ctx context.Context, in *GetMarketByIDRequest, opts ...grpc.CallOption) (*GetMarketByIDResponse, error)
+	GetMarketsByIDs(ctx context.Context, in *GetMarketsByIDsRequest, opts ...grpc.CallOption) (*GetMarketsByIDsResponse, error)
+	GetMarketBySymbol(ctx context.Context, in *GetMarketBySymbolRequest, opts ...grpc.CallOption) (*GetMarketBySymbolResponse, error)
+	WatchMarkets(ctx context.Context, in *WatchMarketsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchMarketsResponse], error)
+	// История изменений рынка, новые записи первыми. Только ROLE_ADMIN.
+	GetMarketHistory(ctx context.Context, in *GetMarketHistoryRequest, opts ...grpc.CallOption) (*GetMarketHistoryResponse, error)
 }
 
 type spotInstrumentServiceClient struct {
@@ -59,12 +68,66 @@ func (c *spotInstrumentServiceClient) GetMarketByID(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *spotInstrumentServiceClient) GetMarketsByIDs(ctx context.Context, in *GetMarketsByIDsRequest, opts ...grpc.CallOption) (*GetMarketsByIDsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMarketsByIDsResponse)
+	err := c.cc.Invoke(ctx, SpotInstrumentService_GetMarketsByIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spotInstrumentServiceClient) GetMarketBySymbol(ctx context.Context, in *GetMarketBySymbolRequest, opts ...grpc.CallOption) (*GetMarketBySymbolResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMarketBySymbolResponse)
+	err := c.cc.Invoke(ctx, SpotInstrumentService_GetMarketBySymbol_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spotInstrumentServiceClient) WatchMarkets(ctx context.Context, in *WatchMarketsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchMarketsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SpotInstrumentService_ServiceDesc.Streams[0], SpotInstrumentService_WatchMarkets_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchMarketsRequest, WatchMarketsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SpotInstrumentService_WatchMarketsClient = grpc.ServerStreamingClient[WatchMarketsResponse]
+
+func (c *spotInstrumentServiceClient) GetMarketHistory(ctx context.Context, in *GetMarketHistoryRequest, opts ...grpc.CallOption) (*GetMarketHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMarketHistoryResponse)
+	err := c.cc.Invoke(ctx, SpotInstrumentService_GetMarketHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SpotInstrumentServiceServer is the server API for SpotInstrumentService service.
 // All implementations must embed UnimplementedSpotInstrumentServiceServer
 // for forward compatibility.
 type SpotInstrumentServiceServer interface {
 	ViewMarkets(context.Context, *ViewMarketsRequest) (*ViewMarketsResponse, error)
 	GetMarketByID(context.Context, *GetMarketByIDRequest) (*GetMarketByIDResponse, error)
+	GetMarketsByIDs(context.Context, *GetMarketsByIDsRequest) (*GetMarketsByIDsResponse, error)
+	GetMarketBySymbol(context.Context, *GetMarketBySymbolRequest) (*GetMarketBySymbolResponse, error)
+	WatchMarkets(*WatchMarketsRequest, grpc.ServerStreamingServer[WatchMarketsResponse]) error
+	// История изменений рынка, новые записи первыми. Только ROLE_ADMIN.
+	GetMarketHistory(context.Context, *GetMarketHistoryRequest) (*GetMarketHistoryResponse, error)
 	mustEmbedUnimplementedSpotInstrumentServiceServer()
 }
 
@@ -81,6 +144,18 @@ func (UnimplementedSpotInstrumentServiceServer) ViewMarkets(context.Context, *Vi
 func (UnimplementedSpotInstrumentServiceServer) GetMarketByID(context.Context, *GetMarketByIDRequest) (*GetMarketByIDResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetMarketByID not implemented")
 }
+func (UnimplementedSpotInstrumentServiceServer) GetMarketsByIDs(context.Context, *GetMarketsByIDsRequest) (*GetMarketsByIDsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMarketsByIDs not implemented")
+}
+func (UnimplementedSpotInstrumentServiceServer) GetMarketBySymbol(context.Context, *GetMarketBySymbolRequest) (*GetMarketBySymbolResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMarketBySymbol not implemented")
+}
+func (UnimplementedSpotInstrumentServiceServer) WatchMarkets(*WatchMarketsRequest, grpc.ServerStreamingServer[WatchMarketsResponse]) error {
+	return status.Error(codes.Unimplemented, "method WatchMarkets not implemented")
+}
+func (UnimplementedSpotInstrumentServiceServer) GetMarketHistory(context.Context, *GetMarketHistoryRequest) (*GetMarketHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMarketHistory not implemented")
+}
 func (UnimplementedSpotInstrumentServiceServer) mustEmbedUnimplementedSpotInstrumentServiceServer() {}
 func (UnimplementedSpotInstrumentServiceServer) testEmbeddedByValue()                               {}
 
@@ -138,6 +213,71 @@ func _SpotInstrumentService_GetMarketByID_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SpotInstrumentService_GetMarketsByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMarketsByIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpotInstrumentServiceServer).GetMarketsByIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SpotInstrumentService_GetMarketsByIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpotInstrumentServiceServer).GetMarketsByIDs(ctx, req.(*GetMarketsByIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpotInstrumentService_GetMarketBySymbol_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMarketBySymbolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpotInstrumentServiceServer).GetMarketBySymbol(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SpotInstrumentService_GetMarketBySymbol_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpotInstrumentServiceServer).GetMarketBySymbol(ctx, req.(*GetMarketBySymbolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpotInstrumentService_WatchMarkets_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchMarketsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SpotInstrumentServiceServer).WatchMarkets(m, &grpc.GenericServerStream[WatchMarketsRequest, WatchMarketsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SpotInstrumentService_WatchMarketsServer = grpc.ServerStreamingServer[WatchMarketsResponse]
+
+func _SpotInstrumentService_GetMarketHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMarketHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpotInstrumentServiceServer).GetMarketHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SpotInstrumentService_GetMarketHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpotInstrumentServiceServer).GetMarketHistory(ctx, req.(*GetMarketHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SpotInstrumentService_ServiceDesc is the grpc.ServiceDesc for SpotInstrumentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,6 +293,720 @@ var SpotInstrumentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMarketByID",
 			Handler:    _SpotInstrumentService_GetMarketByID_Handler,
 		},
+		{
+			MethodName: "GetMarketsByIDs",
+			Handler:    _SpotInstrumentService_GetMarketsByIDs_Handler,
+		},
+		{
+			MethodName: "GetMarketBySymbol",
+			Handler:    _SpotInstrumentService_GetMarketBySymbol_Handler,
+		},
+		{
+			MethodName: "GetMarketHistory",
+			Handler:    _SpotInstrumentService_GetMarketHistory_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchMarkets",
+			Handler:       _SpotInstrumentService_WatchMarkets_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "spot/v1/spot.proto",
+}
+
+const (
+	AssetCatalogService_ListAssets_FullMethodName  = "/spot.v1.AssetCatalogService/ListAssets"
+	AssetCatalogService_CreateAsset_FullMethodName = "/spot.v1.AssetCatalogService/CreateAsset"
+	AssetCatalogService_UpdateAsset_FullMethodName = "/spot.v1.AssetCatalogService/UpdateAsset"
+)
+
+// AssetCatalogServiceClient is the client API for AssetCatalogService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Справочник активов, на которые ссылаются рынки. Изменения доступны только ROLE_ADMIN.
+type AssetCatalogServiceClient interface {
+	ListAssets(ctx context.Context, in *ListAssetsRequest, opts ...grpc.CallOption) (*ListAssetsResponse, error)
+	CreateAsset(ctx context.Context, in *CreateAssetRequest, opts ...grpc.CallOption) (*CreateAssetResponse, error)
+	UpdateAsset(ctx context.Context, in *UpdateAssetRequest, opts ...grpc.CallOption) (*UpdateAssetResponse, error)
+}
+
+type assetCatalogServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAssetCatalogServiceClient(cc grpc.ClientConnInterface) AssetCatalogServiceClient {
+	return &assetCatalogServiceClient{cc}
+}
+
+func (c *assetCatalogServiceClient) ListAssets(ctx context.Context, in *ListAssetsRequest, opts ...grpc.CallOption) (*ListAssetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAssetsResponse)
+	err := c.cc.Invoke(ctx, AssetCatalogService_ListAssets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetCatalogServiceClient) CreateAsset(ctx context.Context, in *CreateAssetRequest, opts ...grpc.CallOption) (*CreateAssetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAssetResponse)
+	err := c.cc.Invoke(ctx, AssetCatalogService_CreateAsset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetCatalogServiceClient) UpdateAsset(ctx context.Context, in *UpdateAssetRequest, opts ...grpc.CallOption) (*UpdateAssetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateAssetResponse)
+	err := c.cc.Invoke(ctx, AssetCatalogService_UpdateAsset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AssetCatalogServiceServer is the server API for AssetCatalogService service.
+// All implementations must embed UnimplementedAssetCatalogServiceServer
+// for forward compatibility.
+//
+// Справочник активов, на которые ссылаются рынки. Изменения доступны только ROLE_ADMIN.
+type AssetCatalogServiceServer interface {
+	ListAssets(context.Context, *ListAssetsRequest) (*ListAssetsResponse, error)
+	CreateAsset(context.Context, *CreateAssetRequest) (*CreateAssetResponse, error)
+	UpdateAsset(context.Context, *UpdateAssetRequest) (*UpdateAssetResponse, error)
+	mustEmbedUnimplementedAssetCatalogServiceServer()
+}
+
+// UnimplementedAssetCatalogServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAssetCatalogServiceServer struct{}
+
+func (UnimplementedAssetCatalogServiceServer) ListAssets(context.Context, *ListAssetsRequest) (*ListAssetsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAssets not implemented")
+}
+func (UnimplementedAssetCatalogServiceServer) CreateAsset(context.Context, *CreateAssetRequest) (*CreateAssetResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAsset not implemented")
+}
+func (UnimplementedAssetCatalogServiceServer) UpdateAsset(context.Context, *UpdateAssetRequest) (*UpdateAssetResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateAsset not implemented")
+}
+func (UnimplementedAssetCatalogServiceServer) mustEmbedUnimplementedAssetCatalogServiceServer() {}
+func (UnimplementedAssetCatalogServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeAssetCatalogServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AssetCatalogServiceServer will
+// result in compilation errors.
+type UnsafeAssetCatalogServiceServer interface {
+	mustEmbedUnimplementedAssetCatalogServiceServer()
+}
+
+func RegisterAssetCatalogServiceServer(s grpc.ServiceRegistrar, srv AssetCatalogServiceServer) {
+	// If the following call panics, it indicates UnimplementedAssetCatalogServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AssetCatalogService_ServiceDesc, srv)
+}
+
+func _AssetCatalogService_ListAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAssetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetCatalogServiceServer).ListAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssetCatalogService_ListAssets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetCatalogServiceServer).ListAssets(ctx, req.(*ListAssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetCatalogService_CreateAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetCatalogServiceServer).CreateAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssetCatalogService_CreateAsset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetCatalogServiceServer).CreateAsset(ctx, req.(*CreateAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetCatalogService_UpdateAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetCatalogServiceServer).UpdateAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssetCatalogService_UpdateAsset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetCatalogServiceServer).UpdateAsset(ctx, req.(*UpdateAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AssetCatalogService_ServiceDesc is the grpc.ServiceDesc for AssetCatalogService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AssetCatalogService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "spot.v1.AssetCatalogService",
+	HandlerType: (*AssetCatalogServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListAssets",
+			Handler:    _AssetCatalogService_ListAssets_Handler,
+		},
+		{
+			MethodName: "CreateAsset",
+			Handler:    _AssetCatalogService_CreateAsset_Handler,
+		},
+		{
+			MethodName: "UpdateAsset",
+			Handler:    _AssetCatalogService_UpdateAsset_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "spot/v1/spot.proto",
+}
+
+const (
+	MarketAccessService_SetMarketRestricted_FullMethodName = "/spot.v1.MarketAccessService/SetMarketRestricted"
+	MarketAccessService_GrantMarketAccess_FullMethodName   = "/spot.v1.MarketAccessService/GrantMarketAccess"
+	MarketAccessService_RevokeMarketAccess_FullMethodName  = "/spot.v1.MarketAccessService/RevokeMarketAccess"
+	MarketAccessService_ListMarketAccess_FullMethodName    = "/spot.v1.MarketAccessService/ListMarketAccess"
+)
+
+// MarketAccessServiceClient is the client API for MarketAccessService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Списки доступа к restricted-рынкам. Только ROLE_ADMIN.
+// Restricted-рынок видят и торгуют им только пользователи и роли из списка
+// (политики видимости с bypass_access_lists видят его всегда).
+type MarketAccessServiceClient interface {
+	SetMarketRestricted(ctx context.Context, in *SetMarketRestrictedRequest, opts ...grpc.CallOption) (*SetMarketRestrictedResponse, error)
+	GrantMarketAccess(ctx context.Context, in *GrantMarketAccessRequest, opts ...grpc.CallOption) (*GrantMarketAccessResponse, error)
+	RevokeMarketAccess(ctx context.Context, in *RevokeMarketAccessRequest, opts ...grpc.CallOption) (*RevokeMarketAccessResponse, error)
+	ListMarketAccess(ctx context.Context, in *ListMarketAccessRequest, opts ...grpc.CallOption) (*ListMarketAccessResponse, error)
+}
+
+type marketAccessServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMarketAccessServiceClient(cc grpc.ClientConnInterface) MarketAccessServiceClient {
+	return &marketAccessServiceClient{cc}
+}
+
+func (c *marketAccessServiceClient) SetMarketRestricted(ctx context.Context, in *SetMarketRestrictedRequest, opts ...grpc.CallOption) (*SetMarketRestrictedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetMarketRestrictedResponse)
+	err := c.cc.Invoke(ctx, MarketAccessService_SetMarketRestricted_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketAccessServiceClient) GrantMarketAccess(ctx context.Context, in *GrantMarketAccessRequest, opts ...grpc.CallOption) (*GrantMarketAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GrantMarketAccessResponse)
+	err := c.cc.Invoke(ctx, MarketAccessService_GrantMarketAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketAccessServiceClient) RevokeMarketAccess(ctx context.Context, in *RevokeMarketAccessRequest, opts ...grpc.CallOption) (*RevokeMarketAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeMarketAccessResponse)
+	err := c.cc.Invoke(ctx, MarketAccessService_RevokeMarketAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketAccessServiceClient) ListMarketAccess(ctx context.Context, in *ListMarketAccessRequest, opts ...grpc.CallOption) (*ListMarketAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMarketAccessResponse)
+	err := c.cc.Invoke(ctx, MarketAccessService_ListMarketAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MarketAccessServiceServer is the server API for MarketAccessService service.
+// All implementations must embed UnimplementedMarketAccessServiceServer
+// for forward compatibility.
+//
+// Списки доступа к restricted-рынкам. Только ROLE_ADMIN.
+// Restricted-рынок видят и торгуют им только пользователи и роли из списка
+// (политики видимости с bypass_access_lists видят его всегда).
+type MarketAccessServiceServer interface {
+	SetMarketRestricted(context.Context, *SetMarketRestrictedRequest) (*SetMarketRestrictedResponse, error)
+	GrantMarketAccess(context.Context, *GrantMarketAccessRequest) (*GrantMarketAccessResponse, error)
+	RevokeMarketAccess(context.Context, *RevokeMarketAccessRequest) (*RevokeMarketAccessResponse, error)
+	ListMarketAccess(context.Context, *ListMarketAccessRequest) (*ListMarketAccessResponse, error)
+	mustEmbedUnimplementedMarketAccessServiceServer()
+}
+
+// UnimplementedMarketAccessServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMarketAccessServiceServer struct{}
+
+func (UnimplementedMarketAccessServiceServer) SetMarketRestricted(context.Context, *SetMarketRestrictedRequest) (*SetMarketRestrictedResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetMarketRestricted not implemented")
+}
+func (UnimplementedMarketAccessServiceServer) GrantMarketAccess(context.Context, *GrantMarketAccessRequest) (*GrantMarketAccessResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GrantMarketAccess not implemented")
+}
+func (UnimplementedMarketAccessServiceServer) RevokeMarketAccess(context.Context, *RevokeMarketAccessRequest) (*RevokeMarketAccessResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeMarketAccess not implemented")
+}
+func (UnimplementedMarketAccessServiceServer) ListMarketAccess(context.Context, *ListMarketAccessRequest) (*ListMarketAccessResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMarketAccess not implemented")
+}
+func (UnimplementedMarketAccessServiceServer) mustEmbedUnimplementedMarketAccessServiceServer() {}
+func (UnimplementedMarketAccessServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeMarketAccessServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MarketAccessServiceServer will
+// result in compilation errors.
+type UnsafeMarketAccessServiceServer interface {
+	mustEmbedUnimplementedMarketAccessServiceServer()
+}
+
+func RegisterMarketAccessServiceServer(s grpc.ServiceRegistrar, srv MarketAccessServiceServer) {
+	// If the following call panics, it indicates UnimplementedMarketAccessServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MarketAccessService_ServiceDesc, srv)
+}
+
+func _MarketAccessService_SetMarketRestricted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMarketRestrictedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketAccessServiceServer).SetMarketRestricted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarketAccessService_SetMarketRestricted_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketAccessServiceServer).SetMarketRestricted(ctx, req.(*SetMarketRestrictedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarketAccessService_GrantMarketAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantMarketAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketAccessServiceServer).GrantMarketAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarketAccessService_GrantMarketAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketAccessServiceServer).GrantMarketAccess(ctx, req.(*GrantMarketAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarketAccessService_RevokeMarketAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeMarketAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketAccessServiceServer).RevokeMarketAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarketAccessService_RevokeMarketAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketAccessServiceServer).RevokeMarketAccess(ctx, req.(*RevokeMarketAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MarketAccessService_ListMarketAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMarketAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketAccessServiceServer).ListMarketAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MarketAccessService_ListMarketAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketAccessServiceServer).ListMarketAccess(ctx, req.(*ListMarketAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MarketAccessService_ServiceDesc is the grpc.ServiceDesc for MarketAccessService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MarketAccessService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "spot.v1.MarketAccessService",
+	HandlerType: (*MarketAccessServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetMarketRestricted",
+			Handler:    _MarketAccessService_SetMarketRestricted_Handler,
+		},
+		{
+			MethodName: "GrantMarketAccess",
+			Handler:    _MarketAccessService_GrantMarketAccess_Handler,
+		},
+		{
+			MethodName: "RevokeMarketAccess",
+			Handler:    _MarketAccessService_RevokeMarketAccess_Handler,
+		},
+		{
+			MethodName: "ListMarketAccess",
+			Handler:    _MarketAccessService_ListMarketAccess_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "spot/v1/spot.proto",
+}
+
+const (
+	TickerService_GetTicker_FullMethodName    = "/spot.v1.TickerService/GetTicker"
+	TickerService_ListTickers_FullMethodName  = "/spot.v1.TickerService/ListTickers"
+	TickerService_WatchTickers_FullMethodName = "/spot.v1.TickerService/WatchTickers"
+)
+
+// TickerServiceClient is the client API for TickerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Статистика рынков за скользящие 24 часа. Видны только тикеры рынков, видимых вызывающему.
+type TickerServiceClient interface {
+	GetTicker(ctx context.Context, in *GetTickerRequest, opts ...grpc.CallOption) (*GetTickerResponse, error)
+	ListTickers(ctx context.Context, in *ListTickersRequest, opts ...grpc.CallOption) (*ListTickersResponse, error)
+	// Сначала текущие тикеры рынков, затем только изменившиеся.
+	WatchTickers(ctx context.Context, in *WatchTickersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchTickersResponse], error)
+}
+
+type tickerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTickerServiceClient(cc grpc.ClientConnInterface) TickerServiceClient {
+	return &tickerServiceClient{cc}
+}
+
+func (c *tickerServiceClient) GetTicker(ctx context.Context, in *GetTickerRequest, opts ...grpc.CallOption) (*GetTickerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTickerResponse)
+	err := c.cc.Invoke(ctx, TickerService_GetTicker_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tickerServiceClient) ListTickers(ctx context.Context, in *ListTickersRequest, opts ...grpc.CallOption) (*ListTickersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTickersResponse)
+	err := c.cc.Invoke(ctx, TickerService_ListTickers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tickerServiceClient) WatchTickers(ctx context.Context, in *WatchTickersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchTickersResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TickerService_ServiceDesc.Streams[0], TickerService_WatchTickers_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchTickersRequest, WatchTickersResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TickerService_WatchTickersClient = grpc.ServerStreamingClient[WatchTickersResponse]
+
+// TickerServiceServer is the server API for TickerService service.
+// All implementations must embed UnimplementedTickerServiceServer
+// for forward compatibility.
+//
+// Статистика рынков за скользящие 24 часа. Видны только тикеры рынков, видимых вызывающему.
+type TickerServiceServer interface {
+	GetTicker(context.Context, *GetTickerRequest) (*GetTickerResponse, error)
+	ListTickers(context.Context, *ListTickersRequest) (*ListTickersResponse, error)
+	// Сначала текущие тикеры рынков, затем только изменившиеся.
+	WatchTickers(*WatchTickersRequest, grpc.ServerStreamingServer[WatchTickersResponse]) error
+	mustEmbedUnimplementedTickerServiceServer()
+}
+
+// UnimplementedTickerServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTickerServiceServer struct{}
+
+func (UnimplementedTickerServiceServer) GetTicker(context.Context, *GetTickerRequest) (*GetTickerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTicker not implemented")
+}
+func (UnimplementedTickerServiceServer) ListTickers(context.Context, *ListTickersRequest) (*ListTickersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTickers not implemented")
+}
+func (UnimplementedTickerServiceServer) WatchTickers(*WatchTickersRequest, grpc.ServerStreamingServer[WatchTickersResponse]) error {
+	return status.Error(codes.Unimplemented, "method WatchTickers not implemented")
+}
+func (UnimplementedTickerServiceServer) mustEmbedUnimplementedTickerServiceServer() {}
+func (UnimplementedTickerServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeTickerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TickerServiceServer will
+// result in compilation errors.
+type UnsafeTickerServiceServer interface {
+	mustEmbedUnimplementedTickerServiceServer()
+}
+
+func RegisterTickerServiceServer(s grpc.ServiceRegistrar, srv TickerServiceServer) {
+	// If the following call panics, it indicates UnimplementedTickerServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TickerService_ServiceDesc, srv)
+}
+
+func _TickerService_GetTicker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTickerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TickerServiceServer).GetTicker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TickerService_GetTicker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TickerServiceServer).GetTicker(ctx, req.(*GetTickerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TickerService_ListTickers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTickersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TickerServiceServer).ListTickers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TickerService_ListTickers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TickerServiceServer).ListTickers(ctx, req.(*ListTickersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TickerService_WatchTickers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchTickersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TickerServiceServer).WatchTickers(m, &grpc.GenericServerStream[WatchTickersRequest, WatchTickersResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TickerService_WatchTickersServer = grpc.ServerStreamingServer[WatchTickersResponse]
+
+// TickerService_ServiceDesc is the grpc.ServiceDesc for TickerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TickerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "spot.v1.TickerService",
+	HandlerType: (*TickerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTicker",
+			Handler:    _TickerService_GetTicker_Handler,
+		},
+		{
+			MethodName: "ListTickers",
+			Handler:    _TickerService_ListTickers_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchTickers",
+			Handler:       _TickerService_WatchTickers_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "spot/v1/spot.proto",
+}
+
+const (
+	CandleService_GetCandles_FullMethodName = "/spot.v1.CandleService/GetCandles"
+)
+
+// CandleServiceClient is the client API for CandleService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CandleServiceClient interface {
+	GetCandles(ctx context.Context, in *GetCandlesRequest, opts ...grpc.CallOption) (*GetCandlesResponse, error)
+}
+
+type candleServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCandleServiceClient(cc grpc.ClientConnInterface) CandleServiceClient {
+	return &candleServiceClient{cc}
+}
+
+func (c *candleServiceClient) GetCandles(ctx context.Context, in *GetCandlesRequest, opts ...grpc.CallOption) (*GetCandlesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCandlesResponse)
+	err := c.cc.Invoke(ctx, CandleService_GetCandles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CandleServiceServer is the server API for CandleService service.
+// All implementations must embed UnimplementedCandleServiceServer
+// for forward compatibility.
+type CandleServiceServer interface {
+	GetCandles(context.Context, *GetCandlesRequest) (*GetCandlesResponse, error)
+	mustEmbedUnimplementedCandleServiceServer()
+}
+
+// UnimplementedCandleServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCandleServiceServer struct{}
+
+func (UnimplementedCandleServiceServer) GetCandles(context.Context, *GetCandlesRequest) (*GetCandlesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCandles not implemented")
+}
+func (UnimplementedCandleServiceServer) mustEmbedUnimplementedCandleServiceServer() {}
+func (UnimplementedCandleServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeCandleServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CandleServiceServer will
+// result in compilation errors.
+type UnsafeCandleServiceServer interface {
+	mustEmbedUnimplementedCandleServiceServer()
+}
+
+func RegisterCandleServiceServer(s grpc.ServiceRegistrar, srv CandleServiceServer) {
+	// If the following call panics, it indicates UnimplementedCandleServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CandleService_ServiceDesc, srv)
+}
+
+func _CandleService_GetCandles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCandlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandleServiceServer).GetCandles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CandleService_GetCandles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandleServiceServer).GetCandles(ctx, req.(*GetCandlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CandleService_ServiceDesc is the grpc.ServiceDesc for CandleService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CandleService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "spot.v1.CandleService",
+	HandlerType: (*CandleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCandles",
+			Handler:    _CandleService_GetCandles_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "spot/v1/spot.proto",
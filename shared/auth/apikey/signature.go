@@ -0,0 +1,137 @@
+package apikey
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/netip"
+	"strconv"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+)
+
+// Заголовки metadata подписанного запроса
+const (
+	HeaderAPIKey    = "api-key"
+	HeaderTimestamp = "timestamp"
+	HeaderNonce     = "nonce"
+	HeaderSignature = "signature"
+)
+
+// Nonce — 16–64 символа из [A-Za-z0-9_-]
+const (
+	minNonceLength = 16
+	maxNonceLength = 64
+)
+
+var ErrUnsupportedRequest = errors.New("request is not a protobuf message")
+
+// SignedRequest — то, что перехватчик извлёк из входящего запроса для проверки
+type SignedRequest struct {
+	KeyID     string
+	Timestamp string
+	Nonce     string
+	Signature string
+	Method    string
+	Body      []byte
+	ClientIP  netip.Addr
+}
+
+// Principal — владелец ключа, от имени которого выполняется запрос.
+// Scopes ограничивают права ролей владельца, ForwardToken — access token для проброса запроса в spot-service
+type Principal struct {
+	UserID       uuid.UUID
+	Roles        []models.UserRole
+	Scopes       []models.Permission
+	KeyID        uuid.UUID
+	ForwardToken string
+}
+
+// DeriveSecret — секрет ключа: HMAC-SHA256(pepper, keyID). Pepper есть только в конфигурации
+// order-service, поэтому по содержимому БД подписать запрос нельзя
+func DeriveSecret(pepper []byte, keyID uuid.UUID) string {
+	mac := hmac.New(sha256.New, pepper)
+	mac.Write(keyID[:])
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// SigningKey — ключ HMAC, производный от секрета
+func SigningKey(secret string) []byte {
+	sum := sha256.Sum256([]byte(secret))
+	return sum[:]
+}
+
+// Sign возвращает hex(HMAC-SHA256(signingKey, method + "\n" + timestamp + "\n" + nonce + "\n" + body)).
+// timestamp — unix-время в миллисекундах в десятичной записи, как в metadata
+func Sign(signingKey []byte, method, timestamp, nonce string, body []byte) string {
+	mac := hmac.New(sha256.New, signingKey)
+	mac.Write([]byte(method))
+	mac.Write([]byte{'\n'})
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte{'\n'})
+	mac.Write([]byte(nonce))
+	mac.Write([]byte{'\n'})
+	mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func Verify(signingKey []byte, method, timestamp, nonce string, body []byte, signature string) bool {
+	expected := Sign(signingKey, method, timestamp, nonce, body)
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
+
+// NewNonce — случайный nonce запроса: 26 символов base32, 128 бит случайности
+func NewNonce() string {
+	return rand.Text()
+}
+
+// ValidNonce проверяет длину и алфавит nonce: он входит в ключ Redis, поэтому произвольные байты не принимаются
+func ValidNonce(nonce string) bool {
+	if len(nonce) < minNonceLength || len(nonce) > maxNonceLength {
+		return false
+	}
+
+	for _, symbol := range nonce {
+		switch {
+		case symbol >= 'a' && symbol <= 'z', symbol >= 'A' && symbol <= 'Z', symbol >= '0' && symbol <= '9':
+		case symbol == '-' || symbol == '_':
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// RequestBody — подписываемое тело: детерминированная protobuf-сериализация запроса.
+// Клиент и сервер должны получать одинаковые байты, поэтому обе стороны используют её
+func RequestBody(request any) ([]byte, error) {
+	message, ok := request.(proto.Message)
+	if !ok {
+		return nil, ErrUnsupportedRequest
+	}
+
+	body, err := proto.MarshalOptions{Deterministic: true}.Marshal(message)
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %w", err)
+	}
+
+	return body, nil
+}
+
+func FormatTimestamp(unixMillis int64) string {
+	return strconv.FormatInt(unixMillis, 10)
+}
+
+func ParseTimestamp(value string) (int64, error) {
+	return strconv.ParseInt(value, 10, 64)
+}
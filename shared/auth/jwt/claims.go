@@ -9,6 +9,11 @@ type TokenType string
 const (
 	TokenTypeAccess  TokenType = "access"
 	TokenTypeRefresh TokenType = "refresh"
+	// TokenTypeAPIKey — токен, с которым order-service пробрасывает в spot-service запрос по API-ключу.
+	// Сессии у него нет: ключ проверяется подписью на order-service, токен живёт минуту
+	TokenTypeAPIKey TokenType = "api_key"
+	// TokenTypeService — токен, с которым сервис сам ходит в spot-service из фоновых задач
+	TokenTypeService TokenType = "service"
 )
 
 type Claims struct {
@@ -16,4 +21,8 @@ type Claims struct {
 	TokenType TokenType `json:"token_type"`
 	SessionID string    `json:"session_id"`
 	UserRoles []string  `json:"user_roles,omitempty"`
+	// Service — имя сервиса-владельца токена с ролью ROLE_SERVICE
+	Service string `json:"service,omitempty"`
+	// Scopes — права API-ключа, есть только у токена типа api_key и сужают права ролей
+	Scopes []string `json:"scopes,omitempty"`
 }
@@ -0,0 +1,121 @@
+package jwt
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const JWKSPath = "/.well-known/jwks.json"
+
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+type JWK struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use,omitempty"`
+	Algorithm string `json:"alg"`
+	// RSA
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// OKP (Ed25519)
+	Curve string `json:"crv,omitempty"`
+	X     string `json:"x,omitempty"`
+}
+
+// JWKSHandler отдаёт публичные ключи набора. Ответ собирается один раз: набор неизменен до рестарта.
+func JWKSHandler(keys *KeySet) (http.Handler, error) {
+	set, err := keys.JWKS()
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(set)
+	if err != nil {
+		return nil, fmt.Errorf("marshal jwks: %w", err)
+	}
+
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodGet && request.Method != http.MethodHead {
+			writer.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		writer.Header().Set("Content-Type", "application/json")
+		writer.Header().Set("Cache-Control", "public, max-age=300")
+		_, _ = writer.Write(body)
+	}), nil
+}
+
+func publicKeyToJWK(kid string, key VerificationKey) (JWK, error) {
+	switch public := key.Key.(type) {
+	case *rsa.PublicKey:
+		return JWK{
+			KeyType:   "RSA",
+			KeyID:     kid,
+			Use:       "sig",
+			Algorithm: key.Method.Alg(),
+			N:         base64.RawURLEncoding.EncodeToString(public.N.Bytes()),
+			E:         base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes()),
+		}, nil
+	case ed25519.PublicKey:
+		return JWK{
+			KeyType:   "OKP",
+			KeyID:     kid,
+			Use:       "sig",
+			Algorithm: key.Method.Alg(),
+			Curve:     "Ed25519",
+			X:         base64.RawURLEncoding.EncodeToString(public),
+		}, nil
+	default:
+		return JWK{}, fmt.Errorf("unsupported public key type %T", key.Key)
+	}
+}
+
+func jwkToVerificationKey(jwk JWK) (VerificationKey, error) {
+	switch {
+	case jwk.KeyType == "RSA" && jwk.Algorithm == AlgorithmRS256:
+		n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+		if err != nil {
+			return VerificationKey{}, fmt.Errorf("decode n: %w", err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+		if err != nil {
+			return VerificationKey{}, fmt.Errorf("decode e: %w", err)
+		}
+
+		exponent := new(big.Int).SetBytes(e)
+		if !exponent.IsInt64() || exponent.Int64() < 3 {
+			return VerificationKey{}, fmt.Errorf("invalid RSA exponent")
+		}
+
+		public := &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}
+		if public.N.BitLen() < minRSAKeyBits {
+			return VerificationKey{}, fmt.Errorf("RSA key must be at least %d bits", minRSAKeyBits)
+		}
+
+		return VerificationKey{Method: jwt.SigningMethodRS256, Key: public}, nil
+
+	case jwk.KeyType == "OKP" && jwk.Curve == "Ed25519" && jwk.Algorithm == AlgorithmEdDSA:
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		if err != nil {
+			return VerificationKey{}, fmt.Errorf("decode x: %w", err)
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return VerificationKey{}, fmt.Errorf("invalid Ed25519 key size %d", len(x))
+		}
+
+		return VerificationKey{Method: jwt.SigningMethodEdDSA, Key: ed25519.PublicKey(x)}, nil
+
+	default:
+		return VerificationKey{}, fmt.Errorf("unsupported jwk kty=%q alg=%q", jwk.KeyType, jwk.Algorithm)
+	}
+}
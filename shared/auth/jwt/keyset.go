@@ -0,0 +1,179 @@
+package jwt
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	AlgorithmHS256 = "HS256"
+	AlgorithmRS256 = "RS256"
+	AlgorithmEdDSA = "EdDSA"
+
+	minRSAKeyBits = 2048
+	keyFileExt    = ".pem"
+)
+
+var ErrUnknownSigningKey = errors.New("unknown signing key id")
+
+// VerificationKey — ключ проверки подписи и алгоритм, которым им подписывают.
+type VerificationKey struct {
+	Method jwt.SigningMethod
+	Key    any
+}
+
+// KeyResolver находит ключ проверки по kid из заголовка токена.
+type KeyResolver interface {
+	ResolveKey(kid string) (VerificationKey, error)
+}
+
+type signingKey struct {
+	id     string
+	method jwt.SigningMethod
+	key    any
+}
+
+// KeySet — приватные ключи издателя токенов. Подписывает активный ключ, проверяются
+// подписи всех ключей набора: так старый ключ остаётся валидным, пока живут выпущенные им токены.
+type KeySet struct {
+	algorithm string
+	active    signingKey
+	public    map[string]VerificationKey
+	ids       []string
+}
+
+// LoadKeySet читает ключи из dir: каждый файл <kid>.pem — приватный ключ в PEM
+// (PKCS#8, для RSA также PKCS#1). Все ключи должны соответствовать algorithm.
+func LoadKeySet(dir, algorithm, activeKeyID string) (*KeySet, error) {
+	method, err := asymmetricMethod(algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	paths, err := filepath.Glob(filepath.Join(dir, "*"+keyFileExt))
+	if err != nil {
+		return nil, fmt.Errorf("list signing keys in %s: %w", dir, err)
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no %s signing keys found in %s", keyFileExt, dir)
+	}
+
+	keySet := &KeySet{
+		algorithm: algorithm,
+		public:    make(map[string]VerificationKey, len(paths)),
+	}
+
+	for _, path := range paths {
+		kid := strings.TrimSuffix(filepath.Base(path), keyFileExt)
+
+		privateKey, publicKey, loadErr := loadPrivateKey(path, algorithm)
+		if loadErr != nil {
+			return nil, fmt.Errorf("load signing key %q: %w", kid, loadErr)
+		}
+
+		keySet.public[kid] = VerificationKey{Method: method, Key: publicKey}
+		keySet.ids = append(keySet.ids, kid)
+
+		if kid == activeKeyID {
+			keySet.active = signingKey{id: kid, method: method, key: privateKey}
+		}
+	}
+	sort.Strings(keySet.ids)
+
+	if keySet.active.key == nil {
+		return nil, fmt.Errorf("active signing key %q not found in %s", activeKeyID, dir)
+	}
+
+	return keySet, nil
+}
+
+func (k *KeySet) ResolveKey(kid string) (VerificationKey, error) {
+	key, ok := k.public[kid]
+	if !ok {
+		return VerificationKey{}, ErrUnknownSigningKey
+	}
+
+	return key, nil
+}
+
+func (k *KeySet) ActiveKeyID() string {
+	return k.active.id
+}
+
+func (k *KeySet) Algorithm() string {
+	return k.algorithm
+}
+
+// JWKS возвращает публичные ключи набора в формате RFC 7517.
+func (k *KeySet) JWKS() (JWKS, error) {
+	set := JWKS{Keys: make([]JWK, 0, len(k.ids))}
+	for _, kid := range k.ids {
+		jwk, err := publicKeyToJWK(kid, k.public[kid])
+		if err != nil {
+			return JWKS{}, err
+		}
+		set.Keys = append(set.Keys, jwk)
+	}
+
+	return set, nil
+}
+
+func asymmetricMethod(algorithm string) (jwt.SigningMethod, error) {
+	switch algorithm {
+	case AlgorithmRS256:
+		return jwt.SigningMethodRS256, nil
+	case AlgorithmEdDSA:
+		return jwt.SigningMethodEdDSA, nil
+	default:
+		return nil, fmt.Errorf("unsupported asymmetric algorithm %q", algorithm)
+	}
+}
+
+func loadPrivateKey(path, algorithm string) (privateKey, publicKey any, err error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, nil, errors.New("no PEM block found")
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		rsaKey, rsaErr := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if rsaErr != nil {
+			return nil, nil, fmt.Errorf("parse private key: %w", err)
+		}
+		parsed = rsaKey
+	}
+
+	switch key := parsed.(type) {
+	case *rsa.PrivateKey:
+		if algorithm != AlgorithmRS256 {
+			return nil, nil, fmt.Errorf("RSA key cannot be used with %s", algorithm)
+		}
+		if key.N.BitLen() < minRSAKeyBits {
+			return nil, nil, fmt.Errorf("RSA key must be at least %d bits, got %d", minRSAKeyBits, key.N.BitLen())
+		}
+		return key, &key.PublicKey, nil
+	case ed25519.PrivateKey:
+		if algorithm != AlgorithmEdDSA {
+			return nil, nil, fmt.Errorf("Ed25519 key cannot be used with %s", algorithm)
+		}
+		return key, key.Public(), nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported private key type %T", parsed)
+	}
+}
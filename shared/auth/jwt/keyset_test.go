@@ -0,0 +1,158 @@
+package jwt
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	authErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/service"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+)
+
+const testTokenTTL = time.Minute
+
+// writeEd25519Keys кладёт в dir по ключу <kid>.pem на каждый kid.
+func writeEd25519Keys(t *testing.T, dir string, kids ...string) {
+	t.Helper()
+
+	for _, kid := range kids {
+		_, private, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+
+		der, err := x509.MarshalPKCS8PrivateKey(private)
+		require.NoError(t, err)
+
+		data := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+		require.NoError(t, os.WriteFile(filepath.Join(dir, kid+keyFileExt), data, 0o600))
+	}
+}
+
+func loadTestKeySet(t *testing.T, dir, activeKeyID string) *KeySet {
+	t.Helper()
+
+	keys, err := LoadKeySet(dir, AlgorithmEdDSA, activeKeyID)
+	require.NoError(t, err)
+
+	return keys
+}
+
+func TestLoadKeySet(t *testing.T) {
+	tests := []struct {
+		name      string
+		kids      []string
+		algorithm string
+		active    string
+		wantErr   bool
+	}{
+		{name: "активный ключ найден", kids: []string{"2026-01", "2026-02"}, algorithm: AlgorithmEdDSA, active: "2026-02"},
+		{name: "активного ключа нет в каталоге", kids: []string{"2026-01"}, algorithm: AlgorithmEdDSA, active: "2026-02", wantErr: true},
+		{name: "алгоритм не совпадает с ключами", kids: []string{"2026-01"}, algorithm: AlgorithmRS256, active: "2026-01", wantErr: true},
+		{name: "пустой каталог", algorithm: AlgorithmEdDSA, active: "2026-01", wantErr: true},
+		{name: "симметричный алгоритм", kids: []string{"2026-01"}, algorithm: AlgorithmHS256, active: "2026-01", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeEd25519Keys(t, dir, tt.kids...)
+
+			keys, err := LoadKeySet(dir, tt.algorithm, tt.active)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.active, keys.ActiveKeyID())
+
+			set, err := keys.JWKS()
+			require.NoError(t, err)
+			require.Len(t, set.Keys, len(tt.kids))
+			for i, jwk := range set.Keys {
+				assert.Equal(t, tt.kids[i], jwk.KeyID)
+				assert.Equal(t, AlgorithmEdDSA, jwk.Algorithm)
+			}
+		})
+	}
+}
+
+func TestKeySetManagerRotation(t *testing.T) {
+	dir := t.TempDir()
+	writeEd25519Keys(t, dir, "old")
+	userID := uuid.New()
+
+	oldManager := NewKeySetManager(loadTestKeySet(t, dir, "old"), testTokenTTL, testTokenTTL)
+	oldToken, err := oldManager.GenerateAccessToken(userID, []models.UserRole{models.UserRoleUser}, "s1")
+	require.NoError(t, err)
+
+	// Ротация: новый активный ключ, старый остаётся в наборе до истечения его токенов
+	writeEd25519Keys(t, dir, "new")
+	rotated := NewKeySetManager(loadTestKeySet(t, dir, "new"), testTokenTTL, testTokenTTL)
+
+	newToken, err := rotated.GenerateAccessToken(userID, []models.UserRole{models.UserRoleUser}, "s1")
+	require.NoError(t, err)
+
+	for _, token := range []string{oldToken, newToken} {
+		claims, parseErr := rotated.ParseToken(token, TokenTypeAccess)
+		require.NoError(t, parseErr)
+		assert.Equal(t, userID.String(), claims.Subject)
+	}
+
+	// Старый ключ выведен из набора — его токены больше не принимаются
+	require.NoError(t, os.Remove(filepath.Join(dir, "old"+keyFileExt)))
+	retired := NewKeySetManager(loadTestKeySet(t, dir, "new"), testTokenTTL, testTokenTTL)
+
+	_, err = retired.ParseToken(oldToken, TokenTypeAccess)
+	assert.ErrorIs(t, err, authErrors.ErrInvalidToken)
+}
+
+func TestKeySetManagerRejectsForeignTokens(t *testing.T) {
+	dir := t.TempDir()
+	writeEd25519Keys(t, dir, "main")
+	manager := NewKeySetManager(loadTestKeySet(t, dir, "main"), testTokenTTL, testTokenTTL)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, minRSAKeyBits)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		method jwt.SigningMethod
+		kid    string
+		key    any
+	}{
+		{name: "неизвестный kid", method: jwt.SigningMethodEdDSA, kid: "unknown", key: ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize))},
+		{name: "алгоритм токена не совпадает с ключом kid", method: jwt.SigningMethodRS256, kid: "main", key: rsaKey},
+		{name: "HS256 не принимается", method: jwt.SigningMethodHS256, kid: "main", key: []byte("secret")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := jwt.NewWithClaims(tt.method, &Claims{
+				RegisteredClaims: jwt.RegisteredClaims{
+					Subject:   uuid.NewString(),
+					ExpiresAt: jwt.NewNumericDate(time.Now().Add(testTokenTTL)),
+				},
+				TokenType: TokenTypeAccess,
+				SessionID: "s1",
+			})
+			token.Header[keyIDHeader] = tt.kid
+
+			signed, err := token.SignedString(tt.key)
+			require.NoError(t, err)
+
+			_, err = manager.ParseToken(signed, TokenTypeAccess)
+			assert.ErrorIs(t, err, authErrors.ErrInvalidToken)
+		})
+	}
+}
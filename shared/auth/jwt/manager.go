@@ -2,6 +2,8 @@ package jwt
 
 import (
 	"errors"
+	"fmt"
+	"slices"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -11,20 +13,56 @@ import (
 	"github.com/nastyazhadan/spot-order-grpc/shared/models"
 )
 
+const (
+	keyIDHeader         = "kid"
+	apiKeySessionPrefix = "api_key:"
+)
+
+var asymmetricAlgorithms = []string{AlgorithmRS256, AlgorithmEdDSA}
+
 type Manager struct {
-	secret     []byte
+	signer     *signingKey
+	resolver   KeyResolver
+	algorithms []string
 	accessTTL  time.Duration
 	refreshTTL time.Duration
 }
 
+// NewManager — HS256 с общим секретом: подписать токен может любой проверяющий сервис.
+// Оставлен для локальной разработки.
 func NewManager(secret string, accessTTL, refreshTTL time.Duration) *Manager {
+	key := []byte(secret)
+
 	return &Manager{
-		secret:     []byte(secret),
+		signer:     &signingKey{method: jwt.SigningMethodHS256, key: key},
+		resolver:   secretResolver{key: key},
+		algorithms: []string{AlgorithmHS256},
 		accessTTL:  accessTTL,
 		refreshTTL: refreshTTL,
 	}
 }
 
+// NewKeySetManager подписывает активным ключом набора и принимает подписи любого его ключа.
+func NewKeySetManager(keys *KeySet, accessTTL, refreshTTL time.Duration) *Manager {
+	active := keys.active
+
+	return &Manager{
+		signer:     &active,
+		resolver:   keys,
+		algorithms: asymmetricAlgorithms,
+		accessTTL:  accessTTL,
+		refreshTTL: refreshTTL,
+	}
+}
+
+// NewVerifier только проверяет токены ключами resolver, например полученными по JWKS.
+func NewVerifier(resolver KeyResolver) *Manager {
+	return &Manager{
+		resolver:   resolver,
+		algorithms: asymmetricAlgorithms,
+	}
+}
+
 func (m *Manager) GenerateAccessToken(userID uuid.UUID, roles []models.UserRole, sessionID string) (string, error) {
 	now := time.Now()
 
@@ -44,9 +82,74 @@ func (m *Manager) GenerateAccessToken(userID uuid.UUID, roles []models.UserRole,
 		UserRoles: userRoles,
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	signed, err := m.sign(claims)
+	if err != nil {
+		return "", authErrors.ErrSignAccessTokenFailed
+	}
+
+	return signed, nil
+}
+
+// GenerateServiceToken выпускает токен сервиса с единственной ролью ROLE_SERVICE.
+// Сессии у токена нет; order-service токены типа service не принимает
+func (m *Manager) GenerateServiceToken(service string, ttl time.Duration) (string, time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(ttl)
+
+	claims := &Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   ServiceUserID(service).String(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+		TokenType: TokenTypeService,
+		SessionID: serviceSessionPrefix + service,
+		UserRoles: []string{models.UserRoleService.String()},
+		Service:   service,
+	}
 
-	signed, err := token.SignedString(m.secret)
+	signed, err := m.sign(claims)
+	if err != nil {
+		return "", time.Time{}, authErrors.ErrSignAccessTokenFailed
+	}
+
+	return signed, expiresAt, nil
+}
+
+// GenerateAPIKeyToken выпускает токен для проброса в spot-service запроса, подписанного API-ключом keyID.
+// Scope ключа едут в токене: spot-service ограничивает ими права ролей владельца так же, как order-service
+func (m *Manager) GenerateAPIKeyToken(
+	userID uuid.UUID,
+	roles []models.UserRole,
+	scopes []models.Permission,
+	keyID uuid.UUID,
+	ttl time.Duration,
+) (string, error) {
+	now := time.Now()
+
+	userRoles, err := UserRolesToClaims(roles)
+	if err != nil {
+		return "", err
+	}
+
+	scopeClaims, err := ScopesToClaims(scopes)
+	if err != nil {
+		return "", err
+	}
+
+	claims := &Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   userID.String(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+		TokenType: TokenTypeAPIKey,
+		SessionID: apiKeySessionPrefix + keyID.String(),
+		UserRoles: userRoles,
+		Scopes:    scopeClaims,
+	}
+
+	signed, err := m.sign(claims)
 	if err != nil {
 		return "", authErrors.ErrSignAccessTokenFailed
 	}
@@ -74,9 +177,7 @@ func (m *Manager) GenerateRefreshToken(userID uuid.UUID, roles []models.UserRole
 		UserRoles: userRoles,
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-
-	signed, err := token.SignedString(m.secret)
+	signed, err := m.sign(claims)
 	if err != nil {
 		return "", authErrors.ErrSignRefreshTokenFailed
 	}
@@ -84,27 +185,30 @@ func (m *Manager) GenerateRefreshToken(userID uuid.UUID, roles []models.UserRole
 	return signed, nil
 }
 
-func (m *Manager) ParseToken(tokenString string, expectedType TokenType) (*Claims, error) {
+// ParseToken проверяет подпись и срок токена; тип токена должен быть одним из expectedTypes.
+func (m *Manager) ParseToken(tokenString string, expectedTypes ...TokenType) (*Claims, error) {
 	claims := &Claims{}
 
 	token, err := jwt.ParseWithClaims(
 		tokenString,
 		claims,
-		func(token *jwt.Token) (any, error) {
-			return m.secret, nil
-		},
-		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		m.verificationKey,
+		jwt.WithValidMethods(m.algorithms),
 	)
 
 	if errors.Is(err, jwt.ErrTokenExpired) {
 		return nil, authErrors.ErrTokenExpired
 	}
 
+	if errors.Is(err, ErrKeySetUnavailable) {
+		return nil, authErrors.ErrSigningKeysUnavailable
+	}
+
 	if err != nil || token == nil || !token.Valid {
 		return nil, authErrors.ErrInvalidToken
 	}
 
-	if claims.TokenType != expectedType {
+	if !slices.Contains(expectedTypes, claims.TokenType) {
 		return nil, authErrors.ErrInvalidTokenType
 	}
 
@@ -118,3 +222,41 @@ func (m *Manager) ParseToken(tokenString string, expectedType TokenType) (*Claim
 
 	return claims, nil
 }
+
+func (m *Manager) sign(claims *Claims) (string, error) {
+	if m.signer == nil {
+		return "", errors.New("token signing is not configured")
+	}
+
+	token := jwt.NewWithClaims(m.signer.method, claims)
+	if m.signer.id != "" {
+		token.Header[keyIDHeader] = m.signer.id
+	}
+
+	return token.SignedString(m.signer.key)
+}
+
+// verificationKey выбирает ключ по kid; алгоритм токена обязан совпадать с алгоритмом ключа,
+// иначе публичный ключ можно было бы подставить как HMAC-секрет.
+func (m *Manager) verificationKey(token *jwt.Token) (any, error) {
+	kid, _ := token.Header[keyIDHeader].(string)
+
+	key, err := m.resolver.ResolveKey(kid)
+	if err != nil {
+		return nil, err
+	}
+
+	if token.Method.Alg() != key.Method.Alg() {
+		return nil, fmt.Errorf("token algorithm %s does not match key %q", token.Method.Alg(), kid)
+	}
+
+	return key.Key, nil
+}
+
+type secretResolver struct {
+	key []byte
+}
+
+func (r secretResolver) ResolveKey(string) (VerificationKey, error) {
+	return VerificationKey{Method: jwt.SigningMethodHS256, Key: r.key}, nil
+}
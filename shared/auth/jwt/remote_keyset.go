@@ -0,0 +1,167 @@
+package jwt
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	"github.com/nastyazhadan/spot-order-grpc/shared/metrics"
+)
+
+const (
+	// Неизвестный kid перезапрашивает JWKS не чаще этого интервала,
+	// чтобы поток токенов с мусорным kid не превратился в поток запросов к издателю
+	minJWKSRefetchInterval = 30 * time.Second
+	maxJWKSBodyBytes       = 1 << 20
+	jwksFetchKey           = "jwks"
+)
+
+var ErrKeySetUnavailable = errors.New("jwks unavailable")
+
+// RemoteKeySet — ключи проверки, загружаемые с JWKS endpoint издателя.
+// Набор кэшируется на refreshInterval; при ошибке загрузки продолжают действовать
+// ранее полученные ключи. Загрузка идёт без блокировки: одновременные запросы
+// ждут одну общую загрузку, остальные проверки токенов читают текущий набор.
+type RemoteKeySet struct {
+	url             string
+	client          *http.Client
+	requestTimeout  time.Duration
+	refreshInterval time.Duration
+	serviceName     string
+	fetches         singleflight.Group
+
+	mu          sync.Mutex
+	keys        map[string]VerificationKey
+	fetchedAt   time.Time
+	attemptedAt time.Time
+}
+
+func NewRemoteKeySet(
+	url string,
+	refreshInterval, requestTimeout time.Duration,
+	serviceName string,
+) *RemoteKeySet {
+	return &RemoteKeySet{
+		url:             url,
+		client:          &http.Client{Timeout: requestTimeout},
+		requestTimeout:  requestTimeout,
+		refreshInterval: refreshInterval,
+		serviceName:     serviceName,
+	}
+}
+
+func (r *RemoteKeySet) ResolveKey(kid string) (VerificationKey, error) {
+	key, found, fresh := r.lookup(kid)
+	if found && fresh {
+		return key, nil
+	}
+
+	_, err, _ := r.fetches.Do(jwksFetchKey, func() (any, error) {
+		return nil, r.refresh()
+	})
+
+	key, found, _ = r.lookup(kid)
+	if found {
+		return key, nil
+	}
+
+	r.mu.Lock()
+	loaded := r.keys != nil
+	r.mu.Unlock()
+
+	switch {
+	case loaded:
+		return VerificationKey{}, ErrUnknownSigningKey
+	case err != nil:
+		return VerificationKey{}, fmt.Errorf("%w: %w", ErrKeySetUnavailable, err)
+	default:
+		return VerificationKey{}, ErrKeySetUnavailable
+	}
+}
+
+func (r *RemoteKeySet) lookup(kid string) (key VerificationKey, found, fresh bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key, found = r.keys[kid]
+	return key, found, time.Since(r.fetchedAt) < r.refreshInterval
+}
+
+// refresh загружает набор, если с прошлой попытки прошло не меньше minJWKSRefetchInterval.
+// Под блокировкой только проверка интервала и замена набора.
+func (r *RemoteKeySet) refresh() error {
+	r.mu.Lock()
+	if !r.attemptedAt.IsZero() && time.Since(r.attemptedAt) < minJWKSRefetchInterval {
+		r.mu.Unlock()
+		return nil
+	}
+	r.attemptedAt = time.Now()
+	r.mu.Unlock()
+
+	keys, err := r.fetch()
+	if err != nil {
+		metrics.JWKSFetchesTotal.WithLabelValues(r.serviceName, "error").Inc()
+		return err
+	}
+
+	metrics.JWKSFetchesTotal.WithLabelValues(r.serviceName, "success").Inc()
+
+	r.mu.Lock()
+	r.keys = keys
+	r.fetchedAt = time.Now()
+	r.mu.Unlock()
+
+	return nil
+}
+
+func (r *RemoteKeySet) fetch() (map[string]VerificationKey, error) {
+	// Загрузка общая для всех ждущих проверок, поэтому не привязана к контексту одной из них
+	ctx, cancel := context.WithTimeout(context.Background(), r.requestTimeout)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, r.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("build jwks request: %w", err)
+	}
+
+	response, err := r.client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("get %s: %w", r.url, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("get %s: unexpected status %d", r.url, response.StatusCode)
+	}
+
+	var set JWKS
+	if err = json.NewDecoder(io.LimitReader(response.Body, maxJWKSBodyBytes)).Decode(&set); err != nil {
+		return nil, fmt.Errorf("decode jwks: %w", err)
+	}
+
+	keys := make(map[string]VerificationKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.KeyID == "" {
+			continue
+		}
+
+		key, convertErr := jwkToVerificationKey(jwk)
+		if convertErr != nil {
+			return nil, fmt.Errorf("jwk %q: %w", jwk.KeyID, convertErr)
+		}
+		keys[jwk.KeyID] = key
+	}
+
+	if len(keys) == 0 {
+		return nil, errors.New("jwks contains no usable keys")
+	}
+
+	return keys, nil
+}
@@ -0,0 +1,221 @@
+package jwt
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+)
+
+// jwksServer отдаёт JWKS текущего набора ключей и считает запросы.
+type jwksServer struct {
+	*httptest.Server
+	handler  atomic.Pointer[http.Handler]
+	requests atomic.Int32
+	// Закрывается, чтобы отпустить запросы; nil — отвечать сразу
+	release chan struct{}
+}
+
+func newJWKSServer(t *testing.T, keys *KeySet) *jwksServer {
+	t.Helper()
+
+	server := &jwksServer{}
+	server.serve(t, keys)
+	server.Server = httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		server.requests.Add(1)
+		if server.release != nil {
+			<-server.release
+		}
+		(*server.handler.Load()).ServeHTTP(writer, request)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func (s *jwksServer) serve(t *testing.T, keys *KeySet) {
+	t.Helper()
+
+	handler, err := JWKSHandler(keys)
+	require.NoError(t, err)
+	s.handler.Store(&handler)
+}
+
+func newTestRemoteKeySet(url string) *RemoteKeySet {
+	return NewRemoteKeySet(url, time.Hour, time.Second, "spot-service")
+}
+
+// allowRefetch снимает ограничение частоты повторных загрузок
+func allowRefetch(keys *RemoteKeySet) {
+	keys.mu.Lock()
+	keys.attemptedAt = time.Time{}
+	keys.mu.Unlock()
+}
+
+func TestRemoteKeySetResolveKey(t *testing.T) {
+	dir := t.TempDir()
+	writeEd25519Keys(t, dir, "k1")
+	server := newJWKSServer(t, loadTestKeySet(t, dir, "k1"))
+	remote := newTestRemoteKeySet(server.URL)
+
+	key, err := remote.ResolveKey("k1")
+	require.NoError(t, err)
+	assert.Equal(t, AlgorithmEdDSA, key.Method.Alg())
+
+	// Набор свежий — повторная проверка не ходит к издателю
+	_, err = remote.ResolveKey("k1")
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), server.requests.Load())
+
+	// Неизвестный kid перезапрашивает JWKS не чаще minJWKSRefetchInterval
+	for range 3 {
+		_, err = remote.ResolveKey("unknown")
+		assert.ErrorIs(t, err, ErrUnknownSigningKey)
+	}
+	assert.Equal(t, int32(1), server.requests.Load())
+
+	allowRefetch(remote)
+	_, err = remote.ResolveKey("unknown")
+	assert.ErrorIs(t, err, ErrUnknownSigningKey)
+	assert.Equal(t, int32(2), server.requests.Load())
+}
+
+func TestRemoteKeySetRotation(t *testing.T) {
+	dir := t.TempDir()
+	writeEd25519Keys(t, dir, "old")
+	server := newJWKSServer(t, loadTestKeySet(t, dir, "old"))
+	verifier := NewVerifier(newTestRemoteKeySet(server.URL))
+
+	oldIssuer := NewKeySetManager(loadTestKeySet(t, dir, "old"), testTokenTTL, testTokenTTL)
+	oldToken, err := oldIssuer.GenerateAccessToken(uuid.New(), []models.UserRole{models.UserRoleUser}, "s1")
+	require.NoError(t, err)
+
+	_, err = verifier.ParseToken(oldToken, TokenTypeAccess)
+	require.NoError(t, err)
+
+	// Издатель перешёл на новый ключ: первый токен с новым kid подгружает JWKS заново
+	writeEd25519Keys(t, dir, "new")
+	rotated := loadTestKeySet(t, dir, "new")
+	server.serve(t, rotated)
+	allowRefetch(verifier.resolver.(*RemoteKeySet))
+
+	newToken, err := NewKeySetManager(rotated, testTokenTTL, testTokenTTL).
+		GenerateAccessToken(uuid.New(), []models.UserRole{models.UserRoleUser}, "s1")
+	require.NoError(t, err)
+
+	for _, token := range []string{newToken, oldToken} {
+		_, err = verifier.ParseToken(token, TokenTypeAccess)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(2), server.requests.Load())
+}
+
+func TestRemoteKeySetUnavailable(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+	}{
+		{
+			name:    "издатель отвечает ошибкой",
+			handler: func(writer http.ResponseWriter, _ *http.Request) { writer.WriteHeader(http.StatusBadGateway) },
+		},
+		{
+			name: "в наборе нет поддерживаемых ключей",
+			handler: func(writer http.ResponseWriter, _ *http.Request) {
+				_, _ = writer.Write([]byte(`{"keys":[{"kty":"oct","kid":"k1","alg":"HS256"}]}`))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(tt.handler)
+			t.Cleanup(server.Close)
+
+			_, err := newTestRemoteKeySet(server.URL).ResolveKey("k1")
+			assert.ErrorIs(t, err, ErrKeySetUnavailable)
+		})
+	}
+}
+
+func TestRemoteKeySetKeepsKeysOnFetchError(t *testing.T) {
+	dir := t.TempDir()
+	writeEd25519Keys(t, dir, "k1")
+	server := newJWKSServer(t, loadTestKeySet(t, dir, "k1"))
+	remote := newTestRemoteKeySet(server.URL)
+
+	_, err := remote.ResolveKey("k1")
+	require.NoError(t, err)
+
+	var failing http.Handler = http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		writer.WriteHeader(http.StatusInternalServerError)
+	})
+	server.handler.Store(&failing)
+	remote.fetchedAt = time.Time{}
+	allowRefetch(remote)
+
+	_, err = remote.ResolveKey("k1")
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), server.requests.Load())
+}
+
+func TestRemoteKeySetSharesConcurrentFetch(t *testing.T) {
+	dir := t.TempDir()
+	writeEd25519Keys(t, dir, "k1")
+	server := newJWKSServer(t, loadTestKeySet(t, dir, "k1"))
+	server.release = make(chan struct{})
+	remote := newTestRemoteKeySet(server.URL)
+
+	const callers = 8
+	var wg sync.WaitGroup
+	errs := make(chan error, callers)
+	for range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := remote.ResolveKey("k1")
+			errs <- err
+		}()
+	}
+
+	// Пока загрузка висит, блокировка набора свободна
+	require.Eventually(t, func() bool { return server.requests.Load() == 1 }, time.Second, time.Millisecond)
+	_, found, _ := remote.lookup("k1")
+	assert.False(t, found)
+
+	close(server.release)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(1), server.requests.Load())
+}
+
+func TestRemoteKeySetRequestTimeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		<-release
+	}))
+	t.Cleanup(func() {
+		close(release)
+		server.Close()
+	})
+
+	remote := NewRemoteKeySet(server.URL, time.Hour, 50*time.Millisecond, "spot-service")
+
+	started := time.Now()
+	_, err := remote.ResolveKey("k1")
+
+	assert.ErrorIs(t, err, ErrKeySetUnavailable)
+	assert.Less(t, time.Since(started), time.Second)
+}
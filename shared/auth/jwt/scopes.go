@@ -0,0 +1,38 @@
+package jwt
+
+import (
+	authErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/service"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+)
+
+// ParseScopesClaims разбирает claim scopes. Пустой claim — токен без ограничения scope
+func ParseScopesClaims(rawScopes []string) ([]models.Permission, error) {
+	if len(rawScopes) == 0 {
+		return nil, nil
+	}
+
+	out := make([]models.Permission, 0, len(rawScopes))
+	for _, raw := range rawScopes {
+		scope, ok := models.ParsePermission(raw)
+		if !ok {
+			return nil, authErrors.ErrInvalidTokenScopes
+		}
+		out = append(out, scope)
+	}
+
+	return out, nil
+}
+
+// ScopesToClaims не допускает пустой набор: токен без scope получил бы все права ролей
+func ScopesToClaims(scopes []models.Permission) ([]string, error) {
+	if len(scopes) == 0 {
+		return nil, authErrors.ErrBuildTokenClaimsFailed
+	}
+
+	out := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		out = append(out, string(scope))
+	}
+
+	return out, nil
+}
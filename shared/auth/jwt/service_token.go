@@ -0,0 +1,59 @@
+package jwt
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const serviceSessionPrefix = "service:"
+
+// ServiceUserID — постоянный sub токенов сервиса, по нему сервис различим в логах и market_access
+func ServiceUserID(service string) uuid.UUID {
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte("service/"+service))
+}
+
+type ServiceTokenIssuer interface {
+	GenerateServiceToken(service string, ttl time.Duration) (string, time.Time, error)
+}
+
+// ServiceTokenSource хранит токен сервиса и перевыпускает его, когда прошло 80% срока жизни,
+// чтобы запрос не ушёл в spot с токеном, истекающим в пути
+type ServiceTokenSource struct {
+	issuer  ServiceTokenIssuer
+	service string
+	ttl     time.Duration
+
+	mu      sync.Mutex
+	token   string
+	renewAt time.Time
+}
+
+func NewServiceTokenSource(issuer ServiceTokenIssuer, service string, ttl time.Duration) *ServiceTokenSource {
+	return &ServiceTokenSource{
+		issuer:  issuer,
+		service: service,
+		ttl:     ttl,
+	}
+}
+
+func (s *ServiceTokenSource) ServiceToken() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if s.token != "" && now.Before(s.renewAt) {
+		return s.token, nil
+	}
+
+	token, expiresAt, err := s.issuer.GenerateServiceToken(s.service, s.ttl)
+	if err != nil {
+		return "", err
+	}
+
+	s.token = token
+	s.renewAt = now.Add(expiresAt.Sub(now) * 4 / 5)
+
+	return token, nil
+}
@@ -0,0 +1,53 @@
+package password
+
+import (
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// MaxBytes — bcrypt учитывает только первые 72 байта пароля, длиннее не принимаем.
+const MaxBytes = 72
+
+var ErrPasswordTooLong = errors.New("password exceeds 72 bytes")
+
+// dummyHash сравнивается с паролем, когда пользователь не найден,
+// чтобы время ответа Login не выдавало существование учётной записи.
+var dummyHash = mustHash("spot-order-dummy-password")
+
+func Hash(password string) (string, error) {
+	if len(password) > MaxBytes {
+		return "", ErrPasswordTooLong
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", fmt.Errorf("hash password: %w", err)
+	}
+
+	return string(hash), nil
+}
+
+func Verify(hash, password string) bool {
+	if len(password) > MaxBytes {
+		return false
+	}
+
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
+
+// VerifyDummy тратит столько же времени, сколько Verify, и всегда возвращает false.
+func VerifyDummy(password string) bool {
+	_ = bcrypt.CompareHashAndPassword(dummyHash, []byte(password))
+	return false
+}
+
+func mustHash(password string) []byte {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		panic(err)
+	}
+
+	return hash
+}
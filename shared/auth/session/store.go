@@ -2,16 +2,17 @@ package session
 
 import (
 	"context"
-	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
 
-	sharedErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors"
 	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/cache"
 )
 
-const sessionPrefix = "auth_session"
+const (
+	sessionPrefix      = "auth_session"
+	sessionIndexPrefix = "auth_sessions"
+)
 
 type Store struct {
 	store *cache.Store
@@ -26,17 +27,35 @@ func (s *Store) IsSessionActive(
 	userID uuid.UUID,
 	sessionID string,
 ) (bool, error) {
-	raw, err := s.store.Get(ctx, SessionKey(userID))
-	if errors.Is(err, sharedErrors.ErrCacheNotFound) {
+	if sessionID == "" {
 		return false, nil
 	}
+
+	active, err := s.store.Exists(ctx, SessionKey(userID, sessionID))
 	if err != nil {
-		return false, fmt.Errorf("get active session: %w", err)
+		return false, fmt.Errorf("check active session: %w", err)
 	}
 
-	return string(raw) == sessionID, nil
+	return active, nil
+}
+
+// SessionKey — hash с метаданными сессии и ключом её текущего refresh token.
+func SessionKey(userID uuid.UUID, sessionID string) string {
+	return SessionKeyPrefix(userID) + sessionID
+}
+
+func SessionKeyPrefix(userID uuid.UUID) string {
+	return fmt.Sprintf("%s:%s:", sessionPrefix, UserHashTag(userID))
+}
+
+// SessionIndexKey — sorted set session_id пользователя по времени создания.
+func SessionIndexKey(userID uuid.UUID) string {
+	return fmt.Sprintf("%s:%s", sessionIndexPrefix, UserHashTag(userID))
 }
 
-func SessionKey(userID uuid.UUID) string {
-	return fmt.Sprintf("%s:%s", sessionPrefix, userID)
+// UserHashTag — hash tag Redis Cluster: все ключи сессий пользователя попадают в один слот.
+// Lua-скрипты сессий получают часть ключей не через KEYS, а по префиксу и из полей hash,
+// и без общего слота такие скрипты в кластере не выполнятся.
+func UserHashTag(userID uuid.UUID) string {
+	return "{" + userID.String() + "}"
 }
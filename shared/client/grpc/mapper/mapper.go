@@ -33,14 +33,77 @@ func MarketFromProto(market *proto.Market) (models.Market, error) {
 	}
 
 	return models.Market{
-		ID:        id,
-		Name:      market.GetName(),
-		Enabled:   market.GetEnabled(),
-		DeletedAt: deletedAt,
-		UpdatedAt: updatedAt,
+		ID:         id,
+		Name:       market.GetName(),
+		BaseAsset:  market.GetBaseAsset(),
+		QuoteAsset: market.GetQuoteAsset(),
+		Enabled:    market.GetEnabled(),
+		DeletedAt:  deletedAt,
+		UpdatedAt:  updatedAt,
+		Version:    market.GetVersion(),
+		Restricted: market.GetRestricted(),
+
+		PublicToAllRoles: market.GetPublicToAllRoles(),
 	}, nil
 }
 
+func MarketLookupFromProto(result *proto.MarketLookupResult) (models.MarketLookup, error) {
+	if result == nil {
+		return models.MarketLookup{}, errors.New("proto market lookup result is nil")
+	}
+
+	id, err := uuid.Parse(result.GetMarketId())
+	if err != nil {
+		return models.MarketLookup{}, fmt.Errorf("invalid market id %q: %w", result.GetMarketId(), err)
+	}
+
+	lookup := models.MarketLookup{ID: id}
+
+	switch result.GetStatus() {
+	case proto.MarketLookupStatus_MARKET_LOOKUP_STATUS_FOUND:
+		market, mapError := MarketFromProto(result.GetMarket())
+		if mapError != nil {
+			return models.MarketLookup{}, mapError
+		}
+		lookup.Status = models.MarketLookupStatusFound
+		lookup.Market = market
+	case proto.MarketLookupStatus_MARKET_LOOKUP_STATUS_NOT_FOUND:
+		lookup.Status = models.MarketLookupStatusNotFound
+	case proto.MarketLookupStatus_MARKET_LOOKUP_STATUS_DISABLED:
+		lookup.Status = models.MarketLookupStatusDisabled
+	default:
+		return models.MarketLookup{}, fmt.Errorf("unexpected market lookup status %s for market %s", result.GetStatus(), id)
+	}
+
+	return lookup, nil
+}
+
+func MarketFilterToProto(filter models.MarketFilter) *proto.MarketFilter {
+	if filter.IsEmpty() {
+		return nil
+	}
+
+	return &proto.MarketFilter{
+		NamePrefix: filter.NamePrefix,
+		BaseAsset:  filter.BaseAsset,
+		QuoteAsset: filter.QuoteAsset,
+		Status:     marketStatusToProto(filter.Status),
+	}
+}
+
+func marketStatusToProto(status models.MarketStatus) proto.MarketStatus {
+	switch status {
+	case models.MarketStatusEnabled:
+		return proto.MarketStatus_MARKET_STATUS_ENABLED
+	case models.MarketStatusDisabled:
+		return proto.MarketStatus_MARKET_STATUS_DISABLED
+	case models.MarketStatusDeleted:
+		return proto.MarketStatus_MARKET_STATUS_DELETED
+	default:
+		return proto.MarketStatus_MARKET_STATUS_UNSPECIFIED
+	}
+}
+
 func requiredTimestampUTC(field string, timestamp *timestamppb.Timestamp) (time.Time, error) {
 	if timestamp == nil {
 		return time.Time{}, fmt.Errorf("%s is required", field)
@@ -23,29 +23,36 @@ import (
 )
 
 type SpotClient struct {
-	api                  proto.SpotInstrumentServiceClient
-	viewMarketsBreaker   *gobreaker.CircuitBreaker[*proto.ViewMarketsResponse]
-	getMarketByIDBreaker *gobreaker.CircuitBreaker[*proto.GetMarketByIDResponse]
-	logger               *zapLogger.Logger
+	api                      proto.SpotInstrumentServiceClient
+	viewMarketsBreaker       *gobreaker.CircuitBreaker[*proto.ViewMarketsResponse]
+	getMarketByIDBreaker     *gobreaker.CircuitBreaker[*proto.GetMarketByIDResponse]
+	getMarketsByIDsBreaker   *gobreaker.CircuitBreaker[*proto.GetMarketsByIDsResponse]
+	getMarketBySymbolBreaker *gobreaker.CircuitBreaker[*proto.GetMarketBySymbolResponse]
+	logger                   *zapLogger.Logger
 }
 
 func NewSpotClient(connection *grpc.ClientConn, cfg config.CircuitBreakerConfig, logger *zapLogger.Logger) *SpotClient {
 	return &SpotClient{
-		api:                  proto.NewSpotInstrumentServiceClient(connection),
-		viewMarketsBreaker:   breaker.New[*proto.ViewMarketsResponse]("spotService.ViewMarkets", cfg, logger),
-		getMarketByIDBreaker: breaker.New[*proto.GetMarketByIDResponse]("spotService.GetMarketByID", cfg, logger),
-		logger:               logger,
+		api:                      proto.NewSpotInstrumentServiceClient(connection),
+		viewMarketsBreaker:       breaker.New[*proto.ViewMarketsResponse]("spotService.ViewMarkets", cfg, logger),
+		getMarketByIDBreaker:     breaker.New[*proto.GetMarketByIDResponse]("spotService.GetMarketByID", cfg, logger),
+		getMarketsByIDsBreaker:   breaker.New[*proto.GetMarketsByIDsResponse]("spotService.GetMarketsByIDs", cfg, logger),
+		getMarketBySymbolBreaker: breaker.New[*proto.GetMarketBySymbolResponse]("spotService.GetMarketBySymbol", cfg, logger),
+		logger:                   logger,
 	}
 }
 
 func (c *SpotClient) ViewMarkets(
 	ctx context.Context,
-	limit, offset uint64,
-) ([]models.Market, uint64, bool, error) {
+	limit uint64,
+	pageToken string,
+	filter models.MarketFilter,
+) ([]models.Market, string, bool, error) {
 	response, err := c.viewMarketsBreaker.Execute(func() (*proto.ViewMarketsResponse, error) {
 		resp, callError := c.api.ViewMarkets(ctx, &proto.ViewMarketsRequest{
-			Limit:  limit,
-			Offset: offset,
+			Limit:     limit,
+			PageToken: pageToken,
+			Filter:    mapper.MarketFilterToProto(filter),
 		})
 		if callError != nil {
 			return nil, callError
@@ -53,21 +60,21 @@ func (c *SpotClient) ViewMarkets(
 		return resp, nil
 	})
 	if err != nil {
-		c.logViewMarketsBreakerError(ctx, err, limit, offset)
+		c.logViewMarketsBreakerError(ctx, err, limit, pageToken != "")
 
-		return nil, 0, false, mapViewMarketsError(err)
+		return nil, "", false, mapViewMarketsError(err)
 	}
 
 	out := make([]models.Market, 0, len(response.GetMarkets()))
 	for _, market := range response.GetMarkets() {
 		mappedMarket, mapError := mapper.MarketFromProto(market)
 		if mapError != nil {
-			return nil, 0, false, fmt.Errorf("map market from proto: %w", mapError)
+			return nil, "", false, fmt.Errorf("map market from proto: %w", mapError)
 		}
 		out = append(out, mappedMarket)
 	}
 
-	return out, response.GetNextOffset(), response.GetHasMore(), nil
+	return out, response.GetNextPageToken(), response.GetHasMore(), nil
 }
 
 func (c *SpotClient) GetMarketByID(
@@ -93,6 +100,63 @@ func (c *SpotClient) GetMarketByID(
 	return market, nil
 }
 
+func (c *SpotClient) GetMarketBySymbol(
+	ctx context.Context,
+	symbol string,
+) (models.Market, error) {
+	response, err := c.getMarketBySymbolBreaker.Execute(func() (*proto.GetMarketBySymbolResponse, error) {
+		return c.api.GetMarketBySymbol(ctx, &proto.GetMarketBySymbolRequest{
+			Symbol: symbol,
+		})
+	})
+	if err != nil {
+		c.logGetMarketBySymbolBreakerError(ctx, err, symbol)
+
+		return models.Market{}, mapGetMarketBySymbolError(err, symbol)
+	}
+
+	market, err := mapper.MarketFromProto(response.GetMarket())
+	if err != nil {
+		return models.Market{}, fmt.Errorf("map market from proto: %w", err)
+	}
+
+	return market, nil
+}
+
+// GetMarketsByIDs возвращает статус каждого id в порядке запроса.
+// Отсутствующие и выключенные рынки не являются ошибкой вызова.
+func (c *SpotClient) GetMarketsByIDs(
+	ctx context.Context,
+	ids []uuid.UUID,
+) ([]models.MarketLookup, error) {
+	marketIDs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		marketIDs = append(marketIDs, id.String())
+	}
+
+	response, err := c.getMarketsByIDsBreaker.Execute(func() (*proto.GetMarketsByIDsResponse, error) {
+		return c.api.GetMarketsByIDs(ctx, &proto.GetMarketsByIDsRequest{
+			MarketIds: marketIDs,
+		})
+	})
+	if err != nil {
+		c.logGetMarketsByIDsBreakerError(ctx, err, len(ids))
+
+		return nil, mapGetMarketsByIDsError(err)
+	}
+
+	out := make([]models.MarketLookup, 0, len(response.GetResults()))
+	for _, result := range response.GetResults() {
+		lookup, mapError := mapper.MarketLookupFromProto(result)
+		if mapError != nil {
+			return nil, fmt.Errorf("map market lookup from proto: %w", mapError)
+		}
+		out = append(out, lookup)
+	}
+
+	return out, nil
+}
+
 func mapViewMarketsError(err error) error {
 	if err == nil {
 		return nil
@@ -115,6 +179,8 @@ func mapViewMarketsError(err error) error {
 	switch stat.Code() {
 	case codes.NotFound:
 		return errors.Join(serviceErrors.ErrMarketsNotFound, err)
+	case codes.InvalidArgument:
+		return errors.Join(serviceErrors.ErrInvalidPagination, err)
 	case codes.Unavailable, codes.DeadlineExceeded:
 		return errors.Join(serviceErrors.ErrSpotUnavailable, err)
 	case codes.Unauthenticated:
@@ -169,14 +235,87 @@ func mapGetMarketByIDError(err error, marketID uuid.UUID) error {
 	}
 }
 
+func mapGetMarketBySymbolError(err error, symbol string) error {
+	if err == nil {
+		return nil
+	}
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		return context.Canceled
+	case errors.Is(err, context.DeadlineExceeded),
+		errors.Is(err, gobreaker.ErrOpenState),
+		errors.Is(err, gobreaker.ErrTooManyRequests):
+		return errors.Join(serviceErrors.ErrSpotUnavailable, err)
+	}
+
+	stat, ok := status.FromError(err)
+	if !ok {
+		return errors.Join(serviceErrors.ErrSpotInternalFailure, err)
+	}
+
+	switch stat.Code() {
+	case codes.NotFound, codes.InvalidArgument:
+		return errors.Join(sharedErrors.ErrMarketSymbolNotFound{Symbol: symbol}, err)
+	case codes.FailedPrecondition:
+		return errors.Join(serviceErrors.ErrMarketDisabled, err)
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return errors.Join(serviceErrors.ErrSpotUnavailable, err)
+	case codes.Unauthenticated:
+		return errors.Join(serviceErrors.ErrSpotUnauthenticated, err)
+	case codes.PermissionDenied:
+		return errors.Join(serviceErrors.ErrSpotPermissionDenied, err)
+	case codes.ResourceExhausted:
+		return errors.Join(serviceErrors.ErrSpotRateLimited, err)
+	default:
+		return errors.Join(serviceErrors.ErrSpotInternalFailure, err)
+	}
+}
+
+func mapGetMarketsByIDsError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		return context.Canceled
+	case errors.Is(err, context.DeadlineExceeded),
+		errors.Is(err, gobreaker.ErrOpenState),
+		errors.Is(err, gobreaker.ErrTooManyRequests):
+		return errors.Join(serviceErrors.ErrSpotUnavailable, err)
+	}
+
+	stat, ok := status.FromError(err)
+	if !ok {
+		return errors.Join(serviceErrors.ErrSpotInternalFailure, err)
+	}
+
+	switch stat.Code() {
+	case codes.InvalidArgument:
+		return errors.Join(serviceErrors.ErrInvalidMarketIDs, err)
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return errors.Join(serviceErrors.ErrSpotUnavailable, err)
+	case codes.Unauthenticated:
+		return errors.Join(serviceErrors.ErrSpotUnauthenticated, err)
+	case codes.PermissionDenied:
+		return errors.Join(serviceErrors.ErrSpotPermissionDenied, err)
+	case codes.ResourceExhausted:
+		return errors.Join(serviceErrors.ErrSpotRateLimited, err)
+	default:
+		return errors.Join(serviceErrors.ErrSpotInternalFailure, err)
+	}
+}
+
 func (c *SpotClient) logViewMarketsBreakerError(
 	ctx context.Context,
 	err error,
-	limit, offset uint64,
+	limit uint64,
+	hasPageToken bool,
 ) {
 	fields := []zap.Field{
 		zap.Uint64("limit", limit),
-		zap.Uint64("offset", offset),
+		zap.Bool("has_page_token", hasPageToken),
 		zap.Error(err),
 	}
 
@@ -217,3 +356,51 @@ func (c *SpotClient) logGetMarketByIDBreakerError(
 		c.logger.Warn(ctx, "SpotClient.GetMarketByID failed", fields...)
 	}
 }
+
+func (c *SpotClient) logGetMarketsByIDsBreakerError(
+	ctx context.Context,
+	err error,
+	idsCount int,
+) {
+	fields := []zap.Field{
+		zap.Int("market_ids_count", idsCount),
+		zap.Error(err),
+	}
+
+	switch {
+	case errors.Is(err, gobreaker.ErrOpenState):
+		c.logger.Warn(ctx, "SpotClient.GetMarketsByIDs skipped by open circuit breaker", fields...)
+	case errors.Is(err, gobreaker.ErrTooManyRequests):
+		c.logger.Warn(ctx, "SpotClient.GetMarketsByIDs rejected by half-open circuit breaker", fields...)
+	case errors.Is(err, context.DeadlineExceeded):
+		c.logger.Warn(ctx, "SpotClient.GetMarketsByIDs failed by deadline exceeded", fields...)
+	case errors.Is(err, context.Canceled):
+		c.logger.Info(ctx, "SpotClient.GetMarketsByIDs canceled", fields...)
+	default:
+		c.logger.Warn(ctx, "SpotClient.GetMarketsByIDs failed", fields...)
+	}
+}
+
+func (c *SpotClient) logGetMarketBySymbolBreakerError(
+	ctx context.Context,
+	err error,
+	symbol string,
+) {
+	fields := []zap.Field{
+		zap.String("market_symbol", symbol),
+		zap.Error(err),
+	}
+
+	switch {
+	case errors.Is(err, gobreaker.ErrOpenState):
+		c.logger.Warn(ctx, "SpotClient.GetMarketBySymbol skipped by open circuit breaker", fields...)
+	case errors.Is(err, gobreaker.ErrTooManyRequests):
+		c.logger.Warn(ctx, "SpotClient.GetMarketBySymbol rejected by half-open circuit breaker", fields...)
+	case errors.Is(err, context.DeadlineExceeded):
+		c.logger.Warn(ctx, "SpotClient.GetMarketBySymbol failed by deadline exceeded", fields...)
+	case errors.Is(err, context.Canceled):
+		c.logger.Info(ctx, "SpotClient.GetMarketBySymbol canceled", fields...)
+	default:
+		c.logger.Warn(ctx, "SpotClient.GetMarketBySymbol failed", fields...)
+	}
+}
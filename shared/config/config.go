@@ -23,6 +23,7 @@ type OrderConfig struct {
 	KeepAlive       KeepAliveConfig          `mapstructure:"keep_alive"`
 	Retry           RetryConfig              `mapstructure:"retry"`
 	Kafka           KafkaConfig              `mapstructure:"kafka"`
+	MarketReplica   MarketReplicaConfig      `mapstructure:"market_replica"`
 }
 
 type SpotConfig struct {
@@ -40,6 +41,11 @@ type SpotConfig struct {
 	KeepAlive     KeepAliveConfig         `mapstructure:"keep_alive"`
 	Kafka         KafkaConfig             `mapstructure:"kafka"`
 	MarketPoller  MarketPollerConfig      `mapstructure:"market_poller"`
+	MarketWatch   MarketWatchConfig       `mapstructure:"market_watch"`
+	LocalCache    LocalCacheConfig        `mapstructure:"local_cache"`
+	Visibility    MarketVisibilityConfig  `mapstructure:"visibility"`
+	Ticker        TickerConfig            `mapstructure:"ticker"`
+	Candles       CandlesConfig           `mapstructure:"candles"`
 }
 
 type ServiceConfig struct {
@@ -141,8 +147,10 @@ type ConsumerConfig struct {
 type TopicsConfig struct {
 	OrderCreated          string `mapstructure:"order_created"`
 	OrderStatusUpdated    string `mapstructure:"order_status_updated"`
+	OrderFilled           string `mapstructure:"order_filled"`
 	MarketStateChanged    string `mapstructure:"market_state_changed"`
 	MarketStateChangedDLQ string `mapstructure:"market_state_changed_dlq"`
+	AuthSecurity          string `mapstructure:"auth_security"`
 }
 
 type OutboxConfig struct {
@@ -154,10 +162,85 @@ type OutboxConfig struct {
 }
 
 type MarketPollerConfig struct {
-	PollInterval      time.Duration `mapstructure:"poll_interval"`
-	ProcessingTimeout time.Duration `mapstructure:"processing_timeout"`
-	BatchSize         int           `mapstructure:"batch_size"`
-	RestartBackoff    time.Duration `mapstructure:"restart_backoff"`
+	PollInterval      time.Duration        `mapstructure:"poll_interval"`
+	FallbackInterval  time.Duration        `mapstructure:"fallback_interval"`
+	ProcessingTimeout time.Duration        `mapstructure:"processing_timeout"`
+	BatchSize         int                  `mapstructure:"batch_size"`
+	RestartBackoff    time.Duration        `mapstructure:"restart_backoff"`
+	LeaderElection    LeaderElectionConfig `mapstructure:"leader_election"`
+}
+
+// LeaderElectionConfig — lease singleton-задачи в PostgreSQL.
+// Лидер продлевает lease раз в RenewInterval, остальные пробуют захватить его раз в RetryInterval.
+type LeaderElectionConfig struct {
+	LeaseTTL      time.Duration `mapstructure:"lease_ttl"`
+	RenewInterval time.Duration `mapstructure:"renew_interval"`
+	RetryInterval time.Duration `mapstructure:"retry_interval"`
+}
+
+// MarketWatchConfig: FeedChannel — канал Redis pub/sub, по которому лидер раздаёт
+// батчи поллера хабам WatchMarkets всех реплик. ChangeLogRetention — сколько обработанные
+// записи market_change_log хранятся для resume: курсор старше отклоняется.
+type MarketWatchConfig struct {
+	SubscriberBuffer   int           `mapstructure:"subscriber_buffer"`
+	MaxSubscribers     int           `mapstructure:"max_subscribers"`
+	PageSize           uint64        `mapstructure:"page_size"`
+	FeedChannel        string        `mapstructure:"feed_channel"`
+	FeedRestartBackoff time.Duration `mapstructure:"feed_restart_backoff"`
+	ChangeLogRetention time.Duration `mapstructure:"change_log_retention"`
+}
+
+// LocalCacheConfig — LRU рынков по id внутри процесса spot перед Redis.
+// InvalidationChannel — канал Redis pub/sub, по которому реплики сбрасывают изменённые рынки.
+type LocalCacheConfig struct {
+	Size                int           `mapstructure:"size"`
+	TTL                 time.Duration `mapstructure:"ttl"`
+	InvalidationChannel string        `mapstructure:"invalidation_channel"`
+	RestartBackoff      time.Duration `mapstructure:"restart_backoff"`
+}
+
+// MarketVisibilityConfig — политики видимости рынков в порядке приоритета.
+// Роли — ROLE_ADMIN/ROLE_VIEWER/ROLE_USER, статусы — enabled/disabled/deleted.
+type MarketVisibilityConfig struct {
+	Policies []MarketVisibilityPolicyConfig `mapstructure:"policies"`
+}
+
+type MarketVisibilityPolicyConfig struct {
+	ID          string   `mapstructure:"id"`
+	Roles       []string `mapstructure:"roles"`
+	Statuses    []string `mapstructure:"statuses"`
+	BaseAssets  []string `mapstructure:"base_assets"`
+	QuoteAssets []string `mapstructure:"quote_assets"`
+	// BypassAccessLists — restricted-рынки видны политике без записи в market_access
+	BypassAccessLists bool `mapstructure:"bypass_access_lists"`
+}
+
+// TickerConfig — скользящая статистика рынков в spot. Лидер читает исполнения из kafka.topics.order_filled
+// за последние Window, раз в FlushInterval пишет изменившиеся тикеры в Redis по ключу Key,
+// а раз в BucketSize (шаг сдвига окна) переписывает их все. SnapshotTTL — сколько тикеры
+// живут в Redis без лидера.
+type TickerConfig struct {
+	Window         time.Duration        `mapstructure:"window"`
+	BucketSize     time.Duration        `mapstructure:"bucket_size"`
+	FlushInterval  time.Duration        `mapstructure:"flush_interval"`
+	WatchInterval  time.Duration        `mapstructure:"watch_interval"`
+	Key            string               `mapstructure:"key"`
+	SnapshotTTL    time.Duration        `mapstructure:"snapshot_ttl"`
+	RestartBackoff time.Duration        `mapstructure:"restart_backoff"`
+	LeaderElection LeaderElectionConfig `mapstructure:"leader_election"`
+}
+
+type CandlesConfig struct {
+	DefaultLimit uint64 `mapstructure:"default_limit"`
+	MaxLimit     uint64 `mapstructure:"max_limit"`
+}
+
+// MarketReplicaConfig — локальная реплика рынков в orderService.
+// MaxLag — сколько реплика может не сверяться со spot, прежде чем CreateOrder пойдёт в spot напрямую.
+type MarketReplicaConfig struct {
+	ResyncInterval time.Duration `mapstructure:"resync_interval"`
+	MaxLag         time.Duration `mapstructure:"max_lag"`
+	PageSize       uint64        `mapstructure:"page_size"`
 }
 
 type RateLimiterByUserConfig struct {
@@ -170,6 +253,7 @@ type OrderGRPCRateLimitConfig struct {
 	CreateOrder    int `mapstructure:"create_order"`
 	GetOrderStatus int `mapstructure:"get_order_status"`
 	RefreshToken   int `mapstructure:"refresh_token"`
+	Login          int `mapstructure:"login"`
 }
 
 type SpotGRPCRateLimitConfig struct {
@@ -199,13 +283,54 @@ type KeepAliveConfig struct {
 }
 
 type AuthVerifierConfig struct {
-	JWTSecret   string   `mapstructure:"jwt_secret"`
-	SkipMethods []string `mapstructure:"skip_methods"`
+	JWTSecret   string     `mapstructure:"jwt_secret"`
+	SkipMethods []string   `mapstructure:"skip_methods"`
+	JWKS        JWKSConfig `mapstructure:"jwks"`
+}
+
+// JWKSConfig — проверка токенов по публичным ключам издателя. Пустой URL — HS256 с JWT_SECRET.
+type JWKSConfig struct {
+	URL             string        `mapstructure:"url"`
+	RefreshInterval time.Duration `mapstructure:"refresh_interval"`
+	RequestTimeout  time.Duration `mapstructure:"request_timeout"`
 }
 
 type AuthIssuerConfig struct {
 	AccessTokenTTL  time.Duration `mapstructure:"access_token_ttl"`
 	RefreshTokenTTL time.Duration `mapstructure:"refresh_token_ttl"`
+	MaxSessions     int           `mapstructure:"max_sessions"`
+	Login           LoginConfig   `mapstructure:"login"`
+	Signing         SigningConfig `mapstructure:"signing"`
+	APIKeys         APIKeysConfig `mapstructure:"api_keys"`
+	// ServiceTokenTTL — срок жизни токена, с которым сервис сам ходит в spot из фоновых задач
+	ServiceTokenTTL time.Duration `mapstructure:"service_token_ttl"`
+}
+
+// SigningConfig — алгоритм подписи токенов. Для RS256/EdDSA ключи лежат в keys_dir
+// файлами <kid>.pem, подписывает active_key_id; HS256 использует JWT_SECRET.
+type SigningConfig struct {
+	Algorithm   string `mapstructure:"algorithm"`
+	KeysDir     string `mapstructure:"keys_dir"`
+	ActiveKeyID string `mapstructure:"active_key_id"`
+}
+
+func (c SigningConfig) IsSymmetric() bool {
+	return c.Algorithm == "HS256"
+}
+
+// LoginConfig — блокировка входа после серии неудачных попыток по одному username.
+type LoginConfig struct {
+	MaxFailedAttempts int64         `mapstructure:"max_failed_attempts"`
+	Lockout           time.Duration `mapstructure:"lockout"`
+}
+
+// APIKeysConfig — ключи для подписи запросов HMAC. replay_window — допустимое
+// расхождение timestamp запроса с часами сервера в обе стороны.
+// Pepper задаётся через API_KEY_PEPPER, из него выводятся секреты ключей
+type APIKeysConfig struct {
+	ReplayWindow time.Duration `mapstructure:"replay_window"`
+	MaxPerUser   int           `mapstructure:"max_per_user"`
+	Pepper       string        `mapstructure:"pepper"`
 }
 
 type RetryConfig struct {
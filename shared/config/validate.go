@@ -508,3 +508,41 @@ func ValidateOutboxConfig(fieldPrefix string, cfg OutboxConfig) error {
 
 	return nil
 }
+
+func ValidateLeaderElectionConfig(fieldPrefix string, cfg LeaderElectionConfig) error {
+	if cfg.LeaseTTL <= 0 {
+		return fmt.Errorf(
+			"%s.lease_ttl must be greater than 0, got %s",
+			fieldPrefix,
+			cfg.LeaseTTL,
+		)
+	}
+
+	if cfg.RenewInterval <= 0 {
+		return fmt.Errorf(
+			"%s.renew_interval must be greater than 0, got %s",
+			fieldPrefix,
+			cfg.RenewInterval,
+		)
+	}
+
+	if cfg.RetryInterval <= 0 {
+		return fmt.Errorf(
+			"%s.retry_interval must be greater than 0, got %s",
+			fieldPrefix,
+			cfg.RetryInterval,
+		)
+	}
+
+	// Одно пропущенное продление не должно стоить лидерства
+	if cfg.LeaseTTL < 2*cfg.RenewInterval {
+		return fmt.Errorf(
+			"%s.lease_ttl (%s) must be at least twice renew_interval (%s)",
+			fieldPrefix,
+			cfg.LeaseTTL,
+			cfg.RenewInterval,
+		)
+	}
+
+	return nil
+}
@@ -9,9 +9,22 @@ import (
 var (
 	ErrOrderNotFound      = shared.ErrNotFound{}
 	ErrOrderAlreadyExists = shared.ErrAlreadyExists{}
+	ErrOrderNotActive     = errors.New("order is not active")
 	ErrMarketNotFound     = shared.ErrMarketNotFound{}
+	ErrAssetNotFound      = shared.ErrAssetNotFound{}
+	ErrAssetAlreadyExists = shared.ErrAssetAlreadyExists{}
 
 	ErrMarketStoreIsEmpty   = errors.New("market store is empty")
 	ErrMarketsNotFound      = errors.New("markets cache not found")
 	ErrMarketCacheCorrupted = errors.New("market cache corrupted")
+	ErrLeaseLost            = errors.New("leader lease lost")
+
+	ErrUserNotFound      = errors.New("user not found")
+	ErrUserAlreadyExists = errors.New("user already exists")
+	ErrUserRolesRequired = errors.New("user must have at least one role")
+
+	ErrRefreshTokenReused = errors.New("refresh token reused")
+
+	ErrAPIKeyNotFound      = errors.New("api key not found")
+	ErrAPIKeyLimitExceeded = errors.New("active api key limit exceeded")
 )
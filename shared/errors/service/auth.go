@@ -15,13 +15,38 @@ var (
 	ErrSignAccessTokenFailed   = errors.New("failed to sign access token")
 	ErrBuildTokenClaimsFailed  = errors.New("failed to build token claims")
 	ErrSessionValidationFailed = errors.New("failed to validate session")
+	ErrSigningKeysUnavailable  = errors.New("token signing keys unavailable")
 
 	ErrMissingUserRoles     = errors.New("user_roles not found in token")
 	ErrInvalidUserRoles     = errors.New("invalid user_roles in token")
 	ErrInvalidUserIDInToken = errors.New("invalid user_id in token")
+	ErrInvalidTokenScopes   = errors.New("invalid scopes in token")
 
 	ErrInvalidJTI             = errors.New("invalid refresh token jti")
 	ErrTokenRevoked           = errors.New("refresh token revoked or not found")
 	ErrSaveTokenFailed        = errors.New("failed to save refresh token")
+	ErrRevokeTokenFailed      = errors.New("failed to revoke session")
+	ErrSessionRevoked         = errors.New("session revoked")
+	ErrRefreshTokenReused     = errors.New("refresh token reuse detected")
+	ErrSessionNotFound        = errors.New("session not found")
 	ErrSignRefreshTokenFailed = errors.New("failed to sign refresh token")
+
+	ErrInvalidCredentials = errors.New("invalid username or password")
+	ErrUserDisabled       = errors.New("user is disabled")
+	ErrLoginLocked        = errors.New("too many failed login attempts")
+	ErrLoginFailed        = errors.New("failed to process login")
+
+	ErrUserNotFound      = errors.New("user not found")
+	ErrUserAlreadyExists = errors.New("user already exists")
+	ErrInvalidUsername   = errors.New("invalid username")
+	ErrRoleNotAssignable = errors.New("role cannot be assigned to user")
+	ErrUserRolesRequired = errors.New("user must have at least one role")
+
+	ErrInvalidAPIKey          = errors.New("invalid api key or signature")
+	ErrAPIKeyRequestExpired   = errors.New("api key request timestamp outside allowed window")
+	ErrAPIKeyRequestReplayed  = errors.New("api key request replayed")
+	ErrAPIKeyIPNotAllowed     = errors.New("api key is not allowed from this address")
+	ErrAPIKeyNotFound         = errors.New("api key not found")
+	ErrAPIKeyLimitExceeded    = errors.New("api key limit exceeded")
+	ErrAPIKeyValidationFailed = errors.New("failed to validate api key")
 )
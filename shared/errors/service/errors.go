@@ -10,9 +10,12 @@ import (
 )
 
 var (
-	ErrOrderNotFound      = shared.ErrNotFound{}
-	ErrOrderAlreadyExists = shared.ErrAlreadyExists{}
-	ErrMarketNotFound     = shared.ErrMarketNotFound{}
+	ErrOrderNotFound        = shared.ErrNotFound{}
+	ErrOrderAlreadyExists   = shared.ErrAlreadyExists{}
+	ErrMarketNotFound       = shared.ErrMarketNotFound{}
+	ErrMarketSymbolNotFound = shared.ErrMarketSymbolNotFound{}
+	ErrAssetNotFound        = shared.ErrAssetNotFound{}
+	ErrAssetAlreadyExists   = shared.ErrAssetAlreadyExists{}
 
 	ErrRateLimitExceeded = ErrLimitExceeded{}
 	ErrMarketUnavailable = ErrUnavailable{}
@@ -22,10 +25,23 @@ var (
 	ErrMarketsNotFound    = errors.New("markets not found")
 	ErrMarketsUnavailable = errors.New("markets are temporarily unavailable")
 
+	ErrMarketWatchLagged        = errors.New("market watch subscriber lagged behind")
+	ErrMarketWatchClosed        = errors.New("market watch stream closed")
+	ErrMarketWatchLimitExceeded = errors.New("market watch subscribers limit exceeded")
+	ErrMarketWatchCursorExpired = errors.New("market watch cursor is older than change log retention")
+	ErrTickerWatchClosed        = errors.New("ticker watch stream closed")
+
 	ErrNilContext        = errors.New("outbox worker: nil context")
 	ErrInvalidPagination = errors.New("invalid pagination parameters")
+	ErrInvalidMarketIDs  = errors.New("invalid market ids")
+
+	ErrInvalidCandleRequest = errors.New("invalid candle request")
+
+	ErrInvalidMarketAccessSubject = errors.New("invalid market access subject")
+	ErrInvalidMarketSpec          = errors.New("invalid market spec")
 
 	ErrUserRoleNotSpecified = errors.New("user role not specified")
+	ErrPermissionDenied     = errors.New("permission denied")
 
 	ErrSpotUnavailable      = errors.New("spot service unavailable")
 	ErrSpotUnauthenticated  = errors.New("spot service unauthenticated")
@@ -51,3 +51,42 @@ func (e ErrMarketNotFound) Is(target error) bool {
 	var errorType ErrMarketNotFound
 	return errors.As(target, &errorType)
 }
+
+type ErrMarketSymbolNotFound struct {
+	Symbol string
+}
+
+func (e ErrMarketSymbolNotFound) Error() string {
+	return fmt.Sprintf("market with symbol=%s not found", e.Symbol)
+}
+
+func (e ErrMarketSymbolNotFound) Is(target error) bool {
+	var errorType ErrMarketSymbolNotFound
+	return errors.As(target, &errorType)
+}
+
+type ErrAssetNotFound struct {
+	Code string
+}
+
+func (e ErrAssetNotFound) Error() string {
+	return fmt.Sprintf("asset with code=%s not found", e.Code)
+}
+
+func (e ErrAssetNotFound) Is(target error) bool {
+	var errorType ErrAssetNotFound
+	return errors.As(target, &errorType)
+}
+
+type ErrAssetAlreadyExists struct {
+	Code string
+}
+
+func (e ErrAssetAlreadyExists) Error() string {
+	return fmt.Sprintf("asset with code=%s already exists", e.Code)
+}
+
+func (e ErrAssetAlreadyExists) Is(target error) bool {
+	var errorType ErrAssetAlreadyExists
+	return errors.As(target, &errorType)
+}
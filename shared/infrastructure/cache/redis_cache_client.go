@@ -61,9 +61,57 @@ func (s *Store) Get(ctx context.Context, key string) ([]byte, error) {
 	return result, nil
 }
 
-func (s *Store) Delete(ctx context.Context, key string) error {
-	if err := s.redis.Del(ctx, key).Err(); err != nil {
-		return fmt.Errorf("failed to delete key %s: %w", key, err)
+// MGet возвращает значения в порядке keys; для отсутствующих ключей элемент равен nil.
+func (s *Store) MGet(ctx context.Context, keys ...string) ([][]byte, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	values, err := s.redis.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to mget %d keys: %w", len(keys), err)
+	}
+
+	result := make([][]byte, len(values))
+	for i, value := range values {
+		switch typed := value.(type) {
+		case nil:
+		case string:
+			result[i] = []byte(typed)
+		default:
+			return nil, fmt.Errorf("unexpected mget value type %T for key %s", value, keys[i])
+		}
+	}
+
+	return result, nil
+}
+
+// SetManyWithTTL записывает значения одним pipeline-запросом.
+func (s *Store) SetManyWithTTL(ctx context.Context, values map[string][]byte, ttl time.Duration) error {
+	if len(values) == 0 {
+		return nil
+	}
+
+	_, err := s.redis.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for key, value := range values {
+			pipe.Set(ctx, key, value, ttl)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set %d keys with ttl: %w", len(values), err)
+	}
+
+	return nil
+}
+
+func (s *Store) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	if err := s.redis.Del(ctx, keys...).Err(); err != nil {
+		return fmt.Errorf("failed to delete keys %v: %w", keys, err)
 	}
 
 	return nil
@@ -85,3 +133,94 @@ func (s *Store) Expire(ctx context.Context, key string, ttl time.Duration) error
 
 	return nil
 }
+
+func (s *Store) Publish(ctx context.Context, channel string, message interface{}) error {
+	if err := s.redis.Publish(ctx, channel, message).Err(); err != nil {
+		return fmt.Errorf("failed to publish to channel %s: %w", channel, err)
+	}
+
+	return nil
+}
+
+// Subscribe возвращает подписку на каналы; закрывать её обязан вызывающий.
+func (s *Store) Subscribe(ctx context.Context, channels ...string) *redis.PubSub {
+	return s.redis.Subscribe(ctx, channels...)
+}
+
+// HSetWithTTL записывает поля хеша и продлевает его TTL одним pipeline-запросом.
+func (s *Store) HSetWithTTL(ctx context.Context, key string, values map[string][]byte, ttl time.Duration) error {
+	if len(values) == 0 {
+		return nil
+	}
+
+	_, err := s.redis.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for field, value := range values {
+			pipe.HSet(ctx, key, field, value)
+		}
+		pipe.PExpire(ctx, key, ttl)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to hset %d fields of %s: %w", len(values), key, err)
+	}
+
+	return nil
+}
+
+// ReplaceHash заменяет хеш целиком в MULTI/EXEC: читатели не видят его пустым или наполовину записанным.
+func (s *Store) ReplaceHash(ctx context.Context, key string, values map[string][]byte, ttl time.Duration) error {
+	_, err := s.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, key)
+		for field, value := range values {
+			pipe.HSet(ctx, key, field, value)
+		}
+		if len(values) > 0 {
+			pipe.PExpire(ctx, key, ttl)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to replace hash %s: %w", key, err)
+	}
+
+	return nil
+}
+
+func (s *Store) HGetAll(ctx context.Context, key string) (map[string][]byte, error) {
+	values, err := s.redis.HGetAll(ctx, key).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to hgetall %s: %w", key, err)
+	}
+
+	result := make(map[string][]byte, len(values))
+	for field, value := range values {
+		result[field] = []byte(value)
+	}
+
+	return result, nil
+}
+
+// HMGet возвращает значения в порядке fields; для отсутствующих полей элемент равен nil.
+func (s *Store) HMGet(ctx context.Context, key string, fields ...string) ([][]byte, error) {
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	values, err := s.redis.HMGet(ctx, key, fields...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to hmget %d fields of %s: %w", len(fields), key, err)
+	}
+
+	result := make([][]byte, len(values))
+	for i, value := range values {
+		switch typed := value.(type) {
+		case nil:
+		case string:
+			result[i] = []byte(typed)
+		default:
+			return nil, fmt.Errorf("unexpected hmget value type %T for field %s", value, fields[i])
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,195 @@
+package leader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	zapLogger "github.com/nastyazhadan/spot-order-grpc/shared/interceptors/logging/zap"
+	"github.com/nastyazhadan/spot-order-grpc/shared/metrics"
+)
+
+type LeaseStore interface {
+	TryAcquire(ctx context.Context, name, holderID string, ttl time.Duration) (int64, bool, error)
+	Release(ctx context.Context, name, holderID string) error
+}
+
+// Job — singleton-задача, которая работает только пока реплика держит lease.
+// fencingToken передаётся в записи, которые нельзя делать бывшему лидеру.
+type Job func(ctx context.Context, fencingToken int64) error
+
+// Elector запускает Job только на одной реплике. Лидер продлевает lease раз в renewInterval;
+// если продлить не удалось, Job отменяется до истечения lease. Остальные реплики
+// раз в retryInterval пробуют захватить lease и подхватывают Job после смерти лидера.
+type Elector struct {
+	store         LeaseStore
+	name          string
+	holderID      string
+	ttl           time.Duration
+	renewInterval time.Duration
+	retryInterval time.Duration
+	serviceName   string
+	logger        *zapLogger.Logger
+}
+
+func NewElector(
+	store LeaseStore,
+	name, holderID string,
+	ttl, renewInterval, retryInterval time.Duration,
+	serviceName string,
+	logger *zapLogger.Logger,
+) *Elector {
+	return &Elector{
+		store:         store,
+		name:          name,
+		holderID:      holderID,
+		ttl:           ttl,
+		renewInterval: renewInterval,
+		retryInterval: retryInterval,
+		serviceName:   serviceName,
+		logger:        logger,
+	}
+}
+
+// NewHolderID уникален для процесса: рестарт реплики — новый претендент с новым токеном.
+func NewHolderID() string {
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "unknown"
+	}
+
+	return hostname + "-" + uuid.NewString()
+}
+
+// Run блокирует до отмены ctx. Ошибка Job возвращается вызывающему после отпускания lease.
+func (e *Elector) Run(ctx context.Context, job Job) error {
+	if ctx == nil {
+		return errors.New("leader elector: nil context")
+	}
+
+	e.logger.Info(ctx, "Leader election started",
+		zap.String("lease", e.name),
+		zap.String("holder_id", e.holderID),
+		zap.Duration("ttl", e.ttl),
+		zap.Duration("renew_interval", e.renewInterval),
+	)
+
+	for {
+		fencingToken, acquired, err := e.store.TryAcquire(ctx, e.name, e.holderID, e.ttl)
+		if err != nil && ctx.Err() == nil {
+			e.logger.Warn(ctx, "Failed to acquire leader lease", zap.String("lease", e.name), zap.Error(err))
+		}
+
+		if acquired {
+			if err = e.lead(ctx, fencingToken, job); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(e.retryInterval):
+		}
+	}
+}
+
+func (e *Elector) lead(ctx context.Context, fencingToken int64, job Job) error {
+	metrics.LeaderElectionIsLeader.WithLabelValues(e.serviceName, e.name).Set(1)
+	metrics.LeaderElectionTransitionsTotal.WithLabelValues(e.serviceName, e.name, "acquired").Inc()
+	e.logger.Info(ctx, "Leader lease acquired",
+		zap.String("lease", e.name),
+		zap.Int64("fencing_token", fencingToken),
+	)
+
+	jobCtx, cancel := context.WithCancel(ctx)
+	lost := make(chan struct{})
+	renewDone := make(chan struct{})
+
+	defer func() {
+		cancel()
+		<-renewDone
+		metrics.LeaderElectionIsLeader.WithLabelValues(e.serviceName, e.name).Set(0)
+	}()
+
+	go func() {
+		defer close(renewDone)
+		e.keepLease(jobCtx, cancel, lost, fencingToken)
+	}()
+
+	jobErr := job(jobCtx, fencingToken)
+
+	select {
+	case <-lost:
+		metrics.LeaderElectionTransitionsTotal.WithLabelValues(e.serviceName, e.name, "lost").Inc()
+		e.logger.Warn(ctx, "Leader lease lost, job stopped",
+			zap.String("lease", e.name),
+			zap.Int64("fencing_token", fencingToken),
+			zap.NamedError("job_error", jobErr),
+		)
+		return nil
+	default:
+	}
+
+	e.release(ctx, fencingToken)
+
+	if jobErr != nil && ctx.Err() == nil {
+		return fmt.Errorf("leader job %s: %w", e.name, jobErr)
+	}
+
+	return nil
+}
+
+// keepLease продлевает lease и отменяет Job, как только лидерство не подтверждено.
+// Ошибка БД тоже считается потерей: не продлённый lease истечёт раньше, чем она пройдёт.
+func (e *Elector) keepLease(ctx context.Context, cancelJob context.CancelFunc, lost chan<- struct{}, fencingToken int64) {
+	ticker := time.NewTicker(e.renewInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			renewedToken, acquired, err := e.store.TryAcquire(ctx, e.name, e.holderID, e.ttl)
+			if ctx.Err() != nil {
+				return
+			}
+			if err == nil && acquired && renewedToken == fencingToken {
+				continue
+			}
+
+			if err != nil {
+				e.logger.Warn(ctx, "Failed to renew leader lease", zap.String("lease", e.name), zap.Error(err))
+			}
+
+			close(lost)
+			cancelJob()
+			return
+		}
+	}
+}
+
+func (e *Elector) release(ctx context.Context, fencingToken int64) {
+	releaseCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), e.renewInterval)
+	defer cancel()
+
+	if err := e.store.Release(releaseCtx, e.name, e.holderID); err != nil {
+		e.logger.Warn(releaseCtx, "Failed to release leader lease, it will expire by ttl",
+			zap.String("lease", e.name),
+			zap.Error(err),
+		)
+		return
+	}
+
+	metrics.LeaderElectionTransitionsTotal.WithLabelValues(e.serviceName, e.name, "released").Inc()
+	e.logger.Info(releaseCtx, "Leader lease released",
+		zap.String("lease", e.name),
+		zap.Int64("fencing_token", fencingToken),
+	)
+}
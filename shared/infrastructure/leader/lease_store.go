@@ -0,0 +1,102 @@
+package leader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	repositoryErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/repository"
+)
+
+// Захват и продление — один запрос: lease переходит к новому владельцу только после
+// истечения, и тогда fencing token растёт. Свой lease продлевается с тем же токеном.
+const acquireLeaseQuery = `
+	INSERT INTO leader_leases (name, holder_id, fencing_token, expires_at, updated_at)
+	VALUES ($1, $2, 1, NOW() + $3 * INTERVAL '1 millisecond', NOW())
+	ON CONFLICT (name) DO UPDATE
+	SET fencing_token = CASE
+	        WHEN leader_leases.holder_id = EXCLUDED.holder_id THEN leader_leases.fencing_token
+	        ELSE leader_leases.fencing_token + 1
+	    END,
+	    holder_id  = EXCLUDED.holder_id,
+	    expires_at = EXCLUDED.expires_at,
+	    updated_at = NOW()
+	WHERE leader_leases.holder_id = EXCLUDED.holder_id
+	   OR leader_leases.expires_at <= NOW()
+	RETURNING fencing_token`
+
+// PostgresLeaseStore хранит lease в таблице leader_leases базы сервиса.
+// Время истечения считается по часам PostgreSQL, а не реплик.
+type PostgresLeaseStore struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresLeaseStore(pool *pgxpool.Pool) *PostgresLeaseStore {
+	return &PostgresLeaseStore{pool: pool}
+}
+
+// TryAcquire захватывает или продлевает lease. acquired == false — lease держит другая реплика.
+func (s *PostgresLeaseStore) TryAcquire(
+	ctx context.Context,
+	name, holderID string,
+	ttl time.Duration,
+) (int64, bool, error) {
+	const op = "PostgresLeaseStore.TryAcquire"
+
+	var fencingToken int64
+
+	err := s.pool.QueryRow(ctx, acquireLeaseQuery, name, holderID, ttl.Milliseconds()).Scan(&fencingToken)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, false, nil
+		}
+		return 0, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return fencingToken, true, nil
+}
+
+// Release отпускает lease досрочно. Строка не удаляется, чтобы fencing token не начался заново.
+func (s *PostgresLeaseStore) Release(ctx context.Context, name, holderID string) error {
+	const op = "PostgresLeaseStore.Release"
+
+	_, err := s.pool.Exec(ctx, `
+		UPDATE leader_leases
+		SET expires_at = NOW(), updated_at = NOW()
+		WHERE name = $1 AND holder_id = $2
+	`, name, holderID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// CheckFencingToken в транзакции записи проверяет, что lease всё ещё за токеном писателя.
+// FOR SHARE не даёт другой реплике перехватить lease до COMMIT этой транзакции.
+func CheckFencingToken(ctx context.Context, transaction pgx.Tx, name string, fencingToken int64) error {
+	var current int64
+
+	err := transaction.QueryRow(ctx, `
+		SELECT fencing_token
+		FROM leader_leases
+		WHERE name = $1
+		FOR SHARE
+	`, name).Scan(&current)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return repositoryErrors.ErrLeaseLost
+		}
+		return fmt.Errorf("check fencing token: %w", err)
+	}
+
+	if current != fencingToken {
+		return repositoryErrors.ErrLeaseLost
+	}
+
+	return nil
+}
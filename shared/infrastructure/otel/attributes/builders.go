@@ -17,6 +17,10 @@ func UserIDValue(v string) attribute.KeyValue      { return attribute.String(Use
 func MarketIDValue(v string) attribute.KeyValue    { return attribute.String(MarketID, v) }
 func UserRoleKeyValue(v string) attribute.KeyValue { return attribute.String(UserRoleKey, v) }
 
+func VisibilityPolicyValue(v string) attribute.KeyValue {
+	return attribute.String(VisibilityPolicy, v)
+}
+
 func OperationNameValue(v string) attribute.KeyValue { return attribute.String(OperationName, v) }
 
 func CacheTTLValue(v time.Duration) attribute.KeyValue {
@@ -71,3 +75,8 @@ func MarketBlockSyncReasonValue(v string) attribute.KeyValue {
 	return attribute.String(MarketBlockSyncReason, v)
 }
 func MarketsCountValue(v int) attribute.KeyValue { return attribute.Int(MarketsCount, v) }
+func MarketReplicaResultValue(v string) attribute.KeyValue {
+	return attribute.String(MarketReplicaResult, v)
+}
+
+func AssetCodeValue(v string) attribute.KeyValue { return attribute.String(AssetCode, v) }
@@ -6,9 +6,10 @@ const (
 	OrderStatus          = "order.status"
 	OrdersCancelledCount = "orders.cancelled_count"
 
-	UserID      = "user.id"
-	UserRoleKey = "user.role_key"
-	MarketID    = "market.id"
+	UserID           = "user.id"
+	UserRoleKey      = "user.role_key"
+	VisibilityPolicy = "market.visibility_policy"
+	MarketID         = "market.id"
 
 	OperationName = "operation.name"
 
@@ -43,4 +44,7 @@ const (
 	MarketBlockSyncFailed = "market.block_sync_failed"
 	MarketBlockSyncReason = "market.block_sync_reason"
 	MarketsCount          = "markets.count"
+	MarketReplicaResult   = "market.replica_result"
+
+	AssetCode = "asset.code"
 )
@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/nastyazhadan/spot-order-grpc/shared/auth/apikey"
+)
+
+// UnaryClientAPIKeyInterceptor подписывает исходящие запросы API-ключом — для ботов на Go.
+// Ставится после retry-перехватчика: повтор с тем же nonce будет отклонён как replay
+func UnaryClientAPIKeyInterceptor(keyID, secret string) grpc.UnaryClientInterceptor {
+	signingKey := apikey.SigningKey(secret)
+
+	return func(
+		ctx context.Context,
+		method string,
+		request, reply any,
+		connection *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		body, err := apikey.RequestBody(request)
+		if err != nil {
+			return err
+		}
+
+		ctx = signOutgoing(ctx, keyID, signingKey, method, body)
+
+		return invoker(ctx, method, request, reply, connection, opts...)
+	}
+}
+
+// StreamClientAPIKeyInterceptor подписывает открытие стрима с пустым телом,
+// как ожидает StreamAPIKeyServerInterceptor
+func StreamClientAPIKeyInterceptor(keyID, secret string) grpc.StreamClientInterceptor {
+	signingKey := apikey.SigningKey(secret)
+
+	return func(
+		ctx context.Context,
+		description *grpc.StreamDesc,
+		connection *grpc.ClientConn,
+		method string,
+		streamer grpc.Streamer,
+		opts ...grpc.CallOption,
+	) (grpc.ClientStream, error) {
+		ctx = signOutgoing(ctx, keyID, signingKey, method, nil)
+
+		return streamer(ctx, description, connection, method, opts...)
+	}
+}
+
+func signOutgoing(ctx context.Context, keyID string, signingKey []byte, method string, body []byte) context.Context {
+	timestamp := apikey.FormatTimestamp(time.Now().UnixMilli())
+	nonce := apikey.NewNonce()
+	signature := apikey.Sign(signingKey, method, timestamp, nonce, body)
+
+	return metadata.AppendToOutgoingContext(ctx,
+		apikey.HeaderAPIKey, keyID,
+		apikey.HeaderTimestamp, timestamp,
+		apikey.HeaderNonce, nonce,
+		apikey.HeaderSignature, signature,
+	)
+}
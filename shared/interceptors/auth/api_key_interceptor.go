@@ -0,0 +1,158 @@
+package auth
+
+import (
+	"context"
+	"net"
+	"net/netip"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/nastyazhadan/spot-order-grpc/shared/auth/apikey"
+	authErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/service"
+	"github.com/nastyazhadan/spot-order-grpc/shared/interceptors/stream"
+	"github.com/nastyazhadan/spot-order-grpc/shared/requestctx"
+)
+
+// APIKeyAuthenticator проверяет подписанный API-ключом запрос и возвращает владельца ключа
+type APIKeyAuthenticator interface {
+	AuthenticateAPIKey(ctx context.Context, request apikey.SignedRequest) (apikey.Principal, error)
+}
+
+// UnaryAPIKeyServerInterceptor аутентифицирует запросы с metadata api-key,
+// остальные передаёт в next — JWT-перехватчик
+func UnaryAPIKeyServerInterceptor(
+	authenticator APIKeyAuthenticator,
+	next grpc.UnaryServerInterceptor,
+) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		request any,
+		serverInfo *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		md, found := metadata.FromIncomingContext(ctx)
+		if !found || len(md.Get(apikey.HeaderAPIKey)) == 0 {
+			return next(ctx, request, serverInfo, handler)
+		}
+
+		body, err := apikey.RequestBody(request)
+		if err != nil {
+			return nil, authErrors.ErrInvalidAPIKey
+		}
+
+		ctx, err = authenticateAPIKey(ctx, md, authenticator, serverInfo.FullMethod, body)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, request)
+	}
+}
+
+// StreamAPIKeyServerInterceptor — то же для стримов. Сообщения стрима приходят после
+// перехватчиков, поэтому подписывается пустое тело: подпись привязывает метод, время и nonce
+func StreamAPIKeyServerInterceptor(
+	authenticator APIKeyAuthenticator,
+	next grpc.StreamServerInterceptor,
+) grpc.StreamServerInterceptor {
+	return func(
+		server any,
+		serverStream grpc.ServerStream,
+		serverInfo *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx := serverStream.Context()
+
+		md, found := metadata.FromIncomingContext(ctx)
+		if !found || len(md.Get(apikey.HeaderAPIKey)) == 0 {
+			return next(server, serverStream, serverInfo, handler)
+		}
+
+		ctx, err := authenticateAPIKey(ctx, md, authenticator, serverInfo.FullMethod, nil)
+		if err != nil {
+			return err
+		}
+
+		return handler(server, stream.WithContext(serverStream, ctx))
+	}
+}
+
+func authenticateAPIKey(
+	ctx context.Context,
+	md metadata.MD,
+	authenticator APIKeyAuthenticator,
+	method string,
+	body []byte,
+) (context.Context, error) {
+	keyID := firstValue(md, apikey.HeaderAPIKey)
+	timestamp := firstValue(md, apikey.HeaderTimestamp)
+	nonce := firstValue(md, apikey.HeaderNonce)
+	signature := firstValue(md, apikey.HeaderSignature)
+	if keyID == "" || timestamp == "" || nonce == "" || signature == "" {
+		return nil, authErrors.ErrInvalidAPIKey
+	}
+
+	principal, err := authenticator.AuthenticateAPIKey(ctx, apikey.SignedRequest{
+		KeyID:     keyID,
+		Timestamp: timestamp,
+		Nonce:     nonce,
+		Signature: signature,
+		Method:    method,
+		Body:      body,
+		ClientIP:  peerAddr(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, ok := requestctx.ContextWithUserID(ctx, principal.UserID)
+	if !ok {
+		return nil, authErrors.ErrInternalAuthContext
+	}
+	ctx, ok = requestctx.ContextWithUserRoles(ctx, principal.Roles)
+	if !ok {
+		return nil, authErrors.ErrInternalAuthContext
+	}
+	ctx, ok = requestctx.ContextWithScopes(ctx, principal.Scopes)
+	if !ok {
+		return nil, authErrors.ErrInternalAuthContext
+	}
+
+	// UnaryClientAuthInterceptor пробрасывает в spot-service заголовок authorization
+	// входящего запроса, поэтому подменяем его выпущенным для ключа access token
+	forwarded := md.Copy()
+	forwarded.Set(authorizationHeader, "Bearer "+principal.ForwardToken)
+
+	return metadata.NewIncomingContext(ctx, forwarded), nil
+}
+
+func firstValue(md metadata.MD, key string) string {
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+// peerAddr — адрес соединения. За прокси это адрес прокси: X-Forwarded-For не учитываем
+func peerAddr(ctx context.Context) netip.Addr {
+	remote, ok := peer.FromContext(ctx)
+	if !ok || remote.Addr == nil {
+		return netip.Addr{}
+	}
+
+	host, _, err := net.SplitHostPort(remote.Addr.String())
+	if err != nil {
+		host = remote.Addr.String()
+	}
+
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return netip.Addr{}
+	}
+
+	return addr.Unmap()
+}
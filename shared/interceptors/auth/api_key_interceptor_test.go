@@ -0,0 +1,133 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/nastyazhadan/spot-order-grpc/shared/auth/apikey"
+	authErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/service"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+	"github.com/nastyazhadan/spot-order-grpc/shared/requestctx"
+)
+
+// stubAuthenticator запоминает последний запрос и возвращает заданный результат
+type stubAuthenticator struct {
+	principal apikey.Principal
+	err       error
+	request   *apikey.SignedRequest
+}
+
+func (a *stubAuthenticator) AuthenticateAPIKey(_ context.Context, request apikey.SignedRequest) (apikey.Principal, error) {
+	a.request = &request
+	return a.principal, a.err
+}
+
+func apiKeyMetadata(omit string) metadata.MD {
+	md := metadata.Pairs(
+		apikey.HeaderAPIKey, uuid.NewString(),
+		apikey.HeaderTimestamp, "1700000000000",
+		apikey.HeaderNonce, apikey.NewNonce(),
+		apikey.HeaderSignature, "signature",
+		authorizationHeader, "Bearer user-token",
+	)
+	if omit != "" {
+		md.Delete(omit)
+	}
+
+	return md
+}
+
+func TestStreamAPIKeyServerInterceptor(t *testing.T) {
+	userID := uuid.New()
+	roles := []models.UserRole{models.UserRoleUser}
+	info := &grpc.StreamServerInfo{FullMethod: testWatchMarkets, IsServerStream: true}
+
+	tests := []struct {
+		name          string
+		md            metadata.MD
+		authenticator *stubAuthenticator
+		wantErr       error
+		wantNext      bool
+	}{
+		{
+			name: "подписанный стрим получает владельца ключа",
+			md:   apiKeyMetadata(""),
+			authenticator: &stubAuthenticator{principal: apikey.Principal{
+				UserID:       userID,
+				Roles:        roles,
+				Scopes:       []models.Permission{models.PermissionOrdersRead},
+				ForwardToken: "forward-token",
+			}},
+		},
+		{
+			name:          "без api-key стрим уходит в JWT-перехватчик",
+			md:            metadata.Pairs(authorizationHeader, "Bearer user-token"),
+			authenticator: &stubAuthenticator{},
+			wantNext:      true,
+		},
+		{
+			name:          "без nonce — ErrInvalidAPIKey",
+			md:            apiKeyMetadata(apikey.HeaderNonce),
+			authenticator: &stubAuthenticator{},
+			wantErr:       authErrors.ErrInvalidAPIKey,
+		},
+		{
+			name:          "ошибка проверки ключа",
+			md:            apiKeyMetadata(""),
+			authenticator: &stubAuthenticator{err: authErrors.ErrAPIKeyRequestReplayed},
+			wantErr:       authErrors.ErrAPIKeyRequestReplayed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nextCalled := false
+			next := func(_ any, _ grpc.ServerStream, _ *grpc.StreamServerInfo, _ grpc.StreamHandler) error {
+				nextCalled = true
+				return nil
+			}
+			interceptor := StreamAPIKeyServerInterceptor(tt.authenticator, next)
+
+			var handlerCtx context.Context
+			serverStream := contextStream{ctx: metadata.NewIncomingContext(context.Background(), tt.md)}
+			err := interceptor(nil, serverStream, info, func(_ any, s grpc.ServerStream) error {
+				handlerCtx = s.Context()
+				return nil
+			})
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, handlerCtx)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantNext, nextCalled)
+			if tt.wantNext {
+				assert.Nil(t, tt.authenticator.request)
+				return
+			}
+
+			// Тело стрима не подписывается
+			require.NotNil(t, tt.authenticator.request)
+			assert.Empty(t, tt.authenticator.request.Body)
+			assert.Equal(t, testWatchMarkets, tt.authenticator.request.Method)
+
+			gotUserID, ok := requestctx.UserIDFromContext(handlerCtx)
+			require.True(t, ok)
+			assert.Equal(t, userID, gotUserID)
+			gotScopes, ok := requestctx.ScopesFromContext(handlerCtx)
+			require.True(t, ok)
+			assert.Equal(t, []models.Permission{models.PermissionOrdersRead}, gotScopes)
+
+			md, _ := metadata.FromIncomingContext(handlerCtx)
+			assert.Equal(t, []string{"Bearer forward-token"}, md.Get(authorizationHeader))
+		})
+	}
+}
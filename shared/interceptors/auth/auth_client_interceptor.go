@@ -2,12 +2,21 @@ package auth
 
 import (
 	"context"
+	"fmt"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 )
 
-func UnaryClientAuthInterceptor() grpc.UnaryClientInterceptor {
+// ServiceTokenSource выдаёт токен сервиса (роль ROLE_SERVICE)
+type ServiceTokenSource interface {
+	ServiceToken() (string, error)
+}
+
+// UnaryClientAuthInterceptor пробрасывает в исходящий вызов authorization входящего запроса.
+// Вызов без входящего токена (фоновые задачи, consumers) уходит с токеном сервиса.
+// Заданный вызывающим исходящий authorization не трогается; nil serviceTokens отключает подстановку
+func UnaryClientAuthInterceptor(serviceTokens ServiceTokenSource) grpc.UnaryClientInterceptor {
 	return func(
 		ctx context.Context,
 		method string,
@@ -16,19 +25,19 @@ func UnaryClientAuthInterceptor() grpc.UnaryClientInterceptor {
 		invoker grpc.UnaryInvoker,
 		opts ...grpc.CallOption,
 	) error {
-		incomingMetadata, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
+		outgoingMetadata, _ := metadata.FromOutgoingContext(ctx)
+		if len(outgoingMetadata.Get(authorizationHeader)) > 0 {
 			return invoker(ctx, method, request, reply, connection, opts...)
 		}
 
-		authValues := incomingMetadata.Get(authorizationHeader)
-		if len(authValues) == 0 {
+		authHeader, err := outgoingAuthorization(ctx, serviceTokens)
+		if err != nil {
+			return err
+		}
+		if authHeader == "" {
 			return invoker(ctx, method, request, reply, connection, opts...)
 		}
 
-		authHeader := authValues[0]
-
-		outgoingMetadata, _ := metadata.FromOutgoingContext(ctx)
 		outgoingMetadata = outgoingMetadata.Copy()
 		outgoingMetadata.Set(authorizationHeader, authHeader)
 
@@ -36,3 +45,22 @@ func UnaryClientAuthInterceptor() grpc.UnaryClientInterceptor {
 		return invoker(ctx, method, request, reply, connection, opts...)
 	}
 }
+
+func outgoingAuthorization(ctx context.Context, serviceTokens ServiceTokenSource) (string, error) {
+	if incomingMetadata, ok := metadata.FromIncomingContext(ctx); ok {
+		if authValues := incomingMetadata.Get(authorizationHeader); len(authValues) > 0 {
+			return authValues[0], nil
+		}
+	}
+
+	if serviceTokens == nil {
+		return "", nil
+	}
+
+	token, err := serviceTokens.ServiceToken()
+	if err != nil {
+		return "", fmt.Errorf("issue service token: %w", err)
+	}
+
+	return "Bearer " + token, nil
+}
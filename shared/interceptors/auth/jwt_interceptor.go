@@ -2,6 +2,8 @@ package auth
 
 import (
 	"context"
+	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/google/uuid"
@@ -11,6 +13,8 @@ import (
 	authjwt "github.com/nastyazhadan/spot-order-grpc/shared/auth/jwt"
 	"github.com/nastyazhadan/spot-order-grpc/shared/config"
 	authErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/service"
+	"github.com/nastyazhadan/spot-order-grpc/shared/interceptors/stream"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
 	"github.com/nastyazhadan/spot-order-grpc/shared/requestctx"
 )
 
@@ -19,12 +23,30 @@ const (
 	bearerPrefix        = "bearer "
 )
 
+// Типы токенов, которые принимает сервис
+var (
+	// UserTokenTypes — только access token из Login
+	UserTokenTypes = []authjwt.TokenType{authjwt.TokenTypeAccess}
+	// ForwardedTokenTypes — ещё и токены, которые выпускает order-service для вызовов spot-service:
+	// запросов по API-ключу и собственных фоновых задач
+	ForwardedTokenTypes = []authjwt.TokenType{authjwt.TokenTypeAccess, authjwt.TokenTypeAPIKey, authjwt.TokenTypeService}
+)
+
 type TokenParser interface {
-	ParseToken(tokenString string, expectedType authjwt.TokenType) (*authjwt.Claims, error)
+	ParseToken(tokenString string, expectedTypes ...authjwt.TokenType) (*authjwt.Claims, error)
+}
+
+// SessionChecker проверяет, что сессия access token не отозвана.
+// Токены сервисов и API-ключей сессий не имеют и не сверяются.
+// nil отключает проверку: сервис без доступа к хранилищу сессий доверяет только подписи.
+type SessionChecker interface {
+	IsSessionActive(ctx context.Context, userID uuid.UUID, sessionID string) (bool, error)
 }
 
 func UnaryServerInterceptor(
 	jwtManager TokenParser,
+	sessions SessionChecker,
+	tokenTypes []authjwt.TokenType,
 	cfg config.AuthVerifierConfig,
 ) grpc.UnaryServerInterceptor {
 	skipMethods := makeSkipMethods(cfg.SkipMethods)
@@ -39,37 +61,120 @@ func UnaryServerInterceptor(
 			return handler(ctx, request)
 		}
 
-		tokenString, err := bearerTokenFromContext(ctx)
+		ctx, err := authenticate(ctx, jwtManager, sessions, tokenTypes)
 		if err != nil {
 			return nil, err
 		}
 
-		claims, err := jwtManager.ParseToken(tokenString, authjwt.TokenTypeAccess)
-		if err != nil {
-			return nil, err
+		return handler(ctx, request)
+	}
+}
+
+func StreamServerInterceptor(
+	jwtManager TokenParser,
+	sessions SessionChecker,
+	tokenTypes []authjwt.TokenType,
+	cfg config.AuthVerifierConfig,
+) grpc.StreamServerInterceptor {
+	skipMethods := makeSkipMethods(cfg.SkipMethods)
+
+	return func(
+		server any,
+		serverStream grpc.ServerStream,
+		serverInfo *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if shouldSkip(serverInfo.FullMethod, skipMethods) {
+			return handler(server, serverStream)
 		}
 
-		userRoles, err := authjwt.ParseUserRolesClaims(claims.UserRoles)
+		ctx, err := authenticate(serverStream.Context(), jwtManager, sessions, tokenTypes)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
-		userID, err := uuid.Parse(claims.Subject)
-		if err != nil {
-			return nil, authErrors.ErrInvalidUserIDInToken
+		return handler(server, stream.WithContext(serverStream, ctx))
+	}
+}
+
+func authenticate(
+	ctx context.Context,
+	jwtManager TokenParser,
+	sessions SessionChecker,
+	tokenTypes []authjwt.TokenType,
+) (context.Context, error) {
+	tokenString, err := bearerTokenFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := jwtManager.ParseToken(tokenString, tokenTypes...)
+	if err != nil {
+		return nil, err
+	}
+
+	userRoles, err := authjwt.ParseUserRolesClaims(claims.UserRoles)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return nil, authErrors.ErrInvalidUserIDInToken
+	}
+
+	// ROLE_SERVICE бывает только у токена сервиса, и только один
+	isService := slices.Contains(userRoles, models.UserRoleService)
+	if isService != (claims.Service != "") || isService != (claims.TokenType == authjwt.TokenTypeService) ||
+		(isService && len(userRoles) > 1) {
+		return nil, authErrors.ErrInvalidUserRoles
+	}
+
+	// Scope есть только у токена API-ключа: без него токен получил бы все права ролей владельца
+	if (claims.TokenType == authjwt.TokenTypeAPIKey) != (len(claims.Scopes) > 0) {
+		return nil, authErrors.ErrInvalidTokenScopes
+	}
+	scopes, err := authjwt.ParseScopesClaims(claims.Scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	if sessions != nil && claims.TokenType == authjwt.TokenTypeAccess {
+		active, checkErr := sessions.IsSessionActive(ctx, userID, claims.SessionID)
+		if checkErr != nil {
+			return nil, fmt.Errorf("%w: %w", authErrors.ErrSessionValidationFailed, checkErr)
+		}
+		if !active {
+			return nil, authErrors.ErrSessionRevoked
 		}
+	}
 
-		ctx, ok := requestctx.ContextWithUserID(ctx, userID)
+	ctx, ok := requestctx.ContextWithUserID(ctx, userID)
+	if !ok {
+		return nil, authErrors.ErrInternalAuthContext
+	}
+	ctx, ok = requestctx.ContextWithUserRoles(ctx, userRoles)
+	if !ok {
+		return nil, authErrors.ErrInternalAuthContext
+	}
+	ctx, ok = requestctx.ContextWithSessionID(ctx, claims.SessionID)
+	if !ok {
+		return nil, authErrors.ErrInternalAuthContext
+	}
+	if isService {
+		ctx, ok = requestctx.ContextWithServiceName(ctx, claims.Service)
 		if !ok {
 			return nil, authErrors.ErrInternalAuthContext
 		}
-		ctx, ok = requestctx.ContextWithUserRoles(ctx, userRoles)
+	}
+	if len(scopes) > 0 {
+		ctx, ok = requestctx.ContextWithScopes(ctx, scopes)
 		if !ok {
 			return nil, authErrors.ErrInternalAuthContext
 		}
-
-		return handler(ctx, request)
 	}
+
+	return ctx, nil
 }
 
 func makeSkipMethods(methods []string) map[string]struct{} {
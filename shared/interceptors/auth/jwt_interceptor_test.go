@@ -0,0 +1,138 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	authjwt "github.com/nastyazhadan/spot-order-grpc/shared/auth/jwt"
+	"github.com/nastyazhadan/spot-order-grpc/shared/config"
+	authErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/service"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+	"github.com/nastyazhadan/spot-order-grpc/shared/requestctx"
+)
+
+const (
+	testTokenTTL = time.Minute
+	testSecret   = "test-secret"
+)
+
+// signClaims подписывает произвольные claims тем же секретом, что и менеджер теста
+func signClaims(t *testing.T, claims *authjwt.Claims) string {
+	t.Helper()
+
+	claims.ExpiresAt = jwt.NewNumericDate(time.Now().Add(testTokenTTL))
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(testSecret))
+	require.NoError(t, err)
+
+	return signed
+}
+
+// sessionSet — активные сессии; err имитирует недоступный Redis
+type sessionSet struct {
+	active  map[string]bool
+	err     error
+	checked int
+}
+
+func (s *sessionSet) IsSessionActive(_ context.Context, _ uuid.UUID, sessionID string) (bool, error) {
+	s.checked++
+	return s.active[sessionID], s.err
+}
+
+func contextWithBearer(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationHeader, "Bearer "+token))
+}
+
+func TestUnaryServerInterceptorSessions(t *testing.T) {
+	manager := authjwt.NewManager(testSecret, testTokenTTL, testTokenTTL)
+	userID := uuid.New()
+	roles := []models.UserRole{models.UserRoleUser}
+
+	accessToken, err := manager.GenerateAccessToken(userID, roles, "s1")
+	require.NoError(t, err)
+	revokedToken, err := manager.GenerateAccessToken(userID, roles, "s2")
+	require.NoError(t, err)
+	apiKeyScopes := []models.Permission{models.PermissionOrdersRead, models.PermissionMarketsRead}
+	apiKeyToken, err := manager.GenerateAPIKeyToken(userID, roles, apiKeyScopes, uuid.New(), testTokenTTL)
+	require.NoError(t, err)
+	unscopedAPIKeyToken := signClaims(t, &authjwt.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{Subject: userID.String()},
+		TokenType:        authjwt.TokenTypeAPIKey,
+		SessionID:        "api_key:" + uuid.NewString(),
+		UserRoles:        []string{models.UserRoleAdmin.String()},
+	})
+	scopedAccessToken := signClaims(t, &authjwt.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{Subject: userID.String()},
+		TokenType:        authjwt.TokenTypeAccess,
+		SessionID:        "s1",
+		UserRoles:        []string{models.UserRoleUser.String()},
+		Scopes:           []string{string(models.PermissionMarketsRead)},
+	})
+	serviceToken, _, err := manager.GenerateServiceToken("order-service", testTokenTTL)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		token       string
+		tokenTypes  []authjwt.TokenType
+		sessionErr  error
+		wantErr     error
+		wantChecked int
+		wantScopes  []models.Permission
+	}{
+		{name: "активная сессия", token: accessToken, tokenTypes: UserTokenTypes, wantChecked: 1},
+		{name: "отозванная сессия", token: revokedToken, tokenTypes: UserTokenTypes, wantErr: authErrors.ErrSessionRevoked, wantChecked: 1},
+		{
+			name:        "хранилище сессий недоступно",
+			token:       accessToken,
+			tokenTypes:  UserTokenTypes,
+			sessionErr:  errors.New("redis down"),
+			wantErr:     authErrors.ErrSessionValidationFailed,
+			wantChecked: 1,
+		},
+		{name: "токен API-ключа принимается без сессии", token: apiKeyToken, tokenTypes: ForwardedTokenTypes, wantScopes: apiKeyScopes},
+		{name: "токен API-ключа без scope", token: unscopedAPIKeyToken, tokenTypes: ForwardedTokenTypes, wantErr: authErrors.ErrInvalidTokenScopes},
+		{name: "scope у access token", token: scopedAccessToken, tokenTypes: UserTokenTypes, wantErr: authErrors.ErrInvalidTokenScopes},
+		{name: "токен API-ключа не принимается от клиента", token: apiKeyToken, tokenTypes: UserTokenTypes, wantErr: authErrors.ErrInvalidTokenType},
+		{name: "токен сервиса принимается без сессии", token: serviceToken, tokenTypes: ForwardedTokenTypes},
+		{name: "токен сервиса не принимается от клиента", token: serviceToken, tokenTypes: UserTokenTypes, wantErr: authErrors.ErrInvalidTokenType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sessions := &sessionSet{active: map[string]bool{"s1": true}, err: tt.sessionErr}
+			interceptor := UnaryServerInterceptor(manager, sessions, tt.tokenTypes, config.AuthVerifierConfig{})
+
+			var handlerCtx context.Context
+			_, err := interceptor(contextWithBearer(tt.token), nil, &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"},
+				func(ctx context.Context, _ any) (any, error) {
+					handlerCtx = ctx
+					return nil, nil
+				})
+
+			assert.Equal(t, tt.wantChecked, sessions.checked)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, handlerCtx)
+				return
+			}
+
+			require.NoError(t, err)
+			_, ok := requestctx.UserRolesFromContext(handlerCtx)
+			assert.True(t, ok)
+
+			scopes, scoped := requestctx.ScopesFromContext(handlerCtx)
+			assert.Equal(t, tt.wantScopes != nil, scoped)
+			assert.Equal(t, tt.wantScopes, scopes)
+		})
+	}
+}
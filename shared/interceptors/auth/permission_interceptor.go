@@ -0,0 +1,142 @@
+package auth
+
+import (
+	"context"
+	"slices"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	commonv1 "github.com/nastyazhadan/spot-order-grpc/protos/gen/go/common/v1"
+	authErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/service"
+	zapLogger "github.com/nastyazhadan/spot-order-grpc/shared/interceptors/logging/zap"
+	"github.com/nastyazhadan/spot-order-grpc/shared/metrics"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+	"github.com/nastyazhadan/spot-order-grpc/shared/requestctx"
+)
+
+const (
+	denyReasonNoRoles = "no_roles"
+	denyReasonRole    = "role"
+	denyReasonScope   = "scope"
+)
+
+// MethodPermissions собирает опции required_permission всех методов из зарегистрированных proto-файлов.
+// Ключ — полное имя метода gRPC, например "/order.v1.OrderService/CreateOrder"
+func MethodPermissions() map[string]models.Permission {
+	permissions := make(map[string]models.Permission)
+
+	protoregistry.GlobalFiles.RangeFiles(func(file protoreflect.FileDescriptor) bool {
+		services := file.Services()
+		for i := range services.Len() {
+			service := services.Get(i)
+			methods := service.Methods()
+
+			for j := range methods.Len() {
+				method := methods.Get(j)
+
+				permission, _ := proto.GetExtension(method.Options(), commonv1.E_RequiredPermission).(string)
+				if permission == "" {
+					continue
+				}
+
+				fullMethod := "/" + string(service.FullName()) + "/" + string(method.Name())
+				permissions[fullMethod] = models.Permission(permission)
+			}
+		}
+
+		return true
+	})
+
+	return permissions
+}
+
+// UnaryPermissionServerInterceptor ставится после аутентификации и проверяет право на метод до обработчика.
+// Метод без объявленного права доступен любому вызывающему, кроме ограниченного scope токена
+func UnaryPermissionServerInterceptor(
+	permissions map[string]models.Permission,
+	serviceName string,
+	logger *zapLogger.Logger,
+) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		request any,
+		serverInfo *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if err := authorize(ctx, permissions, serverInfo.FullMethod, serviceName, logger); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, request)
+	}
+}
+
+func StreamPermissionServerInterceptor(
+	permissions map[string]models.Permission,
+	serviceName string,
+	logger *zapLogger.Logger,
+) grpc.StreamServerInterceptor {
+	return func(
+		server any,
+		serverStream grpc.ServerStream,
+		serverInfo *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx := serverStream.Context()
+		if err := authorize(ctx, permissions, serverInfo.FullMethod, serviceName, logger); err != nil {
+			return err
+		}
+
+		return handler(server, serverStream)
+	}
+}
+
+func authorize(
+	ctx context.Context,
+	permissions map[string]models.Permission,
+	method string,
+	serviceName string,
+	logger *zapLogger.Logger,
+) error {
+	required, declared := permissions[method]
+	reason := denyReason(ctx, required, declared)
+	if reason == "" {
+		return nil
+	}
+
+	metrics.AuthorizationDeniedTotal.WithLabelValues(serviceName, method, reason).Inc()
+	logger.Warn(ctx, "method permission denied",
+		zap.String("method", method),
+		zap.String("required_permission", string(required)),
+		zap.String("reason", reason),
+	)
+
+	return authErrors.ErrPermissionDenied
+}
+
+func denyReason(ctx context.Context, required models.Permission, declared bool) string {
+	scopes, scoped := requestctx.ScopesFromContext(ctx)
+	if !declared {
+		if scoped {
+			return denyReasonScope
+		}
+		return ""
+	}
+
+	roles, ok := requestctx.UserRolesFromContext(ctx)
+	if !ok {
+		return denyReasonNoRoles
+	}
+	if !models.HasPermission(roles, required) {
+		return denyReasonRole
+	}
+	if scoped && !slices.Contains(scopes, required) {
+		return denyReasonScope
+	}
+
+	return ""
+}
@@ -0,0 +1,179 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	_ "github.com/nastyazhadan/spot-order-grpc/protos/gen/go/auth/v1"
+	_ "github.com/nastyazhadan/spot-order-grpc/protos/gen/go/order/v1"
+	_ "github.com/nastyazhadan/spot-order-grpc/protos/gen/go/spot/v1"
+	authErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/service"
+	zapLogger "github.com/nastyazhadan/spot-order-grpc/shared/interceptors/logging/zap"
+	"github.com/nastyazhadan/spot-order-grpc/shared/metrics"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+	"github.com/nastyazhadan/spot-order-grpc/shared/requestctx"
+)
+
+const (
+	testCreateOrder   = "/order.v1.OrderService/CreateOrder"
+	testGetOrder      = "/order.v1.OrderService/GetOrderStatus"
+	testCreateAsset   = "/spot.v1.AssetCatalogService/CreateAsset"
+	testWatchMarkets  = "/spot.v1.SpotInstrumentService/WatchMarkets"
+	testLogout        = "/auth.v1.AuthService/Logout"
+	testRevokeAllUser = "/auth.v1.AuthService/RevokeUserSessions"
+)
+
+// contextStream — серверный поток, у которого есть только контекст
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s contextStream) Context() context.Context {
+	return s.ctx
+}
+
+func contextWithPrincipal(roles []models.UserRole, scopes ...models.Permission) context.Context {
+	ctx := context.Background()
+	if roles != nil {
+		ctx, _ = requestctx.ContextWithUserRoles(ctx, roles)
+	}
+	if scopes != nil {
+		ctx, _ = requestctx.ContextWithScopes(ctx, scopes)
+	}
+
+	return ctx
+}
+
+func TestMethodPermissions(t *testing.T) {
+	permissions := MethodPermissions()
+
+	tests := []struct {
+		method   string
+		want     models.Permission
+		declared bool
+	}{
+		{method: testCreateOrder, want: models.PermissionOrdersWrite, declared: true},
+		{method: testGetOrder, want: models.PermissionOrdersRead, declared: true},
+		{method: testCreateAsset, want: models.PermissionMarketsAdmin, declared: true},
+		{method: testWatchMarkets, want: models.PermissionMarketsRead, declared: true},
+		{method: testRevokeAllUser, want: models.PermissionSessionsAdmin, declared: true},
+		{method: "/auth.v1.UserAdminService/CreateUser", want: models.PermissionUsersAdmin, declared: true},
+		{method: testLogout},
+		{method: "/auth.v1.AuthService/Login"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			got, declared := permissions[tt.method]
+			assert.Equal(t, tt.declared, declared)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUnaryPermissionServerInterceptor(t *testing.T) {
+	permissions := MethodPermissions()
+	user := []models.UserRole{models.UserRoleUser}
+	admin := []models.UserRole{models.UserRoleAdmin}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		method     string
+		wantReason string
+	}{
+		{name: "право есть у роли", ctx: contextWithPrincipal(user), method: testCreateOrder},
+		{name: "права нет ни у одной роли", ctx: contextWithPrincipal(user), method: testCreateAsset, wantReason: denyReasonRole},
+		{name: "право у одной из ролей", ctx: contextWithPrincipal([]models.UserRole{models.UserRoleUser, models.UserRoleAdmin}), method: testCreateAsset},
+		{name: "сервису недоступна запись", ctx: contextWithPrincipal([]models.UserRole{models.UserRoleService}), method: testCreateOrder, wantReason: denyReasonRole},
+		{name: "нет ролей в контексте", ctx: context.Background(), method: testCreateOrder, wantReason: denyReasonNoRoles},
+		{name: "метод без опции открыт токену без scope", ctx: contextWithPrincipal(user), method: testLogout},
+		{name: "метод без опции закрыт для scope", ctx: contextWithPrincipal(user, models.PermissionOrdersWrite), method: testLogout, wantReason: denyReasonScope},
+		{name: "неизвестный метод закрыт для scope", ctx: contextWithPrincipal(admin, models.PermissionOrdersRead), method: "/unknown.v1.Service/Method", wantReason: denyReasonScope},
+		{name: "право есть и у роли, и в scope", ctx: contextWithPrincipal(user, models.PermissionOrdersWrite), method: testCreateOrder},
+		{name: "права нет в scope ключа", ctx: contextWithPrincipal(user, models.PermissionOrdersRead), method: testCreateOrder, wantReason: denyReasonScope},
+		{
+			name:       "scope не расширяет права ролей",
+			ctx:        contextWithPrincipal(user, models.PermissionMarketsAdmin),
+			method:     testCreateAsset,
+			wantReason: denyReasonRole,
+		},
+		{
+			name:       "scope сужает права администратора",
+			ctx:        contextWithPrincipal(admin, models.PermissionOrdersWrite, models.PermissionMarketsRead),
+			method:     testCreateAsset,
+			wantReason: denyReasonScope,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serviceName := "permission-test-" + tt.name
+			interceptor := UnaryPermissionServerInterceptor(permissions, serviceName, zapLogger.NewNop())
+
+			called := false
+			_, err := interceptor(tt.ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method},
+				func(context.Context, any) (any, error) {
+					called = true
+					return nil, nil
+				})
+
+			if tt.wantReason == "" {
+				require.NoError(t, err)
+				assert.True(t, called)
+				return
+			}
+
+			require.ErrorIs(t, err, authErrors.ErrPermissionDenied)
+			assert.False(t, called)
+			assert.InDelta(t, 1, testutil.ToFloat64(
+				metrics.AuthorizationDeniedTotal.WithLabelValues(serviceName, tt.method, tt.wantReason),
+			), 0)
+		})
+	}
+}
+
+func TestStreamPermissionServerInterceptor(t *testing.T) {
+	interceptor := StreamPermissionServerInterceptor(MethodPermissions(), "permission-stream-test", zapLogger.NewNop())
+	info := &grpc.StreamServerInfo{FullMethod: testWatchMarkets, IsServerStream: true}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		wantErr error
+	}{
+		{name: "подписка с правом чтения рынков", ctx: contextWithPrincipal([]models.UserRole{models.UserRoleViewer})},
+		{name: "сервис подписывается на рынки", ctx: contextWithPrincipal([]models.UserRole{models.UserRoleService})},
+		{
+			name:    "scope ключа без чтения рынков",
+			ctx:     contextWithPrincipal([]models.UserRole{models.UserRoleUser}, models.PermissionOrdersRead),
+			wantErr: authErrors.ErrPermissionDenied,
+		},
+		{name: "нет ролей в контексте", ctx: context.Background(), wantErr: authErrors.ErrPermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			err := interceptor(nil, contextStream{ctx: tt.ctx}, info, func(any, grpc.ServerStream) error {
+				called = true
+				return nil
+			})
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.False(t, called)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, called)
+		})
+	}
+}
@@ -29,6 +29,20 @@ func UnaryServerInterceptor(logger *zapLogger.Logger) grpc.UnaryServerIntercepto
 	}
 }
 
+func StreamServerInterceptor(logger *zapLogger.Logger) grpc.StreamServerInterceptor {
+	return func(
+		server any,
+		serverStream grpc.ServerStream,
+		_ *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if err := handler(server, serverStream); err != nil {
+			return mapError(serverStream.Context(), err, logger)
+		}
+		return nil
+	}
+}
+
 func mapError(ctx context.Context, err error, logger *zapLogger.Logger) error {
 	if err == nil {
 		return nil
@@ -56,6 +70,26 @@ func mapError(ctx context.Context, err error, logger *zapLogger.Logger) error {
 		logger.Warn(ctx, "markets are temporarily unavailable", zap.Error(err))
 		return status.Error(codes.Unavailable, "markets are temporarily unavailable")
 
+	case errors.Is(err, service.ErrMarketWatchLagged):
+		logger.Warn(ctx, "market watch subscriber lagged behind", zap.Error(err))
+		return status.Error(codes.Aborted, "market watch lagged behind, resume from last cursor")
+
+	case errors.Is(err, service.ErrMarketWatchClosed):
+		logger.Info(ctx, "market watch stream closed by server", zap.Error(err))
+		return status.Error(codes.Unavailable, "market watch stream closed, resume from last cursor")
+
+	case errors.Is(err, service.ErrTickerWatchClosed):
+		logger.Info(ctx, "ticker watch stream closed by server", zap.Error(err))
+		return status.Error(codes.Unavailable, "ticker watch stream closed, resubscribe")
+
+	case errors.Is(err, service.ErrMarketWatchCursorExpired):
+		logger.Info(ctx, "market watch cursor expired", zap.Error(err))
+		return status.Error(codes.FailedPrecondition, "market watch cursor expired, resubscribe without resume_from")
+
+	case errors.Is(err, service.ErrMarketWatchLimitExceeded):
+		logger.Warn(ctx, "market watch subscribers limit exceeded", zap.Error(err))
+		return status.Error(codes.ResourceExhausted, "too many market watch streams")
+
 	case isSpotDependencyError(err):
 		logSpotDependencyError(ctx, logger, err)
 		return status.Error(codes.Unavailable, "market service temporarily unavailable")
@@ -64,10 +98,42 @@ func mapError(ctx context.Context, err error, logger *zapLogger.Logger) error {
 		logger.Warn(ctx, "order already exists", zap.Error(err))
 		return status.Error(codes.AlreadyExists, "order already exists")
 
+	case errors.Is(err, service.ErrAssetAlreadyExists):
+		logger.Warn(ctx, "asset already exists", zap.Error(err))
+		return status.Error(codes.AlreadyExists, "asset already exists")
+
+	case errors.Is(err, service.ErrUserAlreadyExists):
+		logger.Warn(ctx, "user already exists", zap.Error(err))
+		return status.Error(codes.AlreadyExists, "user already exists")
+
+	case errors.Is(err, service.ErrInvalidUsername):
+		logger.Warn(ctx, "invalid username", zap.Error(err))
+		return status.Error(codes.InvalidArgument, "invalid username")
+
+	case errors.Is(err, service.ErrRoleNotAssignable):
+		logger.Warn(ctx, "role cannot be assigned", zap.Error(err))
+		return status.Error(codes.InvalidArgument, "role cannot be assigned to user")
+
+	case errors.Is(err, service.ErrUserRolesRequired):
+		logger.Warn(ctx, "user roles required", zap.Error(err))
+		return status.Error(codes.FailedPrecondition, "user must have at least one role")
+
 	case errors.Is(err, service.ErrInvalidPagination):
 		logger.Warn(ctx, "invalid pagination parameters", zap.Error(err))
 		return status.Error(codes.InvalidArgument, "invalid pagination parameters")
 
+	case errors.Is(err, service.ErrInvalidMarketIDs):
+		logger.Warn(ctx, "invalid market ids", zap.Error(err))
+		return status.Error(codes.InvalidArgument, "invalid market ids")
+
+	case errors.Is(err, service.ErrInvalidCandleRequest):
+		logger.Warn(ctx, "invalid candle request", zap.Error(err))
+		return status.Error(codes.InvalidArgument, err.Error())
+
+	case errors.Is(err, service.ErrInvalidMarketAccessSubject):
+		logger.Warn(ctx, "invalid market access subject", zap.Error(err))
+		return status.Error(codes.InvalidArgument, "invalid market access subject")
+
 	case errors.Is(err, service.ErrRateLimitExceeded):
 		logger.Warn(ctx, "rate limit exceeded", zap.Error(err))
 		return status.Error(codes.ResourceExhausted, err.Error())
@@ -84,6 +150,34 @@ func mapError(ctx context.Context, err error, logger *zapLogger.Logger) error {
 		logger.Warn(ctx, "order is processing", zap.Error(err))
 		return status.Error(codes.FailedPrecondition, "order is already being processed, wait please")
 
+	case errors.Is(err, service.ErrPermissionDenied):
+		logger.Warn(ctx, "permission denied", zap.Error(err))
+		return status.Error(codes.PermissionDenied, "permission denied")
+
+	case errors.Is(err, service.ErrInvalidCredentials):
+		logger.Warn(ctx, "invalid credentials", zap.Error(err))
+		return status.Error(codes.Unauthenticated, "invalid username or password")
+
+	case errors.Is(err, service.ErrUserDisabled):
+		logger.Warn(ctx, "user is disabled", zap.Error(err))
+		return status.Error(codes.PermissionDenied, "user is disabled")
+
+	case errors.Is(err, service.ErrLoginLocked):
+		logger.Warn(ctx, "login temporarily locked", zap.Error(err))
+		return status.Error(codes.ResourceExhausted, "too many failed login attempts, try again later")
+
+	case errors.Is(err, service.ErrAPIKeyRequestExpired):
+		logger.Warn(ctx, "api key request expired", zap.Error(err))
+		return status.Error(codes.Unauthenticated, "request timestamp is outside the allowed window")
+
+	case errors.Is(err, service.ErrAPIKeyIPNotAllowed):
+		logger.Warn(ctx, "api key used from not allowed address", zap.Error(err))
+		return status.Error(codes.PermissionDenied, "api key is not allowed from this address")
+
+	case errors.Is(err, service.ErrAPIKeyLimitExceeded):
+		logger.Warn(ctx, "api key limit exceeded", zap.Error(err))
+		return status.Error(codes.ResourceExhausted, "api key limit reached, revoke unused keys")
+
 	case isAuthFailure(err):
 		logger.Warn(ctx, "authentication failed", zap.Error(err))
 		return status.Error(codes.Unauthenticated, "authentication failed")
@@ -101,7 +195,12 @@ func mapError(ctx context.Context, err error, logger *zapLogger.Logger) error {
 func isNotFoundError(err error) bool {
 	return errors.Is(err, service.ErrMarketsNotFound) ||
 		errors.Is(err, service.ErrMarketNotFound) ||
-		errors.Is(err, service.ErrOrderNotFound)
+		errors.Is(err, service.ErrMarketSymbolNotFound) ||
+		errors.Is(err, service.ErrAssetNotFound) ||
+		errors.Is(err, service.ErrOrderNotFound) ||
+		errors.Is(err, service.ErrSessionNotFound) ||
+		errors.Is(err, service.ErrAPIKeyNotFound) ||
+		errors.Is(err, service.ErrUserNotFound)
 }
 
 func isSpotDependencyError(err error) bool {
@@ -144,18 +243,27 @@ func isAuthFailure(err error) bool {
 		errors.Is(err, service.ErrMissingUserRoles) ||
 		errors.Is(err, service.ErrInvalidUserRoles) ||
 		errors.Is(err, service.ErrInvalidUserIDInToken) ||
+		errors.Is(err, service.ErrInvalidTokenScopes) ||
 		errors.Is(err, service.ErrInvalidSubject) ||
 		errors.Is(err, service.ErrInvalidJTI) ||
-		errors.Is(err, service.ErrTokenRevoked)
+		errors.Is(err, service.ErrTokenRevoked) ||
+		errors.Is(err, service.ErrRefreshTokenReused) ||
+		errors.Is(err, service.ErrInvalidAPIKey) ||
+		errors.Is(err, service.ErrAPIKeyRequestReplayed) ||
+		errors.Is(err, service.ErrSessionRevoked)
 }
 
 func isAuthInternalFailure(err error) bool {
 	return errors.Is(err, service.ErrSessionValidationFailed) ||
 		errors.Is(err, service.ErrSaveTokenFailed) ||
+		errors.Is(err, service.ErrRevokeTokenFailed) ||
 		errors.Is(err, service.ErrSignAccessTokenFailed) ||
 		errors.Is(err, service.ErrSignRefreshTokenFailed) ||
 		errors.Is(err, service.ErrBuildTokenClaimsFailed) ||
-		errors.Is(err, service.ErrInternalAuthContext)
+		errors.Is(err, service.ErrInternalAuthContext) ||
+		errors.Is(err, service.ErrLoginFailed) ||
+		errors.Is(err, service.ErrSigningKeysUnavailable) ||
+		errors.Is(err, service.ErrAPIKeyValidationFailed)
 }
 
 func CodeFromError(err error) codes.Code {
@@ -29,33 +29,66 @@ func UnaryServerInterceptor(logger *zapLogger.Logger) grpc.UnaryServerIntercepto
 
 		response, err := handler(ctx, request)
 
-		duration := time.Since(startTime)
-
-		if err != nil {
-			code := errors.CodeFromError(err)
-
-			fields := []zap.Field{
-				zap.String("method", method),
-				zap.String("code", code.String()),
-				zap.Duration("duration", duration),
-			}
-
-			switch code {
-			case codes.NotFound,
-				codes.AlreadyExists,
-				codes.PermissionDenied,
-				codes.ResourceExhausted,
-				codes.InvalidArgument,
-				codes.Unauthenticated,
-				codes.FailedPrecondition,
-				codes.Canceled,
-				codes.DeadlineExceeded:
-				logger.Warn(ctx, "gRPC request failed", fields...)
-			default:
-				logger.Error(ctx, "gRPC request failed", fields...)
-			}
-		}
+		logRequestResult(ctx, logger, method, time.Since(startTime), err)
 
 		return response, err
 	}
 }
+
+func StreamServerInterceptor(logger *zapLogger.Logger) grpc.StreamServerInterceptor {
+	return func(
+		server any,
+		serverStream grpc.ServerStream,
+		serverInfo *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx := serverStream.Context()
+		method := path.Base(serverInfo.FullMethod)
+		startTime := time.Now()
+
+		logger.Info(ctx, "gRPC stream started",
+			zap.String("method", method),
+		)
+
+		err := handler(server, serverStream)
+
+		logRequestResult(ctx, logger, method, time.Since(startTime), err)
+
+		return err
+	}
+}
+
+func logRequestResult(
+	ctx context.Context,
+	logger *zapLogger.Logger,
+	method string,
+	duration time.Duration,
+	err error,
+) {
+	if err == nil {
+		return
+	}
+
+	code := errors.CodeFromError(err)
+
+	fields := []zap.Field{
+		zap.String("method", method),
+		zap.String("code", code.String()),
+		zap.Duration("duration", duration),
+	}
+
+	switch code {
+	case codes.NotFound,
+		codes.AlreadyExists,
+		codes.PermissionDenied,
+		codes.ResourceExhausted,
+		codes.InvalidArgument,
+		codes.Unauthenticated,
+		codes.FailedPrecondition,
+		codes.Canceled,
+		codes.DeadlineExceeded:
+		logger.Warn(ctx, "gRPC request failed", fields...)
+	default:
+		logger.Error(ctx, "gRPC request failed", fields...)
+	}
+}
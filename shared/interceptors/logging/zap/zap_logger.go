@@ -255,6 +255,7 @@ func fieldsFromContext(ctx context.Context) []zap.Field {
 	traceID, hasTraceID := requestctx.TraceIDFromContext(ctx)
 	userID, hasUserID := requestctx.UserIDFromContext(ctx)
 	userRoles, hasUserRoles := requestctx.UserRolesFromContext(ctx)
+	serviceName, hasServiceName := requestctx.ServiceNameFromContext(ctx)
 	extra, _ := ctx.Value(extraFieldsKey).(*contextFields)
 
 	totalFields := 0
@@ -267,6 +268,9 @@ func fieldsFromContext(ctx context.Context) []zap.Field {
 	if hasUserRoles {
 		totalFields++
 	}
+	if hasServiceName {
+		totalFields++
+	}
 	if extra != nil {
 		totalFields += len(extra.fields)
 	}
@@ -291,6 +295,9 @@ func fieldsFromContext(ctx context.Context) []zap.Field {
 		slices.Sort(roleNames)
 		fields = append(fields, zap.Strings("user_roles", roleNames))
 	}
+	if hasServiceName {
+		fields = append(fields, zap.String("caller_service", serviceName))
+	}
 	if extra != nil {
 		fields = append(fields, extra.fields...)
 	}
@@ -64,3 +64,47 @@ func UnaryServerInterceptor(serviceName string) grpc.UnaryServerInterceptor {
 		return resp, err
 	}
 }
+
+func StreamServerInterceptor(serviceName string) grpc.StreamServerInterceptor {
+	return func(
+		server any,
+		serverStream grpc.ServerStream,
+		serverInfo *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) (err error) {
+		start := time.Now()
+
+		inFlight := metrics.InFlightRequests.WithLabelValues(serviceName, serverInfo.FullMethod)
+		inFlight.Inc()
+
+		defer func() {
+			inFlight.Dec()
+
+			code := errors.CodeFromError(err).String()
+
+			// Аналогично unary: фиксируем метрики и пробрасываем panic дальше
+			r := recover()
+			if r != nil {
+				code = codes.Internal.String()
+			}
+
+			metrics.RequestsTotal.WithLabelValues(
+				serviceName,
+				serverInfo.FullMethod,
+				code,
+			).Inc()
+
+			metrics.RequestDuration.WithLabelValues(
+				serviceName,
+				serverInfo.FullMethod,
+			).Observe(time.Since(start).Seconds())
+
+			if r != nil {
+				panic(r)
+			}
+		}()
+
+		err = handler(server, serverStream)
+		return err
+	}
+}
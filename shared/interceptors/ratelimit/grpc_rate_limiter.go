@@ -22,6 +22,7 @@ func OrderUnaryServerInterceptor(cfg config.OrderConfig, logger *zapLogger.Logge
 		orderProto.OrderService_CreateOrder_FullMethodName:    cfg.GRPCRateLimit.CreateOrder,
 		orderProto.OrderService_GetOrderStatus_FullMethodName: cfg.GRPCRateLimit.GetOrderStatus,
 		authProto.AuthService_RefreshToken_FullMethodName:     cfg.GRPCRateLimit.RefreshToken,
+		authProto.AuthService_Login_FullMethodName:            cfg.GRPCRateLimit.Login,
 	}, cfg.Service.Name, logger)
 }
 
@@ -34,3 +34,25 @@ func UnaryServerInterceptor(logger *zapLogger.Logger) grpc.UnaryServerIntercepto
 		return handler(ctx, request)
 	}
 }
+
+func StreamServerInterceptor(logger *zapLogger.Logger) grpc.StreamServerInterceptor {
+	return func(
+		server any,
+		serverStream grpc.ServerStream,
+		_ *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				logger.Error(serverStream.Context(), "panic recovered in gRPC stream handler",
+					zap.String("panic", fmt.Sprintf("%v", r)),
+					zap.ByteString("stack", debug.Stack()),
+				)
+
+				err = status.Error(codes.Internal, "internal error")
+			}
+		}()
+
+		return handler(server, serverStream)
+	}
+}
@@ -0,0 +1,26 @@
+package stream
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// serverStream подменяет контекст стрима, чтобы stream-интерсепторы
+// могли передавать обработчику обогащённый context (auth, tracing).
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func WithContext(stream grpc.ServerStream, ctx context.Context) grpc.ServerStream {
+	if wrapped, ok := stream.(*serverStream); ok {
+		return &serverStream{ServerStream: wrapped.ServerStream, ctx: ctx}
+	}
+
+	return &serverStream{ServerStream: stream, ctx: ctx}
+}
@@ -8,6 +8,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 
+	"github.com/nastyazhadan/spot-order-grpc/shared/interceptors/stream"
 	"github.com/nastyazhadan/spot-order-grpc/shared/requestctx"
 )
 
@@ -45,6 +46,42 @@ func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
 	}
 }
 
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		server any,
+		serverStream grpc.ServerStream,
+		serverInfo *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		tracer := otel.Tracer(instrumentationName)
+		propagator := otel.GetTextMapPropagator()
+		ctx := serverStream.Context()
+
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			md = metadata.New(nil)
+		}
+
+		ctx = propagator.Extract(ctx, metadataCarrier(md))
+
+		ctx, span := tracer.Start(
+			ctx,
+			serverInfo.FullMethod,
+			trace.WithSpanKind(trace.SpanKindServer),
+		)
+		defer span.End()
+
+		addTraceIDToResponse(ctx)
+
+		err := handler(server, stream.WithContext(serverStream, ctx))
+		if err != nil {
+			span.RecordError(err)
+		}
+
+		return err
+	}
+}
+
 func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
 	return func(ctx context.Context,
 		method string,
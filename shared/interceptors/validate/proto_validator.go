@@ -31,3 +31,42 @@ func UnaryServerInterceptor() (grpc.UnaryServerInterceptor, error) {
 		return handler(context, request)
 	}, nil
 }
+
+func StreamServerInterceptor() (grpc.StreamServerInterceptor, error) {
+	validator, err := protovalidate.New()
+	if err != nil {
+		return nil, fmt.Errorf("protovalidate.New: %w", err)
+	}
+
+	return func(
+		server any,
+		serverStream grpc.ServerStream,
+		_ *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return handler(server, &validatingServerStream{
+			ServerStream: serverStream,
+			validator:    validator,
+		})
+	}, nil
+}
+
+// validatingServerStream валидирует каждое входящее сообщение стрима.
+type validatingServerStream struct {
+	grpc.ServerStream
+	validator protovalidate.Validator
+}
+
+func (s *validatingServerStream) RecvMsg(message any) error {
+	if err := s.ServerStream.RecvMsg(message); err != nil {
+		return err
+	}
+
+	if protoMessage, ok := message.(proto.Message); ok {
+		if validateErr := s.validator.Validate(protoMessage); validateErr != nil {
+			return status.Error(codes.InvalidArgument, validateErr.Error())
+		}
+	}
+
+	return nil
+}
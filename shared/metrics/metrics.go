@@ -107,6 +107,32 @@ var (
 		[]string{"service", "operation", "role", "result"},
 	)
 
+	// tier: local (LRU в процессе) или redis; result: hit или miss
+	MarketCacheTierLookupsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "grpc_server_market_cache_tier_lookups_total",
+			Help: "Total number of market by id lookups per cache tier",
+		},
+		[]string{"service", "tier", "result"},
+	)
+
+	LeaderElectionIsLeader = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "grpc_server_leader_election_is_leader",
+			Help: "Whether this instance currently holds the leader lease (1) or not (0)",
+		},
+		[]string{"service", "lease"},
+	)
+
+	// transition: acquired, lost или released
+	LeaderElectionTransitionsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "grpc_server_leader_election_transitions_total",
+			Help: "Total number of leader lease transitions",
+		},
+		[]string{"service", "lease", "transition"},
+	)
+
 	MarketBlockStateSyncTotal = promauto.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "grpc_server_market_block_state_sync_total",
@@ -206,6 +232,109 @@ var (
 		},
 		[]string{"service"},
 	)
+
+	MarketReplicaLookupsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "grpc_server_market_replica_lookups_total",
+			Help: "Total number of market replica lookups by result",
+		},
+		[]string{"service", "result"},
+	)
+
+	MarketReplicaSyncsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "grpc_server_market_replica_syncs_total",
+			Help: "Total number of full market replica syncs from spot",
+		},
+		[]string{"service", "result"},
+	)
+
+	MarketWatchSubscribers = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "grpc_server_market_watch_subscribers",
+			Help: "Current number of active WatchMarkets subscribers",
+		},
+		[]string{"service"},
+	)
+
+	MarketWatchDisconnectsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "grpc_server_market_watch_disconnects_total",
+			Help: "Total number of WatchMarkets subscriptions closed by the server by reason",
+		},
+		[]string{"service", "reason"},
+	)
+
+	// result: applied, stale (старше окна), duplicate (повторная доставка) или invalid
+	TickerTradesTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "grpc_server_ticker_trades_total",
+			Help: "Total number of trade events processed by the ticker builder by result",
+		},
+		[]string{"service", "result"},
+	)
+
+	TickerFlushesTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "grpc_server_ticker_flushes_total",
+			Help: "Total number of ticker snapshot writes to Redis by mode and result",
+		},
+		[]string{"service", "mode", "result"},
+	)
+
+	// result: applied, duplicate (event_id уже учтён) или invalid
+	CandleTradesTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "grpc_server_candle_trades_total",
+			Help: "Total number of trade events processed by the candle aggregator by result",
+		},
+		[]string{"service", "result"},
+	)
+
+	// result: success или error
+	JWKSFetchesTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "grpc_server_jwks_fetches_total",
+			Help: "Total number of JWKS downloads by token verifiers by result",
+		},
+		[]string{"service", "result"},
+	)
+
+	// result: success, invalid_credentials, disabled, locked или error
+	LoginAttemptsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "grpc_server_login_attempts_total",
+			Help: "Total number of login attempts by result",
+		},
+		[]string{"service", "result"},
+	)
+
+	// result: success, invalid, expired, replayed, ip_denied, disabled или error
+	APIKeyAuthTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "grpc_server_api_key_auth_total",
+			Help: "Total number of API key signed requests by authentication result",
+		},
+		[]string{"service", "result"},
+	)
+
+	// reason: no_roles, role или scope
+	AuthorizationDeniedTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "grpc_server_authorization_denied_total",
+			Help: "Total number of requests denied by method permission check",
+		},
+		[]string{"service", "method", "reason"},
+	)
+
+	// event_type: refresh_token_reuse
+	SecurityEventsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "grpc_server_auth_security_events_total",
+			Help: "Total number of detected authentication security events by type",
+		},
+		[]string{"service", "event_type"},
+	)
 )
 
 func ObserveWithTrace(ctx context.Context, wrap prometheus.Observer, time float64) {
@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+type Asset struct {
+	Code      string
+	Name      string
+	Precision uint32
+	Enabled   bool
+	UpdatedAt time.Time
+}
+
+// AssetUpdate — частичное изменение актива: nil-поля не трогаются.
+type AssetUpdate struct {
+	Code      string
+	Name      *string
+	Precision *uint32
+	Enabled   *bool
+}
@@ -6,12 +6,50 @@ import (
 	"github.com/google/uuid"
 )
 
-const MarketStateChangedEventType = "market.state.changed"
-
-type MarketStateChangedEvent struct {
-	EventID   uuid.UUID
-	MarketID  uuid.UUID
-	Enabled   bool
-	DeletedAt *time.Time
-	UpdatedAt time.Time
+const MarketUpdatedEventType = "market.updated"
+
+type MarketField string
+
+const (
+	MarketFieldName       MarketField = "name"
+	MarketFieldBaseAsset  MarketField = "base_asset"
+	MarketFieldQuoteAsset MarketField = "quote_asset"
+	MarketFieldEnabled    MarketField = "enabled"
+	MarketFieldDeletedAt  MarketField = "deleted_at"
+	MarketFieldRestricted MarketField = "restricted"
+)
+
+// MarketUpdatedEvent — полный снимок рынка после изменения.
+// Version задаёт порядок событий одного рынка: консьюмеры сравнивают версии, а не updated_at.
+type MarketUpdatedEvent struct {
+	EventID       uuid.UUID
+	MarketID      uuid.UUID
+	Version       int64
+	Name          string
+	BaseAsset     string
+	QuoteAsset    string
+	Enabled       bool
+	DeletedAt     *time.Time
+	UpdatedAt     time.Time
+	Restricted    bool
+	ChangedFields []MarketField
+	Previous      MarketPreviousValues
+	// PublicToAllRoles вычисляется по текущим политикам видимости и в ChangedFields не попадает
+	PublicToAllRoles bool
+}
+
+// MarketPreviousValues — значения до изменения, заполнены только поля из ChangedFields.
+// DeletedAt задан, только если deleted_at менялся; DeletedAt.Value == nil — рынок не был удалён.
+type MarketPreviousValues struct {
+	Name       *string
+	BaseAsset  *string
+	QuoteAsset *string
+	Enabled    *bool
+	DeletedAt  *OptionalTime
+	Restricted *bool
+}
+
+// OptionalTime — значение поля-времени, которое само может отсутствовать.
+type OptionalTime struct {
+	Value *time.Time
 }
@@ -1,15 +1,76 @@
 package models
 
 import (
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 type Market struct {
-	ID        uuid.UUID
-	Name      string
-	Enabled   bool
-	DeletedAt *time.Time
-	UpdatedAt time.Time
+	ID         uuid.UUID
+	Name       string
+	BaseAsset  string
+	QuoteAsset string
+	Enabled    bool
+	DeletedAt  *time.Time
+	UpdatedAt  time.Time
+	// Version растёт на 1 при каждом изменении строки market_store.
+	Version int64
+	// Restricted — рынок доступен только пользователям и ролям из market_access.
+	Restricted bool
+	// PublicToAllRoles — включённый рынок виден любой роли по политикам видимости spot.
+	// Вычисляет spot при выдаче рынка; false — order-service проверяет рынок через spot.
+	PublicToAllRoles bool
+}
+
+type MarketStatus uint8
+
+const (
+	MarketStatusUnspecified MarketStatus = iota
+	MarketStatusEnabled
+	MarketStatusDisabled
+	MarketStatusDeleted
+)
+
+func ParseMarketStatus(value string) (MarketStatus, bool) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "enabled":
+		return MarketStatusEnabled, true
+	case "disabled":
+		return MarketStatusDisabled, true
+	case "deleted":
+		return MarketStatusDeleted, true
+	default:
+		return MarketStatusUnspecified, false
+	}
+}
+
+// MarketFilter — фильтры ViewMarkets. Активы сравниваются с base_asset/quote_asset рынка.
+type MarketFilter struct {
+	NamePrefix string
+	BaseAsset  string
+	QuoteAsset string
+	Status     MarketStatus
+}
+
+func (f MarketFilter) IsEmpty() bool {
+	return f == MarketFilter{}
+}
+
+type MarketLookupStatus uint8
+
+const (
+	MarketLookupStatusUnspecified MarketLookupStatus = iota
+	MarketLookupStatusFound
+	MarketLookupStatusNotFound
+	MarketLookupStatusDisabled
+)
+
+// MarketLookup — результат поиска одного id в GetMarketsByIDs.
+// Market заполнен только при статусе Found.
+type MarketLookup struct {
+	ID     uuid.UUID
+	Status MarketLookupStatus
+	Market Market
 }
@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type MarketAccessSubjectType string
+
+const (
+	MarketAccessSubjectUser MarketAccessSubjectType = "user"
+	// Группа пользователей — роль из JWT (ROLE_USER, ROLE_VIEWER, ...)
+	MarketAccessSubjectRole MarketAccessSubjectType = "role"
+)
+
+// MarketAccessGrant — запись списка доступа к restricted-рынку.
+// SubjectID — user_id для user и имя роли для role.
+type MarketAccessGrant struct {
+	MarketID    uuid.UUID
+	SubjectType MarketAccessSubjectType
+	SubjectID   string
+	GrantedBy   string
+	GrantedAt   time.Time
+}
@@ -0,0 +1,53 @@
+package models
+
+// Permission — право на вызов метода, объявляется опцией (common.v1.required_permission) в proto
+type Permission string
+
+const (
+	PermissionOrdersRead    Permission = "orders:read"
+	PermissionOrdersWrite   Permission = "orders:write"
+	PermissionMarketsRead   Permission = "markets:read"
+	PermissionMarketsAdmin  Permission = "markets:admin"
+	PermissionSessionsAdmin Permission = "sessions:admin"
+	PermissionUsersAdmin    Permission = "users:admin"
+)
+
+var rolePermissions = map[UserRole][]Permission{
+	UserRoleUser: {
+		PermissionOrdersRead, PermissionOrdersWrite, PermissionMarketsRead,
+	},
+	UserRoleViewer: {
+		PermissionOrdersRead, PermissionOrdersWrite, PermissionMarketsRead,
+	},
+	// Сервису хватает чтения рынков для сверки реплики и проверок в фоновых задачах
+	UserRoleService: {
+		PermissionMarketsRead,
+	},
+	UserRoleAdmin: {
+		PermissionOrdersRead, PermissionOrdersWrite, PermissionMarketsRead,
+		PermissionMarketsAdmin, PermissionSessionsAdmin, PermissionUsersAdmin,
+	},
+}
+
+func ParsePermission(value string) (Permission, bool) {
+	switch permission := Permission(value); permission {
+	case PermissionOrdersRead, PermissionOrdersWrite, PermissionMarketsRead,
+		PermissionMarketsAdmin, PermissionSessionsAdmin, PermissionUsersAdmin:
+		return permission, true
+	default:
+		return "", false
+	}
+}
+
+// HasPermission — есть ли право хотя бы у одной из ролей
+func HasPermission(roles []UserRole, permission Permission) bool {
+	for _, role := range roles {
+		for _, granted := range rolePermissions[role] {
+			if granted == permission {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -9,6 +9,8 @@ const (
 	UserRoleUser
 	UserRoleAdmin
 	UserRoleViewer
+	// UserRoleService — роль токена сервиса, пользователям не выдаётся
+	UserRoleService
 )
 
 func (r UserRole) String() string {
@@ -19,6 +21,8 @@ func (r UserRole) String() string {
 		return "ROLE_ADMIN"
 	case UserRoleViewer:
 		return "ROLE_VIEWER"
+	case UserRoleService:
+		return "ROLE_SERVICE"
 	default:
 		return "ROLE_UNSPECIFIED"
 	}
@@ -32,6 +36,8 @@ func ParseUserRole(value string) (UserRole, bool) {
 		return UserRoleAdmin, true
 	case "ROLE_VIEWER":
 		return UserRoleViewer, true
+	case "ROLE_SERVICE":
+		return UserRoleService, true
 	default:
 		return UserRoleUnspecified, false
 	}
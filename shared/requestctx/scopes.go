@@ -0,0 +1,33 @@
+package requestctx
+
+import (
+	"context"
+
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+)
+
+const scopesKey contextKey = "scopes"
+
+// ContextWithScopes ограничивает запрос правами токена (например, API-ключа) поверх прав ролей
+func ContextWithScopes(ctx context.Context, scopes []models.Permission) (context.Context, bool) {
+	if ctx == nil {
+		return nil, false
+	}
+
+	copied := append([]models.Permission(nil), scopes...)
+	return context.WithValue(ctx, scopesKey, copied), true
+}
+
+// ScopesFromContext возвращает false, если запрос не ограничен scope
+func ScopesFromContext(ctx context.Context) ([]models.Permission, bool) {
+	if ctx == nil {
+		return nil, false
+	}
+
+	scopes, ok := ctx.Value(scopesKey).([]models.Permission)
+	if !ok {
+		return nil, false
+	}
+
+	return append([]models.Permission(nil), scopes...), true
+}
@@ -0,0 +1,27 @@
+package requestctx
+
+import "context"
+
+const serviceNameKey contextKey = "service_name"
+
+// ContextWithServiceName отмечает запрос, выполняемый сервисом (роль ROLE_SERVICE), а не пользователем
+func ContextWithServiceName(ctx context.Context, service string) (context.Context, bool) {
+	if ctx == nil {
+		return nil, false
+	}
+
+	return context.WithValue(ctx, serviceNameKey, service), true
+}
+
+func ServiceNameFromContext(ctx context.Context) (string, bool) {
+	if ctx == nil {
+		return "", false
+	}
+
+	service, ok := ctx.Value(serviceNameKey).(string)
+	if !ok || service == "" {
+		return "", false
+	}
+
+	return service, true
+}
@@ -0,0 +1,26 @@
+package requestctx
+
+import "context"
+
+const sessionIDKey contextKey = "session_id"
+
+func ContextWithSessionID(ctx context.Context, sessionID string) (context.Context, bool) {
+	if ctx == nil {
+		return nil, false
+	}
+
+	return context.WithValue(ctx, sessionIDKey, sessionID), true
+}
+
+func SessionIDFromContext(ctx context.Context) (string, bool) {
+	if ctx == nil {
+		return "", false
+	}
+
+	sessionID, ok := ctx.Value(sessionIDKey).(string)
+	if !ok || sessionID == "" {
+		return "", false
+	}
+
+	return sessionID, true
+}
@@ -0,0 +1,239 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	sharedConfig "github.com/nastyazhadan/spot-order-grpc/shared/config"
+	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/db"
+	zapLogger "github.com/nastyazhadan/spot-order-grpc/shared/interceptors/logging/zap"
+	"github.com/nastyazhadan/spot-order-grpc/shared/requestctx"
+	"github.com/nastyazhadan/spot-order-grpc/spotService/config"
+	"github.com/nastyazhadan/spot-order-grpc/spotService/internal/application/dto/inbound/marketfile"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+	"github.com/nastyazhadan/spot-order-grpc/spotService/internal/infrastructure/postgres/spot"
+	spotService "github.com/nastyazhadan/spot-order-grpc/spotService/internal/services/spot"
+)
+
+// Имя сервиса в метриках запросов к БД
+const serviceName = "spot-markets-cli"
+
+const usage = `usage:
+  markets import -file <path> [-format json|csv|yaml] [-dry-run] [-if-empty] [-actor <user_id>]
+  markets export [-file <path>] [-format json|csv|yaml]`
+
+func main() {
+	if len(os.Args) < 2 {
+		log.Fatal(usage)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	var err error
+	switch os.Args[1] {
+	case "import":
+		err = runImport(ctx, os.Args[2:])
+	case "export":
+		err = runExport(ctx, os.Args[2:])
+	default:
+		log.Fatal(usage)
+	}
+	if err != nil {
+		log.Fatalf("markets %s: %v", os.Args[1], err)
+	}
+}
+
+func runImport(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("import", flag.ExitOnError)
+	path := flags.String("file", "", "file with markets")
+	formatName := flags.String("format", "", "json, csv or yaml; by default taken from the file extension")
+	dryRun := flags.Bool("dry-run", false, "print changes without applying them")
+	ifEmpty := flags.Bool("if-empty", false, "import only into an empty catalog, e.g. to seed a fresh database")
+	actor := flags.String("actor", "", "user_id recorded as the author in market history")
+	timeout := flags.Duration("timeout", time.Minute, "import timeout")
+	_ = flags.Parse(args)
+
+	if *path == "" {
+		return errors.New("-file is required")
+	}
+
+	format, err := resolveFormat(*formatName, *path)
+	if err != nil {
+		return err
+	}
+
+	if *actor != "" {
+		actorID, parseErr := uuid.Parse(*actor)
+		if parseErr != nil {
+			return fmt.Errorf("invalid -actor: %w", parseErr)
+		}
+		ctx, _ = requestctx.ContextWithUserID(ctx, actorID)
+	}
+
+	file, err := os.Open(*path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	specs, err := marketfile.Decode(file, format)
+	if err != nil {
+		return err
+	}
+
+	pool, err := openPool(ctx)
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+
+	importer := newMarketImporter(pool, *timeout)
+
+	if *ifEmpty {
+		existing, exportErr := importer.ExportMarkets(ctx)
+		if exportErr != nil {
+			return exportErr
+		}
+		if len(existing) > 0 {
+			fmt.Printf("catalog already has %d markets, nothing imported\n", len(existing))
+			return nil
+		}
+	}
+
+	changes, err := importer.ImportMarkets(ctx, specs, *dryRun)
+	if err != nil {
+		return err
+	}
+
+	printMarketImportChanges(os.Stdout, changes, *dryRun)
+	return nil
+}
+
+func runExport(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	path := flags.String("file", "", "output file; stdout by default")
+	formatName := flags.String("format", "", "json, csv or yaml; by default taken from the file extension, yaml for stdout")
+	timeout := flags.Duration("timeout", time.Minute, "export timeout")
+	_ = flags.Parse(args)
+
+	format := marketfile.FormatYAML
+	if *formatName != "" || *path != "" {
+		var err error
+		if format, err = resolveFormat(*formatName, *path); err != nil {
+			return err
+		}
+	}
+
+	pool, err := openPool(ctx)
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+
+	specs, err := newMarketImporter(pool, *timeout).ExportMarkets(ctx)
+	if err != nil {
+		return err
+	}
+
+	if *path == "" {
+		return marketfile.Encode(os.Stdout, format, specs)
+	}
+
+	file, err := os.Create(*path)
+	if err != nil {
+		return err
+	}
+
+	if err = marketfile.Encode(file, format, specs); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+func resolveFormat(name, path string) (marketfile.Format, error) {
+	if name != "" {
+		format, ok := marketfile.ParseFormat(name)
+		if !ok {
+			return "", fmt.Errorf("unknown format %q", name)
+		}
+		return format, nil
+	}
+
+	format, ok := marketfile.FormatFromPath(path)
+	if !ok {
+		return "", fmt.Errorf("cannot detect format of %q, pass -format", path)
+	}
+
+	return format, nil
+}
+
+func openPool(ctx context.Context) (*pgxpool.Pool, error) {
+	cfg, err := config.LoadMigrate()
+	if err != nil {
+		return nil, fmt.Errorf("load config: %w", err)
+	}
+
+	return db.OpenPostgres(ctx, cfg.DBURI, db.PoolConfig{
+		MaxConnections:  cfg.PostgresPool.MaxConnections,
+		MinConnections:  cfg.PostgresPool.MinConnections,
+		MaxConnLifetime: cfg.PostgresPool.MaxConnLifetime,
+		MaxConnIdleTime: cfg.PostgresPool.MaxConnIdleTime,
+	})
+}
+
+func newMarketImporter(pool *pgxpool.Pool, timeout time.Duration) *spotService.MarketImporter {
+	var spotConfig sharedConfig.SpotConfig
+	spotConfig.Service.Name = serviceName
+
+	return spotService.NewMarketImporter(
+		spot.NewMarketImportStore(pool, spotConfig),
+		spot.NewAssetStore(pool, spotConfig),
+		timeout,
+		zapLogger.NewNop(),
+	)
+}
+
+func printMarketImportChanges(writer io.Writer, changes []domainModels.MarketImportChange, dryRun bool) {
+	var created, updated, unchanged int
+
+	for _, change := range changes {
+		switch change.Action {
+		case domainModels.MarketImportActionCreate:
+			created++
+			fmt.Fprintf(writer, "+ %s (base=%s quote=%s enabled=%t deleted=%t restricted=%t)\n",
+				change.Name, change.Spec.BaseAsset, change.Spec.QuoteAsset,
+				change.Spec.Enabled, change.Spec.Deleted, change.Spec.Restricted,
+			)
+		case domainModels.MarketImportActionUpdate:
+			updated++
+			fields := make([]string, 0, len(change.Fields))
+			for _, field := range change.Fields {
+				fields = append(fields, fmt.Sprintf("%s %s -> %s", field.Field, field.Before, field.After))
+			}
+			fmt.Fprintf(writer, "~ %s: %s\n", change.Name, strings.Join(fields, ", "))
+		default:
+			unchanged++
+		}
+	}
+
+	mode := "applied"
+	if dryRun {
+		mode = "dry run, nothing applied"
+	}
+	fmt.Fprintf(writer, "created: %d, updated: %d, unchanged: %d (%s)\n", created, updated, unchanged, mode)
+}
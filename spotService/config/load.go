@@ -5,8 +5,11 @@ import (
 	"fmt"
 	"math"
 	"os"
+	"slices"
+	"strings"
 
 	"github.com/nastyazhadan/spot-order-grpc/shared/config"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
 )
 
 func Load() (*config.SpotConfig, error) {
@@ -26,7 +29,7 @@ func Load() (*config.SpotConfig, error) {
 	}
 
 	cfg.AuthVerifier.JWTSecret = os.Getenv("JWT_SECRET")
-	if cfg.AuthVerifier.JWTSecret == "" {
+	if cfg.AuthVerifier.JWTSecret == "" && cfg.AuthVerifier.JWKS.URL == "" {
 		return nil, errors.New("JWT_SECRET is required")
 	}
 
@@ -64,6 +67,24 @@ func validateSpotConfig(cfg config.SpotConfig) error {
 	if err := validateSpotMarketPoller(cfg); err != nil {
 		return err
 	}
+	if err := validateSpotMarketWatch(cfg); err != nil {
+		return err
+	}
+	if err := validateSpotLocalCache(cfg); err != nil {
+		return err
+	}
+	if err := validateSpotVisibility(cfg); err != nil {
+		return err
+	}
+	if err := validateSpotTicker(cfg); err != nil {
+		return err
+	}
+	if err := validateSpotCandles(cfg); err != nil {
+		return err
+	}
+	if err := validateSpotJWKS(cfg); err != nil {
+		return err
+	}
 	if err := config.ValidateTracingConfig("tracing", cfg.Tracing); err != nil {
 		return err
 	}
@@ -197,6 +218,14 @@ func validateSpotMarketPoller(cfg config.SpotConfig) error {
 		)
 	}
 
+	if cfg.MarketPoller.FallbackInterval < cfg.MarketPoller.PollInterval {
+		return fmt.Errorf(
+			"market_poller.fallback_interval (%s) must be greater than or equal to market_poller.poll_interval (%s)",
+			cfg.MarketPoller.FallbackInterval,
+			cfg.MarketPoller.PollInterval,
+		)
+	}
+
 	if cfg.MarketPoller.ProcessingTimeout <= 0 {
 		return fmt.Errorf(
 			"market_poller.processing_timeout must be greater than 0, got %s",
@@ -226,6 +255,256 @@ func validateSpotMarketPoller(cfg config.SpotConfig) error {
 		)
 	}
 
+	return config.ValidateLeaderElectionConfig("market_poller.leader_election", cfg.MarketPoller.LeaderElection)
+}
+
+func validateSpotMarketWatch(cfg config.SpotConfig) error {
+	if cfg.MarketWatch.SubscriberBuffer <= 0 {
+		return fmt.Errorf(
+			"market_watch.subscriber_buffer must be greater than 0, got %d",
+			cfg.MarketWatch.SubscriberBuffer,
+		)
+	}
+
+	if cfg.MarketWatch.MaxSubscribers <= 0 {
+		return fmt.Errorf(
+			"market_watch.max_subscribers must be greater than 0, got %d",
+			cfg.MarketWatch.MaxSubscribers,
+		)
+	}
+
+	if cfg.MarketWatch.PageSize <= 0 {
+		return fmt.Errorf(
+			"market_watch.page_size must be greater than 0, got %d",
+			cfg.MarketWatch.PageSize,
+		)
+	}
+
+	if cfg.MarketWatch.PageSize > uint64(math.MaxInt32) {
+		return fmt.Errorf(
+			"market_watch.page_size must be less than or equal to %d, got %d",
+			math.MaxInt32,
+			cfg.MarketWatch.PageSize,
+		)
+	}
+
+	if cfg.MarketWatch.FeedChannel == "" {
+		return errors.New("market_watch.feed_channel is required")
+	}
+
+	if cfg.MarketWatch.FeedRestartBackoff <= 0 {
+		return fmt.Errorf(
+			"market_watch.feed_restart_backoff must be greater than 0, got %s",
+			cfg.MarketWatch.FeedRestartBackoff,
+		)
+	}
+
+	if cfg.MarketWatch.ChangeLogRetention <= 0 {
+		return fmt.Errorf(
+			"market_watch.change_log_retention must be greater than 0, got %s",
+			cfg.MarketWatch.ChangeLogRetention,
+		)
+	}
+
+	if cfg.LocalCache.InvalidationChannel == cfg.MarketWatch.FeedChannel {
+		return errors.New("market_watch.feed_channel must differ from local_cache.invalidation_channel")
+	}
+
+	return nil
+}
+
+func validateSpotLocalCache(cfg config.SpotConfig) error {
+	if cfg.LocalCache.Size <= 0 {
+		return fmt.Errorf(
+			"local_cache.size must be greater than 0, got %d",
+			cfg.LocalCache.Size,
+		)
+	}
+
+	if cfg.LocalCache.TTL <= 0 {
+		return fmt.Errorf(
+			"local_cache.ttl must be greater than 0, got %s",
+			cfg.LocalCache.TTL,
+		)
+	}
+
+	// Локальная копия не должна переживать запись в Redis, из которой она поднята
+	if cfg.LocalCache.TTL > cfg.Redis.CacheTTL {
+		return fmt.Errorf(
+			"local_cache.ttl (%s) must be less than or equal to redis.spot_cache_ttl (%s)",
+			cfg.LocalCache.TTL,
+			cfg.Redis.CacheTTL,
+		)
+	}
+
+	if cfg.LocalCache.InvalidationChannel == "" {
+		return errors.New("local_cache.invalidation_channel is required")
+	}
+
+	if cfg.LocalCache.RestartBackoff <= 0 {
+		return fmt.Errorf(
+			"local_cache.restart_backoff must be greater than 0, got %s",
+			cfg.LocalCache.RestartBackoff,
+		)
+	}
+
+	return nil
+}
+
+func validateSpotVisibility(cfg config.SpotConfig) error {
+	policies := cfg.Visibility.Policies
+	if len(policies) == 0 {
+		return errors.New("visibility.policies must contain at least one policy")
+	}
+
+	policyIDs := make(map[string]struct{}, len(policies))
+	roleOwners := make(map[models.UserRole]string)
+
+	for i, policy := range policies {
+		prefix := fmt.Sprintf("visibility.policies[%d]", i)
+
+		// id входит в ключ Redis market:cache:<id>
+		if policy.ID == "" || strings.ContainsAny(policy.ID, ": \t\n") {
+			return fmt.Errorf("%s.id must be non-empty and must not contain ':' or whitespace, got %q", prefix, policy.ID)
+		}
+		if _, ok := policyIDs[policy.ID]; ok {
+			return fmt.Errorf("%s.id %q is duplicated", prefix, policy.ID)
+		}
+		policyIDs[policy.ID] = struct{}{}
+
+		if len(policy.Roles) == 0 {
+			return fmt.Errorf("%s.roles must not be empty", prefix)
+		}
+		for _, value := range policy.Roles {
+			role, ok := models.ParseUserRole(value)
+			if !ok {
+				return fmt.Errorf("%s.roles: unknown role %q", prefix, value)
+			}
+			// Роль достаётся первой политике, в более поздней она бы не сработала
+			if owner, ok := roleOwners[role]; ok {
+				return fmt.Errorf("%s.roles: role %s is already mapped to policy %q", prefix, role, owner)
+			}
+			roleOwners[role] = policy.ID
+		}
+
+		if len(policy.Statuses) == 0 {
+			return fmt.Errorf("%s.statuses must not be empty", prefix)
+		}
+		for _, value := range policy.Statuses {
+			if _, ok := models.ParseMarketStatus(value); !ok {
+				return fmt.Errorf("%s.statuses: unknown status %q", prefix, value)
+			}
+		}
+
+		if slices.Contains(policy.BaseAssets, "") || slices.Contains(policy.QuoteAssets, "") {
+			return fmt.Errorf("%s: asset allowlists must not contain empty values", prefix)
+		}
+	}
+
+	return nil
+}
+
+func validateSpotTicker(cfg config.SpotConfig) error {
+	if cfg.Ticker.BucketSize <= 0 {
+		return fmt.Errorf(
+			"ticker.bucket_size must be greater than 0, got %s",
+			cfg.Ticker.BucketSize,
+		)
+	}
+
+	if cfg.Ticker.Window < cfg.Ticker.BucketSize || cfg.Ticker.Window%cfg.Ticker.BucketSize != 0 {
+		return fmt.Errorf(
+			"ticker.window (%s) must be a positive multiple of ticker.bucket_size (%s)",
+			cfg.Ticker.Window,
+			cfg.Ticker.BucketSize,
+		)
+	}
+
+	if cfg.Ticker.FlushInterval <= 0 || cfg.Ticker.FlushInterval > cfg.Ticker.BucketSize {
+		return fmt.Errorf(
+			"ticker.flush_interval must be greater than 0 and less than or equal to ticker.bucket_size (%s), got %s",
+			cfg.Ticker.BucketSize,
+			cfg.Ticker.FlushInterval,
+		)
+	}
+
+	if cfg.Ticker.WatchInterval <= 0 {
+		return fmt.Errorf(
+			"ticker.watch_interval must be greater than 0, got %s",
+			cfg.Ticker.WatchInterval,
+		)
+	}
+
+	if cfg.Ticker.Key == "" {
+		return errors.New("ticker.key is required")
+	}
+
+	// Лидер переписывает все тикеры раз в bucket_size: TTL должен пережить хотя бы одну пропущенную запись
+	if cfg.Ticker.SnapshotTTL < 2*cfg.Ticker.BucketSize {
+		return fmt.Errorf(
+			"ticker.snapshot_ttl (%s) must be at least twice ticker.bucket_size (%s)",
+			cfg.Ticker.SnapshotTTL,
+			cfg.Ticker.BucketSize,
+		)
+	}
+
+	if cfg.Ticker.RestartBackoff <= 0 {
+		return fmt.Errorf(
+			"ticker.restart_backoff must be greater than 0, got %s",
+			cfg.Ticker.RestartBackoff,
+		)
+	}
+
+	return config.ValidateLeaderElectionConfig("ticker.leader_election", cfg.Ticker.LeaderElection)
+}
+
+func validateSpotJWKS(cfg config.SpotConfig) error {
+	if cfg.AuthVerifier.JWKS.URL == "" {
+		return nil
+	}
+
+	if cfg.AuthVerifier.JWKS.RefreshInterval <= 0 {
+		return fmt.Errorf(
+			"auth_verifier.jwks.refresh_interval must be greater than 0, got %s",
+			cfg.AuthVerifier.JWKS.RefreshInterval,
+		)
+	}
+
+	if cfg.AuthVerifier.JWKS.RequestTimeout <= 0 {
+		return fmt.Errorf(
+			"auth_verifier.jwks.request_timeout must be greater than 0, got %s",
+			cfg.AuthVerifier.JWKS.RequestTimeout,
+		)
+	}
+
+	return nil
+}
+
+func validateSpotCandles(cfg config.SpotConfig) error {
+	if cfg.Candles.DefaultLimit <= 0 {
+		return fmt.Errorf(
+			"candles.default_limit must be greater than 0, got %d",
+			cfg.Candles.DefaultLimit,
+		)
+	}
+
+	if cfg.Candles.MaxLimit > uint64(math.MaxInt32) {
+		return fmt.Errorf(
+			"candles.max_limit must be less than or equal to %d, got %d",
+			math.MaxInt32,
+			cfg.Candles.MaxLimit,
+		)
+	}
+
+	if cfg.Candles.DefaultLimit > cfg.Candles.MaxLimit {
+		return fmt.Errorf(
+			"candles.default_limit must be less than or equal to candles.max_limit, "+
+				"got default_limit=%d max_limit=%d",
+			cfg.Candles.DefaultLimit,
+			cfg.Candles.MaxLimit,
+		)
+	}
+
 	return nil
 }
 
@@ -238,6 +517,10 @@ func validateSpotKafka(cfg config.SpotConfig) error {
 		return errors.New("kafka.topics.market_state_changed is required")
 	}
 
+	if cfg.Kafka.Topics.OrderFilled == "" {
+		return errors.New("kafka.topics.order_filled is required")
+	}
+
 	if err := config.ValidateKafkaProducerConfig("kafka.producer", cfg.Kafka.Producer); err != nil {
 		return err
 	}
@@ -246,6 +529,15 @@ func validateSpotKafka(cfg config.SpotConfig) error {
 		return err
 	}
 
+	if err := config.ValidateKafkaConsumerConfig("kafka.consumer", cfg.Kafka.Consumer); err != nil {
+		return err
+	}
+
+	// У spot нет продюсера DLQ: необработанное событие перезапускает консьюмер
+	if cfg.Kafka.Consumer.DLQEnabled {
+		return errors.New("kafka.consumer.dlq_enabled is not supported by spot service")
+	}
+
 	return nil
 }
 
@@ -0,0 +1,40 @@
+package inbound
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	proto "github.com/nastyazhadan/spot-order-grpc/protos/gen/go/spot/v1"
+	sharedModels "github.com/nastyazhadan/spot-order-grpc/shared/models"
+)
+
+func AssetToProto(asset sharedModels.Asset) *proto.Asset {
+	var updatedAt *timestamppb.Timestamp
+	if !asset.UpdatedAt.IsZero() {
+		updatedAt = timestamppb.New(asset.UpdatedAt)
+	}
+
+	return &proto.Asset{
+		Code:      asset.Code,
+		Name:      asset.Name,
+		Precision: asset.Precision,
+		Enabled:   asset.Enabled,
+		UpdatedAt: updatedAt,
+	}
+}
+
+func AssetsToProto(assets []sharedModels.Asset) []*proto.Asset {
+	result := make([]*proto.Asset, 0, len(assets))
+	for _, asset := range assets {
+		result = append(result, AssetToProto(asset))
+	}
+	return result
+}
+
+func AssetUpdateFromProto(request *proto.UpdateAssetRequest) sharedModels.AssetUpdate {
+	return sharedModels.AssetUpdate{
+		Code:      request.GetCode(),
+		Name:      request.Name,
+		Precision: request.Precision,
+		Enabled:   request.Enabled,
+	}
+}
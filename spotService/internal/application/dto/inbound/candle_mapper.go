@@ -0,0 +1,52 @@
+package inbound
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	proto "github.com/nastyazhadan/spot-order-grpc/protos/gen/go/spot/v1"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+)
+
+var candleIntervalsFromProto = map[proto.CandleInterval]domainModels.CandleInterval{
+	proto.CandleInterval_CANDLE_INTERVAL_1M: domainModels.CandleInterval1m,
+	proto.CandleInterval_CANDLE_INTERVAL_5M: domainModels.CandleInterval5m,
+	proto.CandleInterval_CANDLE_INTERVAL_1H: domainModels.CandleInterval1h,
+	proto.CandleInterval_CANDLE_INTERVAL_1D: domainModels.CandleInterval1d,
+}
+
+var candleIntervalsToProto = map[domainModels.CandleInterval]proto.CandleInterval{
+	domainModels.CandleInterval1m: proto.CandleInterval_CANDLE_INTERVAL_1M,
+	domainModels.CandleInterval5m: proto.CandleInterval_CANDLE_INTERVAL_5M,
+	domainModels.CandleInterval1h: proto.CandleInterval_CANDLE_INTERVAL_1H,
+	domainModels.CandleInterval1d: proto.CandleInterval_CANDLE_INTERVAL_1D,
+}
+
+func CandleIntervalFromProto(interval proto.CandleInterval) (domainModels.CandleInterval, bool) {
+	result, ok := candleIntervalsFromProto[interval]
+	return result, ok
+}
+
+func CandleToProto(candle domainModels.Candle) *proto.Candle {
+	return &proto.Candle{
+		MarketId:    candle.MarketID.String(),
+		Interval:    candleIntervalsToProto[candle.Interval],
+		OpenTime:    timestamppb.New(candle.OpenTime),
+		CloseTime:   timestamppb.New(candle.CloseTime()),
+		Open:        decimalToProto(candle.Open),
+		High:        decimalToProto(candle.High),
+		Low:         decimalToProto(candle.Low),
+		Close:       decimalToProto(candle.Close),
+		Volume:      decimalToProto(candle.Volume),
+		QuoteVolume: decimalToProto(candle.QuoteVolume),
+		TradesCount: candle.TradesCount,
+	}
+}
+
+func CandlesToProto(candles []domainModels.Candle) []*proto.Candle {
+	result := make([]*proto.Candle, 0, len(candles))
+	for _, candle := range candles {
+		result = append(result, CandleToProto(candle))
+	}
+
+	return result
+}
@@ -0,0 +1,58 @@
+package kafka
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/proto"
+
+	protoEvent "github.com/nastyazhadan/spot-order-grpc/protos/gen/go/events/v1"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+)
+
+// UnmarshalOrderFilled читает исполнение ордера из order.filled как сделку для тикеров и свечей
+func UnmarshalOrderFilled(data []byte) (domainModels.Trade, error) {
+	var event protoEvent.OrderFilledEvent
+	if err := proto.Unmarshal(data, &event); err != nil {
+		return domainModels.Trade{}, fmt.Errorf("proto.UnmarshalOrderFilled: %w", err)
+	}
+
+	eventID, err := uuid.Parse(event.GetEventId())
+	if err != nil {
+		return domainModels.Trade{}, fmt.Errorf("invalid event_id: %w", err)
+	}
+
+	marketID, err := uuid.Parse(event.GetMarketId())
+	if err != nil {
+		return domainModels.Trade{}, fmt.Errorf("invalid market_id: %w", err)
+	}
+
+	price, err := decimal.NewFromString(event.GetPrice().GetValue())
+	if err != nil {
+		return domainModels.Trade{}, fmt.Errorf("invalid price: %w", err)
+	}
+	if !price.IsPositive() {
+		return domainModels.Trade{}, errors.New("price must be > 0")
+	}
+
+	if event.GetQuantity() <= 0 {
+		return domainModels.Trade{}, errors.New("quantity must be > 0")
+	}
+
+	if event.GetFilledAt() == nil {
+		return domainModels.Trade{}, errors.New("filled_at is required")
+	}
+	if err = event.GetFilledAt().CheckValid(); err != nil {
+		return domainModels.Trade{}, fmt.Errorf("invalid filled_at: %w", err)
+	}
+
+	return domainModels.Trade{
+		EventID:    eventID,
+		MarketID:   marketID,
+		Price:      price,
+		Quantity:   decimal.NewFromInt(event.GetQuantity()),
+		ExecutedAt: event.GetFilledAt().AsTime().UTC(),
+	}, nil
+}
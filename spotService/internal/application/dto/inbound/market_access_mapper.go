@@ -0,0 +1,55 @@
+package inbound
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	proto "github.com/nastyazhadan/spot-order-grpc/protos/gen/go/spot/v1"
+	sharedModels "github.com/nastyazhadan/spot-order-grpc/shared/models"
+)
+
+func MarketAccessSubjectTypeFromProto(subjectType proto.MarketAccessSubjectType) sharedModels.MarketAccessSubjectType {
+	switch subjectType {
+	case proto.MarketAccessSubjectType_MARKET_ACCESS_SUBJECT_TYPE_USER:
+		return sharedModels.MarketAccessSubjectUser
+	case proto.MarketAccessSubjectType_MARKET_ACCESS_SUBJECT_TYPE_ROLE:
+		return sharedModels.MarketAccessSubjectRole
+	default:
+		return ""
+	}
+}
+
+func MarketAccessSubjectTypeToProto(subjectType sharedModels.MarketAccessSubjectType) proto.MarketAccessSubjectType {
+	switch subjectType {
+	case sharedModels.MarketAccessSubjectUser:
+		return proto.MarketAccessSubjectType_MARKET_ACCESS_SUBJECT_TYPE_USER
+	case sharedModels.MarketAccessSubjectRole:
+		return proto.MarketAccessSubjectType_MARKET_ACCESS_SUBJECT_TYPE_ROLE
+	default:
+		return proto.MarketAccessSubjectType_MARKET_ACCESS_SUBJECT_TYPE_UNSPECIFIED
+	}
+}
+
+func MarketAccessGrantToProto(grant sharedModels.MarketAccessGrant) *proto.MarketAccessGrant {
+	var grantedAt *timestamppb.Timestamp
+	if !grant.GrantedAt.IsZero() {
+		grantedAt = timestamppb.New(grant.GrantedAt)
+	}
+
+	return &proto.MarketAccessGrant{
+		MarketId: grant.MarketID.String(),
+		Subject: &proto.MarketAccessSubject{
+			Type: MarketAccessSubjectTypeToProto(grant.SubjectType),
+			Id:   grant.SubjectID,
+		},
+		GrantedBy: grant.GrantedBy,
+		GrantedAt: grantedAt,
+	}
+}
+
+func MarketAccessGrantsToProto(grants []sharedModels.MarketAccessGrant) []*proto.MarketAccessGrant {
+	result := make([]*proto.MarketAccessGrant, 0, len(grants))
+	for _, grant := range grants {
+		result = append(result, MarketAccessGrantToProto(grant))
+	}
+	return result
+}
@@ -0,0 +1,48 @@
+package inbound
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	proto "github.com/nastyazhadan/spot-order-grpc/protos/gen/go/spot/v1"
+	"github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+)
+
+func MarketHistoryEntryToProto(entry models.MarketHistoryEntry) *proto.MarketHistoryEntry {
+	result := &proto.MarketHistoryEntry{
+		Id:        entry.ID,
+		MarketId:  entry.MarketID.String(),
+		Operation: marketHistoryOperationToProto(entry.Operation),
+		Actor:     entry.Actor,
+		ChangedAt: timestamppb.New(entry.ChangedAt),
+	}
+
+	if entry.Before != nil {
+		result.Before = MarketToProto(*entry.Before)
+	}
+	if entry.After != nil {
+		result.After = MarketToProto(*entry.After)
+	}
+
+	return result
+}
+
+func MarketHistoryEntriesToProto(entries []models.MarketHistoryEntry) []*proto.MarketHistoryEntry {
+	result := make([]*proto.MarketHistoryEntry, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, MarketHistoryEntryToProto(entry))
+	}
+	return result
+}
+
+func marketHistoryOperationToProto(operation models.MarketHistoryOperation) proto.MarketHistoryOperation {
+	switch operation {
+	case models.MarketHistoryOperationInsert:
+		return proto.MarketHistoryOperation_MARKET_HISTORY_OPERATION_INSERT
+	case models.MarketHistoryOperationUpdate:
+		return proto.MarketHistoryOperation_MARKET_HISTORY_OPERATION_UPDATE
+	case models.MarketHistoryOperationDelete:
+		return proto.MarketHistoryOperation_MARKET_HISTORY_OPERATION_DELETE
+	default:
+		return proto.MarketHistoryOperation_MARKET_HISTORY_OPERATION_UNSPECIFIED
+	}
+}
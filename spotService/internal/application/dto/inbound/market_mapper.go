@@ -4,10 +4,11 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	proto "github.com/nastyazhadan/spot-order-grpc/protos/gen/go/spot/v1"
-	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+	sharedModels "github.com/nastyazhadan/spot-order-grpc/shared/models"
+	"github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
 )
 
-func MarketToProto(market models.Market) *proto.Market {
+func MarketToProto(market sharedModels.Market) *proto.Market {
 	var deletedAt *timestamppb.Timestamp
 	if market.DeletedAt != nil {
 		deletedAt = timestamppb.New(*market.DeletedAt)
@@ -19,10 +20,127 @@ func MarketToProto(market models.Market) *proto.Market {
 	}
 
 	return &proto.Market{
-		Id:        market.ID.String(),
-		Name:      market.Name,
-		Enabled:   market.Enabled,
-		DeletedAt: deletedAt,
-		UpdatedAt: updateAt,
+		Id:         market.ID.String(),
+		Name:       market.Name,
+		BaseAsset:  market.BaseAsset,
+		QuoteAsset: market.QuoteAsset,
+		Enabled:    market.Enabled,
+		DeletedAt:  deletedAt,
+		UpdatedAt:  updateAt,
+		Version:    market.Version,
+		Restricted: market.Restricted,
+
+		PublicToAllRoles: market.PublicToAllRoles,
+	}
+}
+
+func MarketCursorToProto(cursor models.MarketCursor) *proto.MarketCursor {
+	return &proto.MarketCursor{Seq: cursor.Seq}
+}
+
+func MarketCursorFromProto(cursor *proto.MarketCursor) *models.MarketCursor {
+	if cursor == nil {
+		return nil
+	}
+
+	return &models.MarketCursor{Seq: cursor.GetSeq()}
+}
+
+func MarketWatchEventToProto(event models.MarketWatchEvent) *proto.WatchMarketsResponse {
+	response := &proto.WatchMarketsResponse{
+		Cursor: MarketCursorToProto(event.Cursor),
+	}
+
+	switch event.Type {
+	case models.MarketWatchEventTypeSnapshot:
+		markets := make([]*proto.Market, 0, len(event.Snapshot))
+		for _, market := range event.Snapshot {
+			markets = append(markets, MarketToProto(market))
+		}
+
+		response.Payload = &proto.WatchMarketsResponse_Snapshot{
+			Snapshot: &proto.MarketSnapshot{
+				Markets: markets,
+				Last:    event.SnapshotLast,
+			},
+		}
+
+	case models.MarketWatchEventTypeChanges:
+		changes := make([]*proto.MarketChange, 0, len(event.Changes))
+		for _, change := range event.Changes {
+			changes = append(changes, marketChangeToProto(change))
+		}
+
+		response.Payload = &proto.WatchMarketsResponse_Changes{
+			Changes: &proto.MarketChanges{Changes: changes},
+		}
+	}
+
+	return response
+}
+
+func marketChangeToProto(change models.MarketChange) *proto.MarketChange {
+	if change.Type != models.MarketChangeTypeUpserted {
+		return &proto.MarketChange{
+			Type:     proto.MarketChangeType_MARKET_CHANGE_TYPE_REMOVED,
+			MarketId: change.MarketID.String(),
+		}
+	}
+
+	return &proto.MarketChange{
+		Type:     proto.MarketChangeType_MARKET_CHANGE_TYPE_UPSERTED,
+		MarketId: change.MarketID.String(),
+		Market:   MarketToProto(change.Market),
+	}
+}
+
+func MarketFilterFromProto(filter *proto.MarketFilter) sharedModels.MarketFilter {
+	if filter == nil {
+		return sharedModels.MarketFilter{}
+	}
+
+	return sharedModels.MarketFilter{
+		NamePrefix: filter.GetNamePrefix(),
+		BaseAsset:  filter.GetBaseAsset(),
+		QuoteAsset: filter.GetQuoteAsset(),
+		Status:     MarketStatusFromProto(filter.GetStatus()),
+	}
+}
+
+func MarketStatusFromProto(status proto.MarketStatus) sharedModels.MarketStatus {
+	switch status {
+	case proto.MarketStatus_MARKET_STATUS_ENABLED:
+		return sharedModels.MarketStatusEnabled
+	case proto.MarketStatus_MARKET_STATUS_DISABLED:
+		return sharedModels.MarketStatusDisabled
+	case proto.MarketStatus_MARKET_STATUS_DELETED:
+		return sharedModels.MarketStatusDeleted
+	default:
+		return sharedModels.MarketStatusUnspecified
+	}
+}
+
+func MarketLookupToProto(lookup sharedModels.MarketLookup) *proto.MarketLookupResult {
+	result := &proto.MarketLookupResult{
+		MarketId: lookup.ID.String(),
+		Status:   marketLookupStatusToProto(lookup.Status),
+	}
+	if lookup.Status == sharedModels.MarketLookupStatusFound {
+		result.Market = MarketToProto(lookup.Market)
+	}
+
+	return result
+}
+
+func marketLookupStatusToProto(status sharedModels.MarketLookupStatus) proto.MarketLookupStatus {
+	switch status {
+	case sharedModels.MarketLookupStatusFound:
+		return proto.MarketLookupStatus_MARKET_LOOKUP_STATUS_FOUND
+	case sharedModels.MarketLookupStatusNotFound:
+		return proto.MarketLookupStatus_MARKET_LOOKUP_STATUS_NOT_FOUND
+	case sharedModels.MarketLookupStatusDisabled:
+		return proto.MarketLookupStatus_MARKET_LOOKUP_STATUS_DISABLED
+	default:
+		return proto.MarketLookupStatus_MARKET_LOOKUP_STATUS_UNSPECIFIED
 	}
 }
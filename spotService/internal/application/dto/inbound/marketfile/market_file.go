@@ -0,0 +1,286 @@
+// Package marketfile — формат файлов импорта и выгрузки каталога рынков (cmd/markets).
+package marketfile
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+)
+
+type Format string
+
+const (
+	FormatJSON Format = "json"
+	FormatCSV  Format = "csv"
+	FormatYAML Format = "yaml"
+)
+
+func ParseFormat(value string) (Format, bool) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "json":
+		return FormatJSON, true
+	case "csv":
+		return FormatCSV, true
+	case "yaml", "yml":
+		return FormatYAML, true
+	default:
+		return "", false
+	}
+}
+
+// FormatFromPath определяет формат по расширению файла.
+func FormatFromPath(path string) (Format, bool) {
+	return ParseFormat(strings.TrimPrefix(filepath.Ext(path), "."))
+}
+
+var csvHeader = []string{"name", "base_asset", "quote_asset", "enabled", "deleted", "restricted"}
+
+// Market — рынок в файле. Не заданный enabled означает true,
+// не заданные активы берутся из имени BASE-QUOTE.
+type Market struct {
+	Name       string `json:"name"                  yaml:"name"`
+	BaseAsset  string `json:"base_asset,omitempty"  yaml:"base_asset,omitempty"`
+	QuoteAsset string `json:"quote_asset,omitempty" yaml:"quote_asset,omitempty"`
+	Enabled    *bool  `json:"enabled,omitempty"     yaml:"enabled,omitempty"`
+	Deleted    bool   `json:"deleted,omitempty"     yaml:"deleted,omitempty"`
+	Restricted bool   `json:"restricted,omitempty"  yaml:"restricted,omitempty"`
+}
+
+type document struct {
+	Markets []Market `json:"markets" yaml:"markets"`
+}
+
+func (m Market) ToDomain() domainModels.MarketSpec {
+	enabled := true
+	if m.Enabled != nil {
+		enabled = *m.Enabled
+	}
+
+	return domainModels.MarketSpec{
+		Name:       m.Name,
+		BaseAsset:  m.BaseAsset,
+		QuoteAsset: m.QuoteAsset,
+		Enabled:    enabled,
+		Deleted:    m.Deleted,
+		Restricted: m.Restricted,
+	}
+}
+
+func MarketFromDomain(spec domainModels.MarketSpec) Market {
+	enabled := spec.Enabled
+
+	return Market{
+		Name:       spec.Name,
+		BaseAsset:  spec.BaseAsset,
+		QuoteAsset: spec.QuoteAsset,
+		Enabled:    &enabled,
+		Deleted:    spec.Deleted,
+		Restricted: spec.Restricted,
+	}
+}
+
+// Decode читает файл целиком; неизвестные поля и колонки — ошибка, чтобы опечатка
+// в имени поля не превратилась молча в значение по умолчанию.
+func Decode(reader io.Reader, format Format) ([]domainModels.MarketSpec, error) {
+	var (
+		markets []Market
+		err     error
+	)
+
+	switch format {
+	case FormatJSON:
+		markets, err = decodeJSON(reader)
+	case FormatYAML:
+		markets, err = decodeYAML(reader)
+	case FormatCSV:
+		markets, err = decodeCSV(reader)
+	default:
+		return nil, fmt.Errorf("unsupported format %q", format)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("decode %s: %w", format, err)
+	}
+
+	specs := make([]domainModels.MarketSpec, 0, len(markets))
+	for _, market := range markets {
+		specs = append(specs, market.ToDomain())
+	}
+
+	return specs, nil
+}
+
+func Encode(writer io.Writer, format Format, specs []domainModels.MarketSpec) error {
+	markets := make([]Market, 0, len(specs))
+	for _, spec := range specs {
+		markets = append(markets, MarketFromDomain(spec))
+	}
+
+	var err error
+	switch format {
+	case FormatJSON:
+		encoder := json.NewEncoder(writer)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(document{Markets: markets})
+	case FormatYAML:
+		encoder := yaml.NewEncoder(writer)
+		encoder.SetIndent(2)
+		err = encoder.Encode(document{Markets: markets})
+		if err == nil {
+			err = encoder.Close()
+		}
+	case FormatCSV:
+		err = encodeCSV(writer, specs)
+	default:
+		return fmt.Errorf("unsupported format %q", format)
+	}
+	if err != nil {
+		return fmt.Errorf("encode %s: %w", format, err)
+	}
+
+	return nil
+}
+
+func decodeJSON(reader io.Reader) ([]Market, error) {
+	decoder := json.NewDecoder(reader)
+	decoder.DisallowUnknownFields()
+
+	var doc document
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, err
+	}
+
+	return doc.Markets, nil
+}
+
+func decodeYAML(reader io.Reader) ([]Market, error) {
+	decoder := yaml.NewDecoder(reader)
+	decoder.KnownFields(true)
+
+	var doc document
+	if err := decoder.Decode(&doc); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return doc.Markets, nil
+}
+
+func decodeCSV(reader io.Reader) ([]Market, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.TrimLeadingSpace = true
+
+	header, err := csvReader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	columns := make(map[string]int, len(header))
+	for index, column := range header {
+		column = strings.ToLower(strings.TrimSpace(column))
+		if !slices.Contains(csvHeader, column) {
+			return nil, fmt.Errorf("unknown column %q", column)
+		}
+		columns[column] = index
+	}
+	if _, ok := columns["name"]; !ok {
+		return nil, errors.New(`column "name" is required`)
+	}
+
+	var markets []Market
+	for {
+		record, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := csvReader.FieldPos(0)
+		market, err := marketFromCSVRecord(record, columns)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		markets = append(markets, market)
+	}
+
+	return markets, nil
+}
+
+func marketFromCSVRecord(record []string, columns map[string]int) (Market, error) {
+	value := func(column string) string {
+		index, ok := columns[column]
+		if !ok {
+			return ""
+		}
+		return strings.TrimSpace(record[index])
+	}
+
+	market := Market{
+		Name:       value("name"),
+		BaseAsset:  value("base_asset"),
+		QuoteAsset: value("quote_asset"),
+	}
+
+	for _, field := range []struct {
+		column string
+		target func(bool)
+	}{
+		{"enabled", func(v bool) { market.Enabled = &v }},
+		{"deleted", func(v bool) { market.Deleted = v }},
+		{"restricted", func(v bool) { market.Restricted = v }},
+	} {
+		raw := value(field.column)
+		if raw == "" {
+			continue
+		}
+
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			return Market{}, fmt.Errorf("column %q: invalid boolean %q", field.column, raw)
+		}
+		field.target(parsed)
+	}
+
+	return market, nil
+}
+
+func encodeCSV(writer io.Writer, specs []domainModels.MarketSpec) error {
+	csvWriter := csv.NewWriter(writer)
+
+	if err := csvWriter.Write(csvHeader); err != nil {
+		return err
+	}
+
+	for _, spec := range specs {
+		record := []string{
+			spec.Name,
+			spec.BaseAsset,
+			spec.QuoteAsset,
+			strconv.FormatBool(spec.Enabled),
+			strconv.FormatBool(spec.Deleted),
+			strconv.FormatBool(spec.Restricted),
+		}
+		if err := csvWriter.Write(record); err != nil {
+			return err
+		}
+	}
+
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
@@ -0,0 +1,49 @@
+package inbound
+
+import (
+	"github.com/shopspring/decimal"
+	protoDecimal "google.golang.org/genproto/googleapis/type/decimal"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	proto "github.com/nastyazhadan/spot-order-grpc/protos/gen/go/spot/v1"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+)
+
+// TickerToProto оставляет цены, объёмы и время незаданными, если сделок в окне не было.
+func TickerToProto(ticker domainModels.Ticker) *proto.Ticker {
+	result := &proto.Ticker{
+		MarketId:    ticker.MarketID.String(),
+		TradesCount: ticker.TradesCount,
+	}
+
+	if ticker.TradesCount == 0 {
+		return result
+	}
+
+	result.LastPrice = decimalToProto(ticker.LastPrice)
+	result.OpenPrice = decimalToProto(ticker.OpenPrice)
+	result.HighPrice = decimalToProto(ticker.HighPrice)
+	result.LowPrice = decimalToProto(ticker.LowPrice)
+	result.Volume = decimalToProto(ticker.Volume)
+	result.QuoteVolume = decimalToProto(ticker.QuoteVolume)
+	result.PriceChange = decimalToProto(ticker.PriceChange)
+	result.PriceChangePercent = decimalToProto(ticker.PriceChangePercent)
+	result.LastTradeAt = timestamppb.New(ticker.LastTradeAt)
+	result.WindowStart = timestamppb.New(ticker.WindowStart)
+	result.WindowEnd = timestamppb.New(ticker.WindowEnd)
+
+	return result
+}
+
+func TickersToProto(tickers []domainModels.Ticker) []*proto.Ticker {
+	result := make([]*proto.Ticker, 0, len(tickers))
+	for _, ticker := range tickers {
+		result = append(result, TickerToProto(ticker))
+	}
+
+	return result
+}
+
+func decimalToProto(value decimal.Decimal) *protoDecimal.Decimal {
+	return &protoDecimal.Decimal{Value: value.String()}
+}
@@ -2,6 +2,7 @@ package kafka
 
 import (
 	"fmt"
+	"time"
 
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -10,26 +11,62 @@ import (
 	"github.com/nastyazhadan/spot-order-grpc/shared/models"
 )
 
-func Marshal(event models.MarketStateChangedEvent) ([]byte, error) {
+func Marshal(event models.MarketUpdatedEvent) ([]byte, error) {
 	payload, err := proto.Marshal(ToProto(event))
 	if err != nil {
-		return nil, fmt.Errorf("marshal MarketStateChangedEvent: %w", err)
+		return nil, fmt.Errorf("marshal MarketUpdatedEvent: %w", err)
 	}
 
 	return payload, nil
 }
 
-func ToProto(event models.MarketStateChangedEvent) *protoEvent.MarketStateChangedEvent {
-	var deletedAt *timestamppb.Timestamp
-	if event.DeletedAt != nil {
-		deletedAt = timestamppb.New(event.DeletedAt.UTC())
+func ToProto(event models.MarketUpdatedEvent) *protoEvent.MarketUpdatedEvent {
+	changedFields := make([]string, 0, len(event.ChangedFields))
+	for _, field := range event.ChangedFields {
+		changedFields = append(changedFields, string(field))
 	}
 
-	return &protoEvent.MarketStateChangedEvent{
-		EventId:   event.EventID.String(),
-		MarketId:  event.MarketID.String(),
-		Enabled:   event.Enabled,
-		DeletedAt: deletedAt,
-		UpdatedAt: timestamppb.New(event.UpdatedAt.UTC()),
+	return &protoEvent.MarketUpdatedEvent{
+		EventId:       event.EventID.String(),
+		MarketId:      event.MarketID.String(),
+		Enabled:       event.Enabled,
+		DeletedAt:     optionalTimestamp(event.DeletedAt),
+		UpdatedAt:     timestamppb.New(event.UpdatedAt.UTC()),
+		Version:       event.Version,
+		Name:          event.Name,
+		BaseAsset:     event.BaseAsset,
+		QuoteAsset:    event.QuoteAsset,
+		ChangedFields: changedFields,
+		Previous:      previousValuesToProto(event.Previous),
+		Restricted:    event.Restricted,
+
+		PublicToAllRoles: event.PublicToAllRoles,
+	}
+}
+
+func previousValuesToProto(previous models.MarketPreviousValues) *protoEvent.MarketPreviousValues {
+	if previous == (models.MarketPreviousValues{}) {
+		return nil
+	}
+
+	result := &protoEvent.MarketPreviousValues{
+		Name:       previous.Name,
+		BaseAsset:  previous.BaseAsset,
+		QuoteAsset: previous.QuoteAsset,
+		Enabled:    previous.Enabled,
+		Restricted: previous.Restricted,
 	}
+	if previous.DeletedAt != nil {
+		result.DeletedAt = &protoEvent.OptionalTimestamp{Value: optionalTimestamp(previous.DeletedAt.Value)}
+	}
+
+	return result
+}
+
+func optionalTimestamp(value *time.Time) *timestamppb.Timestamp {
+	if value == nil {
+		return nil
+	}
+
+	return timestamppb.New(value.UTC())
 }
@@ -0,0 +1,25 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+)
+
+type Asset struct {
+	Code      string    `db:"code"`
+	Name      string    `db:"name"`
+	Precision int16     `db:"precision"`
+	Enabled   bool      `db:"enabled"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+func (a Asset) ToDomain() models.Asset {
+	return models.Asset{
+		Code:      a.Code,
+		Name:      a.Name,
+		Precision: uint32(a.Precision),
+		Enabled:   a.Enabled,
+		UpdatedAt: a.UpdatedAt,
+	}
+}
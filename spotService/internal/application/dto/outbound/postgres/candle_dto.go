@@ -0,0 +1,50 @@
+package postgres
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+)
+
+// Candle — строка candles; NUMERIC читается строкой, чтобы не терять точность.
+type Candle struct {
+	MarketID    uuid.UUID `db:"market_id"`
+	Interval    string    `db:"interval"`
+	OpenTime    time.Time `db:"open_time"`
+	Open        string    `db:"open"`
+	High        string    `db:"high"`
+	Low         string    `db:"low"`
+	Close       string    `db:"close"`
+	Volume      string    `db:"volume"`
+	QuoteVolume string    `db:"quote_volume"`
+	TradesCount int64     `db:"trades_count"`
+}
+
+func (c Candle) ToDomain() (domainModels.Candle, error) {
+	values := []string{c.Open, c.High, c.Low, c.Close, c.Volume, c.QuoteVolume}
+	parsed := make([]decimal.Decimal, len(values))
+	for i, value := range values {
+		number, err := decimal.NewFromString(value)
+		if err != nil {
+			return domainModels.Candle{}, fmt.Errorf("invalid candle value %q: %w", value, err)
+		}
+		parsed[i] = number
+	}
+
+	return domainModels.Candle{
+		MarketID:    c.MarketID,
+		Interval:    domainModels.CandleInterval(c.Interval),
+		OpenTime:    c.OpenTime.UTC(),
+		Open:        parsed[0],
+		High:        parsed[1],
+		Low:         parsed[2],
+		Close:       parsed[3],
+		Volume:      parsed[4],
+		QuoteVolume: parsed[5],
+		TradesCount: c.TradesCount,
+	}, nil
+}
@@ -0,0 +1,31 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+)
+
+type MarketAccessGrant struct {
+	MarketID    uuid.UUID `db:"market_id"`
+	SubjectType string    `db:"subject_type"`
+	SubjectID   string    `db:"subject_id"`
+	GrantedBy   *string   `db:"granted_by"`
+	GrantedAt   time.Time `db:"granted_at"`
+}
+
+func (g MarketAccessGrant) ToDomain() models.MarketAccessGrant {
+	grant := models.MarketAccessGrant{
+		MarketID:    g.MarketID,
+		SubjectType: models.MarketAccessSubjectType(g.SubjectType),
+		SubjectID:   g.SubjectID,
+		GrantedAt:   g.GrantedAt,
+	}
+	if g.GrantedBy != nil {
+		grant.GrantedBy = *g.GrantedBy
+	}
+
+	return grant
+}
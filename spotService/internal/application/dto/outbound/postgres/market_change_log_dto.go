@@ -0,0 +1,42 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+)
+
+type MarketChangeLogEntry struct {
+	Seq        int64           `db:"seq"`
+	MarketID   uuid.UUID       `db:"market_id"`
+	Name       string          `db:"name"`
+	BaseAsset  string          `db:"base_asset"`
+	QuoteAsset string          `db:"quote_asset"`
+	Enabled    bool            `db:"enabled"`
+	DeletedAt  *time.Time      `db:"deleted_at"`
+	UpdatedAt  time.Time       `db:"updated_at"`
+	Version    int64           `db:"version"`
+	Restricted bool            `db:"restricted"`
+	Previous   *MarketSnapshot `db:"previous"`
+}
+
+func (e MarketChangeLogEntry) ToDomain() domainModels.MarketChangeLogEntry {
+	return domainModels.MarketChangeLogEntry{
+		Seq: e.Seq,
+		Market: models.Market{
+			ID:         e.MarketID,
+			Name:       e.Name,
+			BaseAsset:  e.BaseAsset,
+			QuoteAsset: e.QuoteAsset,
+			Enabled:    e.Enabled,
+			DeletedAt:  e.DeletedAt,
+			UpdatedAt:  e.UpdatedAt,
+			Version:    e.Version,
+			Restricted: e.Restricted,
+		},
+		Previous: e.Previous.toDomain(),
+	}
+}
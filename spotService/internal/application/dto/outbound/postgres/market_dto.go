@@ -8,19 +8,27 @@ import (
 )
 
 type Market struct {
-	ID        uuid.UUID  `db:"id"`
-	Name      string     `db:"name"`
-	Enabled   bool       `db:"enabled"`
-	DeletedAt *time.Time `db:"deleted_at"`
-	UpdatedAt time.Time  `db:"updated_at"`
+	ID         uuid.UUID  `db:"id"`
+	Name       string     `db:"name"`
+	BaseAsset  string     `db:"base_asset"`
+	QuoteAsset string     `db:"quote_asset"`
+	Enabled    bool       `db:"enabled"`
+	DeletedAt  *time.Time `db:"deleted_at"`
+	UpdatedAt  time.Time  `db:"updated_at"`
+	Version    int64      `db:"version"`
+	Restricted bool       `db:"restricted"`
 }
 
 func (m Market) ToDomain() models.Market {
 	return models.Market{
-		ID:        m.ID,
-		Name:      m.Name,
-		Enabled:   m.Enabled,
-		DeletedAt: m.DeletedAt,
-		UpdatedAt: m.UpdatedAt,
+		ID:         m.ID,
+		Name:       m.Name,
+		BaseAsset:  m.BaseAsset,
+		QuoteAsset: m.QuoteAsset,
+		Enabled:    m.Enabled,
+		DeletedAt:  m.DeletedAt,
+		UpdatedAt:  m.UpdatedAt,
+		Version:    m.Version,
+		Restricted: m.Restricted,
 	}
 }
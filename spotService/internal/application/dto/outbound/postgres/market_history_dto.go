@@ -0,0 +1,67 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+)
+
+// MarketSnapshot — строка market_store, сериализованная триггером через to_jsonb.
+type MarketSnapshot struct {
+	ID         uuid.UUID  `json:"id"`
+	Name       string     `json:"name"`
+	BaseAsset  string     `json:"base_asset"`
+	QuoteAsset string     `json:"quote_asset"`
+	Enabled    bool       `json:"enabled"`
+	DeletedAt  *time.Time `json:"deleted_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+	Version    int64      `json:"version"`
+	Restricted bool       `json:"restricted"`
+}
+
+type MarketHistoryEntry struct {
+	ID        int64           `db:"id"`
+	MarketID  uuid.UUID       `db:"market_id"`
+	Operation string          `db:"operation"`
+	OldValues *MarketSnapshot `db:"old_values"`
+	NewValues *MarketSnapshot `db:"new_values"`
+	Actor     *string         `db:"actor"`
+	ChangedAt time.Time       `db:"changed_at"`
+}
+
+func (e MarketHistoryEntry) ToDomain() domainModels.MarketHistoryEntry {
+	entry := domainModels.MarketHistoryEntry{
+		ID:        e.ID,
+		MarketID:  e.MarketID,
+		Operation: domainModels.MarketHistoryOperation(e.Operation),
+		Before:    e.OldValues.toDomain(),
+		After:     e.NewValues.toDomain(),
+		ChangedAt: e.ChangedAt,
+	}
+	if e.Actor != nil {
+		entry.Actor = *e.Actor
+	}
+
+	return entry
+}
+
+func (s *MarketSnapshot) toDomain() *models.Market {
+	if s == nil {
+		return nil
+	}
+
+	return &models.Market{
+		ID:         s.ID,
+		Name:       s.Name,
+		BaseAsset:  s.BaseAsset,
+		QuoteAsset: s.QuoteAsset,
+		Enabled:    s.Enabled,
+		DeletedAt:  s.DeletedAt,
+		UpdatedAt:  s.UpdatedAt,
+		Version:    s.Version,
+		Restricted: s.Restricted,
+	}
+}
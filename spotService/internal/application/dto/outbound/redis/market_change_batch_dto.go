@@ -0,0 +1,42 @@
+package redis
+
+import (
+	sharedModels "github.com/nastyazhadan/spot-order-grpc/shared/models"
+	"github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+)
+
+type MarketChangeBatchView struct {
+	AfterSeq int64             `json:"after_seq"`
+	LastSeq  int64             `json:"last_seq"`
+	Markets  []MarketRedisView `json:"markets"`
+}
+
+func (v MarketChangeBatchView) ToDomain() (models.MarketChangeBatch, error) {
+	markets := make([]sharedModels.Market, 0, len(v.Markets))
+	for _, view := range v.Markets {
+		market, err := view.ToDomain()
+		if err != nil {
+			return models.MarketChangeBatch{}, err
+		}
+		markets = append(markets, market)
+	}
+
+	return models.MarketChangeBatch{
+		AfterSeq: v.AfterSeq,
+		LastSeq:  v.LastSeq,
+		Markets:  markets,
+	}, nil
+}
+
+func MarketChangeBatchFromDomain(batch models.MarketChangeBatch) MarketChangeBatchView {
+	views := make([]MarketRedisView, 0, len(batch.Markets))
+	for _, market := range batch.Markets {
+		views = append(views, FromDomain(market))
+	}
+
+	return MarketChangeBatchView{
+		AfterSeq: batch.AfterSeq,
+		LastSeq:  batch.LastSeq,
+		Markets:  views,
+	}
+}
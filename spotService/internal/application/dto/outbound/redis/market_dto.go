@@ -10,9 +10,13 @@ import (
 type MarketRedisView struct {
 	ID          string `json:"id"`
 	Name        string `json:"name"`
+	BaseAsset   string `json:"base_asset,omitempty"`
+	QuoteAsset  string `json:"quote_asset,omitempty"`
 	Enabled     bool   `json:"enabled"`
 	DeletedAtNs *int64 `json:"deleted_at,omitempty"`
 	UpdatedAtNs *int64 `json:"updated_at,omitempty"`
+	Version     int64  `json:"version,omitempty"`
+	Restricted  bool   `json:"restricted,omitempty"`
 }
 
 func (m MarketRedisView) ToDomain() (models.Market, error) {
@@ -33,11 +37,15 @@ func (m MarketRedisView) ToDomain() (models.Market, error) {
 	}
 
 	return models.Market{
-		ID:        id,
-		Name:      m.Name,
-		Enabled:   m.Enabled,
-		DeletedAt: deletedAt,
-		UpdatedAt: updatedAt,
+		ID:         id,
+		Name:       m.Name,
+		BaseAsset:  m.BaseAsset,
+		QuoteAsset: m.QuoteAsset,
+		Enabled:    m.Enabled,
+		DeletedAt:  deletedAt,
+		UpdatedAt:  updatedAt,
+		Version:    m.Version,
+		Restricted: m.Restricted,
 	}, nil
 }
 
@@ -57,8 +65,12 @@ func FromDomain(market models.Market) MarketRedisView {
 	return MarketRedisView{
 		ID:          market.ID.String(),
 		Name:        market.Name,
+		BaseAsset:   market.BaseAsset,
+		QuoteAsset:  market.QuoteAsset,
 		Enabled:     market.Enabled,
 		DeletedAtNs: deletedAtNs,
 		UpdatedAtNs: updatedAtNs,
+		Version:     market.Version,
+		Restricted:  market.Restricted,
 	}
 }
@@ -0,0 +1,80 @@
+package redis
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+)
+
+// TickerRedisView хранит цены и объёмы строками, чтобы не терять точность в JSON.
+type TickerRedisView struct {
+	MarketID           string `json:"market_id"`
+	LastPrice          string `json:"last_price"`
+	OpenPrice          string `json:"open_price"`
+	HighPrice          string `json:"high_price"`
+	LowPrice           string `json:"low_price"`
+	Volume             string `json:"volume"`
+	QuoteVolume        string `json:"quote_volume"`
+	PriceChange        string `json:"price_change"`
+	PriceChangePercent string `json:"price_change_percent"`
+	TradesCount        int64  `json:"trades_count"`
+	LastTradeAtNs      int64  `json:"last_trade_at"`
+	WindowStartNs      int64  `json:"window_start"`
+	WindowEndNs        int64  `json:"window_end"`
+}
+
+func TickerFromDomain(ticker models.Ticker) TickerRedisView {
+	return TickerRedisView{
+		MarketID:           ticker.MarketID.String(),
+		LastPrice:          ticker.LastPrice.String(),
+		OpenPrice:          ticker.OpenPrice.String(),
+		HighPrice:          ticker.HighPrice.String(),
+		LowPrice:           ticker.LowPrice.String(),
+		Volume:             ticker.Volume.String(),
+		QuoteVolume:        ticker.QuoteVolume.String(),
+		PriceChange:        ticker.PriceChange.String(),
+		PriceChangePercent: ticker.PriceChangePercent.String(),
+		TradesCount:        ticker.TradesCount,
+		LastTradeAtNs:      ticker.LastTradeAt.UnixNano(),
+		WindowStartNs:      ticker.WindowStart.UnixNano(),
+		WindowEndNs:        ticker.WindowEnd.UnixNano(),
+	}
+}
+
+func (v TickerRedisView) ToDomain() (models.Ticker, error) {
+	marketID, err := uuid.Parse(v.MarketID)
+	if err != nil {
+		return models.Ticker{}, err
+	}
+
+	ticker := models.Ticker{
+		MarketID:    marketID,
+		TradesCount: v.TradesCount,
+		LastTradeAt: time.Unix(0, v.LastTradeAtNs).UTC(),
+		WindowStart: time.Unix(0, v.WindowStartNs).UTC(),
+		WindowEnd:   time.Unix(0, v.WindowEndNs).UTC(),
+	}
+
+	for _, field := range []struct {
+		raw    string
+		target *decimal.Decimal
+	}{
+		{v.LastPrice, &ticker.LastPrice},
+		{v.OpenPrice, &ticker.OpenPrice},
+		{v.HighPrice, &ticker.HighPrice},
+		{v.LowPrice, &ticker.LowPrice},
+		{v.Volume, &ticker.Volume},
+		{v.QuoteVolume, &ticker.QuoteVolume},
+		{v.PriceChange, &ticker.PriceChange},
+		{v.PriceChangePercent, &ticker.PriceChangePercent},
+	} {
+		if *field.target, err = decimal.NewFromString(field.raw); err != nil {
+			return models.Ticker{}, err
+		}
+	}
+
+	return ticker, nil
+}
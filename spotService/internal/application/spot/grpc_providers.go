@@ -73,11 +73,22 @@ func provideGRPCServer(
 	recoverer := recovery.UnaryServerInterceptor(appLogger)
 	tracer := tracing.UnaryServerInterceptor()
 	logger := logInterceptor.UnaryServerInterceptor(appLogger)
-	authenticator := auth.UnaryServerInterceptor(container.JWTManager, cfg.AuthVerifier)
+	// Сессии пользовательских токенов сверяются с тем же Redis, где их хранит order-service;
+	// сервисные токены и токены API-ключей сессий не имеют
+	authenticator := auth.UnaryServerInterceptor(
+		container.JWTManager, container.SessionStore, auth.ForwardedTokenTypes, cfg.AuthVerifier,
+	)
+	permissions := auth.MethodPermissions()
+	authorizer := auth.UnaryPermissionServerInterceptor(permissions, cfg.Service.Name, appLogger)
 	errorsMapper := grpcErrors.UnaryServerInterceptor(appLogger)
 	rateLimiter := ratelimit.SpotUnaryServerInterceptor(cfg, appLogger)
 	meter := metricInterceptor.UnaryServerInterceptor(cfg.Service.Name)
 
+	streamValidator, err := validate.StreamServerInterceptor()
+	if err != nil {
+		return nil, err
+	}
+
 	grpcServer := grpc.NewServer(
 		grpc.MaxRecvMsgSize(cfg.Service.MaxRecvMsgSize),
 		grpc.KeepaliveParams(keepalive.ServerParameters{
@@ -89,13 +100,29 @@ func provideGRPCServer(
 			PermitWithoutStream: cfg.KeepAlive.PermitWithoutStream,
 		}),
 		grpc.ChainUnaryInterceptor(
-			validator, recoverer, tracer, meter, logger, errorsMapper, authenticator, rateLimiter,
+			validator, recoverer, tracer, meter, logger, errorsMapper, authenticator, authorizer, rateLimiter,
+		),
+		grpc.ChainStreamInterceptor(
+			streamValidator,
+			recovery.StreamServerInterceptor(appLogger),
+			tracing.StreamServerInterceptor(),
+			metricInterceptor.StreamServerInterceptor(cfg.Service.Name),
+			logInterceptor.StreamServerInterceptor(appLogger),
+			grpcErrors.StreamServerInterceptor(appLogger),
+			auth.StreamServerInterceptor(
+				container.JWTManager, container.SessionStore, auth.ForwardedTokenTypes, cfg.AuthVerifier,
+			),
+			auth.StreamPermissionServerInterceptor(permissions, cfg.Service.Name, appLogger),
 		),
 	)
 
 	reflection.Register(grpcServer)
 	health.RegisterService(grpcServer, healthServer)
-	grpcSpot.Register(grpcServer, container.SpotService)
+	grpcSpot.Register(grpcServer, container.SpotService, container.MarketWatcher, container.MarketHistory)
+	grpcSpot.RegisterAssetCatalog(grpcServer, container.AssetCatalog)
+	grpcSpot.RegisterMarketAccess(grpcServer, container.MarketAccess)
+	grpcSpot.RegisterTickers(grpcServer, container.Tickers)
+	grpcSpot.RegisterCandles(grpcServer, container.Candles)
 
 	return grpcServer, nil
 }
@@ -105,6 +132,7 @@ func startGRPCServer(
 	lifeCycle fx.Lifecycle,
 	server *grpc.Server,
 	listener net.Listener,
+	container *container,
 	logger *zapLogger.Logger,
 ) {
 	appCtx := in.AppCtx
@@ -123,6 +151,10 @@ func startGRPCServer(
 			return nil
 		},
 		OnStop: func(stopCtx context.Context) error {
+			// WatchMarkets- и WatchTickers-стримы бесконечны: закрываем их до GracefulStop
+			container.MarketWatch.Close()
+			container.Tickers.Close()
+
 			return stopGRPCServer(stopCtx, server, logger, "spot")
 		},
 	})
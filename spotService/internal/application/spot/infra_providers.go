@@ -12,7 +12,11 @@ import (
 	"github.com/nastyazhadan/spot-order-grpc/shared/config"
 	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/cache"
 	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/db"
+	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/leader"
 	zapLogger "github.com/nastyazhadan/spot-order-grpc/shared/interceptors/logging/zap"
+	"github.com/nastyazhadan/spot-order-grpc/spotService/internal/infrastructure/kafka/replay"
+	"github.com/nastyazhadan/spot-order-grpc/spotService/internal/infrastructure/memory"
+	"github.com/nastyazhadan/spot-order-grpc/spotService/internal/infrastructure/postgres/changelog"
 	"github.com/nastyazhadan/spot-order-grpc/spotService/internal/infrastructure/postgres/cursor"
 	outboxStore "github.com/nastyazhadan/spot-order-grpc/spotService/internal/infrastructure/postgres/outbox"
 	spotStore "github.com/nastyazhadan/spot-order-grpc/spotService/internal/infrastructure/postgres/spot"
@@ -28,9 +32,23 @@ var InfraProviders = fx.Options(
 
 		provideCacheStore,
 		provideMarketStore,
+		provideAssetStore,
+		provideMarketAccessStore,
+		provideMarketHistoryStore,
 		provideMarketCursorStore,
+		provideMarketChangeLogStore,
+		provideMarketChangeListener,
 		provideMarketCacheRepository,
 		provideMarketByIDCacheRepository,
+		provideMarketBySymbolCacheRepository,
+		provideMarketLocalCache,
+		provideMarketInvalidationBus,
+		provideMarketChangeBus,
+		provideLeaseStore,
+		provideTickerStore,
+		provideTradeSource,
+		provideCandleStore,
+		provideConsumerGroup,
 
 		provideOutboxStore,
 		provideSaramaAsyncProducer,
@@ -97,8 +115,28 @@ func provideMarketStore(pool *pgxpool.Pool, cfg config.SpotConfig) *spotStore.Ma
 	return spotStore.NewMarketStore(pool, cfg)
 }
 
-func provideMarketCursorStore(pool *pgxpool.Pool) *cursor.Store {
-	return cursor.New(pool)
+func provideAssetStore(pool *pgxpool.Pool, cfg config.SpotConfig) *spotStore.AssetStore {
+	return spotStore.NewAssetStore(pool, cfg)
+}
+
+func provideMarketAccessStore(pool *pgxpool.Pool, cfg config.SpotConfig) *spotStore.MarketAccessStore {
+	return spotStore.NewMarketAccessStore(pool, cfg)
+}
+
+func provideMarketHistoryStore(pool *pgxpool.Pool, cfg config.SpotConfig) *spotStore.MarketHistoryStore {
+	return spotStore.NewMarketHistoryStore(pool, cfg)
+}
+
+func provideMarketCursorStore(pool *pgxpool.Pool, cfg config.SpotConfig) *cursor.Store {
+	return cursor.New(pool, cfg.MarketWatch.ChangeLogRetention)
+}
+
+func provideMarketChangeLogStore(pool *pgxpool.Pool, cfg config.SpotConfig) *changelog.Store {
+	return changelog.New(pool, cfg)
+}
+
+func provideMarketChangeListener(pool *pgxpool.Pool) *changelog.Listener {
+	return changelog.NewListener(pool)
 }
 
 func provideMarketCacheRepository(
@@ -115,6 +153,79 @@ func provideMarketByIDCacheRepository(
 	return spotCache.NewMarketByIDCacheRepository(store, cfg.Service.Name)
 }
 
+func provideMarketLocalCache(cfg config.SpotConfig) *memory.MarketLRU {
+	return memory.NewMarketLRU(cfg.LocalCache.Size, cfg.LocalCache.TTL)
+}
+
+func provideMarketInvalidationBus(
+	store *cache.Store,
+	cfg config.SpotConfig,
+) *spotCache.MarketInvalidationBus {
+	return spotCache.NewMarketInvalidationBus(store, cfg.LocalCache.InvalidationChannel)
+}
+
+func provideMarketChangeBus(
+	store *cache.Store,
+	cfg config.SpotConfig,
+) *spotCache.MarketChangeBus {
+	return spotCache.NewMarketChangeBus(store, cfg.MarketWatch.FeedChannel)
+}
+
+func provideLeaseStore(pool *pgxpool.Pool) *leader.PostgresLeaseStore {
+	return leader.NewPostgresLeaseStore(pool)
+}
+
+func provideTickerStore(
+	store *cache.Store,
+	cfg config.SpotConfig,
+) *spotCache.TickerStore {
+	return spotCache.NewTickerStore(store, cfg.Ticker.Key, cfg.Ticker.SnapshotTTL, cfg.Service.Name)
+}
+
+// Соединение с Kafka открывается при каждом получении lease, а не на старте приложения
+func provideTradeSource(cfg config.SpotConfig) *replay.TopicReader {
+	return replay.NewTopicReader(cfg.Kafka.Brokers, cfg.Kafka.Topics.OrderFilled, cfg.Service.Name+"-ticker")
+}
+
+func provideCandleStore(pool *pgxpool.Pool, cfg config.SpotConfig) *spotStore.CandleStore {
+	return spotStore.NewCandleStore(pool, cfg)
+}
+
+func provideConsumerGroup(cfg config.SpotConfig) (sarama.ConsumerGroup, error) {
+	saramaCfg := sarama.NewConfig()
+	saramaCfg.ClientID = cfg.Service.Name
+
+	saramaCfg.Consumer.Group.Session.Timeout = cfg.Kafka.Consumer.SessionTimeout
+	saramaCfg.Consumer.Group.Heartbeat.Interval = cfg.Kafka.Consumer.HeartbeatInterval
+	saramaCfg.Consumer.Offsets.Initial = sarama.OffsetOldest
+
+	saramaCfg.Consumer.Fetch.Max = cfg.Kafka.Consumer.MaxMessageBytes
+	saramaCfg.Consumer.Fetch.Default = cfg.Kafka.Consumer.MaxMessageBytes
+	saramaCfg.Consumer.Retry.Backoff = cfg.Kafka.Consumer.RetryBackoff
+
+	saramaCfg.Net.DialTimeout = cfg.Kafka.Consumer.SessionTimeout
+	saramaCfg.Net.ReadTimeout = cfg.Kafka.Consumer.SessionTimeout
+	saramaCfg.Net.WriteTimeout = cfg.Kafka.Consumer.SessionTimeout
+
+	saramaCfg.Metadata.Timeout = cfg.Kafka.Consumer.SessionTimeout
+	saramaCfg.Metadata.Retry.Max = cfg.Kafka.Consumer.MaxRetries
+	saramaCfg.Metadata.Retry.Backoff = cfg.Kafka.Consumer.RetryBackoff
+
+	group, err := sarama.NewConsumerGroup(cfg.Kafka.Brokers, cfg.Kafka.Consumer.GroupID, saramaCfg)
+	if err != nil {
+		return nil, fmt.Errorf("sarama.NewConsumerGroup: %w", err)
+	}
+
+	return group, nil
+}
+
+func provideMarketBySymbolCacheRepository(
+	store *cache.Store,
+	cfg config.SpotConfig,
+) *spotCache.MarketBySymbolCacheRepository {
+	return spotCache.NewMarketBySymbolCacheRepository(store, cfg.Service.Name)
+}
+
 func provideOutboxStore(
 	pool *pgxpool.Pool,
 	logger *zapLogger.Logger,
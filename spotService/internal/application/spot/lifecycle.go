@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/IBM/sarama"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -20,11 +21,15 @@ import (
 	"github.com/nastyazhadan/spot-order-grpc/shared/config"
 	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/health"
 	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/kafka/producer"
+	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/leader"
 	zapLogger "github.com/nastyazhadan/spot-order-grpc/shared/interceptors/logging/zap"
 	"github.com/nastyazhadan/spot-order-grpc/shared/interceptors/recovery"
 	"github.com/nastyazhadan/spot-order-grpc/shared/interceptors/tracing"
 	"github.com/nastyazhadan/spot-order-grpc/shared/metrics"
 	outbox "github.com/nastyazhadan/spot-order-grpc/spotService/internal/infrastructure/kafka"
+	"github.com/nastyazhadan/spot-order-grpc/spotService/internal/infrastructure/memory"
+	spotCache "github.com/nastyazhadan/spot-order-grpc/spotService/internal/infrastructure/redis"
+	"github.com/nastyazhadan/spot-order-grpc/spotService/internal/services/consumer"
 	spotService "github.com/nastyazhadan/spot-order-grpc/spotService/internal/services/spot"
 )
 
@@ -41,6 +46,10 @@ var Lifecycle = fx.Options(
 		registerKafkaProducer,
 		registerOutboxWorker,
 		registerMarketPoller,
+		registerTickerBuilder,
+		registerFillConsumer,
+		registerMarketInvalidationListener,
+		registerMarketChangeFeed,
 
 		registerReadiness,
 	),
@@ -201,15 +210,29 @@ func registerOutboxWorker(
 	})
 }
 
+// registerMarketPoller запускает поллер под leader election: на нескольких репликах
+// журнал разбирает и сдвигает курсор только держатель lease.
 func registerMarketPoller(
 	in appCtxIn,
 	lifecycle fx.Lifecycle,
 	poller *spotService.MarketPoller,
+	leaseStore *leader.PostgresLeaseStore,
 	logger *zapLogger.Logger,
 	config config.SpotConfig,
 ) {
 	appCtx := in.AppCtx
 
+	elector := leader.NewElector(
+		leaseStore,
+		spotService.MarketPollerLeaseName,
+		leader.NewHolderID(),
+		config.MarketPoller.LeaderElection.LeaseTTL,
+		config.MarketPoller.LeaderElection.RenewInterval,
+		config.MarketPoller.LeaderElection.RetryInterval,
+		config.Service.Name,
+		logger,
+	)
+
 	var (
 		workerCtx context.Context
 		cancel    context.CancelFunc
@@ -221,19 +244,14 @@ func registerMarketPoller(
 			workerCtx, cancel = context.WithCancel(appCtx)
 			done = make(chan struct{})
 
-			logger.Info(startCtx, "Market poller: starting")
-
-			if err := poller.Init(startCtx); err != nil {
-				logger.Error(startCtx, "Market poller: failed to initialize", zap.Error(err))
-				return err
-			}
+			logger.Info(startCtx, "Market poller: starting leader election")
 
 			go func() {
 				defer close(done)
 
 				for {
 					err := recovery.PanicRecoveryHandler(workerCtx, logger, "Market poller", func() error {
-						return poller.Run(workerCtx)
+						return elector.Run(workerCtx, poller.RunAsLeader)
 					})
 					if err == nil || workerCtx.Err() != nil {
 						logger.Info(workerCtx, "Market poller stopped")
@@ -271,6 +289,303 @@ func registerMarketPoller(
 	})
 }
 
+// registerTickerBuilder строит тикеры под leader election: топик читает и пишет тикеры в Redis
+// только держатель lease, остальные реплики читают готовые тикеры из Redis.
+func registerTickerBuilder(
+	in appCtxIn,
+	lifecycle fx.Lifecycle,
+	builder *spotService.TickerBuilder,
+	leaseStore *leader.PostgresLeaseStore,
+	logger *zapLogger.Logger,
+	config config.SpotConfig,
+) {
+	appCtx := in.AppCtx
+
+	elector := leader.NewElector(
+		leaseStore,
+		spotService.TickerBuilderLeaseName,
+		leader.NewHolderID(),
+		config.Ticker.LeaderElection.LeaseTTL,
+		config.Ticker.LeaderElection.RenewInterval,
+		config.Ticker.LeaderElection.RetryInterval,
+		config.Service.Name,
+		logger,
+	)
+
+	var (
+		workerCtx context.Context
+		cancel    context.CancelFunc
+		done      chan struct{}
+	)
+
+	lifecycle.Append(fx.Hook{
+		OnStart: func(startCtx context.Context) error {
+			workerCtx, cancel = context.WithCancel(appCtx)
+			done = make(chan struct{})
+
+			logger.Info(startCtx, "Ticker builder: starting leader election",
+				zap.String("topic", config.Kafka.Topics.OrderFilled),
+			)
+
+			go func() {
+				defer close(done)
+
+				for {
+					err := recovery.PanicRecoveryHandler(workerCtx, logger, "Ticker builder", func() error {
+						return elector.Run(workerCtx, builder.RunAsLeader)
+					})
+					if err == nil || workerCtx.Err() != nil {
+						logger.Info(workerCtx, "Ticker builder stopped")
+						return
+					}
+
+					logger.Error(workerCtx, "Ticker builder exited with error, restarting",
+						zap.Error(err),
+						zap.Duration("restart_after", config.Ticker.RestartBackoff),
+					)
+
+					select {
+					case <-workerCtx.Done():
+						return
+					case <-time.After(config.Ticker.RestartBackoff):
+					}
+				}
+			}()
+
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			logger.Info(stopCtx, "Ticker builder: stopping")
+			cancel()
+
+			select {
+			case <-done:
+				logger.Info(stopCtx, "Ticker builder: stopped")
+				return nil
+			case <-stopCtx.Done():
+				logger.Warn(stopCtx, "Ticker builder: stop timeout exceeded", zap.Error(stopCtx.Err()))
+				return stopCtx.Err()
+			}
+		},
+	})
+}
+
+// registerFillConsumer работает на каждой реплике: партиции order.filled делит consumer group
+func registerFillConsumer(
+	in appCtxIn,
+	lifecycle fx.Lifecycle,
+	consumer *consumer.FillConsumer,
+	group sarama.ConsumerGroup,
+	logger *zapLogger.Logger,
+	config config.SpotConfig,
+) {
+	appCtx := in.AppCtx
+
+	var (
+		consumerCtx context.Context
+		cancel      context.CancelFunc
+		done        chan struct{}
+	)
+
+	lifecycle.Append(fx.Hook{
+		OnStart: func(startCtx context.Context) error {
+			consumerCtx, cancel = context.WithCancel(appCtx)
+			done = make(chan struct{})
+
+			logger.Info(startCtx, "Fill consumer: starting")
+
+			go func() {
+				defer close(done)
+
+				for {
+					err := recovery.PanicRecoveryHandler(consumerCtx, logger, "Fill consumer",
+						func() error {
+							return consumer.Run(consumerCtx)
+						},
+					)
+					if err == nil || consumerCtx.Err() != nil {
+						logger.Info(consumerCtx, "Fill consumer stopped")
+						return
+					}
+
+					logger.Error(consumerCtx, "Fill consumer exited with error, restarting",
+						zap.Error(err),
+						zap.Duration("restart_after", config.Kafka.Consumer.RestartBackoff),
+					)
+
+					select {
+					case <-consumerCtx.Done():
+						return
+					case <-time.After(config.Kafka.Consumer.RestartBackoff):
+					}
+				}
+			}()
+
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			logger.Info(stopCtx, "Fill consumer: stopping")
+			cancel()
+
+			if err := group.Close(); err != nil {
+				logger.Error(stopCtx, "Failed to close consumer group", zap.Error(err))
+			}
+
+			select {
+			case <-done:
+				logger.Info(stopCtx, "Fill consumer: stopped")
+				return nil
+			case <-stopCtx.Done():
+				logger.Warn(stopCtx, "Fill consumer: stop timeout exceeded", zap.Error(stopCtx.Err()))
+				return stopCtx.Err()
+			}
+		},
+	})
+}
+
+// registerMarketInvalidationListener держит подписку на инвалидации локального кэша.
+// После каждой переподписки кэш сбрасывается целиком: сообщения за время обрыва потеряны.
+func registerMarketInvalidationListener(
+	in appCtxIn,
+	lifecycle fx.Lifecycle,
+	bus *spotCache.MarketInvalidationBus,
+	localCache *memory.MarketLRU,
+	logger *zapLogger.Logger,
+	config config.SpotConfig,
+) {
+	appCtx := in.AppCtx
+
+	var (
+		listenerCtx context.Context
+		cancel      context.CancelFunc
+		done        chan struct{}
+	)
+
+	lifecycle.Append(fx.Hook{
+		OnStart: func(startCtx context.Context) error {
+			listenerCtx, cancel = context.WithCancel(appCtx)
+			done = make(chan struct{})
+
+			logger.Info(startCtx, "Market invalidation listener: starting",
+				zap.String("channel", config.LocalCache.InvalidationChannel),
+			)
+
+			go func() {
+				defer close(done)
+
+				for {
+					err := recovery.PanicRecoveryHandler(listenerCtx, logger, "Market invalidation listener", func() error {
+						return bus.Listen(listenerCtx, localCache.Purge, localCache.DeleteMarkets)
+					})
+					if listenerCtx.Err() != nil {
+						logger.Info(listenerCtx, "Market invalidation listener stopped")
+						return
+					}
+
+					// Без подписки локальный кэш может пропустить инвалидацию
+					localCache.Purge()
+					logger.Error(listenerCtx, "Market invalidation listener exited with error, restarting",
+						zap.Error(err),
+						zap.Duration("restart_after", config.LocalCache.RestartBackoff),
+					)
+
+					select {
+					case <-listenerCtx.Done():
+						return
+					case <-time.After(config.LocalCache.RestartBackoff):
+					}
+				}
+			}()
+
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			logger.Info(stopCtx, "Market invalidation listener: stopping")
+			cancel()
+
+			select {
+			case <-done:
+				logger.Info(stopCtx, "Market invalidation listener: stopped")
+				return nil
+			case <-stopCtx.Done():
+				logger.Warn(stopCtx, "Market invalidation listener: stop timeout exceeded", zap.Error(stopCtx.Err()))
+				return stopCtx.Err()
+			}
+		},
+	})
+}
+
+// registerMarketChangeFeed подписывает хаб WatchMarkets этой реплики на батчи поллера лидера.
+func registerMarketChangeFeed(
+	in appCtxIn,
+	lifecycle fx.Lifecycle,
+	bus *spotCache.MarketChangeBus,
+	feed *spotService.MarketChangeFeed,
+	logger *zapLogger.Logger,
+	config config.SpotConfig,
+) {
+	appCtx := in.AppCtx
+
+	var (
+		listenerCtx context.Context
+		cancel      context.CancelFunc
+		done        chan struct{}
+	)
+
+	lifecycle.Append(fx.Hook{
+		OnStart: func(startCtx context.Context) error {
+			listenerCtx, cancel = context.WithCancel(appCtx)
+			done = make(chan struct{})
+
+			logger.Info(startCtx, "Market change feed: starting",
+				zap.String("channel", config.MarketWatch.FeedChannel),
+			)
+
+			go func() {
+				defer close(done)
+
+				for {
+					err := recovery.PanicRecoveryHandler(listenerCtx, logger, "Market change feed", func() error {
+						return bus.Listen(listenerCtx, feed.Reset, feed.Deliver)
+					})
+					if listenerCtx.Err() != nil {
+						logger.Info(listenerCtx, "Market change feed stopped")
+						return
+					}
+
+					// Пока подписки нет, подписчики хаба не получают изменений
+					feed.Reset()
+					logger.Error(listenerCtx, "Market change feed exited with error, restarting",
+						zap.Error(err),
+						zap.Duration("restart_after", config.MarketWatch.FeedRestartBackoff),
+					)
+
+					select {
+					case <-listenerCtx.Done():
+						return
+					case <-time.After(config.MarketWatch.FeedRestartBackoff):
+					}
+				}
+			}()
+
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			logger.Info(stopCtx, "Market change feed: stopping")
+			cancel()
+
+			select {
+			case <-done:
+				logger.Info(stopCtx, "Market change feed: stopped")
+				return nil
+			case <-stopCtx.Done():
+				logger.Warn(stopCtx, "Market change feed: stop timeout exceeded", zap.Error(stopCtx.Err()))
+				return stopCtx.Err()
+			}
+		},
+	})
+}
+
 func registerKafkaProducer(
 	lifecycle fx.Lifecycle,
 	client *producer.Client,
@@ -1,18 +1,29 @@
 package spot
 
 import (
+	"fmt"
+
 	"github.com/IBM/sarama"
 	"go.uber.org/fx"
 
 	authjwt "github.com/nastyazhadan/spot-order-grpc/shared/auth/jwt"
+	authsession "github.com/nastyazhadan/spot-order-grpc/shared/auth/session"
 	"github.com/nastyazhadan/spot-order-grpc/shared/config"
+	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/cache"
+	sharedConsumer "github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/kafka/consumer"
 	sharedProducer "github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/kafka/producer"
 	zapLogger "github.com/nastyazhadan/spot-order-grpc/shared/interceptors/logging/zap"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
 	outbox "github.com/nastyazhadan/spot-order-grpc/spotService/internal/infrastructure/kafka"
+	"github.com/nastyazhadan/spot-order-grpc/spotService/internal/infrastructure/kafka/replay"
+	"github.com/nastyazhadan/spot-order-grpc/spotService/internal/infrastructure/memory"
+	"github.com/nastyazhadan/spot-order-grpc/spotService/internal/infrastructure/postgres/changelog"
 	"github.com/nastyazhadan/spot-order-grpc/spotService/internal/infrastructure/postgres/cursor"
 	outboxStore "github.com/nastyazhadan/spot-order-grpc/spotService/internal/infrastructure/postgres/outbox"
 	spotStore "github.com/nastyazhadan/spot-order-grpc/spotService/internal/infrastructure/postgres/spot"
 	spotCache "github.com/nastyazhadan/spot-order-grpc/spotService/internal/infrastructure/redis"
+	"github.com/nastyazhadan/spot-order-grpc/spotService/internal/services/consumer"
 	"github.com/nastyazhadan/spot-order-grpc/spotService/internal/services/producer"
 	spotService "github.com/nastyazhadan/spot-order-grpc/spotService/internal/services/spot"
 )
@@ -20,27 +31,63 @@ import (
 var ServiceProviders = fx.Options(
 	fx.Provide(
 		provideJWTManager,
+		provideSessionStore,
 
 		provideKafkaClient,
 		provideMarketStateChangedProducer,
 		provideSpotOutboxWorker,
 		provideMarketEventProducer,
 
+		provideVisibilityPolicies,
 		provideSpotService,
+		provideAssetCatalog,
+		provideMarketAccessManager,
+		provideMarketHistory,
+		provideMarketWatchHub,
+		provideMarketWatcher,
+		provideMarketChangeFeed,
 		provideMarketPoller,
+		provideTickerBuilder,
+		provideTickerViewer,
+		provideCandleAggregator,
+		provideCandleViewer,
+		provideFillConsumer,
 		provideContainer,
 	),
 )
 
 type container struct {
-	JWTManager  *authjwt.Manager
-	SpotService *spotService.MarketViewer
+	JWTManager    *authjwt.Manager
+	SessionStore  *authsession.Store
+	SpotService   *spotService.MarketViewer
+	AssetCatalog  *spotService.AssetCatalog
+	MarketAccess  *spotService.MarketAccessManager
+	MarketHistory *spotService.MarketHistory
+	MarketWatcher *spotService.MarketWatcher
+	MarketWatch   *spotService.MarketWatchHub
+	Tickers       *spotService.TickerViewer
+	Candles       *spotService.CandleViewer
 }
 
 func provideJWTManager(cfg config.SpotConfig) *authjwt.Manager {
+	jwks := cfg.AuthVerifier.JWKS
+	if jwks.URL != "" {
+		return authjwt.NewVerifier(authjwt.NewRemoteKeySet(
+			jwks.URL,
+			jwks.RefreshInterval,
+			jwks.RequestTimeout,
+			cfg.Service.Name,
+		))
+	}
+
 	return authjwt.NewManager(cfg.AuthVerifier.JWTSecret, 0, 0)
 }
 
+// provideSessionStore читает сессии, которые пишет order-service: Redis у сервисов общий
+func provideSessionStore(store *cache.Store) *authsession.Store {
+	return authsession.New(store)
+}
+
 func provideKafkaClient(
 	asyncProducer sarama.AsyncProducer,
 	cfg config.SpotConfig,
@@ -90,10 +137,51 @@ func provideMarketEventProducer(
 	return producer.New(store, cursorStore, logger, cfg)
 }
 
+// Конфиг уже проверен в validateSpotVisibility, здесь политики только компилируются
+func provideVisibilityPolicies(cfg config.SpotConfig) (domainModels.VisibilityPolicies, error) {
+	policies := make(domainModels.VisibilityPolicies, 0, len(cfg.Visibility.Policies))
+
+	for _, policyConfig := range cfg.Visibility.Policies {
+		roles := make([]models.UserRole, 0, len(policyConfig.Roles))
+		for _, value := range policyConfig.Roles {
+			role, ok := models.ParseUserRole(value)
+			if !ok {
+				return nil, fmt.Errorf("visibility policy %s: unknown role %q", policyConfig.ID, value)
+			}
+			roles = append(roles, role)
+		}
+
+		statuses := make([]models.MarketStatus, 0, len(policyConfig.Statuses))
+		for _, value := range policyConfig.Statuses {
+			status, ok := models.ParseMarketStatus(value)
+			if !ok {
+				return nil, fmt.Errorf("visibility policy %s: unknown status %q", policyConfig.ID, value)
+			}
+			statuses = append(statuses, status)
+		}
+
+		policies = append(policies, domainModels.NewVisibilityPolicy(
+			policyConfig.ID,
+			roles,
+			statuses,
+			policyConfig.BaseAssets,
+			policyConfig.QuoteAssets,
+			policyConfig.BypassAccessLists,
+		))
+	}
+
+	return policies, nil
+}
+
 func provideSpotService(
 	repository *spotStore.MarketStore,
 	cacheRepository *spotCache.MarketCacheRepository,
 	cacheByIDRepository *spotCache.MarketByIDCacheRepository,
+	cacheBySymbolRepository *spotCache.MarketBySymbolCacheRepository,
+	localCache *memory.MarketLRU,
+	invalidationBus *spotCache.MarketInvalidationBus,
+	policies domainModels.VisibilityPolicies,
+	accessStore *spotStore.MarketAccessStore,
 	cfg config.SpotConfig,
 	logger *zapLogger.Logger,
 ) *spotService.MarketViewer {
@@ -101,6 +189,11 @@ func provideSpotService(
 		repository,
 		cacheRepository,
 		cacheByIDRepository,
+		cacheBySymbolRepository,
+		localCache,
+		invalidationBus,
+		policies,
+		accessStore,
 		cfg.Redis.CacheTTL,
 		cfg.Timeouts.Service,
 		cfg.ViewMarkets.DefaultLimit,
@@ -111,32 +204,228 @@ func provideSpotService(
 	)
 }
 
-func provideMarketPoller(
+func provideAssetCatalog(
+	store *spotStore.AssetStore,
+	policies domainModels.VisibilityPolicies,
+	cfg config.SpotConfig,
+	logger *zapLogger.Logger,
+) *spotService.AssetCatalog {
+	return spotService.NewAssetCatalog(
+		store,
+		policies,
+		cfg.Timeouts.Service,
+		logger,
+	)
+}
+
+func provideMarketAccessManager(
+	store *spotStore.MarketAccessStore,
+	cfg config.SpotConfig,
+	logger *zapLogger.Logger,
+) *spotService.MarketAccessManager {
+	return spotService.NewMarketAccessManager(
+		store,
+		cfg.Timeouts.Service,
+		logger,
+	)
+}
+
+func provideMarketHistory(
+	store *spotStore.MarketHistoryStore,
+	cfg config.SpotConfig,
+	logger *zapLogger.Logger,
+) *spotService.MarketHistory {
+	return spotService.NewMarketHistory(
+		store,
+		cfg.Timeouts.Service,
+		cfg.ViewMarkets.DefaultLimit,
+		cfg.ViewMarkets.MaxLimit,
+		logger,
+	)
+}
+
+func provideMarketWatchHub(cfg config.SpotConfig) *spotService.MarketWatchHub {
+	return spotService.NewMarketWatchHub(
+		cfg.MarketWatch.SubscriberBuffer,
+		cfg.MarketWatch.MaxSubscribers,
+		cfg.Service.Name,
+	)
+}
+
+func provideMarketWatcher(
 	store *spotStore.MarketStore,
+	changeLog *changelog.Store,
+	hub *spotService.MarketWatchHub,
+	policies domainModels.VisibilityPolicies,
+	accessStore *spotStore.MarketAccessStore,
+	cfg config.SpotConfig,
+	logger *zapLogger.Logger,
+) *spotService.MarketWatcher {
+	return spotService.NewMarketWatcher(
+		store,
+		changeLog,
+		hub,
+		policies,
+		accessStore,
+		cfg.Timeouts.Service,
+		cfg.MarketWatch.PageSize,
+		logger,
+	)
+}
+
+func provideMarketChangeFeed(
+	bus *spotCache.MarketChangeBus,
+	hub *spotService.MarketWatchHub,
+	cfg config.SpotConfig,
+	logger *zapLogger.Logger,
+) *spotService.MarketChangeFeed {
+	return spotService.NewMarketChangeFeed(
+		bus,
+		hub,
+		cfg.Timeouts.Service,
+		logger,
+	)
+}
+
+func provideMarketPoller(
+	changeLog *changelog.Store,
+	listener *changelog.Listener,
 	marketViewer *spotService.MarketViewer,
+	changeFeed *spotService.MarketChangeFeed,
 	marketProducer *producer.MarketProducer,
 	cursorStore *cursor.Store,
+	policies domainModels.VisibilityPolicies,
 	cfg config.SpotConfig,
 	logger *zapLogger.Logger,
 ) *spotService.MarketPoller {
 	return spotService.NewMarketPoller(
-		store,
+		changeLog,
+		listener,
 		marketProducer,
 		cursorStore,
 		marketViewer,
+		changeFeed,
+		policies,
 		cfg.MarketPoller.PollInterval,
+		cfg.MarketPoller.FallbackInterval,
 		cfg.MarketPoller.ProcessingTimeout,
 		cfg.MarketPoller.BatchSize,
 		logger,
 	)
 }
 
+func provideTickerBuilder(
+	source *replay.TopicReader,
+	store *spotCache.TickerStore,
+	cfg config.SpotConfig,
+	logger *zapLogger.Logger,
+) *spotService.TickerBuilder {
+	return spotService.NewTickerBuilder(
+		source,
+		store,
+		cfg.Ticker.Window,
+		cfg.Ticker.BucketSize,
+		cfg.Ticker.FlushInterval,
+		cfg.Service.Name,
+		logger,
+	)
+}
+
+func provideTickerViewer(
+	store *spotCache.TickerStore,
+	marketViewer *spotService.MarketViewer,
+	cfg config.SpotConfig,
+	logger *zapLogger.Logger,
+) *spotService.TickerViewer {
+	return spotService.NewTickerViewer(
+		store,
+		marketViewer,
+		cfg.Ticker.WatchInterval,
+		cfg.Timeouts.Service,
+		logger,
+	)
+}
+
+func provideCandleAggregator(
+	store *spotStore.CandleStore,
+	cfg config.SpotConfig,
+	logger *zapLogger.Logger,
+) *spotService.CandleAggregator {
+	return spotService.NewCandleAggregator(
+		store,
+		cfg.Timeouts.Service,
+		cfg.Service.Name,
+		logger,
+	)
+}
+
+func provideCandleViewer(
+	store *spotStore.CandleStore,
+	marketViewer *spotService.MarketViewer,
+	cfg config.SpotConfig,
+	logger *zapLogger.Logger,
+) *spotService.CandleViewer {
+	return spotService.NewCandleViewer(
+		store,
+		marketViewer,
+		cfg.Timeouts.Service,
+		cfg.Candles.DefaultLimit,
+		cfg.Candles.MaxLimit,
+		logger,
+	)
+}
+
+// DLQ в spot нет: битое событие пропускается, а после исчерпания ретраев консьюмер перезапускается
+func provideFillConsumer(
+	group sarama.ConsumerGroup,
+	aggregator *spotService.CandleAggregator,
+	cfg config.SpotConfig,
+	logger *zapLogger.Logger,
+) *consumer.FillConsumer {
+	kafkaConsumer := sharedConsumer.New(
+		group,
+		[]string{cfg.Kafka.Topics.OrderFilled},
+		cfg.Service.Name,
+		logger,
+		sharedConsumer.RetryMiddleware(
+			cfg.Kafka.Consumer.MaxRetries,
+			cfg.Kafka.Consumer.RetryBackoff,
+			cfg.Kafka.Consumer.RetryJitter,
+			logger,
+		),
+	)
+
+	return consumer.NewFillConsumer(
+		kafkaConsumer,
+		aggregator,
+		cfg.Kafka.Consumer.GroupID,
+		cfg.Service.Name,
+		logger,
+	)
+}
+
 func provideContainer(
 	jwtManager *authjwt.Manager,
+	sessionStore *authsession.Store,
 	service *spotService.MarketViewer,
+	assetCatalog *spotService.AssetCatalog,
+	marketAccess *spotService.MarketAccessManager,
+	history *spotService.MarketHistory,
+	watcher *spotService.MarketWatcher,
+	hub *spotService.MarketWatchHub,
+	tickers *spotService.TickerViewer,
+	candles *spotService.CandleViewer,
 ) *container {
 	return &container{
-		JWTManager:  jwtManager,
-		SpotService: service,
+		JWTManager:    jwtManager,
+		SessionStore:  sessionStore,
+		SpotService:   service,
+		AssetCatalog:  assetCatalog,
+		MarketAccess:  marketAccess,
+		MarketHistory: history,
+		MarketWatcher: watcher,
+		MarketWatch:   hub,
+		Tickers:       tickers,
+		Candles:       candles,
 	}
 }
@@ -0,0 +1,65 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type CandleInterval string
+
+const (
+	CandleInterval1m CandleInterval = "1m"
+	CandleInterval5m CandleInterval = "5m"
+	CandleInterval1h CandleInterval = "1h"
+	CandleInterval1d CandleInterval = "1d"
+)
+
+// CandleIntervals — интервалы, которые агрегатор ведёт для каждой сделки.
+var CandleIntervals = []CandleInterval{
+	CandleInterval1m,
+	CandleInterval5m,
+	CandleInterval1h,
+	CandleInterval1d,
+}
+
+var candleIntervalDurations = map[CandleInterval]time.Duration{
+	CandleInterval1m: time.Minute,
+	CandleInterval5m: 5 * time.Minute,
+	CandleInterval1h: time.Hour,
+	CandleInterval1d: 24 * time.Hour,
+}
+
+func (i CandleInterval) Valid() bool {
+	_, ok := candleIntervalDurations[i]
+	return ok
+}
+
+func (i CandleInterval) Duration() time.Duration {
+	return candleIntervalDurations[i]
+}
+
+// OpenTime — начало свечи, в которую попадает момент t. Границы выровнены по UTC.
+func (i CandleInterval) OpenTime(t time.Time) time.Time {
+	return t.UTC().Truncate(i.Duration())
+}
+
+// Candle — OHLCV-свеча [OpenTime, OpenTime + Interval). Open и Close — цены самой ранней
+// и самой поздней по времени сделки, а не первой и последней полученной.
+type Candle struct {
+	MarketID    uuid.UUID
+	Interval    CandleInterval
+	OpenTime    time.Time
+	Open        decimal.Decimal
+	High        decimal.Decimal
+	Low         decimal.Decimal
+	Close       decimal.Decimal
+	Volume      decimal.Decimal
+	QuoteVolume decimal.Decimal
+	TradesCount int64
+}
+
+func (c Candle) CloseTime() time.Time {
+	return c.OpenTime.Add(c.Interval.Duration())
+}
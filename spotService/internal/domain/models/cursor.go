@@ -1,13 +1,9 @@
 package models
 
-import (
-	"time"
-
-	"github.com/google/uuid"
-)
-
+// PollerCursor — позиция поллера в журнале изменений рынков (market_change_log.seq).
+// FencingToken — токен lease с именем PollerName, под которым поллер сдвигает курсор.
 type PollerCursor struct {
-	PollerName string
-	LastSeenAt time.Time
-	LastSeenID uuid.UUID
+	PollerName   string
+	LastSeq      int64
+	FencingToken int64
 }
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+
+	sharedModels "github.com/nastyazhadan/spot-order-grpc/shared/models"
+)
+
+// MarketAccessSubject — вызывающий, для которого ищутся записи market_access:
+// запись на его user_id или на любую из его ролей.
+type MarketAccessSubject struct {
+	UserID uuid.UUID
+	Roles  []sharedModels.UserRole
+}
+
+// MarketAccess — какие restricted-рынки открыты вызывающему.
+type MarketAccess struct {
+	BypassAccessLists bool
+	GrantedMarketIDs  []uuid.UUID
+}
+
+func (a MarketAccess) Allows(market sharedModels.Market) bool {
+	return !market.Restricted || a.BypassAccessLists || slices.Contains(a.GrantedMarketIDs, market.ID)
+}
+
+// SharesHeadCache — head-cache политики годится вызывающему: в нём нет рынков,
+// открытых только ему: кэш политики без bypass хранит только рынки без ограничения доступа.
+func (a MarketAccess) SharesHeadCache() bool {
+	return a.BypassAccessLists || len(a.GrantedMarketIDs) == 0
+}
@@ -0,0 +1,26 @@
+package models
+
+import sharedModels "github.com/nastyazhadan/spot-order-grpc/shared/models"
+
+// MarketChangeLogEntry — запись журнала изменений: состояние рынка сразу после изменения.
+// Previous — состояние до изменения, nil для INSERT и записей, перенесённых при миграции.
+type MarketChangeLogEntry struct {
+	Seq      int64
+	Market   sharedModels.Market
+	Previous *sharedModels.Market
+}
+
+// MarketChangeBatch — обработанный поллером отрезок журнала (AfterSeq, LastSeq].
+// По AfterSeq получатель видит, что пропустил предыдущий батч.
+type MarketChangeBatch struct {
+	AfterSeq int64
+	LastSeq  int64
+	Markets  []sharedModels.Market
+}
+
+// MarketChangeLogBounds — границы журнала: записи с seq <= PrunedSeq уже удалены,
+// LatestSeq — последний закоммиченный seq.
+type MarketChangeLogBounds struct {
+	PrunedSeq int64
+	LatestSeq int64
+}
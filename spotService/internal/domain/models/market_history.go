@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	sharedModels "github.com/nastyazhadan/spot-order-grpc/shared/models"
+)
+
+type MarketHistoryOperation string
+
+const (
+	MarketHistoryOperationInsert MarketHistoryOperation = "INSERT"
+	MarketHistoryOperationUpdate MarketHistoryOperation = "UPDATE"
+	MarketHistoryOperationDelete MarketHistoryOperation = "DELETE"
+)
+
+// MarketHistoryEntry — запись market_store_history. Before пуст для INSERT, After — для DELETE.
+type MarketHistoryEntry struct {
+	ID        int64
+	MarketID  uuid.UUID
+	Operation MarketHistoryOperation
+	Before    *sharedModels.Market
+	After     *sharedModels.Market
+	Actor     string
+	ChangedAt time.Time
+}
@@ -0,0 +1,102 @@
+package models
+
+import (
+	"strconv"
+
+	sharedModels "github.com/nastyazhadan/spot-order-grpc/shared/models"
+)
+
+// MarketSpec — желаемое состояние рынка из файла импорта. Рынок ищется по имени.
+type MarketSpec struct {
+	Name       string
+	BaseAsset  string
+	QuoteAsset string
+	Enabled    bool
+	Deleted    bool
+	Restricted bool
+}
+
+func MarketSpecFromMarket(market sharedModels.Market) MarketSpec {
+	return MarketSpec{
+		Name:       market.Name,
+		BaseAsset:  market.BaseAsset,
+		QuoteAsset: market.QuoteAsset,
+		Enabled:    market.Enabled,
+		Deleted:    market.DeletedAt != nil,
+		Restricted: market.Restricted,
+	}
+}
+
+type MarketImportAction string
+
+const (
+	MarketImportActionCreate    MarketImportAction = "create"
+	MarketImportActionUpdate    MarketImportAction = "update"
+	MarketImportActionUnchanged MarketImportAction = "unchanged"
+)
+
+// MarketImportFieldDeleted — в файле импорта удаление задаётся флагом, а не deleted_at.
+const MarketImportFieldDeleted sharedModels.MarketField = "deleted"
+
+type MarketFieldChange struct {
+	Field  sharedModels.MarketField
+	Before string
+	After  string
+}
+
+// MarketImportChange — что импорт делает с одним рынком. Fields пуст для create и unchanged.
+type MarketImportChange struct {
+	Name   string
+	Action MarketImportAction
+	Spec   MarketSpec
+	Fields []MarketFieldChange
+}
+
+// PlanMarketImport сравнивает спецификацию с текущим рынком; existing == nil — рынка ещё нет.
+func PlanMarketImport(existing *sharedModels.Market, spec MarketSpec) MarketImportChange {
+	change := MarketImportChange{
+		Name: spec.Name,
+		Spec: spec,
+	}
+
+	if existing == nil {
+		change.Action = MarketImportActionCreate
+		return change
+	}
+
+	current := MarketSpecFromMarket(*existing)
+	if current.BaseAsset != spec.BaseAsset {
+		change.Fields = append(change.Fields, MarketFieldChange{
+			Field: sharedModels.MarketFieldBaseAsset, Before: current.BaseAsset, After: spec.BaseAsset,
+		})
+	}
+	if current.QuoteAsset != spec.QuoteAsset {
+		change.Fields = append(change.Fields, MarketFieldChange{
+			Field: sharedModels.MarketFieldQuoteAsset, Before: current.QuoteAsset, After: spec.QuoteAsset,
+		})
+	}
+	if current.Enabled != spec.Enabled {
+		change.Fields = append(change.Fields, boolFieldChange(sharedModels.MarketFieldEnabled, current.Enabled, spec.Enabled))
+	}
+	if current.Deleted != spec.Deleted {
+		change.Fields = append(change.Fields, boolFieldChange(MarketImportFieldDeleted, current.Deleted, spec.Deleted))
+	}
+	if current.Restricted != spec.Restricted {
+		change.Fields = append(change.Fields, boolFieldChange(sharedModels.MarketFieldRestricted, current.Restricted, spec.Restricted))
+	}
+
+	change.Action = MarketImportActionUnchanged
+	if len(change.Fields) > 0 {
+		change.Action = MarketImportActionUpdate
+	}
+
+	return change
+}
+
+func boolFieldChange(field sharedModels.MarketField, before, after bool) MarketFieldChange {
+	return MarketFieldChange{
+		Field:  field,
+		Before: strconv.FormatBool(before),
+		After:  strconv.FormatBool(after),
+	}
+}
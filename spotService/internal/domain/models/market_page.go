@@ -0,0 +1,9 @@
+package models
+
+import "github.com/google/uuid"
+
+// MarketPageKey — keyset-позиция последнего рынка страницы в порядке (name, id).
+type MarketPageKey struct {
+	Name string
+	ID   uuid.UUID
+}
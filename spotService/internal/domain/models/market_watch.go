@@ -0,0 +1,42 @@
+package models
+
+import (
+	"github.com/google/uuid"
+
+	sharedModels "github.com/nastyazhadan/spot-order-grpc/shared/models"
+)
+
+// MarketCursor — позиция в потоке изменений рынков: seq последней отправленной записи market_change_log.
+type MarketCursor struct {
+	Seq int64
+}
+
+type MarketChangeType uint8
+
+const (
+	MarketChangeTypeUnspecified MarketChangeType = iota
+	MarketChangeTypeUpserted
+	MarketChangeTypeRemoved
+)
+
+type MarketChange struct {
+	Type     MarketChangeType
+	MarketID uuid.UUID
+	Market   sharedModels.Market
+}
+
+type MarketWatchEventType uint8
+
+const (
+	MarketWatchEventTypeUnspecified MarketWatchEventType = iota
+	MarketWatchEventTypeSnapshot
+	MarketWatchEventTypeChanges
+)
+
+type MarketWatchEvent struct {
+	Type         MarketWatchEventType
+	Snapshot     []sharedModels.Market
+	SnapshotLast bool
+	Changes      []MarketChange
+	Cursor       MarketCursor
+}
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Ticker — статистика рынка за скользящее окно [WindowStart, WindowEnd).
+// Volume считается в базовом активе, QuoteVolume — в котируемом (сумма price*quantity).
+// Рынок без сделок в окне отдаётся пустым тикером: TradesCount = 0, цены нулевые.
+type Ticker struct {
+	MarketID           uuid.UUID
+	LastPrice          decimal.Decimal
+	OpenPrice          decimal.Decimal
+	HighPrice          decimal.Decimal
+	LowPrice           decimal.Decimal
+	Volume             decimal.Decimal
+	QuoteVolume        decimal.Decimal
+	PriceChange        decimal.Decimal
+	PriceChangePercent decimal.Decimal
+	TradesCount        int64
+	LastTradeAt        time.Time
+	WindowStart        time.Time
+	WindowEnd          time.Time
+}
+
+func EmptyTicker(marketID uuid.UUID) Ticker {
+	return Ticker{MarketID: marketID}
+}
+
+// Equal сравнивает тикеры по значению: decimal.Decimal нельзя сравнивать через ==.
+func (t Ticker) Equal(other Ticker) bool {
+	return t.MarketID == other.MarketID &&
+		t.LastPrice.Equal(other.LastPrice) &&
+		t.OpenPrice.Equal(other.OpenPrice) &&
+		t.HighPrice.Equal(other.HighPrice) &&
+		t.LowPrice.Equal(other.LowPrice) &&
+		t.Volume.Equal(other.Volume) &&
+		t.QuoteVolume.Equal(other.QuoteVolume) &&
+		t.PriceChange.Equal(other.PriceChange) &&
+		t.PriceChangePercent.Equal(other.PriceChangePercent) &&
+		t.TradesCount == other.TradesCount &&
+		t.LastTradeAt.Equal(other.LastTradeAt) &&
+		t.WindowStart.Equal(other.WindowStart) &&
+		t.WindowEnd.Equal(other.WindowEnd)
+}
@@ -0,0 +1,19 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Trade — сделка, из которой строятся тикеры и свечи: исполнение ордера из order.filled.
+// EventID — id события order.filled. Доставка at-least-once: свечи отбрасывают повтор через candle_inbox,
+// тикеры — по набору EventID в бакете окна (повтор старше окна уже не влияет на тикер).
+type Trade struct {
+	EventID    uuid.UUID
+	MarketID   uuid.UUID
+	Price      decimal.Decimal
+	Quantity   decimal.Decimal
+	ExecutedAt time.Time
+}
@@ -0,0 +1,148 @@
+package models
+
+import (
+	"slices"
+
+	sharedModels "github.com/nastyazhadan/spot-order-grpc/shared/models"
+)
+
+type MarketVisibility uint8
+
+const (
+	MarketVisible MarketVisibility = iota
+	MarketHidden
+	// MarketHiddenDisabled — рынок скрыт только потому, что выключен:
+	// GetMarketByID отвечает ErrDisabled, а не NotFound.
+	MarketHiddenDisabled
+)
+
+// VisibilityPolicy — скомпилированное правило видимости рынков.
+// ID используется как ключ role-based head-cache и область page_token.
+type VisibilityPolicy struct {
+	ID       string
+	Roles    []sharedModels.UserRole
+	Statuses []sharedModels.MarketStatus
+	// Пустой allowlist — любые активы
+	BaseAssets  []string
+	QuoteAssets []string
+	// BypassAccessLists — restricted-рынки видны без записи в market_access
+	BypassAccessLists bool
+}
+
+func NewVisibilityPolicy(
+	id string,
+	roles []sharedModels.UserRole,
+	statuses []sharedModels.MarketStatus,
+	baseAssets, quoteAssets []string,
+	bypassAccessLists bool,
+) VisibilityPolicy {
+	sortedStatuses := slices.Clone(statuses)
+	slices.Sort(sortedStatuses)
+
+	return VisibilityPolicy{
+		ID:          id,
+		Roles:       roles,
+		Statuses:    slices.Compact(sortedStatuses),
+		BaseAssets:  baseAssets,
+		QuoteAssets: quoteAssets,
+
+		BypassAccessLists: bypassAccessLists,
+	}
+}
+
+func (p VisibilityPolicy) AllowsStatus(status sharedModels.MarketStatus) bool {
+	return slices.Contains(p.Statuses, status)
+}
+
+func (p VisibilityPolicy) Check(market sharedModels.Market) MarketVisibility {
+	status := MarketStatusOf(market)
+
+	if status == sharedModels.MarketStatusDeleted && !p.AllowsStatus(status) {
+		return MarketHidden
+	}
+	if !allowedAsset(p.BaseAssets, market.BaseAsset) || !allowedAsset(p.QuoteAssets, market.QuoteAsset) {
+		return MarketHidden
+	}
+	if !p.AllowsStatus(status) {
+		return MarketHiddenDisabled
+	}
+
+	return MarketVisible
+}
+
+func (p VisibilityPolicy) Visible(market sharedModels.Market) bool {
+	return p.Check(market) == MarketVisible
+}
+
+// AllowsAsset сообщает, может ли актив встретиться в видимом политикой рынке как base или quote.
+func (p VisibilityPolicy) AllowsAsset(code string) bool {
+	return allowedAsset(p.BaseAssets, code) || allowedAsset(p.QuoteAssets, code)
+}
+
+func allowedAsset(allowlist []string, asset string) bool {
+	return len(allowlist) == 0 || slices.Contains(allowlist, asset)
+}
+
+func MarketStatusOf(market sharedModels.Market) sharedModels.MarketStatus {
+	switch {
+	case market.DeletedAt != nil:
+		return sharedModels.MarketStatusDeleted
+	case market.Enabled:
+		return sharedModels.MarketStatusEnabled
+	default:
+		return sharedModels.MarketStatusDisabled
+	}
+}
+
+// VisibilityPolicies упорядочены по приоритету: пользователю достаётся первая политика,
+// в которой есть хотя бы одна из его ролей.
+type VisibilityPolicies []VisibilityPolicy
+
+func (p VisibilityPolicies) Resolve(roles []sharedModels.UserRole) (VisibilityPolicy, bool) {
+	for _, policy := range p {
+		for _, role := range roles {
+			if slices.Contains(policy.Roles, role) {
+				return policy, true
+			}
+		}
+	}
+
+	return VisibilityPolicy{}, false
+}
+
+var policyRoles = []sharedModels.UserRole{
+	sharedModels.UserRoleUser,
+	sharedModels.UserRoleAdmin,
+	sharedModels.UserRoleViewer,
+	sharedModels.UserRoleService,
+}
+
+// PublicToAllRoles сообщает, что включённый рынок увидит любая роль: у каждой роли есть политика,
+// и она пропускает рынок по статусу enabled и спискам активов. Списки доступа здесь не учитываются,
+// для них есть Market.Restricted.
+func (p VisibilityPolicies) PublicToAllRoles(market sharedModels.Market) bool {
+	enabled := market
+	enabled.Enabled = true
+	enabled.DeletedAt = nil
+
+	for _, role := range policyRoles {
+		policy, ok := p.Resolve([]sharedModels.UserRole{role})
+		if !ok || !policy.Visible(enabled) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// WithPublicToAllRoles возвращает копию markets с вычисленным PublicToAllRoles:
+// исходный срез может принадлежать кэшу.
+func (p VisibilityPolicies) WithPublicToAllRoles(markets []sharedModels.Market) []sharedModels.Market {
+	result := make([]sharedModels.Market, len(markets))
+	for i, market := range markets {
+		market.PublicToAllRoles = p.PublicToAllRoles(market)
+		result[i] = market
+	}
+
+	return result
+}
@@ -5,10 +5,11 @@ package mocks
 import (
 	context "context"
 
+	uuid "github.com/google/uuid"
+
 	models "github.com/nastyazhadan/spot-order-grpc/shared/models"
-	mock "github.com/stretchr/testify/mock"
 
-	uuid "github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // SpotInstrument is an autogenerated mock type for the SpotInstrument type
@@ -44,43 +45,145 @@ func (_m *SpotInstrument) GetMarketByID(ctx context.Context, id uuid.UUID) (mode
 	return r0, r1
 }
 
-// ViewMarkets provides a mock function with given fields: ctx, limit, offset
-func (_m *SpotInstrument) ViewMarkets(ctx context.Context, limit uint64, offset uint64) ([]models.Market, uint64, bool, error) {
-	ret := _m.Called(ctx, limit, offset)
+// GetMarketBySymbol provides a mock function with given fields: ctx, symbol
+func (_m *SpotInstrument) GetMarketBySymbol(ctx context.Context, symbol string) (models.Market, error) {
+	ret := _m.Called(ctx, symbol)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMarketBySymbol")
+	}
+
+	var r0 models.Market
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.Market, error)); ok {
+		return rf(ctx, symbol)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.Market); ok {
+		r0 = rf(ctx, symbol)
+	} else {
+		r0 = ret.Get(0).(models.Market)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, symbol)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMarketsByIDs provides a mock function with given fields: ctx, ids
+func (_m *SpotInstrument) GetMarketsByIDs(ctx context.Context, ids []uuid.UUID) ([]models.MarketLookup, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMarketsByIDs")
+	}
+
+	var r0 []models.MarketLookup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]models.MarketLookup, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []models.MarketLookup); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.MarketLookup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ViewMarkets provides a mock function with given fields: ctx, limit, pageToken, filter
+func (_m *SpotInstrument) ViewMarkets(ctx context.Context, limit uint64, pageToken string, filter models.MarketFilter) ([]models.Market, string, bool, error) {
+	ret := _m.Called(ctx, limit, pageToken, filter)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ViewMarkets")
 	}
 
 	var r0 []models.Market
-	var r1 uint64
+	var r1 string
+	var r2 bool
+	var r3 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string, models.MarketFilter) ([]models.Market, string, bool, error)); ok {
+		return rf(ctx, limit, pageToken, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string, models.MarketFilter) []models.Market); ok {
+		r0 = rf(ctx, limit, pageToken, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Market)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, string, models.MarketFilter) string); ok {
+		r1 = rf(ctx, limit, pageToken, filter)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uint64, string, models.MarketFilter) bool); ok {
+		r2 = rf(ctx, limit, pageToken, filter)
+	} else {
+		r2 = ret.Get(2).(bool)
+	}
+
+	if rf, ok := ret.Get(3).(func(context.Context, uint64, string, models.MarketFilter) error); ok {
+		r3 = rf(ctx, limit, pageToken, filter)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
+// ViewMarketsByOffset provides a mock function with given fields: ctx, limit, offset, filter
+func (_m *SpotInstrument) ViewMarketsByOffset(ctx context.Context, limit uint64, offset uint64, filter models.MarketFilter) ([]models.Market, string, bool, error) {
+	ret := _m.Called(ctx, limit, offset, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ViewMarketsByOffset")
+	}
+
+	var r0 []models.Market
+	var r1 string
 	var r2 bool
 	var r3 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) ([]models.Market, uint64, bool, error)); ok {
-		return rf(ctx, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, models.MarketFilter) ([]models.Market, string, bool, error)); ok {
+		return rf(ctx, limit, offset, filter)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) []models.Market); ok {
-		r0 = rf(ctx, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, models.MarketFilter) []models.Market); ok {
+		r0 = rf(ctx, limit, offset, filter)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.Market)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64) uint64); ok {
-		r1 = rf(ctx, limit, offset)
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, models.MarketFilter) string); ok {
+		r1 = rf(ctx, limit, offset, filter)
 	} else {
-		r1 = ret.Get(1).(uint64)
+		r1 = ret.Get(1).(string)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, uint64, uint64) bool); ok {
-		r2 = rf(ctx, limit, offset)
+	if rf, ok := ret.Get(2).(func(context.Context, uint64, uint64, models.MarketFilter) bool); ok {
+		r2 = rf(ctx, limit, offset, filter)
 	} else {
 		r2 = ret.Get(2).(bool)
 	}
 
-	if rf, ok := ret.Get(3).(func(context.Context, uint64, uint64) error); ok {
-		r3 = rf(ctx, limit, offset)
+	if rf, ok := ret.Get(3).(func(context.Context, uint64, uint64, models.MarketFilter) error); ok {
+		r3 = rf(ctx, limit, offset, filter)
 	} else {
 		r3 = ret.Error(3)
 	}
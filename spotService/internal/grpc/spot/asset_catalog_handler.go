@@ -0,0 +1,95 @@
+package spot
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	proto "github.com/nastyazhadan/spot-order-grpc/protos/gen/go/spot/v1"
+	"github.com/nastyazhadan/spot-order-grpc/shared/errors"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+	mapper "github.com/nastyazhadan/spot-order-grpc/spotService/internal/application/dto/inbound"
+)
+
+type AssetCatalog interface {
+	ListAssets(ctx context.Context) ([]models.Asset, error)
+	CreateAsset(ctx context.Context, asset models.Asset) (models.Asset, error)
+	UpdateAsset(ctx context.Context, update models.AssetUpdate) (models.Asset, int64, error)
+}
+
+type assetServerAPI struct {
+	proto.UnimplementedAssetCatalogServiceServer
+	assetCatalog AssetCatalog
+}
+
+func RegisterAssetCatalog(server *grpc.Server, assetCatalog AssetCatalog) {
+	proto.RegisterAssetCatalogServiceServer(
+		server, &assetServerAPI{
+			assetCatalog: assetCatalog,
+		})
+}
+
+func (s *assetServerAPI) ListAssets(
+	ctx context.Context,
+	request *proto.ListAssetsRequest,
+) (*proto.ListAssetsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, errors.MsgRequestRequired)
+	}
+
+	assets, err := s.assetCatalog.ListAssets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.ListAssetsResponse{
+		Assets: mapper.AssetsToProto(assets),
+	}, nil
+}
+
+func (s *assetServerAPI) CreateAsset(
+	ctx context.Context,
+	request *proto.CreateAssetRequest,
+) (*proto.CreateAssetResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, errors.MsgRequestRequired)
+	}
+
+	asset, err := s.assetCatalog.CreateAsset(ctx, models.Asset{
+		Code:      request.GetCode(),
+		Name:      request.GetName(),
+		Precision: request.GetPrecision(),
+		Enabled:   request.GetEnabled(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.CreateAssetResponse{
+		Asset: mapper.AssetToProto(asset),
+	}, nil
+}
+
+func (s *assetServerAPI) UpdateAsset(
+	ctx context.Context,
+	request *proto.UpdateAssetRequest,
+) (*proto.UpdateAssetResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, errors.MsgRequestRequired)
+	}
+	if request.Name == nil && request.Precision == nil && request.Enabled == nil {
+		return nil, status.Error(codes.InvalidArgument, "at least one of name, precision, enabled is required")
+	}
+
+	asset, disabledMarkets, err := s.assetCatalog.UpdateAsset(ctx, mapper.AssetUpdateFromProto(request))
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.UpdateAssetResponse{
+		Asset:           mapper.AssetToProto(asset),
+		DisabledMarkets: uint32(disabledMarkets),
+	}, nil
+}
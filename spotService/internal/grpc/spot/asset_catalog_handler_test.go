@@ -0,0 +1,147 @@
+package spot
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	proto "github.com/nastyazhadan/spot-order-grpc/protos/gen/go/spot/v1"
+	serviceErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/service"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+	"github.com/nastyazhadan/spot-order-grpc/spotService/internal/grpc/mocks"
+)
+
+func newAssetServer(svc *mocks.AssetCatalog) *assetServerAPI {
+	return &assetServerAPI{assetCatalog: svc}
+}
+
+func TestListAssets(t *testing.T) {
+	updatedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		request    *proto.ListAssetsRequest
+		setupMocks func(*mocks.AssetCatalog)
+		checkResp  func(t *testing.T, resp *proto.ListAssetsResponse)
+		checkErr   func(t *testing.T, err error)
+	}{
+		{
+			name:       "nil request — InvalidArgument",
+			request:    nil,
+			setupMocks: func(_ *mocks.AssetCatalog) {},
+			checkErr: func(t *testing.T, err error) {
+				assertGRPCCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name:    "активы маппятся в proto",
+			request: &proto.ListAssetsRequest{},
+			setupMocks: func(svc *mocks.AssetCatalog) {
+				svc.On("ListAssets", mock.Anything).Return([]models.Asset{
+					{Code: "BTC", Name: "Bitcoin", Precision: 8, Enabled: true, UpdatedAt: updatedAt},
+				}, nil)
+			},
+			checkResp: func(t *testing.T, resp *proto.ListAssetsResponse) {
+				require.Len(t, resp.GetAssets(), 1)
+				asset := resp.GetAssets()[0]
+				assert.Equal(t, "BTC", asset.GetCode())
+				assert.Equal(t, "Bitcoin", asset.GetName())
+				assert.Equal(t, uint32(8), asset.GetPrecision())
+				assert.True(t, asset.GetEnabled())
+				assert.Equal(t, updatedAt, asset.GetUpdatedAt().AsTime())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := mocks.NewAssetCatalog(t)
+			tt.setupMocks(svc)
+
+			resp, err := newAssetServer(svc).ListAssets(context.Background(), tt.request)
+
+			if tt.checkErr != nil {
+				tt.checkErr(t, err)
+				assert.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				tt.checkResp(t, resp)
+			}
+		})
+	}
+}
+
+func TestUpdateAsset(t *testing.T) {
+	disabled := false
+
+	tests := []struct {
+		name       string
+		request    *proto.UpdateAssetRequest
+		setupMocks func(*mocks.AssetCatalog)
+		checkResp  func(t *testing.T, resp *proto.UpdateAssetResponse)
+		checkErr   func(t *testing.T, err error)
+	}{
+		{
+			name:       "nil request — InvalidArgument",
+			request:    nil,
+			setupMocks: func(_ *mocks.AssetCatalog) {},
+			checkErr: func(t *testing.T, err error) {
+				assertGRPCCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name:       "ни одного изменяемого поля — InvalidArgument",
+			request:    &proto.UpdateAssetRequest{Code: "BTC"},
+			setupMocks: func(_ *mocks.AssetCatalog) {},
+			checkErr: func(t *testing.T, err error) {
+				assertGRPCCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name:    "выключение — в ответе число выключенных рынков",
+			request: &proto.UpdateAssetRequest{Code: "BTC", Enabled: &disabled},
+			setupMocks: func(svc *mocks.AssetCatalog) {
+				svc.On("UpdateAsset", mock.Anything, models.AssetUpdate{Code: "BTC", Enabled: &disabled}).
+					Return(models.Asset{Code: "BTC", Name: "Bitcoin", Precision: 8}, int64(2), nil)
+			},
+			checkResp: func(t *testing.T, resp *proto.UpdateAssetResponse) {
+				assert.Equal(t, "BTC", resp.GetAsset().GetCode())
+				assert.False(t, resp.GetAsset().GetEnabled())
+				assert.Equal(t, uint32(2), resp.GetDisabledMarkets())
+			},
+		},
+		{
+			name:    "сервис возвращает ErrPermissionDenied — пробрасывается без изменений",
+			request: &proto.UpdateAssetRequest{Code: "BTC", Enabled: &disabled},
+			setupMocks: func(svc *mocks.AssetCatalog) {
+				svc.On("UpdateAsset", mock.Anything, mock.Anything).
+					Return(models.Asset{}, int64(0), serviceErrors.ErrPermissionDenied)
+			},
+			checkErr: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, serviceErrors.ErrPermissionDenied)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := mocks.NewAssetCatalog(t)
+			tt.setupMocks(svc)
+
+			resp, err := newAssetServer(svc).UpdateAsset(context.Background(), tt.request)
+
+			if tt.checkErr != nil {
+				tt.checkErr(t, err)
+				assert.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				tt.checkResp(t, resp)
+			}
+		})
+	}
+}
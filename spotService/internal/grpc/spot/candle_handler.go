@@ -0,0 +1,81 @@
+package spot
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	proto "github.com/nastyazhadan/spot-order-grpc/protos/gen/go/spot/v1"
+	"github.com/nastyazhadan/spot-order-grpc/shared/errors"
+	mapper "github.com/nastyazhadan/spot-order-grpc/spotService/internal/application/dto/inbound"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+)
+
+type Candles interface {
+	GetCandles(
+		ctx context.Context,
+		marketID uuid.UUID,
+		interval domainModels.CandleInterval,
+		from, to time.Time,
+		limit uint64,
+		pageToken string,
+	) ([]domainModels.Candle, string, bool, error)
+}
+
+type candleServerAPI struct {
+	proto.UnimplementedCandleServiceServer
+	candles Candles
+}
+
+func RegisterCandles(server *grpc.Server, candles Candles) {
+	proto.RegisterCandleServiceServer(
+		server, &candleServerAPI{
+			candles: candles,
+		})
+}
+
+func (s *candleServerAPI) GetCandles(
+	ctx context.Context,
+	request *proto.GetCandlesRequest,
+) (*proto.GetCandlesResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, errors.MsgRequestRequired)
+	}
+
+	marketID, err := uuid.Parse(request.GetMarketId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid market_id")
+	}
+
+	interval, ok := mapper.CandleIntervalFromProto(request.GetInterval())
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid interval")
+	}
+
+	if request.GetFrom() == nil || request.GetTo() == nil {
+		return nil, status.Error(codes.InvalidArgument, "from and to are required")
+	}
+
+	candles, nextPageToken, hasMore, err := s.candles.GetCandles(
+		ctx,
+		marketID,
+		interval,
+		request.GetFrom().AsTime(),
+		request.GetTo().AsTime(),
+		request.GetLimit(),
+		request.GetPageToken(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.GetCandlesResponse{
+		Candles:       mapper.CandlesToProto(candles),
+		HasMore:       hasMore,
+		NextPageToken: nextPageToken,
+	}, nil
+}
@@ -0,0 +1,141 @@
+package spot
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	proto "github.com/nastyazhadan/spot-order-grpc/protos/gen/go/spot/v1"
+	serviceErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/service"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+	"github.com/nastyazhadan/spot-order-grpc/spotService/internal/grpc/mocks"
+)
+
+func newCandleServer(svc *mocks.Candles) *candleServerAPI {
+	return &candleServerAPI{candles: svc}
+}
+
+func TestGetCandles(t *testing.T) {
+	marketID := uuid.New()
+	from := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	to := from.Add(time.Hour)
+
+	validRequest := func() *proto.GetCandlesRequest {
+		return &proto.GetCandlesRequest{
+			MarketId:  marketID.String(),
+			Interval:  proto.CandleInterval_CANDLE_INTERVAL_5M,
+			From:      timestamppb.New(from),
+			To:        timestamppb.New(to),
+			Limit:     10,
+			PageToken: "token",
+		}
+	}
+
+	tests := []struct {
+		name       string
+		request    *proto.GetCandlesRequest
+		setupMocks func(*mocks.Candles)
+		checkResp  func(t *testing.T, resp *proto.GetCandlesResponse)
+		checkErr   func(t *testing.T, err error)
+	}{
+		{
+			name:       "nil request — InvalidArgument",
+			request:    nil,
+			setupMocks: func(_ *mocks.Candles) {},
+			checkErr: func(t *testing.T, err error) {
+				assertGRPCCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "невалидный market_id — InvalidArgument",
+			request: func() *proto.GetCandlesRequest {
+				request := validRequest()
+				request.MarketId = "not-a-uuid"
+				return request
+			}(),
+			setupMocks: func(_ *mocks.Candles) {},
+			checkErr: func(t *testing.T, err error) {
+				assertGRPCCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "интервал не задан — InvalidArgument",
+			request: func() *proto.GetCandlesRequest {
+				request := validRequest()
+				request.Interval = proto.CandleInterval_CANDLE_INTERVAL_UNSPECIFIED
+				return request
+			}(),
+			setupMocks: func(_ *mocks.Candles) {},
+			checkErr: func(t *testing.T, err error) {
+				assertGRPCCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name:    "свечи и пагинация маппятся в proto",
+			request: validRequest(),
+			setupMocks: func(svc *mocks.Candles) {
+				svc.On("GetCandles", mock.Anything, marketID, domainModels.CandleInterval5m, from, to, uint64(10), "token").
+					Return([]domainModels.Candle{{
+						MarketID:    marketID,
+						Interval:    domainModels.CandleInterval5m,
+						OpenTime:    from,
+						Open:        decimal.RequireFromString("100"),
+						High:        decimal.RequireFromString("120"),
+						Low:         decimal.RequireFromString("90"),
+						Close:       decimal.RequireFromString("110"),
+						Volume:      decimal.NewFromInt(3),
+						QuoteVolume: decimal.RequireFromString("320"),
+						TradesCount: 3,
+					}}, "next", true, nil).Once()
+			},
+			checkResp: func(t *testing.T, resp *proto.GetCandlesResponse) {
+				require.Len(t, resp.GetCandles(), 1)
+				candle := resp.GetCandles()[0]
+				assert.Equal(t, marketID.String(), candle.GetMarketId())
+				assert.Equal(t, proto.CandleInterval_CANDLE_INTERVAL_5M, candle.GetInterval())
+				assert.Equal(t, from, candle.GetOpenTime().AsTime())
+				assert.Equal(t, from.Add(5*time.Minute), candle.GetCloseTime().AsTime())
+				assert.Equal(t, "100", candle.GetOpen().GetValue())
+				assert.Equal(t, "110", candle.GetClose().GetValue())
+				assert.Equal(t, int64(3), candle.GetTradesCount())
+				assert.True(t, resp.GetHasMore())
+				assert.Equal(t, "next", resp.GetNextPageToken())
+			},
+		},
+		{
+			name:    "ошибка сервиса пробрасывается",
+			request: validRequest(),
+			setupMocks: func(svc *mocks.Candles) {
+				svc.On("GetCandles", mock.Anything, marketID, domainModels.CandleInterval5m, from, to, uint64(10), "token").
+					Return(nil, "", false, serviceErrors.ErrInvalidCandleRequest).Once()
+			},
+			checkErr: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, serviceErrors.ErrInvalidCandleRequest)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := mocks.NewCandles(t)
+			tt.setupMocks(svc)
+
+			resp, err := newCandleServer(svc).GetCandles(context.Background(), tt.request)
+			if tt.checkErr != nil {
+				tt.checkErr(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			tt.checkResp(t, resp)
+		})
+	}
+}
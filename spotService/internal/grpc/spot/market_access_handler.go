@@ -0,0 +1,138 @@
+package spot
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	proto "github.com/nastyazhadan/spot-order-grpc/protos/gen/go/spot/v1"
+	"github.com/nastyazhadan/spot-order-grpc/shared/errors"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+	mapper "github.com/nastyazhadan/spot-order-grpc/spotService/internal/application/dto/inbound"
+)
+
+type MarketAccess interface {
+	SetMarketRestricted(ctx context.Context, id uuid.UUID, restricted bool) (models.Market, error)
+	GrantMarketAccess(ctx context.Context, grant models.MarketAccessGrant) (models.MarketAccessGrant, error)
+	RevokeMarketAccess(
+		ctx context.Context,
+		marketID uuid.UUID,
+		subjectType models.MarketAccessSubjectType,
+		subjectID string,
+	) (bool, error)
+	ListMarketAccess(ctx context.Context, marketID uuid.UUID) ([]models.MarketAccessGrant, error)
+}
+
+type marketAccessServerAPI struct {
+	proto.UnimplementedMarketAccessServiceServer
+	marketAccess MarketAccess
+}
+
+func RegisterMarketAccess(server *grpc.Server, marketAccess MarketAccess) {
+	proto.RegisterMarketAccessServiceServer(
+		server, &marketAccessServerAPI{
+			marketAccess: marketAccess,
+		})
+}
+
+func (s *marketAccessServerAPI) SetMarketRestricted(
+	ctx context.Context,
+	request *proto.SetMarketRestrictedRequest,
+) (*proto.SetMarketRestrictedResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, errors.MsgRequestRequired)
+	}
+
+	marketID, err := uuid.Parse(request.GetMarketId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid market_id")
+	}
+
+	market, err := s.marketAccess.SetMarketRestricted(ctx, marketID, request.GetRestricted())
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.SetMarketRestrictedResponse{
+		Market: mapper.MarketToProto(market),
+	}, nil
+}
+
+func (s *marketAccessServerAPI) GrantMarketAccess(
+	ctx context.Context,
+	request *proto.GrantMarketAccessRequest,
+) (*proto.GrantMarketAccessResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, errors.MsgRequestRequired)
+	}
+
+	marketID, err := uuid.Parse(request.GetMarketId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid market_id")
+	}
+
+	grant, err := s.marketAccess.GrantMarketAccess(ctx, models.MarketAccessGrant{
+		MarketID:    marketID,
+		SubjectType: mapper.MarketAccessSubjectTypeFromProto(request.GetSubject().GetType()),
+		SubjectID:   request.GetSubject().GetId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.GrantMarketAccessResponse{
+		Grant: mapper.MarketAccessGrantToProto(grant),
+	}, nil
+}
+
+func (s *marketAccessServerAPI) RevokeMarketAccess(
+	ctx context.Context,
+	request *proto.RevokeMarketAccessRequest,
+) (*proto.RevokeMarketAccessResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, errors.MsgRequestRequired)
+	}
+
+	marketID, err := uuid.Parse(request.GetMarketId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid market_id")
+	}
+
+	revoked, err := s.marketAccess.RevokeMarketAccess(ctx, marketID,
+		mapper.MarketAccessSubjectTypeFromProto(request.GetSubject().GetType()),
+		request.GetSubject().GetId(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.RevokeMarketAccessResponse{
+		Revoked: revoked,
+	}, nil
+}
+
+func (s *marketAccessServerAPI) ListMarketAccess(
+	ctx context.Context,
+	request *proto.ListMarketAccessRequest,
+) (*proto.ListMarketAccessResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, errors.MsgRequestRequired)
+	}
+
+	marketID, err := uuid.Parse(request.GetMarketId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid market_id")
+	}
+
+	grants, err := s.marketAccess.ListMarketAccess(ctx, marketID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.ListMarketAccessResponse{
+		Grants: mapper.MarketAccessGrantsToProto(grants),
+	}, nil
+}
@@ -0,0 +1,100 @@
+package spot
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	proto "github.com/nastyazhadan/spot-order-grpc/protos/gen/go/spot/v1"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+	"github.com/nastyazhadan/spot-order-grpc/spotService/internal/grpc/mocks"
+)
+
+func newMarketAccessServer(svc *mocks.MarketAccess) *marketAccessServerAPI {
+	return &marketAccessServerAPI{marketAccess: svc}
+}
+
+func TestGrantMarketAccess(t *testing.T) {
+	marketID := uuid.New()
+	adminID := uuid.New()
+	grantedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		request    *proto.GrantMarketAccessRequest
+		setupMocks func(*mocks.MarketAccess)
+		checkResp  func(t *testing.T, resp *proto.GrantMarketAccessResponse)
+		checkErr   func(t *testing.T, err error)
+	}{
+		{
+			name:       "nil request — InvalidArgument",
+			request:    nil,
+			setupMocks: func(_ *mocks.MarketAccess) {},
+			checkErr: func(t *testing.T, err error) {
+				assertGRPCCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name:       "невалидный market_id — InvalidArgument",
+			request:    &proto.GrantMarketAccessRequest{MarketId: "not-a-uuid"},
+			setupMocks: func(_ *mocks.MarketAccess) {},
+			checkErr: func(t *testing.T, err error) {
+				assertGRPCCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "субъект-роль маппится в домен и обратно",
+			request: &proto.GrantMarketAccessRequest{
+				MarketId: marketID.String(),
+				Subject: &proto.MarketAccessSubject{
+					Type: proto.MarketAccessSubjectType_MARKET_ACCESS_SUBJECT_TYPE_ROLE,
+					Id:   "ROLE_VIEWER",
+				},
+			},
+			setupMocks: func(svc *mocks.MarketAccess) {
+				svc.On("GrantMarketAccess", mock.Anything, models.MarketAccessGrant{
+					MarketID:    marketID,
+					SubjectType: models.MarketAccessSubjectRole,
+					SubjectID:   "ROLE_VIEWER",
+				}).Return(models.MarketAccessGrant{
+					MarketID:    marketID,
+					SubjectType: models.MarketAccessSubjectRole,
+					SubjectID:   "ROLE_VIEWER",
+					GrantedBy:   adminID.String(),
+					GrantedAt:   grantedAt,
+				}, nil).Once()
+			},
+			checkResp: func(t *testing.T, resp *proto.GrantMarketAccessResponse) {
+				grant := resp.GetGrant()
+				assert.Equal(t, marketID.String(), grant.GetMarketId())
+				assert.Equal(t, proto.MarketAccessSubjectType_MARKET_ACCESS_SUBJECT_TYPE_ROLE, grant.GetSubject().GetType())
+				assert.Equal(t, "ROLE_VIEWER", grant.GetSubject().GetId())
+				assert.Equal(t, adminID.String(), grant.GetGrantedBy())
+				assert.Equal(t, grantedAt, grant.GetGrantedAt().AsTime())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := mocks.NewMarketAccess(t)
+			tt.setupMocks(svc)
+
+			resp, err := newMarketAccessServer(svc).GrantMarketAccess(context.Background(), tt.request)
+
+			if tt.checkErr != nil {
+				tt.checkErr(t, err)
+				assert.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				tt.checkResp(t, resp)
+			}
+		})
+	}
+}
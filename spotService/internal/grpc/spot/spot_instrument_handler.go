@@ -12,22 +12,57 @@ import (
 	"github.com/nastyazhadan/spot-order-grpc/shared/errors"
 	"github.com/nastyazhadan/spot-order-grpc/shared/models"
 	mapper "github.com/nastyazhadan/spot-order-grpc/spotService/internal/application/dto/inbound"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
 )
 
 type SpotInstrument interface {
-	ViewMarkets(ctx context.Context, limit, offset uint64) ([]models.Market, uint64, bool, error)
+	ViewMarkets(
+		ctx context.Context,
+		limit uint64,
+		pageToken string,
+		filter models.MarketFilter,
+	) ([]models.Market, string, bool, error)
+	ViewMarketsByOffset(
+		ctx context.Context,
+		limit, offset uint64,
+		filter models.MarketFilter,
+	) ([]models.Market, string, bool, error)
 	GetMarketByID(ctx context.Context, id uuid.UUID) (models.Market, error)
+	GetMarketsByIDs(ctx context.Context, ids []uuid.UUID) ([]models.MarketLookup, error)
+	GetMarketBySymbol(ctx context.Context, symbol string) (models.Market, error)
+}
+
+type MarketWatcher interface {
+	WatchMarkets(ctx context.Context, resumeFrom *domainModels.MarketCursor, send func(event domainModels.MarketWatchEvent) error) error
+}
+
+type MarketHistory interface {
+	GetMarketHistory(
+		ctx context.Context,
+		marketID uuid.UUID,
+		limit uint64,
+		pageToken string,
+	) ([]domainModels.MarketHistoryEntry, string, bool, error)
 }
 
 type serverAPI struct {
 	proto.UnimplementedSpotInstrumentServiceServer
 	spotInstrument SpotInstrument
+	marketWatcher  MarketWatcher
+	marketHistory  MarketHistory
 }
 
-func Register(server *grpc.Server, spotInstrument SpotInstrument) {
+func Register(
+	server *grpc.Server,
+	spotInstrument SpotInstrument,
+	marketWatcher MarketWatcher,
+	marketHistory MarketHistory,
+) {
 	proto.RegisterSpotInstrumentServiceServer(
 		server, &serverAPI{
 			spotInstrument: spotInstrument,
+			marketWatcher:  marketWatcher,
+			marketHistory:  marketHistory,
 		})
 }
 
@@ -39,7 +74,30 @@ func (s *serverAPI) ViewMarkets(
 		return nil, status.Error(codes.InvalidArgument, errors.MsgRequestRequired)
 	}
 
-	markets, nextOffset, hasMore, err := s.spotInstrument.ViewMarkets(ctx, request.GetLimit(), request.GetOffset())
+	filter := mapper.MarketFilterFromProto(request.GetFilter())
+
+	// offset устарел: учитывается только без page_token
+	offset := request.GetOffset() //nolint:staticcheck // устаревшее поле обслуживается до его удаления
+	if request.GetPageToken() != "" {
+		offset = 0
+	}
+
+	var (
+		markets       []models.Market
+		nextPageToken string
+		hasMore       bool
+		err           error
+	)
+	if offset > 0 {
+		markets, nextPageToken, hasMore, err = s.spotInstrument.ViewMarketsByOffset(ctx, request.GetLimit(), offset, filter)
+	} else {
+		markets, nextPageToken, hasMore, err = s.spotInstrument.ViewMarkets(
+			ctx,
+			request.GetLimit(),
+			request.GetPageToken(),
+			filter,
+		)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -49,11 +107,17 @@ func (s *serverAPI) ViewMarkets(
 		out = append(out, mapper.MarketToProto(market))
 	}
 
-	return &proto.ViewMarketsResponse{
-		Markets:    out,
-		NextOffset: nextOffset,
-		HasMore:    hasMore,
-	}, nil
+	response := &proto.ViewMarketsResponse{
+		Markets:       out,
+		HasMore:       hasMore,
+		NextPageToken: nextPageToken,
+	}
+	// Смещение известно, только если клиент пришёл без page_token
+	if hasMore && request.GetPageToken() == "" {
+		response.NextOffset = offset + uint64(len(out)) //nolint:staticcheck // см. offset выше
+	}
+
+	return response, nil
 }
 
 func (s *serverAPI) GetMarketByID(
@@ -78,3 +142,101 @@ func (s *serverAPI) GetMarketByID(
 		Market: mapper.MarketToProto(market),
 	}, nil
 }
+
+func (s *serverAPI) GetMarketBySymbol(
+	ctx context.Context,
+	request *proto.GetMarketBySymbolRequest,
+) (*proto.GetMarketBySymbolResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, errors.MsgRequestRequired)
+	}
+	if request.GetSymbol() == "" {
+		return nil, status.Error(codes.InvalidArgument, "symbol is required")
+	}
+
+	market, err := s.spotInstrument.GetMarketBySymbol(ctx, request.GetSymbol())
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.GetMarketBySymbolResponse{
+		Market: mapper.MarketToProto(market),
+	}, nil
+}
+
+func (s *serverAPI) GetMarketsByIDs(
+	ctx context.Context,
+	request *proto.GetMarketsByIDsRequest,
+) (*proto.GetMarketsByIDsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, errors.MsgRequestRequired)
+	}
+
+	marketIDs := make([]uuid.UUID, 0, len(request.GetMarketIds()))
+	for _, rawID := range request.GetMarketIds() {
+		marketID, err := uuid.Parse(rawID)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid market_ids")
+		}
+		marketIDs = append(marketIDs, marketID)
+	}
+
+	lookups, err := s.spotInstrument.GetMarketsByIDs(ctx, marketIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*proto.MarketLookupResult, 0, len(lookups))
+	for _, lookup := range lookups {
+		results = append(results, mapper.MarketLookupToProto(lookup))
+	}
+
+	return &proto.GetMarketsByIDsResponse{
+		Results: results,
+	}, nil
+}
+
+func (s *serverAPI) GetMarketHistory(
+	ctx context.Context,
+	request *proto.GetMarketHistoryRequest,
+) (*proto.GetMarketHistoryResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, errors.MsgRequestRequired)
+	}
+
+	marketID, err := uuid.Parse(request.GetMarketId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid market_id")
+	}
+
+	entries, nextPageToken, hasMore, err := s.marketHistory.GetMarketHistory(
+		ctx,
+		marketID,
+		request.GetLimit(),
+		request.GetPageToken(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.GetMarketHistoryResponse{
+		Entries:       mapper.MarketHistoryEntriesToProto(entries),
+		HasMore:       hasMore,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *serverAPI) WatchMarkets(
+	request *proto.WatchMarketsRequest,
+	stream grpc.ServerStreamingServer[proto.WatchMarketsResponse],
+) error {
+	if request == nil {
+		return status.Error(codes.InvalidArgument, errors.MsgRequestRequired)
+	}
+
+	return s.marketWatcher.WatchMarkets(stream.Context(), mapper.MarketCursorFromProto(request.GetResumeFrom()),
+		func(event domainModels.MarketWatchEvent) error {
+			return stream.Send(mapper.MarketWatchEventToProto(event))
+		},
+	)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -17,6 +18,8 @@ import (
 	sharedErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors"
 	serviceErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/service"
 	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+	mapper "github.com/nastyazhadan/spot-order-grpc/spotService/internal/application/dto/inbound"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
 	"github.com/nastyazhadan/spot-order-grpc/spotService/internal/grpc/mocks"
 )
 
@@ -71,65 +74,116 @@ func TestViewMarkets(t *testing.T) {
 			},
 		},
 		{
-			name:    "limit=0, offset=0 — сервис вызывается с нулями (defaults на стороне сервиса)",
-			request: &proto.ViewMarketsRequest{Limit: 0, Offset: 0},
+			name:    "limit=0 без токена — сервис вызывается с нулями (defaults на стороне сервиса)",
+			request: &proto.ViewMarketsRequest{Limit: 0},
 			setupMocks: func(svc *mocks.SpotInstrument) {
-				svc.On("ViewMarkets", mock.Anything, uint64(0), uint64(0)).
-					Return([]models.Market{activeMarket}, uint64(0), false, nil)
+				svc.On("ViewMarkets", mock.Anything, uint64(0), "", models.MarketFilter{}).
+					Return([]models.Market{activeMarket}, "", false, nil)
 			},
 			checkResp: func(t *testing.T, resp *proto.ViewMarketsResponse) {
 				require.NotNil(t, resp)
 				assert.Len(t, resp.GetMarkets(), 1)
 				assert.False(t, resp.GetHasMore())
-				assert.Equal(t, uint64(0), resp.GetNextOffset())
+				assert.Empty(t, resp.GetNextPageToken())
 			},
 		},
 		{
-			name:    "limit=10, offset=20 — сервис вызывается с этими значениями",
-			request: &proto.ViewMarketsRequest{Limit: 10, Offset: 20},
+			name:    "page_token пробрасывается в сервис без изменений",
+			request: &proto.ViewMarketsRequest{Limit: 10, PageToken: "opaque-token"},
 			setupMocks: func(svc *mocks.SpotInstrument) {
-				svc.On("ViewMarkets", mock.Anything, uint64(10), uint64(20)).
-					Return([]models.Market{activeMarket}, uint64(30), true, nil)
+				svc.On("ViewMarkets", mock.Anything, uint64(10), "opaque-token", models.MarketFilter{}).
+					Return([]models.Market{activeMarket}, "next-token", true, nil)
 			},
 			checkResp: func(t *testing.T, resp *proto.ViewMarketsResponse) {
 				require.NotNil(t, resp)
 				assert.True(t, resp.GetHasMore())
-				assert.Equal(t, uint64(30), resp.GetNextOffset())
+				assert.Equal(t, "next-token", resp.GetNextPageToken())
 			},
 		},
 		{
-			name:    "hasMore=true — nextOffset и hasMore пробрасываются в ответ",
-			request: &proto.ViewMarketsRequest{Limit: 5, Offset: 0},
+			name: "фильтр маппится в доменную модель",
+			request: &proto.ViewMarketsRequest{
+				Limit: 5,
+				Filter: &proto.MarketFilter{
+					NamePrefix: "BTC",
+					QuoteAsset: "USDT",
+					Status:     proto.MarketStatus_MARKET_STATUS_ENABLED,
+				},
+			},
 			setupMocks: func(svc *mocks.SpotInstrument) {
-				svc.On("ViewMarkets", mock.Anything, uint64(5), uint64(0)).
-					Return([]models.Market{activeMarket, disabledMarket}, uint64(5), true, nil)
+				filter := models.MarketFilter{
+					NamePrefix: "BTC",
+					QuoteAsset: "USDT",
+					Status:     models.MarketStatusEnabled,
+				}
+				svc.On("ViewMarkets", mock.Anything, uint64(5), "", filter).
+					Return([]models.Market{activeMarket}, "", false, nil)
+			},
+			checkResp: func(t *testing.T, resp *proto.ViewMarketsResponse) {
+				require.Len(t, resp.GetMarkets(), 1)
+			},
+		},
+		{
+			name:    "hasMore=true — nextPageToken и hasMore пробрасываются в ответ",
+			request: &proto.ViewMarketsRequest{Limit: 5},
+			setupMocks: func(svc *mocks.SpotInstrument) {
+				svc.On("ViewMarkets", mock.Anything, uint64(5), "", models.MarketFilter{}).
+					Return([]models.Market{activeMarket, disabledMarket}, "next-token", true, nil)
 			},
 			checkResp: func(t *testing.T, resp *proto.ViewMarketsResponse) {
 				require.NotNil(t, resp)
 				assert.True(t, resp.GetHasMore())
-				assert.Equal(t, uint64(5), resp.GetNextOffset())
+				assert.Equal(t, "next-token", resp.GetNextPageToken())
+				assert.Len(t, resp.GetMarkets(), 2)
+				assert.Equal(t, uint64(2), resp.GetNextOffset())
+			},
+		},
+		{
+			name:    "устаревший offset без токена — страница по смещению и next_offset",
+			request: &proto.ViewMarketsRequest{Limit: 2, Offset: 4},
+			setupMocks: func(svc *mocks.SpotInstrument) {
+				svc.On("ViewMarketsByOffset", mock.Anything, uint64(2), uint64(4), models.MarketFilter{}).
+					Return([]models.Market{activeMarket, disabledMarket}, "next-token", true, nil)
+			},
+			checkResp: func(t *testing.T, resp *proto.ViewMarketsResponse) {
+				require.NotNil(t, resp)
 				assert.Len(t, resp.GetMarkets(), 2)
+				assert.Equal(t, uint64(6), resp.GetNextOffset())
+				assert.Equal(t, "next-token", resp.GetNextPageToken())
 			},
 		},
 		{
-			name:    "hasMore=false — nextOffset=0 в ответе",
-			request: &proto.ViewMarketsRequest{Limit: 5, Offset: 0},
+			name:    "page_token важнее offset — next_offset не заполняется",
+			request: &proto.ViewMarketsRequest{Limit: 2, Offset: 4, PageToken: "opaque-token"},
 			setupMocks: func(svc *mocks.SpotInstrument) {
-				svc.On("ViewMarkets", mock.Anything, uint64(5), uint64(0)).
-					Return([]models.Market{activeMarket}, uint64(0), false, nil)
+				svc.On("ViewMarkets", mock.Anything, uint64(2), "opaque-token", models.MarketFilter{}).
+					Return([]models.Market{activeMarket, disabledMarket}, "next-token", true, nil)
+			},
+			checkResp: func(t *testing.T, resp *proto.ViewMarketsResponse) {
+				require.NotNil(t, resp)
+				assert.Zero(t, resp.GetNextOffset())
+				assert.Equal(t, "next-token", resp.GetNextPageToken())
+			},
+		},
+		{
+			name:    "hasMore=false — пустой nextPageToken в ответе",
+			request: &proto.ViewMarketsRequest{Limit: 5},
+			setupMocks: func(svc *mocks.SpotInstrument) {
+				svc.On("ViewMarkets", mock.Anything, uint64(5), "", models.MarketFilter{}).
+					Return([]models.Market{activeMarket}, "", false, nil)
 			},
 			checkResp: func(t *testing.T, resp *proto.ViewMarketsResponse) {
 				require.NotNil(t, resp)
 				assert.False(t, resp.GetHasMore())
-				assert.Equal(t, uint64(0), resp.GetNextOffset())
+				assert.Empty(t, resp.GetNextPageToken())
 			},
 		},
 		{
 			name:    "пустой список маркетов — ответ с пустым массивом, не nil",
-			request: &proto.ViewMarketsRequest{Limit: 10, Offset: 0},
+			request: &proto.ViewMarketsRequest{Limit: 10},
 			setupMocks: func(svc *mocks.SpotInstrument) {
-				svc.On("ViewMarkets", mock.Anything, uint64(10), uint64(0)).
-					Return([]models.Market{}, uint64(0), false, nil)
+				svc.On("ViewMarkets", mock.Anything, uint64(10), "", models.MarketFilter{}).
+					Return([]models.Market{}, "", false, nil)
 			},
 			checkResp: func(t *testing.T, resp *proto.ViewMarketsResponse) {
 				require.NotNil(t, resp)
@@ -139,7 +193,7 @@ func TestViewMarkets(t *testing.T) {
 		},
 		{
 			name:    "активный маркет — все поля маппятся корректно",
-			request: &proto.ViewMarketsRequest{Limit: 1, Offset: 0},
+			request: &proto.ViewMarketsRequest{Limit: 1},
 			setupMocks: func(svc *mocks.SpotInstrument) {
 				market := models.Market{
 					ID:        uuid.MustParse("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"),
@@ -148,8 +202,8 @@ func TestViewMarkets(t *testing.T) {
 					DeletedAt: nil,
 					UpdatedAt: time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC),
 				}
-				svc.On("ViewMarkets", mock.Anything, uint64(1), uint64(0)).
-					Return([]models.Market{market}, uint64(0), false, nil)
+				svc.On("ViewMarkets", mock.Anything, uint64(1), "", models.MarketFilter{}).
+					Return([]models.Market{market}, "", false, nil)
 			},
 			checkResp: func(t *testing.T, resp *proto.ViewMarketsResponse) {
 				require.NotNil(t, resp)
@@ -164,10 +218,10 @@ func TestViewMarkets(t *testing.T) {
 		},
 		{
 			name:    "маркет с DeletedAt — deletedAt маппится в proto timestamp",
-			request: &proto.ViewMarketsRequest{Limit: 1, Offset: 0},
+			request: &proto.ViewMarketsRequest{Limit: 1},
 			setupMocks: func(svc *mocks.SpotInstrument) {
-				svc.On("ViewMarkets", mock.Anything, uint64(1), uint64(0)).
-					Return([]models.Market{deletedMarket}, uint64(0), false, nil)
+				svc.On("ViewMarkets", mock.Anything, uint64(1), "", models.MarketFilter{}).
+					Return([]models.Market{deletedMarket}, "", false, nil)
 			},
 			checkResp: func(t *testing.T, resp *proto.ViewMarketsResponse) {
 				require.NotNil(t, resp)
@@ -179,13 +233,13 @@ func TestViewMarkets(t *testing.T) {
 		},
 		{
 			name:    "маркет с нулевым UpdatedAt — updatedAt в proto равен nil",
-			request: &proto.ViewMarketsRequest{Limit: 1, Offset: 0},
+			request: &proto.ViewMarketsRequest{Limit: 1},
 			setupMocks: func(svc *mocks.SpotInstrument) {
 				market := models.Market{
 					ID: uuid.New(), Name: "test", Enabled: true, UpdatedAt: time.Time{},
 				}
-				svc.On("ViewMarkets", mock.Anything, uint64(1), uint64(0)).
-					Return([]models.Market{market}, uint64(0), false, nil)
+				svc.On("ViewMarkets", mock.Anything, uint64(1), "", models.MarketFilter{}).
+					Return([]models.Market{market}, "", false, nil)
 			},
 			checkResp: func(t *testing.T, resp *proto.ViewMarketsResponse) {
 				require.Len(t, resp.GetMarkets(), 1)
@@ -194,11 +248,11 @@ func TestViewMarkets(t *testing.T) {
 		},
 		{
 			name:    "несколько маркетов — порядок сохраняется",
-			request: &proto.ViewMarketsRequest{Limit: 10, Offset: 0},
+			request: &proto.ViewMarketsRequest{Limit: 10},
 			setupMocks: func(svc *mocks.SpotInstrument) {
 				markets := []models.Market{activeMarket, disabledMarket, deletedMarket}
-				svc.On("ViewMarkets", mock.Anything, uint64(10), uint64(0)).
-					Return(markets, uint64(0), false, nil)
+				svc.On("ViewMarkets", mock.Anything, uint64(10), "", models.MarketFilter{}).
+					Return(markets, "", false, nil)
 			},
 			checkResp: func(t *testing.T, resp *proto.ViewMarketsResponse) {
 				require.Len(t, resp.GetMarkets(), 3)
@@ -209,10 +263,10 @@ func TestViewMarkets(t *testing.T) {
 		},
 		{
 			name:    "сервис возвращает ошибку — хендлер пробрасывает её без изменений",
-			request: &proto.ViewMarketsRequest{Limit: 10, Offset: 0},
+			request: &proto.ViewMarketsRequest{Limit: 10},
 			setupMocks: func(svc *mocks.SpotInstrument) {
-				svc.On("ViewMarkets", mock.Anything, uint64(10), uint64(0)).
-					Return(nil, uint64(0), false, serviceErrors.ErrMarketsNotFound)
+				svc.On("ViewMarkets", mock.Anything, uint64(10), "", models.MarketFilter{}).
+					Return(nil, "", false, serviceErrors.ErrMarketsNotFound)
 			},
 			checkErr: func(t *testing.T, err error) {
 				require.Error(t, err)
@@ -221,10 +275,10 @@ func TestViewMarkets(t *testing.T) {
 		},
 		{
 			name:    "сервис возвращает ErrInvalidPagination — хендлер пробрасывает",
-			request: &proto.ViewMarketsRequest{Limit: 10, Offset: 0},
+			request: &proto.ViewMarketsRequest{Limit: 10},
 			setupMocks: func(svc *mocks.SpotInstrument) {
-				svc.On("ViewMarkets", mock.Anything, uint64(10), uint64(0)).
-					Return(nil, uint64(0), false, serviceErrors.ErrInvalidPagination)
+				svc.On("ViewMarkets", mock.Anything, uint64(10), "", models.MarketFilter{}).
+					Return(nil, "", false, serviceErrors.ErrInvalidPagination)
 			},
 			checkErr: func(t *testing.T, err error) {
 				require.Error(t, err)
@@ -233,10 +287,10 @@ func TestViewMarkets(t *testing.T) {
 		},
 		{
 			name:    "сервис возвращает неизвестную ошибку — хендлер пробрасывает",
-			request: &proto.ViewMarketsRequest{Limit: 10, Offset: 0},
+			request: &proto.ViewMarketsRequest{Limit: 10},
 			setupMocks: func(svc *mocks.SpotInstrument) {
-				svc.On("ViewMarkets", mock.Anything, uint64(10), uint64(0)).
-					Return(nil, uint64(0), false, errors.New("internal db error"))
+				svc.On("ViewMarkets", mock.Anything, uint64(10), "", models.MarketFilter{}).
+					Return(nil, "", false, errors.New("internal db error"))
 			},
 			checkErr: func(t *testing.T, err error) {
 				require.Error(t, err)
@@ -245,10 +299,10 @@ func TestViewMarkets(t *testing.T) {
 		},
 		{
 			name:    "сервис возвращает gRPC status error — пробрасывается как есть",
-			request: &proto.ViewMarketsRequest{Limit: 10, Offset: 0},
+			request: &proto.ViewMarketsRequest{Limit: 10},
 			setupMocks: func(svc *mocks.SpotInstrument) {
-				svc.On("ViewMarkets", mock.Anything, uint64(10), uint64(0)).
-					Return(nil, uint64(0), false, status.Error(codes.Unavailable, "market unavailable"))
+				svc.On("ViewMarkets", mock.Anything, uint64(10), "", models.MarketFilter{}).
+					Return(nil, "", false, status.Error(codes.Unavailable, "market unavailable"))
 			},
 			checkErr: func(t *testing.T, err error) {
 				assertGRPCCode(t, err, codes.Unavailable)
@@ -479,3 +533,407 @@ func TestGetMarketByID(t *testing.T) {
 		})
 	}
 }
+
+func TestGetMarketBySymbol(t *testing.T) {
+	market := models.Market{
+		ID:        uuid.New(),
+		Name:      "BTC-USDT",
+		Enabled:   true,
+		UpdatedAt: time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name       string
+		request    *proto.GetMarketBySymbolRequest
+		setupMocks func(*mocks.SpotInstrument)
+		checkResp  func(t *testing.T, resp *proto.GetMarketBySymbolResponse)
+		checkErr   func(t *testing.T, err error)
+	}{
+		{
+			name:       "nil request — InvalidArgument",
+			request:    nil,
+			setupMocks: func(_ *mocks.SpotInstrument) {},
+			checkErr: func(t *testing.T, err error) {
+				assertGRPCCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name:       "пустой symbol — InvalidArgument",
+			request:    &proto.GetMarketBySymbolRequest{Symbol: ""},
+			setupMocks: func(_ *mocks.SpotInstrument) {},
+			checkErr: func(t *testing.T, err error) {
+				assertGRPCCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name:    "маркет найден — поля маппятся корректно",
+			request: &proto.GetMarketBySymbolRequest{Symbol: "BTC-USDT"},
+			setupMocks: func(svc *mocks.SpotInstrument) {
+				svc.On("GetMarketBySymbol", mock.Anything, "BTC-USDT").
+					Return(market, nil)
+			},
+			checkResp: func(t *testing.T, resp *proto.GetMarketBySymbolResponse) {
+				require.NotNil(t, resp)
+				assert.Equal(t, market.ID.String(), resp.GetMarket().GetId())
+				assert.Equal(t, "BTC-USDT", resp.GetMarket().GetName())
+			},
+		},
+		{
+			name:    "сервис возвращает ErrMarketSymbolNotFound — пробрасывается без изменений",
+			request: &proto.GetMarketBySymbolRequest{Symbol: "UNKNOWN"},
+			setupMocks: func(svc *mocks.SpotInstrument) {
+				svc.On("GetMarketBySymbol", mock.Anything, "UNKNOWN").
+					Return(models.Market{}, serviceErrors.ErrMarketSymbolNotFound)
+			},
+			checkErr: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, serviceErrors.ErrMarketSymbolNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := mocks.NewSpotInstrument(t)
+			tt.setupMocks(svc)
+
+			server := newServer(svc)
+			resp, err := server.GetMarketBySymbol(context.Background(), tt.request)
+
+			if tt.checkErr != nil {
+				tt.checkErr(t, err)
+				assert.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				if tt.checkResp != nil {
+					tt.checkResp(t, resp)
+				}
+			}
+		})
+	}
+}
+
+type fakeWatchStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*proto.WatchMarketsResponse
+}
+
+func (s *fakeWatchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeWatchStream) Send(response *proto.WatchMarketsResponse) error {
+	s.sent = append(s.sent, response)
+	return nil
+}
+
+func TestGetMarketsByIDs(t *testing.T) {
+	activeMarket := models.Market{
+		ID:        uuid.New(),
+		Name:      "BTC/USD",
+		Enabled:   true,
+		UpdatedAt: time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC),
+	}
+	disabledID := uuid.New()
+	missingID := uuid.New()
+
+	tests := []struct {
+		name       string
+		request    *proto.GetMarketsByIDsRequest
+		setupMocks func(*mocks.SpotInstrument)
+		checkResp  func(t *testing.T, resp *proto.GetMarketsByIDsResponse)
+		checkErr   func(t *testing.T, err error)
+	}{
+		{
+			name:       "nil request — InvalidArgument",
+			request:    nil,
+			setupMocks: func(_ *mocks.SpotInstrument) {},
+			checkErr: func(t *testing.T, err error) {
+				assertGRPCCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name:       "невалидный UUID в списке — InvalidArgument",
+			request:    &proto.GetMarketsByIDsRequest{MarketIds: []string{activeMarket.ID.String(), "not-a-uuid"}},
+			setupMocks: func(_ *mocks.SpotInstrument) {},
+			checkErr: func(t *testing.T, err error) {
+				assertGRPCCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "статусы маппятся по каждому id, market заполнен только для FOUND",
+			request: &proto.GetMarketsByIDsRequest{MarketIds: []string{
+				activeMarket.ID.String(), disabledID.String(), missingID.String(),
+			}},
+			setupMocks: func(svc *mocks.SpotInstrument) {
+				svc.On("GetMarketsByIDs", mock.Anything, []uuid.UUID{activeMarket.ID, disabledID, missingID}).
+					Return([]models.MarketLookup{
+						{ID: activeMarket.ID, Status: models.MarketLookupStatusFound, Market: activeMarket},
+						{ID: disabledID, Status: models.MarketLookupStatusDisabled},
+						{ID: missingID, Status: models.MarketLookupStatusNotFound},
+					}, nil)
+			},
+			checkResp: func(t *testing.T, resp *proto.GetMarketsByIDsResponse) {
+				require.Len(t, resp.GetResults(), 3)
+
+				found := resp.GetResults()[0]
+				assert.Equal(t, activeMarket.ID.String(), found.GetMarketId())
+				assert.Equal(t, proto.MarketLookupStatus_MARKET_LOOKUP_STATUS_FOUND, found.GetStatus())
+				assert.Equal(t, "BTC/USD", found.GetMarket().GetName())
+
+				disabled := resp.GetResults()[1]
+				assert.Equal(t, disabledID.String(), disabled.GetMarketId())
+				assert.Equal(t, proto.MarketLookupStatus_MARKET_LOOKUP_STATUS_DISABLED, disabled.GetStatus())
+				assert.Nil(t, disabled.GetMarket())
+
+				missing := resp.GetResults()[2]
+				assert.Equal(t, missingID.String(), missing.GetMarketId())
+				assert.Equal(t, proto.MarketLookupStatus_MARKET_LOOKUP_STATUS_NOT_FOUND, missing.GetStatus())
+				assert.Nil(t, missing.GetMarket())
+			},
+		},
+		{
+			name:    "сервис возвращает ошибку — хендлер пробрасывает её без изменений",
+			request: &proto.GetMarketsByIDsRequest{MarketIds: []string{missingID.String()}},
+			setupMocks: func(svc *mocks.SpotInstrument) {
+				svc.On("GetMarketsByIDs", mock.Anything, []uuid.UUID{missingID}).
+					Return(nil, serviceErrors.ErrUserRoleNotSpecified)
+			},
+			checkErr: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, serviceErrors.ErrUserRoleNotSpecified)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := mocks.NewSpotInstrument(t)
+			tt.setupMocks(svc)
+
+			server := newServer(svc)
+			resp, err := server.GetMarketsByIDs(context.Background(), tt.request)
+
+			if tt.checkErr != nil {
+				tt.checkErr(t, err)
+				assert.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				if tt.checkResp != nil {
+					tt.checkResp(t, resp)
+				}
+			}
+		})
+	}
+}
+
+func TestGetMarketHistory(t *testing.T) {
+	marketID := uuid.New()
+	changedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	before := models.Market{ID: marketID, Name: "BTC-USDT", Enabled: true}
+	after := models.Market{ID: marketID, Name: "BTC-USDT", Enabled: false}
+
+	tests := []struct {
+		name       string
+		request    *proto.GetMarketHistoryRequest
+		setupMocks func(*mocks.MarketHistory)
+		checkResp  func(t *testing.T, resp *proto.GetMarketHistoryResponse)
+		checkErr   func(t *testing.T, err error)
+	}{
+		{
+			name:       "nil request — InvalidArgument",
+			request:    nil,
+			setupMocks: func(_ *mocks.MarketHistory) {},
+			checkErr: func(t *testing.T, err error) {
+				assertGRPCCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name:       "невалидный market_id — InvalidArgument",
+			request:    &proto.GetMarketHistoryRequest{MarketId: "not-a-uuid"},
+			setupMocks: func(_ *mocks.MarketHistory) {},
+			checkErr: func(t *testing.T, err error) {
+				assertGRPCCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name:    "записи маппятся в proto вместе с пагинацией",
+			request: &proto.GetMarketHistoryRequest{MarketId: marketID.String(), Limit: 1, PageToken: "token"},
+			setupMocks: func(svc *mocks.MarketHistory) {
+				svc.On("GetMarketHistory", mock.Anything, marketID, uint64(1), "token").
+					Return([]domainModels.MarketHistoryEntry{{
+						ID:        7,
+						MarketID:  marketID,
+						Operation: domainModels.MarketHistoryOperationUpdate,
+						Before:    &before,
+						After:     &after,
+						Actor:     "admin-id",
+						ChangedAt: changedAt,
+					}}, "next", true, nil)
+			},
+			checkResp: func(t *testing.T, resp *proto.GetMarketHistoryResponse) {
+				require.Len(t, resp.GetEntries(), 1)
+				entry := resp.GetEntries()[0]
+				assert.Equal(t, int64(7), entry.GetId())
+				assert.Equal(t, marketID.String(), entry.GetMarketId())
+				assert.Equal(t, proto.MarketHistoryOperation_MARKET_HISTORY_OPERATION_UPDATE, entry.GetOperation())
+				assert.True(t, entry.GetBefore().GetEnabled())
+				assert.False(t, entry.GetAfter().GetEnabled())
+				assert.Equal(t, "admin-id", entry.GetActor())
+				assert.Equal(t, changedAt, entry.GetChangedAt().AsTime())
+				assert.True(t, resp.GetHasMore())
+				assert.Equal(t, "next", resp.GetNextPageToken())
+			},
+		},
+		{
+			name:    "INSERT — before не заполняется",
+			request: &proto.GetMarketHistoryRequest{MarketId: marketID.String()},
+			setupMocks: func(svc *mocks.MarketHistory) {
+				svc.On("GetMarketHistory", mock.Anything, marketID, uint64(0), "").
+					Return([]domainModels.MarketHistoryEntry{{
+						ID:        1,
+						MarketID:  marketID,
+						Operation: domainModels.MarketHistoryOperationInsert,
+						After:     &before,
+						ChangedAt: changedAt,
+					}}, "", false, nil)
+			},
+			checkResp: func(t *testing.T, resp *proto.GetMarketHistoryResponse) {
+				require.Len(t, resp.GetEntries(), 1)
+				assert.Nil(t, resp.GetEntries()[0].GetBefore())
+				assert.NotNil(t, resp.GetEntries()[0].GetAfter())
+				assert.False(t, resp.GetHasMore())
+			},
+		},
+		{
+			name:    "сервис возвращает ErrPermissionDenied — пробрасывается без изменений",
+			request: &proto.GetMarketHistoryRequest{MarketId: marketID.String()},
+			setupMocks: func(svc *mocks.MarketHistory) {
+				svc.On("GetMarketHistory", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, "", false, serviceErrors.ErrPermissionDenied)
+			},
+			checkErr: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, serviceErrors.ErrPermissionDenied)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := mocks.NewMarketHistory(t)
+			tt.setupMocks(svc)
+
+			server := &serverAPI{marketHistory: svc}
+			resp, err := server.GetMarketHistory(context.Background(), tt.request)
+
+			if tt.checkErr != nil {
+				tt.checkErr(t, err)
+				assert.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				tt.checkResp(t, resp)
+			}
+		})
+	}
+}
+
+func TestWatchMarkets(t *testing.T) {
+	cursor := domainModels.MarketCursor{Seq: 42}
+	market := models.Market{
+		ID:        uuid.New(),
+		Name:      "BTC-USDT",
+		Enabled:   true,
+		UpdatedAt: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name       string
+		request    *proto.WatchMarketsRequest
+		setupMocks func(*mocks.MarketWatcher)
+		checkSent  func(t *testing.T, sent []*proto.WatchMarketsResponse)
+		checkErr   func(t *testing.T, err error)
+	}{
+		{
+			name:       "nil request — InvalidArgument",
+			request:    nil,
+			setupMocks: func(_ *mocks.MarketWatcher) {},
+			checkErr: func(t *testing.T, err error) {
+				assertGRPCCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name:    "без курсора — snapshot и изменения маппятся в proto",
+			request: &proto.WatchMarketsRequest{},
+			setupMocks: func(watcher *mocks.MarketWatcher) {
+				watcher.On("WatchMarkets", mock.Anything, (*domainModels.MarketCursor)(nil), mock.Anything).
+					Return(func(_ context.Context, _ *domainModels.MarketCursor, send func(domainModels.MarketWatchEvent) error) error {
+						if err := send(domainModels.MarketWatchEvent{
+							Type:         domainModels.MarketWatchEventTypeSnapshot,
+							Snapshot:     []models.Market{market},
+							SnapshotLast: true,
+							Cursor:       cursor,
+						}); err != nil {
+							return err
+						}
+						return send(domainModels.MarketWatchEvent{
+							Type: domainModels.MarketWatchEventTypeChanges,
+							Changes: []domainModels.MarketChange{
+								{Type: domainModels.MarketChangeTypeRemoved, MarketID: market.ID},
+							},
+							Cursor: domainModels.MarketCursor{Seq: cursor.Seq + 1},
+						})
+					})
+			},
+			checkSent: func(t *testing.T, sent []*proto.WatchMarketsResponse) {
+				require.Len(t, sent, 2)
+
+				snapshot := sent[0].GetSnapshot()
+				require.NotNil(t, snapshot)
+				assert.True(t, snapshot.GetLast())
+				require.Len(t, snapshot.GetMarkets(), 1)
+				assert.Equal(t, market.ID.String(), snapshot.GetMarkets()[0].GetId())
+				assert.Equal(t, cursor.Seq, sent[0].GetCursor().GetSeq())
+
+				changes := sent[1].GetChanges().GetChanges()
+				require.Len(t, changes, 1)
+				assert.Equal(t, proto.MarketChangeType_MARKET_CHANGE_TYPE_REMOVED, changes[0].GetType())
+				assert.Nil(t, changes[0].GetMarket())
+				assert.Equal(t, cursor.Seq+1, sent[1].GetCursor().GetSeq())
+			},
+		},
+		{
+			name: "курсор передаётся в сервис, ошибка сервиса пробрасывается",
+			request: &proto.WatchMarketsRequest{
+				ResumeFrom: mapper.MarketCursorToProto(cursor),
+			},
+			setupMocks: func(watcher *mocks.MarketWatcher) {
+				watcher.On("WatchMarkets", mock.Anything, &cursor, mock.Anything).
+					Return(serviceErrors.ErrMarketWatchLagged)
+			},
+			checkErr: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, serviceErrors.ErrMarketWatchLagged)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			watcher := mocks.NewMarketWatcher(t)
+			tt.setupMocks(watcher)
+
+			server := &serverAPI{marketWatcher: watcher}
+			stream := &fakeWatchStream{ctx: context.Background()}
+
+			err := server.WatchMarkets(tt.request, stream)
+
+			if tt.checkErr != nil {
+				tt.checkErr(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			if tt.checkSent != nil {
+				tt.checkSent(t, stream.sent)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package spot
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	proto "github.com/nastyazhadan/spot-order-grpc/protos/gen/go/spot/v1"
+	"github.com/nastyazhadan/spot-order-grpc/shared/errors"
+	mapper "github.com/nastyazhadan/spot-order-grpc/spotService/internal/application/dto/inbound"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+)
+
+type Tickers interface {
+	GetTicker(ctx context.Context, marketID uuid.UUID) (domainModels.Ticker, error)
+	ListTickers(ctx context.Context) ([]domainModels.Ticker, error)
+	WatchTickers(ctx context.Context, marketIDs []uuid.UUID, send func(ticker domainModels.Ticker) error) error
+}
+
+type tickerServerAPI struct {
+	proto.UnimplementedTickerServiceServer
+	tickers Tickers
+}
+
+func RegisterTickers(server *grpc.Server, tickers Tickers) {
+	proto.RegisterTickerServiceServer(
+		server, &tickerServerAPI{
+			tickers: tickers,
+		})
+}
+
+func (s *tickerServerAPI) GetTicker(
+	ctx context.Context,
+	request *proto.GetTickerRequest,
+) (*proto.GetTickerResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, errors.MsgRequestRequired)
+	}
+
+	marketID, err := uuid.Parse(request.GetMarketId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid market_id")
+	}
+
+	ticker, err := s.tickers.GetTicker(ctx, marketID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.GetTickerResponse{
+		Ticker: mapper.TickerToProto(ticker),
+	}, nil
+}
+
+func (s *tickerServerAPI) ListTickers(
+	ctx context.Context,
+	request *proto.ListTickersRequest,
+) (*proto.ListTickersResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, errors.MsgRequestRequired)
+	}
+
+	tickers, err := s.tickers.ListTickers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.ListTickersResponse{
+		Tickers: mapper.TickersToProto(tickers),
+	}, nil
+}
+
+func (s *tickerServerAPI) WatchTickers(
+	request *proto.WatchTickersRequest,
+	stream grpc.ServerStreamingServer[proto.WatchTickersResponse],
+) error {
+	if request == nil {
+		return status.Error(codes.InvalidArgument, errors.MsgRequestRequired)
+	}
+
+	marketIDs := make([]uuid.UUID, 0, len(request.GetMarketIds()))
+	for _, rawID := range request.GetMarketIds() {
+		marketID, err := uuid.Parse(rawID)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, "invalid market_ids")
+		}
+		marketIDs = append(marketIDs, marketID)
+	}
+
+	return s.tickers.WatchTickers(stream.Context(), marketIDs,
+		func(ticker domainModels.Ticker) error {
+			return stream.Send(&proto.WatchTickersResponse{
+				Ticker: mapper.TickerToProto(ticker),
+			})
+		},
+	)
+}
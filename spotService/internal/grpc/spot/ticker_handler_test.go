@@ -0,0 +1,193 @@
+package spot
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	proto "github.com/nastyazhadan/spot-order-grpc/protos/gen/go/spot/v1"
+	serviceErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/service"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+	"github.com/nastyazhadan/spot-order-grpc/spotService/internal/grpc/mocks"
+)
+
+func newTickerServer(svc *mocks.Tickers) *tickerServerAPI {
+	return &tickerServerAPI{tickers: svc}
+}
+
+type fakeTickerStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*proto.WatchTickersResponse
+}
+
+func (s *fakeTickerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeTickerStream) Send(response *proto.WatchTickersResponse) error {
+	s.sent = append(s.sent, response)
+	return nil
+}
+
+func TestGetTicker(t *testing.T) {
+	marketID := uuid.New()
+	lastTradeAt := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		request    *proto.GetTickerRequest
+		setupMocks func(*mocks.Tickers)
+		checkResp  func(t *testing.T, resp *proto.GetTickerResponse)
+		checkErr   func(t *testing.T, err error)
+	}{
+		{
+			name:       "nil request — InvalidArgument",
+			request:    nil,
+			setupMocks: func(_ *mocks.Tickers) {},
+			checkErr: func(t *testing.T, err error) {
+				assertGRPCCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name:       "невалидный market_id — InvalidArgument",
+			request:    &proto.GetTickerRequest{MarketId: "not-a-uuid"},
+			setupMocks: func(_ *mocks.Tickers) {},
+			checkErr: func(t *testing.T, err error) {
+				assertGRPCCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name:    "тикер маппится в proto",
+			request: &proto.GetTickerRequest{MarketId: marketID.String()},
+			setupMocks: func(svc *mocks.Tickers) {
+				svc.On("GetTicker", mock.Anything, marketID).Return(domainModels.Ticker{
+					MarketID:           marketID,
+					LastPrice:          decimal.RequireFromString("101.5"),
+					PriceChangePercent: decimal.RequireFromString("-1.25"),
+					TradesCount:        3,
+					LastTradeAt:        lastTradeAt,
+				}, nil).Once()
+			},
+			checkResp: func(t *testing.T, resp *proto.GetTickerResponse) {
+				ticker := resp.GetTicker()
+				assert.Equal(t, marketID.String(), ticker.GetMarketId())
+				assert.Equal(t, "101.5", ticker.GetLastPrice().GetValue())
+				assert.Equal(t, "-1.25", ticker.GetPriceChangePercent().GetValue())
+				assert.Equal(t, int64(3), ticker.GetTradesCount())
+				assert.Equal(t, lastTradeAt, ticker.GetLastTradeAt().AsTime())
+			},
+		},
+		{
+			name:    "пустой тикер — цены и время не заданы",
+			request: &proto.GetTickerRequest{MarketId: marketID.String()},
+			setupMocks: func(svc *mocks.Tickers) {
+				svc.On("GetTicker", mock.Anything, marketID).Return(domainModels.EmptyTicker(marketID), nil).Once()
+			},
+			checkResp: func(t *testing.T, resp *proto.GetTickerResponse) {
+				ticker := resp.GetTicker()
+				assert.Equal(t, marketID.String(), ticker.GetMarketId())
+				assert.Zero(t, ticker.GetTradesCount())
+				assert.Nil(t, ticker.GetLastPrice())
+				assert.Nil(t, ticker.GetLastTradeAt())
+			},
+		},
+		{
+			name:    "ошибка сервиса пробрасывается",
+			request: &proto.GetTickerRequest{MarketId: marketID.String()},
+			setupMocks: func(svc *mocks.Tickers) {
+				svc.On("GetTicker", mock.Anything, marketID).
+					Return(domainModels.Ticker{}, serviceErrors.ErrMarketNotFound).Once()
+			},
+			checkErr: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, serviceErrors.ErrMarketNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := mocks.NewTickers(t)
+			tt.setupMocks(svc)
+
+			resp, err := newTickerServer(svc).GetTicker(context.Background(), tt.request)
+			if tt.checkErr != nil {
+				tt.checkErr(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			tt.checkResp(t, resp)
+		})
+	}
+}
+
+func TestWatchTickers(t *testing.T) {
+	marketID := uuid.New()
+
+	tests := []struct {
+		name       string
+		request    *proto.WatchTickersRequest
+		setupMocks func(*mocks.Tickers)
+		checkSent  func(t *testing.T, sent []*proto.WatchTickersResponse)
+		checkErr   func(t *testing.T, err error)
+	}{
+		{
+			name:       "nil request — InvalidArgument",
+			request:    nil,
+			setupMocks: func(_ *mocks.Tickers) {},
+			checkErr: func(t *testing.T, err error) {
+				assertGRPCCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name:       "невалидный market_id — InvalidArgument",
+			request:    &proto.WatchTickersRequest{MarketIds: []string{marketID.String(), "bad"}},
+			setupMocks: func(_ *mocks.Tickers) {},
+			checkErr: func(t *testing.T, err error) {
+				assertGRPCCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name:    "тикеры сервиса отправляются в поток",
+			request: &proto.WatchTickersRequest{MarketIds: []string{marketID.String()}},
+			setupMocks: func(svc *mocks.Tickers) {
+				svc.On("WatchTickers", mock.Anything, []uuid.UUID{marketID}, mock.Anything).
+					Run(func(args mock.Arguments) {
+						send := args.Get(2).(func(domainModels.Ticker) error)
+						_ = send(domainModels.EmptyTicker(marketID))
+					}).
+					Return(nil).Once()
+			},
+			checkSent: func(t *testing.T, sent []*proto.WatchTickersResponse) {
+				require.Len(t, sent, 1)
+				assert.Equal(t, marketID.String(), sent[0].GetTicker().GetMarketId())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := mocks.NewTickers(t)
+			tt.setupMocks(svc)
+
+			stream := &fakeTickerStream{ctx: context.Background()}
+			err := newTickerServer(svc).WatchTickers(tt.request, stream)
+			if tt.checkErr != nil {
+				tt.checkErr(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			tt.checkSent(t, stream.sent)
+		})
+	}
+}
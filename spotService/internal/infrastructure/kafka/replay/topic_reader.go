@@ -0,0 +1,187 @@
+// Package replay — чтение топика Kafka с заданного момента без consumer group.
+package replay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/IBM/sarama"
+
+	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/kafka"
+)
+
+// TopicReader читает все партиции топика начиная с первого сообщения не старше from.
+// Офсеты нигде не сохраняются: каждый запуск перечитывает топик заново, поэтому
+// построенное по нему состояние можно восстановить, просто перезапустив чтение.
+// Партиции, добавленные после запуска, подхватываются только следующим запуском.
+type TopicReader struct {
+	brokers  []string
+	topic    string
+	clientID string
+}
+
+func NewTopicReader(brokers []string, topic, clientID string) *TopicReader {
+	return &TopicReader{
+		brokers:  brokers,
+		topic:    topic,
+		clientID: clientID,
+	}
+}
+
+// ReadFrom вызывает handle для каждого сообщения в одной горутине до отмены ctx или ошибки чтения.
+// caughtUp вызывается один раз, когда дочитаны все сообщения, бывшие в топике на момент запуска.
+func (r *TopicReader) ReadFrom(
+	ctx context.Context,
+	from time.Time,
+	handle func(ctx context.Context, message kafka.Message),
+	caughtUp func(),
+) error {
+	const op = "TopicReader.ReadFrom"
+
+	cfg := sarama.NewConfig()
+	cfg.ClientID = r.clientID
+	cfg.Consumer.Return.Errors = true
+
+	client, err := sarama.NewClient(r.brokers, cfg)
+	if err != nil {
+		return fmt.Errorf("%s: new client: %w", op, err)
+	}
+	defer client.Close()
+
+	consumer, err := sarama.NewConsumerFromClient(client)
+	if err != nil {
+		return fmt.Errorf("%s: new consumer: %w", op, err)
+	}
+	defer consumer.Close()
+
+	partitions, err := client.Partitions(r.topic)
+	if err != nil {
+		return fmt.Errorf("%s: partitions of %s: %w", op, r.topic, err)
+	}
+
+	readCtx, cancel := context.WithCancel(ctx)
+
+	var partitionConsumers []sarama.PartitionConsumer
+	// Дочерние consumer закрываются раньше consumer.Close: так требует sarama
+	defer func() {
+		cancel()
+		for _, partitionConsumer := range partitionConsumers {
+			_ = partitionConsumer.Close()
+		}
+	}()
+
+	var (
+		messages = make(chan *sarama.ConsumerMessage)
+		errs     = make(chan error, len(partitions))
+		// Последний офсет, который надо дочитать в каждой партиции
+		pending = make(map[int32]int64, len(partitions))
+	)
+
+	for _, partition := range partitions {
+		offset, newest, offsetErr := r.offsets(client, partition, from)
+		if offsetErr != nil {
+			return fmt.Errorf("%s: %w", op, offsetErr)
+		}
+		if offset != sarama.OffsetNewest && offset < newest {
+			pending[partition] = newest - 1
+		}
+
+		partitionConsumer, consumeErr := consumer.ConsumePartition(r.topic, partition, offset)
+		if consumeErr != nil {
+			return fmt.Errorf("%s: consume partition %d: %w", op, partition, consumeErr)
+		}
+		partitionConsumers = append(partitionConsumers, partitionConsumer)
+
+		go forwardPartition(readCtx, partitionConsumer, messages, errs)
+	}
+
+	if len(pending) == 0 {
+		caughtUp()
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err = <-errs:
+			return fmt.Errorf("%s: %w", op, err)
+		case message := <-messages:
+			handle(ctx, toMessage(message))
+
+			last, ok := pending[message.Partition]
+			if ok && message.Offset >= last {
+				delete(pending, message.Partition)
+				if len(pending) == 0 {
+					caughtUp()
+				}
+			}
+		}
+	}
+}
+
+// offsets возвращает офсет первого сообщения не старше from (OffsetNewest, если таких нет)
+// и офсет, следующий за последним сообщением партиции.
+func (r *TopicReader) offsets(client sarama.Client, partition int32, from time.Time) (int64, int64, error) {
+	offset, err := client.GetOffset(r.topic, partition, from.UnixMilli())
+	if err != nil {
+		return 0, 0, fmt.Errorf("offset of partition %d at %s: %w", partition, from, err)
+	}
+
+	newest, err := client.GetOffset(r.topic, partition, sarama.OffsetNewest)
+	if err != nil {
+		return 0, 0, fmt.Errorf("newest offset of partition %d: %w", partition, err)
+	}
+
+	return offset, newest, nil
+}
+
+func forwardPartition(
+	ctx context.Context,
+	partitionConsumer sarama.PartitionConsumer,
+	messages chan<- *sarama.ConsumerMessage,
+	errs chan<- error,
+) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case message, ok := <-partitionConsumer.Messages():
+			if !ok {
+				errs <- errors.New("partition consumer closed")
+				return
+			}
+			select {
+			case messages <- message:
+			case <-ctx.Done():
+				return
+			}
+		case err, ok := <-partitionConsumer.Errors():
+			if !ok {
+				errs <- errors.New("partition consumer closed")
+				return
+			}
+			errs <- err
+			return
+		}
+	}
+}
+
+func toMessage(message *sarama.ConsumerMessage) kafka.Message {
+	headers := make(map[string][]byte, len(message.Headers))
+	for _, header := range message.Headers {
+		headers[string(header.Key)] = header.Value
+	}
+
+	return kafka.Message{
+		Headers:        headers,
+		Timestamp:      message.Timestamp,
+		BlockTimestamp: message.BlockTimestamp,
+		Key:            message.Key,
+		Value:          message.Value,
+		Topic:          message.Topic,
+		Partition:      message.Partition,
+		Offset:         message.Offset,
+	}
+}
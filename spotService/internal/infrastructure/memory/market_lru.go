@@ -0,0 +1,108 @@
+package memory
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+)
+
+// MarketLRU — кэш рынков по id внутри процесса перед Redis.
+// Ограничен по числу записей и по TTL: даже если инвалидация не дошла,
+// реплика отдаёт устаревший рынок не дольше ttl.
+type MarketLRU struct {
+	mu      sync.Mutex
+	size    int
+	ttl     time.Duration
+	entries map[uuid.UUID]*list.Element
+	order   *list.List
+	now     func() time.Time
+}
+
+type lruEntry struct {
+	market    models.Market
+	expiresAt time.Time
+}
+
+func NewMarketLRU(size int, ttl time.Duration) *MarketLRU {
+	return &MarketLRU{
+		size:    size,
+		ttl:     ttl,
+		entries: make(map[uuid.UUID]*list.Element, size),
+		order:   list.New(),
+		now:     time.Now,
+	}
+}
+
+func (c *MarketLRU) GetMarket(id uuid.UUID) (models.Market, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[id]
+	if !ok {
+		return models.Market{}, false
+	}
+
+	entry := element.Value.(*lruEntry)
+	if !c.now().Before(entry.expiresAt) {
+		c.removeElement(element)
+		return models.Market{}, false
+	}
+
+	c.order.MoveToFront(element)
+	return entry.market, true
+}
+
+func (c *MarketLRU) SetMarket(market models.Market) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := c.now().Add(c.ttl)
+
+	if element, ok := c.entries[market.ID]; ok {
+		element.Value = &lruEntry{market: market, expiresAt: expiresAt}
+		c.order.MoveToFront(element)
+		return
+	}
+
+	c.entries[market.ID] = c.order.PushFront(&lruEntry{market: market, expiresAt: expiresAt})
+
+	for c.order.Len() > c.size {
+		c.removeElement(c.order.Back())
+	}
+}
+
+func (c *MarketLRU) DeleteMarkets(ids []uuid.UUID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, id := range ids {
+		if element, ok := c.entries[id]; ok {
+			c.removeElement(element)
+		}
+	}
+}
+
+// Purge сбрасывает весь кэш: вызывается, когда часть инвалидаций могла потеряться
+func (c *MarketLRU) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[uuid.UUID]*list.Element, c.size)
+	c.order.Init()
+}
+
+func (c *MarketLRU) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
+
+func (c *MarketLRU) removeElement(element *list.Element) {
+	entry := c.order.Remove(element).(*lruEntry)
+	delete(c.entries, entry.market.ID)
+}
@@ -0,0 +1,133 @@
+package memory
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+)
+
+const testTTL = time.Minute
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func newTestLRU(size int) (*MarketLRU, *fakeClock) {
+	clock := &fakeClock{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	cache := NewMarketLRU(size, testTTL)
+	cache.now = clock.Now
+
+	return cache, clock
+}
+
+func newMarket(name string) models.Market {
+	return models.Market{ID: uuid.New(), Name: name, Enabled: true}
+}
+
+func TestMarketLRU(t *testing.T) {
+	btc := newMarket("BTC-USDT")
+	eth := newMarket("ETH-USDT")
+	sol := newMarket("SOL-USDT")
+
+	tests := []struct {
+		name   string
+		size   int
+		action func(c *MarketLRU, clock *fakeClock)
+		want   []models.Market
+		absent []models.Market
+	}{
+		{
+			name: "запись читается до истечения ttl",
+			size: 2,
+			action: func(c *MarketLRU, clock *fakeClock) {
+				c.SetMarket(btc)
+				clock.now = clock.now.Add(testTTL - time.Second)
+			},
+			want: []models.Market{btc},
+		},
+		{
+			name: "по истечении ttl запись не отдаётся",
+			size: 2,
+			action: func(c *MarketLRU, clock *fakeClock) {
+				c.SetMarket(btc)
+				clock.now = clock.now.Add(testTTL)
+			},
+			absent: []models.Market{btc},
+		},
+		{
+			name: "при переполнении вытесняется давно не читанный рынок",
+			size: 2,
+			action: func(c *MarketLRU, _ *fakeClock) {
+				c.SetMarket(btc)
+				c.SetMarket(eth)
+				c.GetMarket(btc.ID)
+				c.SetMarket(sol)
+			},
+			want:   []models.Market{btc, sol},
+			absent: []models.Market{eth},
+		},
+		{
+			name: "повторная запись обновляет рынок и продлевает ttl",
+			size: 2,
+			action: func(c *MarketLRU, clock *fakeClock) {
+				c.SetMarket(btc)
+				clock.now = clock.now.Add(testTTL - time.Second)
+
+				updated := btc
+				updated.Enabled = false
+				c.SetMarket(updated)
+				clock.now = clock.now.Add(testTTL - time.Second)
+			},
+			want: []models.Market{{ID: btc.ID, Name: btc.Name, Enabled: false}},
+		},
+		{
+			name: "DeleteMarkets удаляет только переданные id",
+			size: 3,
+			action: func(c *MarketLRU, _ *fakeClock) {
+				c.SetMarket(btc)
+				c.SetMarket(eth)
+				c.DeleteMarkets([]uuid.UUID{btc.ID, uuid.New()})
+			},
+			want:   []models.Market{eth},
+			absent: []models.Market{btc},
+		},
+		{
+			name: "Purge очищает весь кэш",
+			size: 3,
+			action: func(c *MarketLRU, _ *fakeClock) {
+				c.SetMarket(btc)
+				c.SetMarket(eth)
+				c.Purge()
+			},
+			absent: []models.Market{btc, eth},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache, clock := newTestLRU(tt.size)
+			tt.action(cache, clock)
+
+			for _, want := range tt.want {
+				got, ok := cache.GetMarket(want.ID)
+				require.True(t, ok, "рынок %s должен быть в кэше", want.Name)
+				assert.Equal(t, want, got)
+			}
+			for _, market := range tt.absent {
+				_, ok := cache.GetMarket(market.ID)
+				assert.False(t, ok, "рынка %s не должно быть в кэше", market.Name)
+			}
+			assert.LessOrEqual(t, cache.Len(), tt.size)
+		})
+	}
+}
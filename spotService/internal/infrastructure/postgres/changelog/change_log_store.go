@@ -0,0 +1,108 @@
+package changelog
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/nastyazhadan/spot-order-grpc/shared/config"
+	"github.com/nastyazhadan/spot-order-grpc/shared/interceptors/tracing"
+	"github.com/nastyazhadan/spot-order-grpc/shared/metrics"
+	dto "github.com/nastyazhadan/spot-order-grpc/spotService/internal/application/dto/outbound/postgres"
+	"github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+)
+
+// Store читает журнал market_change_log, который заполняет триггер trg_log_market_change.
+type Store struct {
+	pool   *pgxpool.Pool
+	config config.SpotConfig
+}
+
+func New(pool *pgxpool.Pool, cfg config.SpotConfig) *Store {
+	return &Store{
+		pool:   pool,
+		config: cfg,
+	}
+}
+
+func (s *Store) ListChangesAfter(
+	ctx context.Context,
+	afterSeq int64,
+	limit int,
+) ([]models.MarketChangeLogEntry, error) {
+	const op = "postgres.ChangeLogStore.ListChangesAfter"
+
+	ctx, span := tracing.StartSpan(ctx, "postgres.list_market_changes_after",
+		trace.WithSpanKind(trace.SpanKindClient),
+	)
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		metrics.ObserveWithTrace(ctx,
+			metrics.DBQueryDuration.WithLabelValues(s.config.Service.Name, "market.list_changes_after"),
+			time.Since(start).Seconds(),
+		)
+	}()
+
+	rows, err := s.pool.Query(ctx, `
+		SELECT seq, market_id, name, base_asset, quote_asset, enabled, deleted_at, updated_at, version, restricted, previous
+		FROM market_change_log
+		WHERE seq > $1
+		ORDER BY seq
+		LIMIT $2
+	`, afterSeq, limit)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, fmt.Errorf("%s: query market changes: %w", op, err)
+	}
+	defer rows.Close()
+
+	dtoEntries, err := pgx.CollectRows(rows, pgx.RowToStructByName[dto.MarketChangeLogEntry])
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, fmt.Errorf("%s: collect rows: %w", op, err)
+	}
+
+	entries := make([]models.MarketChangeLogEntry, 0, len(dtoEntries))
+	for _, entry := range dtoEntries {
+		entries = append(entries, entry.ToDomain())
+	}
+
+	return entries, nil
+}
+
+func (s *Store) GetChangeLogBounds(ctx context.Context) (models.MarketChangeLogBounds, error) {
+	const op = "postgres.ChangeLogStore.GetChangeLogBounds"
+
+	ctx, span := tracing.StartSpan(ctx, "postgres.get_market_change_log_bounds",
+		trace.WithSpanKind(trace.SpanKindClient),
+	)
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		metrics.ObserveWithTrace(ctx,
+			metrics.DBQueryDuration.WithLabelValues(s.config.Service.Name, "market.get_change_log_bounds"),
+			time.Since(start).Seconds(),
+		)
+	}()
+
+	// Записи после границы очистки не удаляются, поэтому пустой журнал означает,
+	// что последний закоммиченный seq — сама граница
+	var bounds models.MarketChangeLogBounds
+	err := s.pool.QueryRow(ctx, `
+		SELECT h.pruned_seq, GREATEST(h.pruned_seq, COALESCE((SELECT MAX(seq) FROM market_change_log), 0))
+		FROM market_change_log_horizon h
+	`).Scan(&bounds.PrunedSeq, &bounds.LatestSeq)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return models.MarketChangeLogBounds{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return bounds, nil
+}
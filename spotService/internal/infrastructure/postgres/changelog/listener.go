@@ -0,0 +1,62 @@
+package changelog
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	marketChangesChannel = "market_changes"
+	closeTimeout         = 5 * time.Second
+)
+
+// Listener держит выделенное соединение с LISTEN market_changes.
+// Уведомления не несут данных: это только сигнал перечитать журнал.
+type Listener struct {
+	pool *pgxpool.Pool
+}
+
+func NewListener(pool *pgxpool.Pool) *Listener {
+	return &Listener{pool: pool}
+}
+
+// Listen блокируется до отмены ctx или обрыва соединения.
+// onNotify вызывается сразу после подписки, чтобы подобрать изменения,
+// закоммиченные до LISTEN, и затем на каждое уведомление.
+func (l *Listener) Listen(ctx context.Context, onNotify func()) error {
+	const op = "postgres.Listener.Listen"
+
+	pooledConn, err := l.pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: acquire connection: %w", op, err)
+	}
+
+	// Соединение с активным LISTEN нельзя возвращать в пул
+	conn := pooledConn.Hijack()
+	defer closeConn(conn)
+
+	if _, err = conn.Exec(ctx, "LISTEN "+marketChangesChannel); err != nil {
+		return fmt.Errorf("%s: listen: %w", op, err)
+	}
+
+	onNotify()
+
+	for {
+		if _, err = conn.WaitForNotification(ctx); err != nil {
+			return fmt.Errorf("%s: wait for notification: %w", op, err)
+		}
+
+		onNotify()
+	}
+}
+
+func closeConn(conn *pgx.Conn) {
+	ctx, cancel := context.WithTimeout(context.Background(), closeTimeout)
+	defer cancel()
+
+	_ = conn.Close(ctx)
+}
@@ -4,20 +4,26 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 
+	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/leader"
 	dto "github.com/nastyazhadan/spot-order-grpc/spotService/internal/application/dto/outbound/postgres"
 	"github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
 )
 
 type Store struct {
-	pool *pgxpool.Pool
+	pool               *pgxpool.Pool
+	changeLogRetention time.Duration
 }
 
-func New(pool *pgxpool.Pool) *Store {
-	return &Store{pool: pool}
+func New(pool *pgxpool.Pool, changeLogRetention time.Duration) *Store {
+	return &Store{
+		pool:               pool,
+		changeLogRetention: changeLogRetention,
+	}
 }
 
 func (s *Store) Get(ctx context.Context, pollerName string) (models.PollerCursor, error) {
@@ -26,10 +32,10 @@ func (s *Store) Get(ctx context.Context, pollerName string) (models.PollerCursor
 	var cursor dto.PollerCursor
 
 	err := s.pool.QueryRow(ctx, `
-		SELECT poller_name, last_seen_at, last_seen_id
+		SELECT poller_name, last_seq
 		FROM market_poller_cursor
 		WHERE poller_name = $1
-	`, pollerName).Scan(&cursor.PollerName, &cursor.LastSeenAt, &cursor.LastSeenID)
+	`, pollerName).Scan(&cursor.PollerName, &cursor.LastSeq)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return models.PollerCursor{}, pgx.ErrNoRows
@@ -40,23 +46,45 @@ func (s *Store) Get(ctx context.Context, pollerName string) (models.PollerCursor
 	return dto.ToDomain(cursor), nil
 }
 
+// SaveCursorTransaction сдвигает курсор и удаляет из журнала записи, которые уже обработаны
+// всеми поллерами и старше changeLogRetention: до этого по ним догоняют стримы WatchMarkets.
+// Наибольший удалённый seq запоминается как граница, за которой курсор resume отклоняется. Курсор сдвигает только держатель lease
+// поллера: бывший лидер получит ErrLeaseLost, и вся транзакция с outbox откатится.
 func (s *Store) SaveCursorTransaction(ctx context.Context, transaction pgx.Tx, cursor models.PollerCursor) error {
 	const op = "CursorStore.SaveCursorTransaction"
 
+	if err := leader.CheckFencingToken(ctx, transaction, cursor.PollerName, cursor.FencingToken); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
 	dtoCursor := dto.FromDomain(cursor)
 
 	_, err := transaction.Exec(ctx, `
-		INSERT INTO market_poller_cursor (poller_name, last_seen_at, last_seen_id, updated_at)
-		VALUES ($1, $2, $3, NOW())
+		INSERT INTO market_poller_cursor (poller_name, last_seq, updated_at)
+		VALUES ($1, $2, NOW())
 		ON CONFLICT (poller_name)
 		DO UPDATE SET
-			last_seen_at = EXCLUDED.last_seen_at,
-			last_seen_id = EXCLUDED.last_seen_id,
+			last_seq = EXCLUDED.last_seq,
 			updated_at = NOW()
-	`, dtoCursor.PollerName, dtoCursor.LastSeenAt.UTC(), dtoCursor.LastSeenID)
+	`, dtoCursor.PollerName, dtoCursor.LastSeq)
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
+	_, err = transaction.Exec(ctx, `
+		WITH pruned AS (
+			DELETE FROM market_change_log
+			WHERE seq <= (SELECT MIN(last_seq) FROM market_poller_cursor)
+			  AND logged_at < NOW() - make_interval(secs => $1)
+			RETURNING seq
+		)
+		UPDATE market_change_log_horizon
+		SET pruned_seq = GREATEST(pruned_seq, (SELECT MAX(seq) FROM pruned))
+		WHERE EXISTS (SELECT 1 FROM pruned)
+	`, s.changeLogRetention.Seconds())
+	if err != nil {
+		return fmt.Errorf("%s: prune change log: %w", op, err)
+	}
+
 	return nil
 }
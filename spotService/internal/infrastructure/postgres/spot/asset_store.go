@@ -0,0 +1,221 @@
+package spot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/nastyazhadan/spot-order-grpc/shared/config"
+	repositoryErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/repository"
+	"github.com/nastyazhadan/spot-order-grpc/shared/interceptors/tracing"
+	"github.com/nastyazhadan/spot-order-grpc/shared/metrics"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+	dto "github.com/nastyazhadan/spot-order-grpc/spotService/internal/application/dto/outbound/postgres"
+)
+
+const (
+	uniqueViolationCode  = "23505"
+	assetsPrimaryKeyName = "assets_pkey"
+)
+
+type AssetStore struct {
+	pool   *pgxpool.Pool
+	config config.SpotConfig
+}
+
+func NewAssetStore(pool *pgxpool.Pool, cfg config.SpotConfig) *AssetStore {
+	return &AssetStore{
+		pool:   pool,
+		config: cfg,
+	}
+}
+
+func (s *AssetStore) ListAssets(
+	ctx context.Context,
+	includeDisabled bool,
+) ([]models.Asset, error) {
+	const op = "postgres.AssetStore.ListAssets"
+
+	ctx, span := tracing.StartSpan(ctx, "postgres.list_assets",
+		trace.WithSpanKind(trace.SpanKindClient),
+	)
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		metrics.ObserveWithTrace(ctx,
+			metrics.DBQueryDuration.WithLabelValues(s.config.Service.Name, "list_assets"),
+			time.Since(start).Seconds(),
+		)
+	}()
+
+	rows, err := s.pool.Query(ctx, `
+		SELECT code, name, precision, enabled, updated_at
+		FROM assets
+		WHERE $1 OR enabled = TRUE
+		ORDER BY code
+	`, includeDisabled)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	assetsDTO, err := pgx.CollectRows(rows, pgx.RowToStructByName[dto.Asset])
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	assets := make([]models.Asset, 0, len(assetsDTO))
+	for _, assetDTO := range assetsDTO {
+		assets = append(assets, assetDTO.ToDomain())
+	}
+
+	return assets, nil
+}
+
+func (s *AssetStore) CreateAsset(
+	ctx context.Context,
+	asset models.Asset,
+) (models.Asset, error) {
+	const op = "postgres.AssetStore.CreateAsset"
+
+	ctx, span := tracing.StartSpan(ctx, "postgres.create_asset",
+		trace.WithSpanKind(trace.SpanKindClient),
+	)
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		metrics.ObserveWithTrace(ctx,
+			metrics.DBQueryDuration.WithLabelValues(s.config.Service.Name, "create_asset"),
+			time.Since(start).Seconds(),
+		)
+	}()
+
+	rows, err := s.pool.Query(ctx, `
+		INSERT INTO assets (code, name, precision, enabled)
+		VALUES ($1, $2, $3, $4)
+		RETURNING code, name, precision, enabled, updated_at
+	`, asset.Code, asset.Name, int16(asset.Precision), asset.Enabled)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return models.Asset{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	assetDTO, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[dto.Asset])
+	if err != nil {
+		if isAssetPrimaryKeyViolation(err) {
+			return models.Asset{}, fmt.Errorf("%s: %w", op, repositoryErrors.ErrAssetAlreadyExists)
+		}
+
+		tracing.RecordError(span, err)
+		return models.Asset{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return assetDTO.ToDomain(), nil
+}
+
+// UpdateAsset применяет изменение и, если актив выключается, в той же транзакции
+// выключает все неудалённые рынки с этим активом. Каждое такое изменение триггер пишет
+// в market_change_log, и MarketPoller разошлёт его через outbox как обычное изменение рынка.
+func (s *AssetStore) UpdateAsset(
+	ctx context.Context,
+	update models.AssetUpdate,
+) (models.Asset, int64, error) {
+	const op = "postgres.AssetStore.UpdateAsset"
+
+	ctx, span := tracing.StartSpan(ctx, "postgres.update_asset",
+		trace.WithSpanKind(trace.SpanKindClient),
+	)
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		metrics.ObserveWithTrace(ctx,
+			metrics.DBQueryDuration.WithLabelValues(s.config.Service.Name, "update_asset"),
+			time.Since(start).Seconds(),
+		)
+	}()
+
+	var precision *int16
+	if update.Precision != nil {
+		value := int16(*update.Precision)
+		precision = &value
+	}
+
+	var (
+		asset           models.Asset
+		disabledMarkets int64
+	)
+
+	err := pgx.BeginFunc(ctx, s.pool, func(transaction pgx.Tx) error {
+		if err := setAuditActor(ctx, transaction); err != nil {
+			return err
+		}
+
+		rows, err := transaction.Query(ctx, `
+			UPDATE assets
+			SET name      = COALESCE($2, name),
+			    precision = COALESCE($3, precision),
+			    enabled   = COALESCE($4, enabled)
+			WHERE code = $1
+			RETURNING code, name, precision, enabled, updated_at
+		`, update.Code, update.Name, precision, update.Enabled)
+		if err != nil {
+			return err
+		}
+
+		assetDTO, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[dto.Asset])
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return repositoryErrors.ErrAssetNotFound
+			}
+			return err
+		}
+		asset = assetDTO.ToDomain()
+
+		if asset.Enabled {
+			return nil
+		}
+
+		result, err := transaction.Exec(ctx, `
+			UPDATE market_store
+			SET enabled = FALSE
+			WHERE (base_asset = $1 OR quote_asset = $1)
+			  AND enabled = TRUE
+			  AND deleted_at IS NULL
+		`, asset.Code)
+		if err != nil {
+			return err
+		}
+		disabledMarkets = result.RowsAffected()
+
+		return nil
+	})
+	if err != nil {
+		if !errors.Is(err, repositoryErrors.ErrAssetNotFound) {
+			tracing.RecordError(span, err)
+		}
+		return models.Asset{}, 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return asset, disabledMarkets, nil
+}
+
+func isAssetPrimaryKeyViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == uniqueViolationCode && pgErr.ConstraintName == assetsPrimaryKeyName
+	}
+
+	return false
+}
@@ -0,0 +1,177 @@
+package spot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/nastyazhadan/spot-order-grpc/shared/config"
+	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/otel/attributes"
+	"github.com/nastyazhadan/spot-order-grpc/shared/interceptors/tracing"
+	"github.com/nastyazhadan/spot-order-grpc/shared/metrics"
+	dto "github.com/nastyazhadan/spot-order-grpc/spotService/internal/application/dto/outbound/postgres"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+)
+
+type CandleStore struct {
+	pool   *pgxpool.Pool
+	config config.SpotConfig
+}
+
+func NewCandleStore(pool *pgxpool.Pool, cfg config.SpotConfig) *CandleStore {
+	return &CandleStore{
+		pool:   pool,
+		config: cfg,
+	}
+}
+
+// ApplyTrade добавляет сделку во все свечи интервалов одной транзакцией с записью event_id в candle_inbox.
+// false — событие уже учтено этой consumer group, свечи не меняются.
+// Open/close выбираются по времени сделки, поэтому опоздавшая сделка корректно правит и закрытую свечу.
+func (s *CandleStore) ApplyTrade(
+	ctx context.Context,
+	consumerGroup string,
+	trade domainModels.Trade,
+	intervals []domainModels.CandleInterval,
+) (bool, error) {
+	const op = "postgres.CandleStore.ApplyTrade"
+
+	ctx, span := tracing.StartSpan(ctx, "postgres.apply_trade_to_candles",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attributes.EventIDValue(trade.EventID.String()),
+			attributes.MarketIDValue(trade.MarketID.String()),
+			attributes.ConsumerGroupValue(consumerGroup),
+		),
+	)
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		metrics.ObserveWithTrace(ctx,
+			metrics.DBQueryDuration.WithLabelValues(s.config.Service.Name, "candles.apply_trade"),
+			time.Since(start).Seconds(),
+		)
+	}()
+
+	names := make([]string, 0, len(intervals))
+	openTimes := make([]time.Time, 0, len(intervals))
+	for _, interval := range intervals {
+		names = append(names, string(interval))
+		openTimes = append(openTimes, interval.OpenTime(trade.ExecutedAt))
+	}
+
+	applied := false
+	err := pgx.BeginFunc(ctx, s.pool, func(transaction pgx.Tx) error {
+		result, err := transaction.Exec(ctx, `
+			INSERT INTO candle_inbox (event_id, consumer_group)
+			VALUES ($1, $2)
+			ON CONFLICT (event_id, consumer_group) DO NOTHING
+		`, trade.EventID, consumerGroup)
+		if err != nil {
+			return fmt.Errorf("insert candle inbox: %w", err)
+		}
+		if result.RowsAffected() == 0 {
+			return nil
+		}
+
+		// Свечи одной сделки всегда обновляются в одном порядке интервалов,
+		// поэтому параллельные транзакции по рынку не взаимоблокируются
+		_, err = transaction.Exec(ctx, `
+			INSERT INTO candles AS c (
+				market_id, interval, open_time, open, high, low, close,
+				volume, quote_volume, trades_count, first_trade_at, last_trade_at
+			)
+			SELECT $1, bucket.interval, bucket.open_time, $4::numeric, $4::numeric, $4::numeric, $4::numeric,
+			       $5::numeric, $4::numeric * $5::numeric, 1, $6, $6
+			FROM unnest($2::text[], $3::timestamptz[]) AS bucket(interval, open_time)
+			ON CONFLICT (market_id, interval, open_time) DO UPDATE
+			SET open           = CASE WHEN EXCLUDED.first_trade_at < c.first_trade_at THEN EXCLUDED.open ELSE c.open END,
+			    close          = CASE WHEN EXCLUDED.last_trade_at >= c.last_trade_at THEN EXCLUDED.close ELSE c.close END,
+			    high           = GREATEST(c.high, EXCLUDED.high),
+			    low            = LEAST(c.low, EXCLUDED.low),
+			    volume         = c.volume + EXCLUDED.volume,
+			    quote_volume   = c.quote_volume + EXCLUDED.quote_volume,
+			    trades_count   = c.trades_count + 1,
+			    first_trade_at = LEAST(c.first_trade_at, EXCLUDED.first_trade_at),
+			    last_trade_at  = GREATEST(c.last_trade_at, EXCLUDED.last_trade_at),
+			    updated_at     = NOW()
+		`, trade.MarketID, names, openTimes, trade.Price.String(), trade.Quantity.String(), trade.ExecutedAt)
+		if err != nil {
+			return fmt.Errorf("upsert candles: %w", err)
+		}
+
+		applied = true
+		return nil
+	})
+	if err != nil {
+		tracing.RecordError(span, err)
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return applied, nil
+}
+
+// GetCandles возвращает свечи с open_time в [from, to) по возрастанию open_time.
+func (s *CandleStore) GetCandles(
+	ctx context.Context,
+	marketID uuid.UUID,
+	interval domainModels.CandleInterval,
+	from, to time.Time,
+	limit uint64,
+) ([]domainModels.Candle, error) {
+	const op = "postgres.CandleStore.GetCandles"
+
+	ctx, span := tracing.StartSpan(ctx, "postgres.get_candles",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attributes.MarketIDValue(marketID.String())),
+	)
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		metrics.ObserveWithTrace(ctx,
+			metrics.DBQueryDuration.WithLabelValues(s.config.Service.Name, "candles.get"),
+			time.Since(start).Seconds(),
+		)
+	}()
+
+	rows, err := s.pool.Query(ctx, `
+		SELECT market_id, interval, open_time, open, high, low, close, volume, quote_volume, trades_count
+		FROM candles
+		WHERE market_id = $1
+		  AND interval = $2
+		  AND open_time >= $3
+		  AND open_time < $4
+		ORDER BY open_time
+		LIMIT $5
+	`, marketID, string(interval), from, to, limit)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, fmt.Errorf("%s: query candles: %w", op, err)
+	}
+	defer rows.Close()
+
+	dtoCandles, err := pgx.CollectRows(rows, pgx.RowToStructByName[dto.Candle])
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, fmt.Errorf("%s: collect rows: %w", op, err)
+	}
+
+	candles := make([]domainModels.Candle, 0, len(dtoCandles))
+	for _, dtoCandle := range dtoCandles {
+		candle, convertErr := dtoCandle.ToDomain()
+		if convertErr != nil {
+			tracing.RecordError(span, convertErr)
+			return nil, fmt.Errorf("%s: %w", op, convertErr)
+		}
+		candles = append(candles, candle)
+	}
+
+	return candles, nil
+}
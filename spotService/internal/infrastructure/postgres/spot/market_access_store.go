@@ -0,0 +1,306 @@
+package spot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/nastyazhadan/spot-order-grpc/shared/config"
+	repositoryErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/repository"
+	"github.com/nastyazhadan/spot-order-grpc/shared/interceptors/tracing"
+	"github.com/nastyazhadan/spot-order-grpc/shared/metrics"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+	"github.com/nastyazhadan/spot-order-grpc/shared/requestctx"
+	dto "github.com/nastyazhadan/spot-order-grpc/spotService/internal/application/dto/outbound/postgres"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+)
+
+const (
+	foreignKeyViolationCode       = "23503"
+	marketAccessMarketForeignKey  = "market_access_market_id_fkey"
+	marketAccessGrantColumnsQuery = "market_id, subject_type, subject_id, granted_by, granted_at"
+)
+
+// MarketAccessStore — списки доступа к restricted-рынкам (таблица market_access).
+type MarketAccessStore struct {
+	pool   *pgxpool.Pool
+	config config.SpotConfig
+}
+
+func NewMarketAccessStore(pool *pgxpool.Pool, cfg config.SpotConfig) *MarketAccessStore {
+	return &MarketAccessStore{
+		pool:   pool,
+		config: cfg,
+	}
+}
+
+// ListGrantedMarketIDs возвращает рынки, выданные пользователю или любой из его ролей.
+// Пустой marketIDs — без ограничения по рынкам.
+func (s *MarketAccessStore) ListGrantedMarketIDs(
+	ctx context.Context,
+	subject domainModels.MarketAccessSubject,
+	marketIDs []uuid.UUID,
+) ([]uuid.UUID, error) {
+	const op = "postgres.MarketAccessStore.ListGrantedMarketIDs"
+
+	ctx, span := tracing.StartSpan(ctx, "postgres.list_granted_market_ids",
+		trace.WithSpanKind(trace.SpanKindClient),
+	)
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		metrics.ObserveWithTrace(ctx,
+			metrics.DBQueryDuration.WithLabelValues(s.config.Service.Name, "list_granted_market_ids"),
+			time.Since(start).Seconds(),
+		)
+	}()
+
+	roles := make([]string, 0, len(subject.Roles))
+	for _, role := range subject.Roles {
+		roles = append(roles, role.String())
+	}
+
+	userID := ""
+	if subject.UserID != uuid.Nil {
+		userID = subject.UserID.String()
+	}
+
+	rows, err := s.pool.Query(ctx, `
+		SELECT DISTINCT market_id
+		FROM market_access
+		WHERE ((subject_type = 'user' AND subject_id = $1)
+		    OR (subject_type = 'role' AND subject_id = ANY($2)))
+		  AND (cardinality($3::uuid[]) = 0 OR market_id = ANY($3))
+	`, userID, roles, marketIDs)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return ids, nil
+}
+
+// SetMarketRestricted меняет флаг рынка. Изменение строки market_store проходит
+// через журнал изменений: MarketPoller сбросит кэши и разошлёт market.updated.
+func (s *MarketAccessStore) SetMarketRestricted(
+	ctx context.Context,
+	id uuid.UUID,
+	restricted bool,
+) (models.Market, error) {
+	const op = "postgres.MarketAccessStore.SetMarketRestricted"
+
+	ctx, span := tracing.StartSpan(ctx, "postgres.set_market_restricted",
+		trace.WithSpanKind(trace.SpanKindClient),
+	)
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		metrics.ObserveWithTrace(ctx,
+			metrics.DBQueryDuration.WithLabelValues(s.config.Service.Name, "set_market_restricted"),
+			time.Since(start).Seconds(),
+		)
+	}()
+
+	var market models.Market
+
+	err := pgx.BeginFunc(ctx, s.pool, func(transaction pgx.Tx) error {
+		if err := setAuditActor(ctx, transaction); err != nil {
+			return err
+		}
+
+		// Повторная установка того же значения не создаёт новую версию рынка
+		rows, err := transaction.Query(ctx, `
+			WITH updated AS (
+				UPDATE market_store
+				SET restricted = $2
+				WHERE id = $1 AND restricted <> $2
+				RETURNING id, name, base_asset, quote_asset, enabled, deleted_at, updated_at, version, restricted
+			)
+			SELECT id, name, base_asset, quote_asset, enabled, deleted_at, updated_at, version, restricted FROM updated
+			UNION ALL
+			SELECT id, name, base_asset, quote_asset, enabled, deleted_at, updated_at, version, restricted FROM market_store
+			WHERE id = $1 AND NOT EXISTS (SELECT 1 FROM updated)
+		`, id, restricted)
+		if err != nil {
+			return err
+		}
+
+		marketDTO, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[dto.Market])
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return repositoryErrors.ErrMarketNotFound
+			}
+			return err
+		}
+		market = marketDTO.ToDomain()
+
+		return nil
+	})
+	if err != nil {
+		if !errors.Is(err, repositoryErrors.ErrMarketNotFound) {
+			tracing.RecordError(span, err)
+		}
+		return models.Market{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return market, nil
+}
+
+// GrantMarketAccess идемпотентна: повторная выдача возвращает существующую запись.
+func (s *MarketAccessStore) GrantMarketAccess(
+	ctx context.Context,
+	grant models.MarketAccessGrant,
+) (models.MarketAccessGrant, error) {
+	const op = "postgres.MarketAccessStore.GrantMarketAccess"
+
+	ctx, span := tracing.StartSpan(ctx, "postgres.grant_market_access",
+		trace.WithSpanKind(trace.SpanKindClient),
+	)
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		metrics.ObserveWithTrace(ctx,
+			metrics.DBQueryDuration.WithLabelValues(s.config.Service.Name, "grant_market_access"),
+			time.Since(start).Seconds(),
+		)
+	}()
+
+	var grantedBy *string
+	if userID, ok := requestctx.UserIDFromContext(ctx); ok {
+		value := userID.String()
+		grantedBy = &value
+	}
+
+	rows, err := s.pool.Query(ctx, `
+		WITH inserted AS (
+			INSERT INTO market_access (market_id, subject_type, subject_id, granted_by)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (market_id, subject_type, subject_id) DO NOTHING
+			RETURNING `+marketAccessGrantColumnsQuery+`
+		)
+		SELECT `+marketAccessGrantColumnsQuery+` FROM inserted
+		UNION ALL
+		SELECT `+marketAccessGrantColumnsQuery+` FROM market_access
+		WHERE market_id = $1 AND subject_type = $2 AND subject_id = $3
+		  AND NOT EXISTS (SELECT 1 FROM inserted)
+	`, grant.MarketID, string(grant.SubjectType), grant.SubjectID, grantedBy)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return models.MarketAccessGrant{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	grantDTO, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[dto.MarketAccessGrant])
+	if err != nil {
+		if isMarketAccessMarketViolation(err) {
+			return models.MarketAccessGrant{}, fmt.Errorf("%s: %w", op, repositoryErrors.ErrMarketNotFound)
+		}
+
+		tracing.RecordError(span, err)
+		return models.MarketAccessGrant{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return grantDTO.ToDomain(), nil
+}
+
+// RevokeMarketAccess возвращает false, если такой записи не было.
+func (s *MarketAccessStore) RevokeMarketAccess(
+	ctx context.Context,
+	marketID uuid.UUID,
+	subjectType models.MarketAccessSubjectType,
+	subjectID string,
+) (bool, error) {
+	const op = "postgres.MarketAccessStore.RevokeMarketAccess"
+
+	ctx, span := tracing.StartSpan(ctx, "postgres.revoke_market_access",
+		trace.WithSpanKind(trace.SpanKindClient),
+	)
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		metrics.ObserveWithTrace(ctx,
+			metrics.DBQueryDuration.WithLabelValues(s.config.Service.Name, "revoke_market_access"),
+			time.Since(start).Seconds(),
+		)
+	}()
+
+	tag, err := s.pool.Exec(ctx, `
+		DELETE FROM market_access
+		WHERE market_id = $1 AND subject_type = $2 AND subject_id = $3
+	`, marketID, string(subjectType), subjectID)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+func (s *MarketAccessStore) ListMarketAccess(
+	ctx context.Context,
+	marketID uuid.UUID,
+) ([]models.MarketAccessGrant, error) {
+	const op = "postgres.MarketAccessStore.ListMarketAccess"
+
+	ctx, span := tracing.StartSpan(ctx, "postgres.list_market_access",
+		trace.WithSpanKind(trace.SpanKindClient),
+	)
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		metrics.ObserveWithTrace(ctx,
+			metrics.DBQueryDuration.WithLabelValues(s.config.Service.Name, "list_market_access"),
+			time.Since(start).Seconds(),
+		)
+	}()
+
+	rows, err := s.pool.Query(ctx, `
+		SELECT `+marketAccessGrantColumnsQuery+`
+		FROM market_access
+		WHERE market_id = $1
+		ORDER BY subject_type, subject_id
+	`, marketID)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	grantsDTO, err := pgx.CollectRows(rows, pgx.RowToStructByName[dto.MarketAccessGrant])
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	grants := make([]models.MarketAccessGrant, 0, len(grantsDTO))
+	for _, grantDTO := range grantsDTO {
+		grants = append(grants, grantDTO.ToDomain())
+	}
+
+	return grants, nil
+}
+
+func isMarketAccessMarketViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == foreignKeyViolationCode && pgErr.ConstraintName == marketAccessMarketForeignKey
+	}
+
+	return false
+}
@@ -0,0 +1,99 @@
+package spot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/nastyazhadan/spot-order-grpc/shared/config"
+	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/otel/attributes"
+	"github.com/nastyazhadan/spot-order-grpc/shared/interceptors/tracing"
+	"github.com/nastyazhadan/spot-order-grpc/shared/metrics"
+	"github.com/nastyazhadan/spot-order-grpc/shared/requestctx"
+	dto "github.com/nastyazhadan/spot-order-grpc/spotService/internal/application/dto/outbound/postgres"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+)
+
+// auditActorSetting читает триггер trg_record_market_history и пишет в market_store_history.actor.
+const auditActorSetting = "spot.actor"
+
+type MarketHistoryStore struct {
+	pool   *pgxpool.Pool
+	config config.SpotConfig
+}
+
+func NewMarketHistoryStore(pool *pgxpool.Pool, cfg config.SpotConfig) *MarketHistoryStore {
+	return &MarketHistoryStore{
+		pool:   pool,
+		config: cfg,
+	}
+}
+
+// GetMarketHistory возвращает записи рынка в порядке убывания id.
+// beforeID = 0 — начиная с самой новой записи.
+func (s *MarketHistoryStore) GetMarketHistory(
+	ctx context.Context,
+	marketID uuid.UUID,
+	beforeID int64,
+	limit uint64,
+) ([]domainModels.MarketHistoryEntry, error) {
+	const op = "postgres.MarketHistoryStore.GetMarketHistory"
+
+	ctx, span := tracing.StartSpan(ctx, "postgres.get_market_history",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attributes.MarketIDValue(marketID.String())),
+	)
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		metrics.ObserveWithTrace(ctx,
+			metrics.DBQueryDuration.WithLabelValues(s.config.Service.Name, "market.get_history"),
+			time.Since(start).Seconds(),
+		)
+	}()
+
+	rows, err := s.pool.Query(ctx, `
+		SELECT id, market_id, operation, old_values, new_values, actor, changed_at
+		FROM market_store_history
+		WHERE market_id = $1
+		  AND ($2 = 0 OR id < $2)
+		ORDER BY id DESC
+		LIMIT $3
+	`, marketID, beforeID, limit)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, fmt.Errorf("%s: query market history: %w", op, err)
+	}
+	defer rows.Close()
+
+	dtoEntries, err := pgx.CollectRows(rows, pgx.RowToStructByName[dto.MarketHistoryEntry])
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, fmt.Errorf("%s: collect rows: %w", op, err)
+	}
+
+	entries := make([]domainModels.MarketHistoryEntry, 0, len(dtoEntries))
+	for _, entry := range dtoEntries {
+		entries = append(entries, entry.ToDomain())
+	}
+
+	return entries, nil
+}
+
+// setAuditActor выставляет пользователя запроса автором изменений рынков до конца транзакции.
+// Без user_id в контексте автор остаётся пустым.
+func setAuditActor(ctx context.Context, transaction pgx.Tx) error {
+	userID, ok := requestctx.UserIDFromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	_, err := transaction.Exec(ctx, `SELECT set_config($1, $2, true)`, auditActorSetting, userID.String())
+	return err
+}
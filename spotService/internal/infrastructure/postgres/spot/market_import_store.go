@@ -0,0 +1,220 @@
+package spot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/nastyazhadan/spot-order-grpc/shared/config"
+	"github.com/nastyazhadan/spot-order-grpc/shared/interceptors/tracing"
+	"github.com/nastyazhadan/spot-order-grpc/shared/metrics"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+	dto "github.com/nastyazhadan/spot-order-grpc/spotService/internal/application/dto/outbound/postgres"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+)
+
+// MarketImportStore — импорт и выгрузка каталога рынков. Записи идут обычными
+// INSERT/UPDATE в market_store, поэтому срабатывают триггеры updated_at, version,
+// журнала изменений и аудита, а MarketPoller разносит изменения как любые другие.
+type MarketImportStore struct {
+	pool   *pgxpool.Pool
+	config config.SpotConfig
+}
+
+func NewMarketImportStore(pool *pgxpool.Pool, cfg config.SpotConfig) *MarketImportStore {
+	return &MarketImportStore{
+		pool:   pool,
+		config: cfg,
+	}
+}
+
+// ImportMarkets сопоставляет спецификации с рынками по имени и применяет отличия
+// в одной транзакции. Имя ищется сначала среди неудалённых рынков, затем среди
+// удалённых — так повторный импорт удалённого рынка не создаёт его заново.
+// При dryRun возвращается только план: рынки читаются в read-only транзакции без блокировок.
+func (s *MarketImportStore) ImportMarkets(
+	ctx context.Context,
+	specs []domainModels.MarketSpec,
+	dryRun bool,
+) ([]domainModels.MarketImportChange, error) {
+	const op = "postgres.MarketImportStore.ImportMarkets"
+
+	ctx, span := tracing.StartSpan(ctx, "postgres.import_markets",
+		trace.WithSpanKind(trace.SpanKindClient),
+	)
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		metrics.ObserveWithTrace(ctx,
+			metrics.DBQueryDuration.WithLabelValues(s.config.Service.Name, "import_markets"),
+			time.Since(start).Seconds(),
+		)
+	}()
+
+	names := make([]string, 0, len(specs))
+	for _, spec := range specs {
+		names = append(names, spec.Name)
+	}
+
+	var changes []domainModels.MarketImportChange
+
+	txOptions := pgx.TxOptions{}
+	if dryRun {
+		txOptions.AccessMode = pgx.ReadOnly
+	}
+
+	err := pgx.BeginTxFunc(ctx, s.pool, txOptions, func(transaction pgx.Tx) error {
+		if !dryRun {
+			if err := setAuditActor(ctx, transaction); err != nil {
+				return err
+			}
+		}
+
+		existing, err := selectMarketsByName(ctx, transaction, names, !dryRun)
+		if err != nil {
+			return err
+		}
+
+		changes = make([]domainModels.MarketImportChange, 0, len(specs))
+		for _, spec := range specs {
+			var current *models.Market
+			if market, ok := existing[spec.Name]; ok {
+				current = &market
+			}
+
+			change := domainModels.PlanMarketImport(current, spec)
+			changes = append(changes, change)
+
+			if dryRun {
+				continue
+			}
+
+			switch change.Action {
+			case domainModels.MarketImportActionCreate:
+				err = insertImportedMarket(ctx, transaction, spec)
+			case domainModels.MarketImportActionUpdate:
+				err = updateImportedMarket(ctx, transaction, current.ID, spec)
+			}
+			if err != nil {
+				return fmt.Errorf("market %s: %w", spec.Name, err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return changes, nil
+}
+
+// selectMarketsByName читает рынки с этими именами. С lock найденные рынки блокируются
+// до конца транзакции, чтобы применяемый план не разошёлся с параллельными изменениями.
+func selectMarketsByName(
+	ctx context.Context,
+	transaction pgx.Tx,
+	names []string,
+	lock bool,
+) (map[string]models.Market, error) {
+	query := `
+		SELECT id, name, base_asset, quote_asset, enabled, deleted_at, updated_at, version, restricted
+		FROM market_store
+		WHERE name = ANY($1)
+		ORDER BY name, deleted_at IS NOT NULL, deleted_at DESC
+	`
+	if lock {
+		query += "FOR UPDATE"
+	}
+
+	rows, err := transaction.Query(ctx, query, names)
+	if err != nil {
+		return nil, err
+	}
+
+	marketDTOs, err := pgx.CollectRows(rows, pgx.RowToStructByName[dto.Market])
+	if err != nil {
+		return nil, err
+	}
+
+	markets := make(map[string]models.Market, len(marketDTOs))
+	for _, marketDTO := range marketDTOs {
+		if _, ok := markets[marketDTO.Name]; !ok {
+			markets[marketDTO.Name] = marketDTO.ToDomain()
+		}
+	}
+
+	return markets, nil
+}
+
+func insertImportedMarket(ctx context.Context, transaction pgx.Tx, spec domainModels.MarketSpec) error {
+	_, err := transaction.Exec(ctx, `
+		INSERT INTO market_store (id, name, base_asset, quote_asset, enabled, restricted, deleted_at)
+		VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, CASE WHEN $6::boolean THEN NOW() END)
+	`, spec.Name, spec.BaseAsset, spec.QuoteAsset, spec.Enabled, spec.Restricted, spec.Deleted)
+
+	return err
+}
+
+func updateImportedMarket(
+	ctx context.Context,
+	transaction pgx.Tx,
+	id uuid.UUID,
+	spec domainModels.MarketSpec,
+) error {
+	_, err := transaction.Exec(ctx, `
+		UPDATE market_store
+		SET base_asset  = $2,
+		    quote_asset = $3,
+		    enabled     = $4,
+		    restricted  = $5,
+		    deleted_at  = CASE WHEN $6::boolean THEN COALESCE(deleted_at, NOW()) END
+		WHERE id = $1
+	`, id, spec.BaseAsset, spec.QuoteAsset, spec.Enabled, spec.Restricted, spec.Deleted)
+
+	return err
+}
+
+// ExportMarkets выгружает каталог в том же виде, в каком его принимает ImportMarkets:
+// по одному рынку на имя, неудалённый рынок важнее удалённых.
+func (s *MarketImportStore) ExportMarkets(ctx context.Context) ([]models.Market, error) {
+	const op = "postgres.MarketImportStore.ExportMarkets"
+
+	ctx, span := tracing.StartSpan(ctx, "postgres.export_markets",
+		trace.WithSpanKind(trace.SpanKindClient),
+	)
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		metrics.ObserveWithTrace(ctx,
+			metrics.DBQueryDuration.WithLabelValues(s.config.Service.Name, "export_markets"),
+			time.Since(start).Seconds(),
+		)
+	}()
+
+	rows, err := s.pool.Query(ctx, `
+		SELECT DISTINCT ON (name) id, name, base_asset, quote_asset, enabled, deleted_at, updated_at, version, restricted
+		FROM market_store
+		ORDER BY name, deleted_at IS NOT NULL, deleted_at DESC
+	`)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	marketDTOs, err := pgx.CollectRows(rows, pgx.RowToStructByName[dto.Market])
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return dtoMarketsToDomain(marketDTOs), nil
+}
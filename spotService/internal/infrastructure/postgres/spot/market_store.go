@@ -4,6 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -17,12 +20,10 @@ import (
 	"github.com/nastyazhadan/spot-order-grpc/shared/metrics"
 	"github.com/nastyazhadan/spot-order-grpc/shared/models"
 	dto "github.com/nastyazhadan/spot-order-grpc/spotService/internal/application/dto/outbound/postgres"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
 )
 
-const (
-	roleAdminKey  = "admin"
-	roleViewerKey = "viewer"
-)
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
 
 type MarketStore struct {
 	pool   *pgxpool.Pool
@@ -38,8 +39,11 @@ func NewMarketStore(pool *pgxpool.Pool, cfg config.SpotConfig) *MarketStore {
 
 func (m *MarketStore) GetMarketsPage(
 	ctx context.Context,
-	roleKey string,
-	limit, offset uint64,
+	policy domainModels.VisibilityPolicy,
+	access domainModels.MarketAccess,
+	filter models.MarketFilter,
+	after *domainModels.MarketPageKey,
+	limit uint64,
 ) ([]models.Market, error) {
 	const op = "postgres.MarketStore.GetMarketsPage"
 
@@ -56,14 +60,56 @@ func (m *MarketStore) GetMarketsPage(
 		)
 	}()
 
-	dtoMarkets, loadError := m.loadMarketsPage(ctx, roleKey, limit, offset)
+	dtoMarkets, loadError := m.loadMarketsPage(ctx, policy, access, filter, after, 0, limit)
+	if loadError != nil {
+		tracing.RecordError(span, loadError)
+		return nil, fmt.Errorf("%s: %w", op, loadError)
+	}
+
+	if len(dtoMarkets) == 0 {
+		markets, err := m.emptyPageResult(ctx, filter, after == nil)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		return markets, nil
+	}
+
+	return dtoMarketsToDomain(dtoMarkets), nil
+}
+
+// GetMarketsPageByOffset — страница с пропуском offset рынков для устаревшего ViewMarketsRequest.offset.
+// Сортировка та же, что у keyset-страниц, но каждая следующая страница читает все предыдущие
+func (m *MarketStore) GetMarketsPageByOffset(
+	ctx context.Context,
+	policy domainModels.VisibilityPolicy,
+	access domainModels.MarketAccess,
+	filter models.MarketFilter,
+	offset, limit uint64,
+) ([]models.Market, error) {
+	const op = "postgres.MarketStore.GetMarketsPageByOffset"
+
+	ctx, span := tracing.StartSpan(ctx, "postgres.get_markets_page_by_offset",
+		trace.WithSpanKind(trace.SpanKindClient),
+	)
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		metrics.ObserveWithTrace(ctx,
+			metrics.DBQueryDuration.WithLabelValues(m.config.Service.Name, "get_markets_page_by_offset"),
+			time.Since(start).Seconds(),
+		)
+	}()
+
+	dtoMarkets, loadError := m.loadMarketsPage(ctx, policy, access, filter, nil, offset, limit)
 	if loadError != nil {
 		tracing.RecordError(span, loadError)
 		return nil, fmt.Errorf("%s: %w", op, loadError)
 	}
 
 	if len(dtoMarkets) == 0 {
-		markets, err := m.emptyPageResult(ctx, offset)
+		markets, err := m.emptyPageResult(ctx, filter, offset == 0)
 		if err != nil {
 			tracing.RecordError(span, err)
 			return nil, fmt.Errorf("%s: %w", op, err)
@@ -76,28 +122,28 @@ func (m *MarketStore) GetMarketsPage(
 
 func (m *MarketStore) loadMarketsPage(
 	ctx context.Context,
-	roleKey string,
-	limit, offset uint64,
+	policy domainModels.VisibilityPolicy,
+	access domainModels.MarketAccess,
+	filter models.MarketFilter,
+	after *domainModels.MarketPageKey,
+	offset, limit uint64,
 ) ([]dto.Market, error) {
 	const op = "postgres.MarketStore.loadMarketsPage"
 
-	whereClause := "deleted_at IS NULL AND enabled = TRUE"
-	switch roleKey {
-	case roleAdminKey:
-		whereClause = "TRUE"
-	case roleViewerKey:
-		whereClause = "deleted_at IS NULL"
-	}
+	conditions, args := buildMarketsPageConditions(policy, access, filter, after)
+	args = append(args, int(limit+1), int64(offset))
 
+	// Условия видимости совпадают с partial-индексами из миграции 005,
+	// keyset по (name, id) читает индекс без OFFSET; offset > 0 бывает только у устаревших запросов
 	query := fmt.Sprintf(`
-		SELECT id, name, enabled, deleted_at, updated_at 
+		SELECT id, name, base_asset, quote_asset, enabled, deleted_at, updated_at, version, restricted 
 		FROM market_store
 		WHERE %s
 		ORDER BY name, id
-		LIMIT $1 OFFSET $2
-	`, whereClause)
+		LIMIT $%d OFFSET $%d
+	`, strings.Join(conditions, " AND "), len(args)-1, len(args))
 
-	rows, err := m.pool.Query(ctx, query, int(limit+1), int(offset))
+	rows, err := m.pool.Query(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -111,11 +157,121 @@ func (m *MarketStore) loadMarketsPage(
 	return marketsDTO, nil
 }
 
+func buildMarketsPageConditions(
+	policy domainModels.VisibilityPolicy,
+	access domainModels.MarketAccess,
+	filter models.MarketFilter,
+	after *domainModels.MarketPageKey,
+) ([]string, []any) {
+	var (
+		conditions []string
+		args       []any
+	)
+
+	addArg := func(value any) string {
+		args = append(args, value)
+		return "$" + strconv.Itoa(len(args))
+	}
+
+	conditions = append(conditions, visibilityStatusCondition(policy.Statuses))
+	if len(policy.BaseAssets) > 0 {
+		conditions = append(conditions, "base_asset = ANY("+addArg(policy.BaseAssets)+")")
+	}
+	if len(policy.QuoteAssets) > 0 {
+		conditions = append(conditions, "quote_asset = ANY("+addArg(policy.QuoteAssets)+")")
+	}
+	if condition, ok := marketAccessCondition(access, addArg); ok {
+		conditions = append(conditions, condition)
+	}
+
+	if condition, ok := marketStatusCondition(filter.Status); ok {
+		conditions = append(conditions, condition)
+	}
+
+	if filter.NamePrefix != "" {
+		conditions = append(conditions, "name LIKE "+addArg(escapeLikePattern(filter.NamePrefix)+"%"))
+	}
+	if filter.BaseAsset != "" {
+		conditions = append(conditions, "base_asset = "+addArg(filter.BaseAsset))
+	}
+	if filter.QuoteAsset != "" {
+		conditions = append(conditions, "quote_asset = "+addArg(filter.QuoteAsset))
+	}
+
+	if after != nil {
+		nameArg := addArg(after.Name)
+		idArg := addArg(after.ID)
+		conditions = append(conditions, fmt.Sprintf("(name, id) > (%s, %s)", nameArg, idArg))
+	}
+
+	return conditions, args
+}
+
+// Типовые наборы статусов дают те же условия, что и partial-индексы из миграции 005.
+func visibilityStatusCondition(statuses []models.MarketStatus) string {
+	switch {
+	case slices.Equal(statuses, []models.MarketStatus{
+		models.MarketStatusEnabled, models.MarketStatusDisabled, models.MarketStatusDeleted,
+	}):
+		return "TRUE"
+	case slices.Equal(statuses, []models.MarketStatus{models.MarketStatusEnabled, models.MarketStatusDisabled}):
+		return "deleted_at IS NULL"
+	}
+
+	parts := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		if condition, ok := marketStatusCondition(status); ok {
+			parts = append(parts, condition)
+		}
+	}
+	if len(parts) == 0 {
+		return "FALSE"
+	}
+	if len(parts) == 1 {
+		return parts[0]
+	}
+
+	return "((" + strings.Join(parts, ") OR (") + "))"
+}
+
+// Пустой список выданных рынков сводится к restricted = FALSE: такую страницу
+// можно положить в общий head-cache политики
+func marketAccessCondition(access domainModels.MarketAccess, addArg func(value any) string) (string, bool) {
+	if access.BypassAccessLists {
+		return "", false
+	}
+	if len(access.GrantedMarketIDs) == 0 {
+		return "restricted = FALSE", true
+	}
+
+	return "(restricted = FALSE OR id = ANY(" + addArg(access.GrantedMarketIDs) + "))", true
+}
+
+func marketStatusCondition(status models.MarketStatus) (string, bool) {
+	switch status {
+	case models.MarketStatusEnabled:
+		return "deleted_at IS NULL AND enabled = TRUE", true
+	case models.MarketStatusDisabled:
+		return "deleted_at IS NULL AND enabled = FALSE", true
+	case models.MarketStatusDeleted:
+		return "deleted_at IS NOT NULL", true
+	default:
+		return "", false
+	}
+}
+
+func escapeLikePattern(value string) string {
+	return likeEscaper.Replace(value)
+}
+
+// Пустой результат первой нефильтрованной страницы означает пустой market store;
+// для фильтров и последующих страниц это обычная пустая выдача.
 func (m *MarketStore) emptyPageResult(
 	ctx context.Context,
-	offset uint64,
+	filter models.MarketFilter,
+	firstPage bool,
 ) ([]models.Market, error) {
-	if offset > 0 {
+	if !firstPage || !filter.IsEmpty() {
 		return []models.Market{}, nil
 	}
 
@@ -168,7 +324,7 @@ func (m *MarketStore) GetMarketByID(
 	}()
 
 	rows, err := m.pool.Query(ctx, `
-		SELECT id, name, enabled, deleted_at, updated_at FROM market_store 
+		SELECT id, name, base_asset, quote_asset, enabled, deleted_at, updated_at, version, restricted FROM market_store 
 		WHERE id = $1
 	`, id)
 	if err != nil {
@@ -191,47 +347,92 @@ func (m *MarketStore) GetMarketByID(
 	return marketDTO.ToDomain(), nil
 }
 
-func (m *MarketStore) ListUpdatedSince(
+// GetMarketBySymbol ищет неудалённый рынок по имени; уникальность
+// гарантируется индексом uq_market_store_active_name.
+func (m *MarketStore) GetMarketBySymbol(
 	ctx context.Context,
-	updatedAt time.Time,
-	lastID uuid.UUID,
-	limit int,
+	symbol string,
+) (models.Market, error) {
+	const op = "postgres.MarketStore.GetMarketBySymbol"
+
+	ctx, span := tracing.StartSpan(ctx, "postgres.get_market_by_symbol",
+		trace.WithSpanKind(trace.SpanKindClient),
+	)
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		metrics.ObserveWithTrace(ctx,
+			metrics.DBQueryDuration.WithLabelValues(m.config.Service.Name, "get_market_by_symbol"),
+			time.Since(start).Seconds(),
+		)
+	}()
+
+	rows, err := m.pool.Query(ctx, `
+		SELECT id, name, base_asset, quote_asset, enabled, deleted_at, updated_at, version, restricted FROM market_store
+		WHERE name = $1 AND deleted_at IS NULL
+	`, symbol)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return models.Market{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	marketDTO, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[dto.Market])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Market{}, fmt.Errorf("%s: %w", op, repositoryErrors.ErrMarketNotFound)
+		}
+
+		tracing.RecordError(span, err)
+		return models.Market{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return marketDTO.ToDomain(), nil
+}
+
+// GetMarketsByIDs возвращает найденные рынки без учёта видимости;
+// отсутствующие id просто не попадают в результат.
+func (m *MarketStore) GetMarketsByIDs(
+	ctx context.Context,
+	ids []uuid.UUID,
 ) ([]models.Market, error) {
-	const op = "postgres.MarketStore.ListUpdatedSince"
+	const op = "postgres.MarketStore.GetMarketsByIDs"
 
-	ctx, span := tracing.StartSpan(ctx, "postgres.list_updated_since",
+	ctx, span := tracing.StartSpan(ctx, "postgres.get_markets_by_ids",
 		trace.WithSpanKind(trace.SpanKindClient),
 	)
 	defer span.End()
 
+	if len(ids) == 0 {
+		return []models.Market{}, nil
+	}
+
 	start := time.Now()
 	defer func() {
 		metrics.ObserveWithTrace(ctx,
-			metrics.DBQueryDuration.WithLabelValues(m.config.Service.Name, "market.list_updated_since"),
+			metrics.DBQueryDuration.WithLabelValues(m.config.Service.Name, "get_markets_by_ids"),
 			time.Since(start).Seconds(),
 		)
 	}()
 
 	rows, err := m.pool.Query(ctx, `
-		SELECT id, name, enabled, deleted_at, updated_at 
-		FROM market_store
-		WHERE (updated_at, id) > ($1, $2)
-		ORDER BY updated_at, id
-		LIMIT $3
-	`, updatedAt.UTC(), lastID, limit)
+		SELECT id, name, base_asset, quote_asset, enabled, deleted_at, updated_at, version, restricted FROM market_store
+		WHERE id = ANY($1)
+	`, ids)
 	if err != nil {
 		tracing.RecordError(span, err)
-		return nil, fmt.Errorf("%s: query updated markets: %w", op, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 	defer rows.Close()
 
-	dtoMarkets, err := pgx.CollectRows(rows, pgx.RowToStructByName[dto.Market])
+	marketsDTO, err := pgx.CollectRows(rows, pgx.RowToStructByName[dto.Market])
 	if err != nil {
 		tracing.RecordError(span, err)
-		return nil, fmt.Errorf("%s: collect rows: %w", op, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return dtoMarketsToDomain(dtoMarkets), nil
+	return dtoMarketsToDomain(marketsDTO), nil
 }
 
 func dtoMarketsToDomain(dtoMarkets []dto.Market) []models.Market {
@@ -78,6 +78,63 @@ func (m *MarketByIDCacheRepository) GetMarketByID(
 	return market, nil
 }
 
+// GetMarketsByIDs читает рынки одним MGET. Отсутствующие и повреждённые ключи
+// не попадают в результат: повреждённые удаляются, как и в GetMarketByID.
+func (m *MarketByIDCacheRepository) GetMarketsByIDs(
+	ctx context.Context,
+	ids []uuid.UUID,
+) (map[uuid.UUID]models.Market, error) {
+	const op = "redis.MarketByIDCacheRepository.GetMarketsByIDs"
+
+	ctx, span := tracing.StartSpan(ctx, "redis.get_markets_by_ids",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attributes.DBSystemValue(dbSystem),
+			attributes.BatchSizeValue(len(ids)),
+		),
+	)
+	defer span.End()
+
+	if len(ids) == 0 {
+		return map[uuid.UUID]models.Market{}, nil
+	}
+
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, cacheByIDKey(id))
+	}
+
+	start := time.Now()
+	values, err := m.cacheStore.MGet(ctx, keys...)
+	metrics.ObserveWithTrace(ctx,
+		metrics.CacheOperationDuration.WithLabelValues(m.serviceName, "get_by_ids"),
+		time.Since(start).Seconds(),
+	)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	markets := make(map[uuid.UUID]models.Market, len(ids))
+	for i, data := range values {
+		if data == nil {
+			metrics.CacheMissesTotal.WithLabelValues(m.serviceName, "get_by_ids").Inc()
+			continue
+		}
+
+		market, reason, decodeError := decodeCachedMarket(data)
+		if decodeError != nil {
+			m.invalidateCorruptedCache(ctx, span, ids[i], reason, decodeError)
+			continue
+		}
+
+		metrics.CacheHitsTotal.WithLabelValues(m.serviceName, "get_by_ids").Inc()
+		markets[ids[i]] = market
+	}
+
+	return markets, nil
+}
+
 func (m *MarketByIDCacheRepository) invalidateCorruptedCache(
 	ctx context.Context,
 	span trace.Span,
@@ -142,6 +199,47 @@ func (m *MarketByIDCacheRepository) SetMarketByID(
 	return nil
 }
 
+func (m *MarketByIDCacheRepository) SetMarketsByIDs(
+	ctx context.Context,
+	markets []models.Market,
+	ttl time.Duration,
+) error {
+	const op = "redis.MarketByIDCacheRepository.SetMarketsByIDs"
+
+	ctx, span := tracing.StartSpan(ctx, "redis.set_markets_by_ids",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attributes.DBSystemValue(dbSystem),
+			attributes.BatchSizeValue(len(markets)),
+			attributes.CacheTTLValue(ttl),
+		),
+	)
+	defer span.End()
+
+	values := make(map[string][]byte, len(markets))
+	for _, market := range markets {
+		data, err := encodeMarket(market)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		values[cacheByIDKey(market.ID)] = data
+	}
+
+	start := time.Now()
+	err := m.cacheStore.SetManyWithTTL(ctx, values, ttl)
+	metrics.ObserveWithTrace(ctx,
+		metrics.CacheOperationDuration.WithLabelValues(m.serviceName, "set_by_ids"),
+		time.Since(start).Seconds(),
+	)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
 func (m *MarketByIDCacheRepository) DeleteMarketByID(
 	ctx context.Context,
 	id uuid.UUID,
@@ -0,0 +1,153 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+
+	sharedErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors"
+	repositoryErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/repository"
+	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/cache"
+	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/otel/attributes"
+	"github.com/nastyazhadan/spot-order-grpc/shared/interceptors/tracing"
+	"github.com/nastyazhadan/spot-order-grpc/shared/metrics"
+)
+
+const cacheBySymbolKeyPrefix = "market:by_symbol"
+
+// MarketBySymbolCacheRepository хранит только соответствие symbol -> market_id,
+// сами данные рынка читаются через by-id cache.
+type MarketBySymbolCacheRepository struct {
+	cacheStore  *cache.Store
+	serviceName string
+}
+
+func NewMarketBySymbolCacheRepository(store *cache.Store, serviceName string) *MarketBySymbolCacheRepository {
+	return &MarketBySymbolCacheRepository{
+		cacheStore:  store,
+		serviceName: serviceName,
+	}
+}
+
+func (m *MarketBySymbolCacheRepository) GetMarketIDBySymbol(
+	ctx context.Context,
+	symbol string,
+) (uuid.UUID, error) {
+	const op = "redis.MarketBySymbolCacheRepository.GetMarketIDBySymbol"
+
+	ctx, span := tracing.StartSpan(ctx, "redis.get_market_id_by_symbol",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attributes.DBSystemValue(dbSystem)),
+	)
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		metrics.ObserveWithTrace(ctx,
+			metrics.CacheOperationDuration.WithLabelValues(m.serviceName, "get_by_symbol"),
+			time.Since(start).Seconds(),
+		)
+	}()
+
+	data, err := m.cacheStore.Get(ctx, cacheBySymbolKey(symbol))
+	if err != nil {
+		if errors.Is(err, sharedErrors.ErrCacheNotFound) {
+			metrics.CacheMissesTotal.WithLabelValues(m.serviceName, "get_by_symbol").Inc()
+			return uuid.Nil, repositoryErrors.ErrMarketNotFound
+		}
+
+		tracing.RecordError(span, err)
+		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	id, err := uuid.ParseBytes(data)
+	if err != nil {
+		span.SetAttributes(
+			attributes.CacheCorruptedValue(true),
+			attributes.CacheCorruptedReasonValue("uuid_parse"),
+		)
+		tracing.RecordError(span, err)
+		return uuid.Nil, fmt.Errorf("%s: %w", op, repositoryErrors.ErrMarketCacheCorrupted)
+	}
+
+	metrics.CacheHitsTotal.WithLabelValues(m.serviceName, "get_by_symbol").Inc()
+	return id, nil
+}
+
+func (m *MarketBySymbolCacheRepository) SetMarketIDBySymbol(
+	ctx context.Context,
+	symbol string,
+	id uuid.UUID,
+	ttl time.Duration,
+) error {
+	const op = "redis.MarketBySymbolCacheRepository.SetMarketIDBySymbol"
+
+	ctx, span := tracing.StartSpan(ctx, "redis.set_market_id_by_symbol",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attributes.DBSystemValue(dbSystem),
+			attributes.MarketIDValue(id.String()),
+			attributes.CacheTTLValue(ttl),
+		),
+	)
+	defer span.End()
+
+	start := time.Now()
+	err := m.cacheStore.SetWithTTL(ctx, cacheBySymbolKey(symbol), id.String(), ttl)
+	metrics.ObserveWithTrace(ctx,
+		metrics.CacheOperationDuration.WithLabelValues(m.serviceName, "set_by_symbol"),
+		time.Since(start).Seconds(),
+	)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (m *MarketBySymbolCacheRepository) DeleteBySymbols(
+	ctx context.Context,
+	symbols []string,
+) error {
+	const op = "redis.MarketBySymbolCacheRepository.DeleteBySymbols"
+
+	ctx, span := tracing.StartSpan(ctx, "redis.delete_markets_by_symbols",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attributes.DBSystemValue(dbSystem),
+			attributes.BatchSizeValue(len(symbols)),
+		),
+	)
+	defer span.End()
+
+	if len(symbols) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(symbols))
+	for _, symbol := range symbols {
+		keys = append(keys, cacheBySymbolKey(symbol))
+	}
+
+	start := time.Now()
+	err := m.cacheStore.Delete(ctx, keys...)
+	metrics.ObserveWithTrace(ctx,
+		metrics.CacheOperationDuration.WithLabelValues(m.serviceName, "delete_by_symbols"),
+		time.Since(start).Seconds(),
+	)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func cacheBySymbolKey(symbol string) string {
+	return fmt.Sprintf("%s:%s", cacheBySymbolKeyPrefix, symbol)
+}
@@ -38,7 +38,7 @@ func NewMarketCacheRepository(store *cache.Store, serviceName string) *MarketCac
 
 func (m *MarketCacheRepository) GetMarkets(
 	ctx context.Context,
-	roleKey string,
+	policyID string,
 ) ([]models.Market, error) {
 	const op = "redis.MarketCacheRepository.GetMarkets"
 
@@ -46,7 +46,7 @@ func (m *MarketCacheRepository) GetMarkets(
 		trace.WithSpanKind(trace.SpanKindClient),
 		trace.WithAttributes(
 			attributes.DBSystemValue(dbSystem),
-			attributes.UserRoleKeyValue(roleKey),
+			attributes.VisibilityPolicyValue(policyID),
 		),
 	)
 	defer span.End()
@@ -60,7 +60,7 @@ func (m *MarketCacheRepository) GetMarkets(
 		)
 	}()
 
-	data, err := m.cacheStore.Get(ctx, cacheKey(roleKey))
+	data, err := m.cacheStore.Get(ctx, cacheKey(policyID))
 	if err != nil {
 		if errors.Is(err, sharedErrors.ErrCacheNotFound) {
 			metrics.CacheMissesTotal.WithLabelValues(m.serviceName, "get_markets").Inc()
@@ -73,7 +73,7 @@ func (m *MarketCacheRepository) GetMarkets(
 
 	markets, reason, err := decodeCachedMarkets(data)
 	if err != nil {
-		m.invalidateCorruptedCache(ctx, span, roleKey, reason, err)
+		m.invalidateCorruptedCache(ctx, span, policyID, reason, err)
 		return nil, fmt.Errorf("%s: %w", op, repositoryErrors.ErrMarketCacheCorrupted)
 	}
 
@@ -84,7 +84,7 @@ func (m *MarketCacheRepository) GetMarkets(
 func (m *MarketCacheRepository) invalidateCorruptedCache(
 	ctx context.Context,
 	span trace.Span,
-	roleKey string,
+	policyID string,
 	reason string,
 	cause error,
 ) {
@@ -94,23 +94,23 @@ func (m *MarketCacheRepository) invalidateCorruptedCache(
 	)
 	tracing.RecordError(span, cause)
 
-	if err := m.DeleteMarkets(ctx, roleKey); err != nil {
+	if err := m.DeleteMarkets(ctx, policyID); err != nil {
 		span.SetAttributes(attributes.CacheInvalidationFailedValue(true))
 		tracing.RecordError(span, err)
 
 		metrics.CacheInvalidationsTotal.
-			WithLabelValues(m.serviceName, reason, roleKey, "error").Inc()
+			WithLabelValues(m.serviceName, reason, policyID, "error").Inc()
 		return
 	}
 
 	metrics.CacheInvalidationsTotal.
-		WithLabelValues(m.serviceName, reason, roleKey, "success").Inc()
+		WithLabelValues(m.serviceName, reason, policyID, "success").Inc()
 }
 
 func (m *MarketCacheRepository) SetMarkets(
 	ctx context.Context,
 	markets []models.Market,
-	roleKey string,
+	policyID string,
 	ttl time.Duration,
 ) error {
 	const op = "redis.MarketCacheRepository.SetMarkets"
@@ -119,7 +119,7 @@ func (m *MarketCacheRepository) SetMarkets(
 		trace.WithSpanKind(trace.SpanKindClient),
 		trace.WithAttributes(
 			attributes.DBSystemValue(dbSystem),
-			attributes.UserRoleKeyValue(roleKey),
+			attributes.VisibilityPolicyValue(policyID),
 			attributes.MarketsCountValue(len(markets)),
 			attributes.CacheTTLValue(ttl),
 		),
@@ -133,7 +133,7 @@ func (m *MarketCacheRepository) SetMarkets(
 	}
 
 	start := time.Now()
-	err = m.cacheStore.SetWithTTL(ctx, cacheKey(roleKey), data, ttl)
+	err = m.cacheStore.SetWithTTL(ctx, cacheKey(policyID), data, ttl)
 	metrics.ObserveWithTrace(ctx,
 		metrics.CacheOperationDuration.WithLabelValues(m.serviceName, "set_markets"),
 		time.Since(start).Seconds(),
@@ -148,7 +148,7 @@ func (m *MarketCacheRepository) SetMarkets(
 
 func (m *MarketCacheRepository) DeleteMarkets(
 	ctx context.Context,
-	roleKey string,
+	policyID string,
 ) error {
 	const op = "redis.MarketCacheRepository.DeleteMarkets"
 
@@ -156,13 +156,13 @@ func (m *MarketCacheRepository) DeleteMarkets(
 		trace.WithSpanKind(trace.SpanKindClient),
 		trace.WithAttributes(
 			attributes.DBSystemValue(dbSystem),
-			attributes.UserRoleKeyValue(roleKey),
+			attributes.VisibilityPolicyValue(policyID),
 		),
 	)
 	defer span.End()
 
 	start := time.Now()
-	err := m.cacheStore.Delete(ctx, cacheKey(roleKey))
+	err := m.cacheStore.Delete(ctx, cacheKey(policyID))
 	metrics.ObserveWithTrace(ctx,
 		metrics.CacheOperationDuration.WithLabelValues(m.serviceName, "delete_markets"),
 		time.Since(start).Seconds(),
@@ -207,6 +207,6 @@ func encodeMarkets(markets []models.Market) ([]byte, error) {
 	return data, nil
 }
 
-func cacheKey(roleKey string) string {
-	return fmt.Sprintf("%s:%s", cacheKeyPrefix, roleKey)
+func cacheKey(policyID string) string {
+	return fmt.Sprintf("%s:%s", cacheKeyPrefix, policyID)
 }
@@ -0,0 +1,81 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/cache"
+	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/otel/attributes"
+	"github.com/nastyazhadan/spot-order-grpc/shared/interceptors/tracing"
+	dto "github.com/nastyazhadan/spot-order-grpc/spotService/internal/application/dto/outbound/redis"
+	"github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+)
+
+// MarketChangeBus раздаёт батчи поллера с реплики-лидера хабам WatchMarkets всех реплик.
+type MarketChangeBus struct {
+	cacheStore *cache.Store
+	channel    string
+}
+
+func NewMarketChangeBus(store *cache.Store, channel string) *MarketChangeBus {
+	return &MarketChangeBus{
+		cacheStore: store,
+		channel:    channel,
+	}
+}
+
+func (b *MarketChangeBus) PublishMarketChanges(ctx context.Context, batch models.MarketChangeBatch) error {
+	const op = "redis.MarketChangeBus.PublishMarketChanges"
+
+	ctx, span := tracing.StartSpan(ctx, "redis.publish_market_changes",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			attributes.DBSystemValue(dbSystem),
+			attributes.MarketsCountValue(len(batch.Markets)),
+		),
+	)
+	defer span.End()
+
+	payload, err := json.Marshal(dto.MarketChangeBatchFromDomain(batch))
+	if err != nil {
+		tracing.RecordError(span, err)
+		return fmt.Errorf("%s: marshal batch: %w", op, err)
+	}
+
+	if err = b.cacheStore.Publish(ctx, b.channel, payload); err != nil {
+		tracing.RecordError(span, err)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// Listen вызывает onReset после (пере)подписки и на нечитаемое сообщение,
+// остальные батчи передаёт в onBatch.
+func (b *MarketChangeBus) Listen(
+	ctx context.Context,
+	onReset func(),
+	onBatch func(batch models.MarketChangeBatch),
+) error {
+	const op = "redis.MarketChangeBus.Listen"
+
+	err := listenChannel(ctx, b.cacheStore, b.channel, onReset, func(payload []byte) error {
+		var view dto.MarketChangeBatchView
+		if err := json.Unmarshal(payload, &view); err != nil {
+			return err
+		}
+
+		batch, err := view.ToDomain()
+		if err != nil {
+			return err
+		}
+
+		onBatch(batch)
+		return nil
+	})
+
+	return fmt.Errorf("%s: %w", op, err)
+}
@@ -0,0 +1,77 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/cache"
+	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/otel/attributes"
+	"github.com/nastyazhadan/spot-order-grpc/shared/interceptors/tracing"
+)
+
+// MarketInvalidationBus рассылает id изменённых рынков всем репликам spot через Redis pub/sub,
+// чтобы каждая сбросила их из локального кэша. Pub/sub не хранит сообщения:
+// всё, что пришло во время обрыва подписки, теряется.
+type MarketInvalidationBus struct {
+	cacheStore *cache.Store
+	channel    string
+}
+
+func NewMarketInvalidationBus(store *cache.Store, channel string) *MarketInvalidationBus {
+	return &MarketInvalidationBus{
+		cacheStore: store,
+		channel:    channel,
+	}
+}
+
+func (b *MarketInvalidationBus) PublishInvalidation(ctx context.Context, ids []uuid.UUID) error {
+	const op = "redis.MarketInvalidationBus.PublishInvalidation"
+
+	ctx, span := tracing.StartSpan(ctx, "redis.publish_market_invalidation",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			attributes.DBSystemValue(dbSystem),
+			attributes.BatchSizeValue(len(ids)),
+		),
+	)
+	defer span.End()
+
+	payload, err := json.Marshal(ids)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return fmt.Errorf("%s: marshal ids: %w", op, err)
+	}
+
+	if err = b.cacheStore.Publish(ctx, b.channel, payload); err != nil {
+		tracing.RecordError(span, err)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// Listen сбрасывает локальный кэш целиком через onReset после (пере)подписки
+// и на нечитаемое сообщение, остальные сообщения передаёт в onInvalidate.
+func (b *MarketInvalidationBus) Listen(
+	ctx context.Context,
+	onReset func(),
+	onInvalidate func(ids []uuid.UUID),
+) error {
+	const op = "redis.MarketInvalidationBus.Listen"
+
+	err := listenChannel(ctx, b.cacheStore, b.channel, onReset, func(payload []byte) error {
+		var ids []uuid.UUID
+		if err := json.Unmarshal(payload, &ids); err != nil {
+			return err
+		}
+
+		onInvalidate(ids)
+		return nil
+	})
+
+	return fmt.Errorf("%s: %w", op, err)
+}
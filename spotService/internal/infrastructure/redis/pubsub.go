@@ -0,0 +1,40 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/cache"
+)
+
+// listenChannel блокируется до отмены ctx или обрыва подписки.
+// onReset вызывается после подписки и когда handle не смог разобрать сообщение:
+// pub/sub не хранит сообщения, и получатель должен считать, что часть потеряна.
+func listenChannel(
+	ctx context.Context,
+	store *cache.Store,
+	channel string,
+	onReset func(),
+	handle func(payload []byte) error,
+) error {
+	pubSub := store.Subscribe(ctx, channel)
+	defer func() { _ = pubSub.Close() }()
+
+	// Дожидаемся подтверждения подписки, иначе сброс может её опередить
+	if _, err := pubSub.Receive(ctx); err != nil {
+		return fmt.Errorf("subscribe to %s: %w", channel, err)
+	}
+
+	onReset()
+
+	for {
+		message, err := pubSub.ReceiveMessage(ctx)
+		if err != nil {
+			return fmt.Errorf("receive message from %s: %w", channel, err)
+		}
+
+		if err = handle([]byte(message.Payload)); err != nil {
+			onReset()
+		}
+	}
+}
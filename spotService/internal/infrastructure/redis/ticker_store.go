@@ -0,0 +1,208 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/cache"
+	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/otel/attributes"
+	"github.com/nastyazhadan/spot-order-grpc/shared/interceptors/tracing"
+	"github.com/nastyazhadan/spot-order-grpc/shared/metrics"
+	dto "github.com/nastyazhadan/spot-order-grpc/spotService/internal/application/dto/outbound/redis"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+)
+
+// TickerStore хранит тикеры одним хешем: поле — market_id, значение — JSON тикера.
+// Хеш пишет только лидер TickerBuilder; TTL снимает тикеры, если лидера нет.
+type TickerStore struct {
+	cacheStore  *cache.Store
+	key         string
+	ttl         time.Duration
+	serviceName string
+}
+
+func NewTickerStore(store *cache.Store, key string, ttl time.Duration, serviceName string) *TickerStore {
+	return &TickerStore{
+		cacheStore:  store,
+		key:         key,
+		ttl:         ttl,
+		serviceName: serviceName,
+	}
+}
+
+// SaveTickers обновляет изменившиеся тикеры, не трогая остальные.
+func (s *TickerStore) SaveTickers(ctx context.Context, tickers []domainModels.Ticker) error {
+	const op = "redis.TickerStore.SaveTickers"
+
+	ctx, span := tracing.StartSpan(ctx, "redis.save_tickers",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attributes.DBSystemValue(dbSystem),
+			attributes.BatchSizeValue(len(tickers)),
+		),
+	)
+	defer span.End()
+
+	values, err := encodeTickers(tickers)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	start := time.Now()
+	err = s.cacheStore.HSetWithTTL(ctx, s.key, values, s.ttl)
+	metrics.ObserveWithTrace(ctx,
+		metrics.CacheOperationDuration.WithLabelValues(s.serviceName, "save_tickers"),
+		time.Since(start).Seconds(),
+	)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// ReplaceTickers заменяет все тикеры разом: так исчезают рынки, пропущенные инкрементальными записями,
+// и восстанавливается хеш после сброса Redis.
+func (s *TickerStore) ReplaceTickers(ctx context.Context, tickers []domainModels.Ticker) error {
+	const op = "redis.TickerStore.ReplaceTickers"
+
+	ctx, span := tracing.StartSpan(ctx, "redis.replace_tickers",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attributes.DBSystemValue(dbSystem),
+			attributes.BatchSizeValue(len(tickers)),
+		),
+	)
+	defer span.End()
+
+	values, err := encodeTickers(tickers)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	start := time.Now()
+	err = s.cacheStore.ReplaceHash(ctx, s.key, values, s.ttl)
+	metrics.ObserveWithTrace(ctx,
+		metrics.CacheOperationDuration.WithLabelValues(s.serviceName, "replace_tickers"),
+		time.Since(start).Seconds(),
+	)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// GetTickers возвращает тикеры найденных рынков. Повреждённые записи пропускаются:
+// лидер перепишет их при следующей полной записи.
+func (s *TickerStore) GetTickers(
+	ctx context.Context,
+	marketIDs []uuid.UUID,
+) (map[uuid.UUID]domainModels.Ticker, error) {
+	const op = "redis.TickerStore.GetTickers"
+
+	ctx, span := tracing.StartSpan(ctx, "redis.get_tickers",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attributes.DBSystemValue(dbSystem),
+			attributes.BatchSizeValue(len(marketIDs)),
+		),
+	)
+	defer span.End()
+
+	fields := make([]string, 0, len(marketIDs))
+	for _, marketID := range marketIDs {
+		fields = append(fields, marketID.String())
+	}
+
+	start := time.Now()
+	values, err := s.cacheStore.HMGet(ctx, s.key, fields...)
+	metrics.ObserveWithTrace(ctx,
+		metrics.CacheOperationDuration.WithLabelValues(s.serviceName, "get_tickers"),
+		time.Since(start).Seconds(),
+	)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	tickers := make(map[uuid.UUID]domainModels.Ticker, len(values))
+	for _, data := range values {
+		if data == nil {
+			continue
+		}
+
+		ticker, decodeErr := decodeTicker(data)
+		if decodeErr != nil {
+			tracing.RecordError(span, decodeErr)
+			continue
+		}
+		tickers[ticker.MarketID] = ticker
+	}
+
+	return tickers, nil
+}
+
+func (s *TickerStore) ListTickers(ctx context.Context) ([]domainModels.Ticker, error) {
+	const op = "redis.TickerStore.ListTickers"
+
+	ctx, span := tracing.StartSpan(ctx, "redis.list_tickers",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attributes.DBSystemValue(dbSystem)),
+	)
+	defer span.End()
+
+	start := time.Now()
+	values, err := s.cacheStore.HGetAll(ctx, s.key)
+	metrics.ObserveWithTrace(ctx,
+		metrics.CacheOperationDuration.WithLabelValues(s.serviceName, "list_tickers"),
+		time.Since(start).Seconds(),
+	)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	tickers := make([]domainModels.Ticker, 0, len(values))
+	for _, data := range values {
+		ticker, decodeErr := decodeTicker(data)
+		if decodeErr != nil {
+			tracing.RecordError(span, decodeErr)
+			continue
+		}
+		tickers = append(tickers, ticker)
+	}
+
+	return tickers, nil
+}
+
+func encodeTickers(tickers []domainModels.Ticker) (map[string][]byte, error) {
+	values := make(map[string][]byte, len(tickers))
+	for _, ticker := range tickers {
+		data, err := json.Marshal(dto.TickerFromDomain(ticker))
+		if err != nil {
+			return nil, err
+		}
+		values[ticker.MarketID.String()] = data
+	}
+
+	return values, nil
+}
+
+func decodeTicker(data []byte) (domainModels.Ticker, error) {
+	var view dto.TickerRedisView
+	if err := json.Unmarshal(data, &view); err != nil {
+		return domainModels.Ticker{}, err
+	}
+
+	return view.ToDomain()
+}
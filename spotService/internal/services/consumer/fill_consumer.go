@@ -0,0 +1,109 @@
+package consumer
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/kafka"
+	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/kafka/consumer"
+	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/otel/attributes"
+	zapLogger "github.com/nastyazhadan/spot-order-grpc/shared/interceptors/logging/zap"
+	"github.com/nastyazhadan/spot-order-grpc/shared/interceptors/tracing"
+	"github.com/nastyazhadan/spot-order-grpc/shared/metrics"
+	mapper "github.com/nastyazhadan/spot-order-grpc/spotService/internal/application/dto/inbound/kafka"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+)
+
+const messagingSystem = "kafka"
+
+type Consumer interface {
+	Consume(ctx context.Context, handler consumer.MessageHandler) error
+}
+
+type TradeProcessor interface {
+	ProcessTrade(ctx context.Context, consumerGroup string, trade domainModels.Trade) error
+}
+
+// FillConsumer читает исполнения из order.filled в своей consumer group и передаёт их агрегатору свечей.
+type FillConsumer struct {
+	consumer      Consumer
+	processor     TradeProcessor
+	consumerGroup string
+	serviceName   string
+	logger        *zapLogger.Logger
+}
+
+func NewFillConsumer(
+	consumer Consumer,
+	processor TradeProcessor,
+	consumerGroup string,
+	serviceName string,
+	logger *zapLogger.Logger,
+) *FillConsumer {
+	return &FillConsumer{
+		consumer:      consumer,
+		processor:     processor,
+		consumerGroup: consumerGroup,
+		serviceName:   serviceName,
+		logger:        logger,
+	}
+}
+
+func (c *FillConsumer) Run(ctx context.Context) error {
+	return c.consumer.Consume(ctx, c.handleOrderFilled)
+}
+
+func (c *FillConsumer) handleOrderFilled(ctx context.Context, msg kafka.Message) error {
+	const op = "FillConsumer.handleOrderFilled"
+
+	ctx, span := tracing.StartSpan(ctx, "fill_consumer.handle_order_filled",
+		trace.WithAttributes(
+			attributes.MessagingSystemValue(messagingSystem),
+			attributes.MessagingDestinationValue(msg.Topic),
+			attributes.KafkaOffsetValue(msg.Offset),
+		),
+	)
+	defer span.End()
+
+	trade, err := mapper.UnmarshalOrderFilled(msg.Value)
+	if err != nil {
+		tracing.RecordError(span, err)
+		metrics.CandleTradesTotal.WithLabelValues(c.serviceName, "invalid").Inc()
+
+		c.logger.Error(ctx, "Failed to unmarshal OrderFilledEvent",
+			zap.String("topic", msg.Topic),
+			zap.Int32("partition", msg.Partition),
+			zap.Int64("offset", msg.Offset),
+			zap.Error(err),
+		)
+
+		return consumer.NonRetryableError{
+			Err: fmt.Errorf("%s: %w", op, err),
+		}
+	}
+
+	span.SetAttributes(
+		attributes.EventIDValue(trade.EventID.String()),
+		attributes.MarketIDValue(trade.MarketID.String()),
+	)
+
+	if err = c.processor.ProcessTrade(ctx, c.consumerGroup, trade); err != nil {
+		tracing.RecordError(span, err)
+
+		c.logger.Error(ctx, "Failed to apply trade to candles",
+			zap.String("topic", msg.Topic),
+			zap.Int32("partition", msg.Partition),
+			zap.Int64("offset", msg.Offset),
+			zap.String("event_id", trade.EventID.String()),
+			zap.String("market_id", trade.MarketID.String()),
+			zap.Error(err),
+		)
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
@@ -5,12 +5,13 @@ package mocks
 import (
 	context "context"
 
-	models "github.com/nastyazhadan/spot-order-grpc/shared/models"
-	mock "github.com/stretchr/testify/mock"
-
 	time "time"
 
 	uuid "github.com/google/uuid"
+
+	models "github.com/nastyazhadan/spot-order-grpc/shared/models"
+
+	mock "github.com/stretchr/testify/mock"
 )
 
 // MarketByIDCacheRepository is an autogenerated mock type for the MarketByIDCacheRepository type
@@ -64,6 +65,36 @@ func (_m *MarketByIDCacheRepository) GetMarketByID(ctx context.Context, id uuid.
 	return r0, r1
 }
 
+// GetMarketsByIDs provides a mock function with given fields: ctx, ids
+func (_m *MarketByIDCacheRepository) GetMarketsByIDs(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]models.Market, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMarketsByIDs")
+	}
+
+	var r0 map[uuid.UUID]models.Market
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) (map[uuid.UUID]models.Market, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) map[uuid.UUID]models.Market); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID]models.Market)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetMarketByID provides a mock function with given fields: ctx, market, ttl
 func (_m *MarketByIDCacheRepository) SetMarketByID(ctx context.Context, market models.Market, ttl time.Duration) error {
 	ret := _m.Called(ctx, market, ttl)
@@ -82,6 +113,24 @@ func (_m *MarketByIDCacheRepository) SetMarketByID(ctx context.Context, market m
 	return r0
 }
 
+// SetMarketsByIDs provides a mock function with given fields: ctx, markets, ttl
+func (_m *MarketByIDCacheRepository) SetMarketsByIDs(ctx context.Context, markets []models.Market, ttl time.Duration) error {
+	ret := _m.Called(ctx, markets, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetMarketsByIDs")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []models.Market, time.Duration) error); ok {
+		r0 = rf(ctx, markets, ttl)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewMarketByIDCacheRepository creates a new instance of MarketByIDCacheRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMarketByIDCacheRepository(t interface {
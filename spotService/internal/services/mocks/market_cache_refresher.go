@@ -5,9 +5,9 @@ package mocks
 import (
 	context "context"
 
-	mock "github.com/stretchr/testify/mock"
-
 	uuid "github.com/google/uuid"
+
+	mock "github.com/stretchr/testify/mock"
 )
 
 // MarketCacheRefresher is an autogenerated mock type for the MarketCacheRefresher type
@@ -33,6 +33,24 @@ func (_m *MarketCacheRefresher) InvalidateByIDs(ctx context.Context, ids []uuid.
 	return r0
 }
 
+// InvalidateBySymbols provides a mock function with given fields: ctx, symbols
+func (_m *MarketCacheRefresher) InvalidateBySymbols(ctx context.Context, symbols []string) error {
+	ret := _m.Called(ctx, symbols)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InvalidateBySymbols")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) error); ok {
+		r0 = rf(ctx, symbols)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RefreshAll provides a mock function with given fields: ctx
 func (_m *MarketCacheRefresher) RefreshAll(ctx context.Context) error {
 	ret := _m.Called(ctx)
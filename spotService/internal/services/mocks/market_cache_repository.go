@@ -16,9 +16,9 @@ type MarketCacheRepository struct {
 	mock.Mock
 }
 
-// DeleteMarkets provides a mock function with given fields: ctx, roleKey
-func (_m *MarketCacheRepository) DeleteMarkets(ctx context.Context, roleKey string) error {
-	ret := _m.Called(ctx, roleKey)
+// DeleteMarkets provides a mock function with given fields: ctx, policyID
+func (_m *MarketCacheRepository) DeleteMarkets(ctx context.Context, policyID string) error {
+	ret := _m.Called(ctx, policyID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteMarkets")
@@ -26,7 +26,7 @@ func (_m *MarketCacheRepository) DeleteMarkets(ctx context.Context, roleKey stri
 
 	var r0 error
 	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(ctx, roleKey)
+		r0 = rf(ctx, policyID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -34,9 +34,9 @@ func (_m *MarketCacheRepository) DeleteMarkets(ctx context.Context, roleKey stri
 	return r0
 }
 
-// GetMarkets provides a mock function with given fields: ctx, roleKey
-func (_m *MarketCacheRepository) GetMarkets(ctx context.Context, roleKey string) ([]models.Market, error) {
-	ret := _m.Called(ctx, roleKey)
+// GetMarkets provides a mock function with given fields: ctx, policyID
+func (_m *MarketCacheRepository) GetMarkets(ctx context.Context, policyID string) ([]models.Market, error) {
+	ret := _m.Called(ctx, policyID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetMarkets")
@@ -45,10 +45,10 @@ func (_m *MarketCacheRepository) GetMarkets(ctx context.Context, roleKey string)
 	var r0 []models.Market
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.Market, error)); ok {
-		return rf(ctx, roleKey)
+		return rf(ctx, policyID)
 	}
 	if rf, ok := ret.Get(0).(func(context.Context, string) []models.Market); ok {
-		r0 = rf(ctx, roleKey)
+		r0 = rf(ctx, policyID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.Market)
@@ -56,7 +56,7 @@ func (_m *MarketCacheRepository) GetMarkets(ctx context.Context, roleKey string)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, roleKey)
+		r1 = rf(ctx, policyID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -64,9 +64,9 @@ func (_m *MarketCacheRepository) GetMarkets(ctx context.Context, roleKey string)
 	return r0, r1
 }
 
-// SetMarkets provides a mock function with given fields: ctx, market, roleKey, ttl
-func (_m *MarketCacheRepository) SetMarkets(ctx context.Context, market []models.Market, roleKey string, ttl time.Duration) error {
-	ret := _m.Called(ctx, market, roleKey, ttl)
+// SetMarkets provides a mock function with given fields: ctx, market, policyID, ttl
+func (_m *MarketCacheRepository) SetMarkets(ctx context.Context, market []models.Market, policyID string, ttl time.Duration) error {
+	ret := _m.Called(ctx, market, policyID, ttl)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SetMarkets")
@@ -74,7 +74,7 @@ func (_m *MarketCacheRepository) SetMarkets(ctx context.Context, market []models
 
 	var r0 error
 	if rf, ok := ret.Get(0).(func(context.Context, []models.Market, string, time.Duration) error); ok {
-		r0 = rf(ctx, market, roleKey, ttl)
+		r0 = rf(ctx, market, policyID, ttl)
 	} else {
 		r0 = ret.Error(0)
 	}
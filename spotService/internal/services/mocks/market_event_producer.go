@@ -16,16 +16,16 @@ type MarketEventProducer struct {
 	mock.Mock
 }
 
-// PublishMarketStateChanged provides a mock function with given fields: ctx, events, cursor
-func (_m *MarketEventProducer) PublishMarketStateChanged(ctx context.Context, events []models.MarketStateChangedEvent, cursor domainmodels.PollerCursor) error {
+// PublishMarketUpdated provides a mock function with given fields: ctx, events, cursor
+func (_m *MarketEventProducer) PublishMarketUpdated(ctx context.Context, events []models.MarketUpdatedEvent, cursor domainmodels.PollerCursor) error {
 	ret := _m.Called(ctx, events, cursor)
 
 	if len(ret) == 0 {
-		panic("no return value specified for PublishMarketStateChanged")
+		panic("no return value specified for PublishMarketUpdated")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, []models.MarketStateChangedEvent, domainmodels.PollerCursor) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, []models.MarketUpdatedEvent, domainmodels.PollerCursor) error); ok {
 		r0 = rf(ctx, events, cursor)
 	} else {
 		r0 = ret.Error(0)
@@ -5,12 +5,9 @@ package mocks
 import (
 	context "context"
 
-	models "github.com/nastyazhadan/spot-order-grpc/shared/models"
-	mock "github.com/stretchr/testify/mock"
-
-	time "time"
+	models "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
 
-	uuid "github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // MarketReader is an autogenerated mock type for the MarketReader type
@@ -18,29 +15,29 @@ type MarketReader struct {
 	mock.Mock
 }
 
-// ListUpdatedSince provides a mock function with given fields: ctx, since, afterID, limit
-func (_m *MarketReader) ListUpdatedSince(ctx context.Context, since time.Time, afterID uuid.UUID, limit int) ([]models.Market, error) {
-	ret := _m.Called(ctx, since, afterID, limit)
+// ListChangesAfter provides a mock function with given fields: ctx, afterSeq, limit
+func (_m *MarketReader) ListChangesAfter(ctx context.Context, afterSeq int64, limit int) ([]models.MarketChangeLogEntry, error) {
+	ret := _m.Called(ctx, afterSeq, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListUpdatedSince")
+		panic("no return value specified for ListChangesAfter")
 	}
 
-	var r0 []models.Market
+	var r0 []models.MarketChangeLogEntry
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, time.Time, uuid.UUID, int) ([]models.Market, error)); ok {
-		return rf(ctx, since, afterID, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int) ([]models.MarketChangeLogEntry, error)); ok {
+		return rf(ctx, afterSeq, limit)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, time.Time, uuid.UUID, int) []models.Market); ok {
-		r0 = rf(ctx, since, afterID, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int) []models.MarketChangeLogEntry); ok {
+		r0 = rf(ctx, afterSeq, limit)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Market)
+			r0 = ret.Get(0).([]models.MarketChangeLogEntry)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, time.Time, uuid.UUID, int) error); ok {
-		r1 = rf(ctx, since, afterID, limit)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int) error); ok {
+		r1 = rf(ctx, afterSeq, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
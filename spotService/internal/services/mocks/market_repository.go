@@ -5,10 +5,13 @@ package mocks
 import (
 	context "context"
 
+	uuid "github.com/google/uuid"
+
 	models "github.com/nastyazhadan/spot-order-grpc/shared/models"
-	mock "github.com/stretchr/testify/mock"
 
-	uuid "github.com/google/uuid"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+
+	mock "github.com/stretchr/testify/mock"
 )
 
 // MarketRepository is an autogenerated mock type for the MarketRepository type
@@ -44,9 +47,67 @@ func (_m *MarketRepository) GetMarketByID(ctx context.Context, id uuid.UUID) (mo
 	return r0, r1
 }
 
-// GetMarketsPage provides a mock function with given fields: ctx, roleKey, limit, offset
-func (_m *MarketRepository) GetMarketsPage(ctx context.Context, roleKey string, limit uint64, offset uint64) ([]models.Market, error) {
-	ret := _m.Called(ctx, roleKey, limit, offset)
+// GetMarketBySymbol provides a mock function with given fields: ctx, symbol
+func (_m *MarketRepository) GetMarketBySymbol(ctx context.Context, symbol string) (models.Market, error) {
+	ret := _m.Called(ctx, symbol)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMarketBySymbol")
+	}
+
+	var r0 models.Market
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.Market, error)); ok {
+		return rf(ctx, symbol)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.Market); ok {
+		r0 = rf(ctx, symbol)
+	} else {
+		r0 = ret.Get(0).(models.Market)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, symbol)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMarketsByIDs provides a mock function with given fields: ctx, ids
+func (_m *MarketRepository) GetMarketsByIDs(ctx context.Context, ids []uuid.UUID) ([]models.Market, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMarketsByIDs")
+	}
+
+	var r0 []models.Market
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]models.Market, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []models.Market); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Market)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMarketsPage provides a mock function with given fields: ctx, policy, access, filter, after, limit
+func (_m *MarketRepository) GetMarketsPage(ctx context.Context, policy domainModels.VisibilityPolicy, access domainModels.MarketAccess, filter models.MarketFilter, after *domainModels.MarketPageKey, limit uint64) ([]models.Market, error) {
+	ret := _m.Called(ctx, policy, access, filter, after, limit)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetMarketsPage")
@@ -54,19 +115,49 @@ func (_m *MarketRepository) GetMarketsPage(ctx context.Context, roleKey string,
 
 	var r0 []models.Market
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, uint64, uint64) ([]models.Market, error)); ok {
-		return rf(ctx, roleKey, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, domainModels.VisibilityPolicy, domainModels.MarketAccess, models.MarketFilter, *domainModels.MarketPageKey, uint64) ([]models.Market, error)); ok {
+		return rf(ctx, policy, access, filter, after, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domainModels.VisibilityPolicy, domainModels.MarketAccess, models.MarketFilter, *domainModels.MarketPageKey, uint64) []models.Market); ok {
+		r0 = rf(ctx, policy, access, filter, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Market)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domainModels.VisibilityPolicy, domainModels.MarketAccess, models.MarketFilter, *domainModels.MarketPageKey, uint64) error); ok {
+		r1 = rf(ctx, policy, access, filter, after, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMarketsPageByOffset provides a mock function with given fields: ctx, policy, access, filter, offset, limit
+func (_m *MarketRepository) GetMarketsPageByOffset(ctx context.Context, policy domainModels.VisibilityPolicy, access domainModels.MarketAccess, filter models.MarketFilter, offset uint64, limit uint64) ([]models.Market, error) {
+	ret := _m.Called(ctx, policy, access, filter, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMarketsPageByOffset")
+	}
+
+	var r0 []models.Market
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domainModels.VisibilityPolicy, domainModels.MarketAccess, models.MarketFilter, uint64, uint64) ([]models.Market, error)); ok {
+		return rf(ctx, policy, access, filter, offset, limit)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, uint64, uint64) []models.Market); ok {
-		r0 = rf(ctx, roleKey, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, domainModels.VisibilityPolicy, domainModels.MarketAccess, models.MarketFilter, uint64, uint64) []models.Market); ok {
+		r0 = rf(ctx, policy, access, filter, offset, limit)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.Market)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, uint64, uint64) error); ok {
-		r1 = rf(ctx, roleKey, limit, offset)
+	if rf, ok := ret.Get(1).(func(context.Context, domainModels.VisibilityPolicy, domainModels.MarketAccess, models.MarketFilter, uint64, uint64) error); ok {
+		r1 = rf(ctx, policy, access, filter, offset, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
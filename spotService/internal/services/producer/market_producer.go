@@ -43,20 +43,20 @@ func New(writer OutboxWriter, store CursorStore, logger *zapLogger.Logger, cfg c
 	}
 }
 
-// PublishMarketStateChanged не публикует напрямую в Kafka - это делает outbox.Worker асинхронно.
-func (p *MarketProducer) PublishMarketStateChanged(
+// PublishMarketUpdated не публикует напрямую в Kafka - это делает outbox.Worker асинхронно.
+func (p *MarketProducer) PublishMarketUpdated(
 	ctx context.Context,
-	events []sharedModels.MarketStateChangedEvent,
+	events []sharedModels.MarketUpdatedEvent,
 	cursor models.PollerCursor,
 ) error {
-	const op = "MarketProducer.PublishMarketStateChanged"
+	const op = "MarketProducer.PublishMarketUpdated"
 
 	if len(events) == 0 {
 		// Сохраняем курсор даже если событие не опубликовано
 		return p.saveCursor(ctx, cursor)
 	}
 
-	ctx, span := tracing.StartSpan(ctx, "producer.publish_market_state_changed")
+	ctx, span := tracing.StartSpan(ctx, "producer.publish_market_updated")
 	defer span.End()
 
 	transaction, err := p.outboxWriter.BeginTransaction(ctx)
@@ -96,10 +96,9 @@ func (p *MarketProducer) PublishMarketStateChanged(
 	}
 
 	committed = true
-	p.logger.Info(ctx, "MarketStateChangedEvent saved to outbox with cursor",
+	p.logger.Info(ctx, "MarketUpdatedEvent saved to outbox with cursor",
 		zap.Int("events_count", len(events)),
-		zap.Time("last_seen_at", cursor.LastSeenAt),
-		zap.String("last_seen_id", cursor.LastSeenID.String()),
+		zap.Int64("last_seq", cursor.LastSeq),
 		zap.String("poller_name", cursor.PollerName),
 	)
 
@@ -109,12 +108,12 @@ func (p *MarketProducer) PublishMarketStateChanged(
 func (p *MarketProducer) buildOutboxEvent(
 	ctx context.Context,
 	span trace.Span,
-	event sharedModels.MarketStateChangedEvent,
+	event sharedModels.MarketUpdatedEvent,
 ) (models.OutboxEvent, error) {
 	payload, err := mapper.Marshal(event)
 	if err != nil {
 		tracing.RecordError(span, err)
-		p.logger.Error(ctx, "Failed to marshal MarketStateChangedEvent",
+		p.logger.Error(ctx, "Failed to marshal MarketUpdatedEvent",
 			zap.String("market_id", event.MarketID.String()),
 			zap.String("event_id", event.EventID.String()),
 			zap.Error(err),
@@ -126,7 +125,7 @@ func (p *MarketProducer) buildOutboxEvent(
 	return models.OutboxEvent{
 		ID:          uuid.New(),
 		EventID:     event.EventID,
-		EventType:   sharedModels.MarketStateChangedEventType,
+		EventType:   sharedModels.MarketUpdatedEventType,
 		AggregateID: event.MarketID,
 		Payload:     payload,
 		Status:      models.OutboxEventStatusPending,
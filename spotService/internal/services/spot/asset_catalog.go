@@ -0,0 +1,144 @@
+package spot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	sharedErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors"
+	repositoryErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/repository"
+	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/otel/attributes"
+	zapLogger "github.com/nastyazhadan/spot-order-grpc/shared/interceptors/logging/zap"
+	"github.com/nastyazhadan/spot-order-grpc/shared/interceptors/tracing"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+)
+
+type AssetRepository interface {
+	ListAssets(ctx context.Context, includeDisabled bool) ([]models.Asset, error)
+	CreateAsset(ctx context.Context, asset models.Asset) (models.Asset, error)
+	UpdateAsset(ctx context.Context, update models.AssetUpdate) (models.Asset, int64, error)
+}
+
+// AssetCatalog — справочник активов. Выключение актива каскадно выключает
+// его рынки в PostgreSQL, дальше изменения рынков разносит MarketPoller.
+// Видимость справочника задаёт та же политика, что и видимость рынков.
+type AssetCatalog struct {
+	assetRepository AssetRepository
+	policies        domainModels.VisibilityPolicies
+	serviceTimeout  time.Duration
+	logger          *zapLogger.Logger
+}
+
+func NewAssetCatalog(
+	repo AssetRepository,
+	policies domainModels.VisibilityPolicies,
+	timeout time.Duration,
+	logger *zapLogger.Logger,
+) *AssetCatalog {
+	return &AssetCatalog{
+		assetRepository: repo,
+		policies:        policies,
+		serviceTimeout:  timeout,
+		logger:          logger,
+	}
+}
+
+func (s *AssetCatalog) ListAssets(ctx context.Context) ([]models.Asset, error) {
+	const op = "AssetCatalog.ListAssets"
+
+	ctx, cancel := contextWithTimeout(ctx, s.serviceTimeout)
+	defer cancel()
+
+	ctx, span := tracing.StartSpan(ctx, "spot.list_assets")
+	defer span.End()
+
+	policy, err := resolveVisibilityPolicy(ctx, s.policies)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	span.SetAttributes(attributes.VisibilityPolicyValue(policy.ID))
+
+	// Выключенные активы видны тем, кому политика показывает выключенные рынки
+	assets, err := s.assetRepository.ListAssets(ctx, policy.AllowsStatus(models.MarketStatusDisabled))
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	visible := assets[:0]
+	for _, asset := range assets {
+		if policy.AllowsAsset(asset.Code) {
+			visible = append(visible, asset)
+		}
+	}
+
+	return visible, nil
+}
+
+func (s *AssetCatalog) CreateAsset(ctx context.Context, asset models.Asset) (models.Asset, error) {
+	const op = "AssetCatalog.CreateAsset"
+
+	ctx, cancel := contextWithTimeout(ctx, s.serviceTimeout)
+	defer cancel()
+
+	ctx, span := tracing.StartSpan(ctx, "spot.create_asset",
+		trace.WithAttributes(attributes.AssetCodeValue(asset.Code)),
+	)
+	defer span.End()
+
+	created, err := s.assetRepository.CreateAsset(ctx, asset)
+	if err != nil {
+		if errors.Is(err, repositoryErrors.ErrAssetAlreadyExists) {
+			return models.Asset{}, sharedErrors.ErrAssetAlreadyExists{Code: asset.Code}
+		}
+
+		tracing.RecordError(span, err)
+		return models.Asset{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	s.logger.Info(ctx, "Asset created",
+		zap.String("asset_code", created.Code),
+		zap.Bool("enabled", created.Enabled),
+	)
+
+	return created, nil
+}
+
+// UpdateAsset возвращает обновлённый актив и число рынков, выключенных вместе с ним.
+// Повторное включение актива рынки не включает: их прежнее состояние неизвестно.
+func (s *AssetCatalog) UpdateAsset(ctx context.Context, update models.AssetUpdate) (models.Asset, int64, error) {
+	const op = "AssetCatalog.UpdateAsset"
+
+	ctx, cancel := contextWithTimeout(ctx, s.serviceTimeout)
+	defer cancel()
+
+	ctx, span := tracing.StartSpan(ctx, "spot.update_asset",
+		trace.WithAttributes(attributes.AssetCodeValue(update.Code)),
+	)
+	defer span.End()
+
+	asset, disabledMarkets, err := s.assetRepository.UpdateAsset(ctx, update)
+	if err != nil {
+		if errors.Is(err, repositoryErrors.ErrAssetNotFound) {
+			return models.Asset{}, 0, sharedErrors.ErrAssetNotFound{Code: update.Code}
+		}
+
+		tracing.RecordError(span, err)
+		return models.Asset{}, 0, fmt.Errorf("%s: %w", op, err)
+	}
+	span.SetAttributes(attributes.MarketsCountValue(int(disabledMarkets)))
+
+	s.logger.Info(ctx, "Asset updated",
+		zap.String("asset_code", asset.Code),
+		zap.Bool("enabled", asset.Enabled),
+		zap.Int64("disabled_markets", disabledMarkets),
+	)
+
+	return asset, disabledMarkets, nil
+}
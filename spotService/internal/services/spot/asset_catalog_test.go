@@ -0,0 +1,256 @@
+package spot
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	sharedErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors"
+	repositoryErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/repository"
+	serviceErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/service"
+	zapLogger "github.com/nastyazhadan/spot-order-grpc/shared/interceptors/logging/zap"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+	"github.com/nastyazhadan/spot-order-grpc/spotService/internal/services/mocks"
+)
+
+func newTestAssetCatalog(repo *mocks.AssetRepository) *AssetCatalog {
+	return NewAssetCatalog(repo, testVisibilityPolicies, testTimeout, zapLogger.NewNop())
+}
+
+func TestListAssets(t *testing.T) {
+	assets := []models.Asset{
+		{Code: "BTC", Name: "Bitcoin", Precision: 8, Enabled: true},
+		{Code: "XRP", Name: "Ripple", Precision: 6, Enabled: false},
+	}
+
+	// Как в config.yaml: ROLE_SERVICE входит в политику admin
+	servicePolicies := domainModels.VisibilityPolicies{
+		domainModels.NewVisibilityPolicy(
+			roleAdminKey,
+			[]models.UserRole{models.UserRoleAdmin, models.UserRoleService},
+			[]models.MarketStatus{models.MarketStatusEnabled, models.MarketStatusDisabled, models.MarketStatusDeleted},
+			nil, nil, true,
+		),
+		testUserPolicy,
+	}
+	btcOnlyPolicies := domainModels.VisibilityPolicies{
+		domainModels.NewVisibilityPolicy(
+			roleUserKey,
+			[]models.UserRole{models.UserRoleUser},
+			[]models.MarketStatus{models.MarketStatusEnabled, models.MarketStatusDisabled},
+			[]string{"BTC"}, []string{"BTC"}, false,
+		),
+	}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		policies   domainModels.VisibilityPolicies
+		setupMocks func(repo *mocks.AssetRepository)
+		wantLen    int
+		wantErr    error
+	}{
+		{
+			name:       "нет роли в контексте — ErrUserRoleNotSpecified",
+			ctx:        context.Background(),
+			setupMocks: func(_ *mocks.AssetRepository) {},
+			wantErr:    serviceErrors.ErrUserRoleNotSpecified,
+		},
+		{
+			name: "admin — выключенные активы включаются в выдачу",
+			ctx:  ctxWithRoles(models.UserRoleAdmin),
+			setupMocks: func(repo *mocks.AssetRepository) {
+				repo.On("ListAssets", mock.Anything, true).Return(assets, nil).Once()
+			},
+			wantLen: 2,
+		},
+		{
+			name: "viewer — выключенные активы включаются в выдачу",
+			ctx:  ctxWithRoles(models.UserRoleViewer),
+			setupMocks: func(repo *mocks.AssetRepository) {
+				repo.On("ListAssets", mock.Anything, true).Return(assets, nil).Once()
+			},
+			wantLen: 2,
+		},
+		{
+			name: "user — только включённые активы",
+			ctx:  ctxWithRoles(models.UserRoleUser),
+			setupMocks: func(repo *mocks.AssetRepository) {
+				repo.On("ListAssets", mock.Anything, false).Return(assets[:1], nil).Once()
+			},
+			wantLen: 1,
+		},
+		{
+			name:     "service — видимость по политике admin",
+			ctx:      ctxWithRoles(models.UserRoleService),
+			policies: servicePolicies,
+			setupMocks: func(repo *mocks.AssetRepository) {
+				repo.On("ListAssets", mock.Anything, true).Return(assets, nil).Once()
+			},
+			wantLen: 2,
+		},
+		{
+			name:     "allowlist политики — активы вне списка скрыты",
+			ctx:      ctxWithRoles(models.UserRoleUser),
+			policies: btcOnlyPolicies,
+			setupMocks: func(repo *mocks.AssetRepository) {
+				repo.On("ListAssets", mock.Anything, true).Return(assets, nil).Once()
+			},
+			wantLen: 1,
+		},
+		{
+			name:       "нет подходящей политики — ErrUserRoleNotSpecified",
+			ctx:        ctxWithRoles(models.UserRoleViewer),
+			policies:   btcOnlyPolicies,
+			setupMocks: func(_ *mocks.AssetRepository) {},
+			wantErr:    serviceErrors.ErrUserRoleNotSpecified,
+		},
+		{
+			name: "ошибка репозитория — пробрасывается",
+			ctx:  ctxWithRoles(models.UserRoleAdmin),
+			setupMocks: func(repo *mocks.AssetRepository) {
+				repo.On("ListAssets", mock.Anything, true).Return(nil, context.DeadlineExceeded).Once()
+			},
+			wantErr: context.DeadlineExceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mocks.AssetRepository{}
+			tt.setupMocks(repo)
+
+			catalog := newTestAssetCatalog(repo)
+			if tt.policies != nil {
+				catalog.policies = tt.policies
+			}
+
+			got, err := catalog.ListAssets(tt.ctx)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Len(t, got, tt.wantLen)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCreateAsset(t *testing.T) {
+	asset := models.Asset{Code: "XRP", Name: "Ripple", Precision: 6, Enabled: true}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		setupMocks func(repo *mocks.AssetRepository)
+		checkErr   func(t *testing.T, err error)
+	}{
+		{
+			name: "admin — актив создаётся",
+			ctx:  ctxWithRoles(models.UserRoleAdmin),
+			setupMocks: func(repo *mocks.AssetRepository) {
+				repo.On("CreateAsset", mock.Anything, asset).Return(asset, nil).Once()
+			},
+			checkErr: func(t *testing.T, err error) { require.NoError(t, err) },
+		},
+		{
+			name: "код уже занят — ErrAssetAlreadyExists с кодом",
+			ctx:  ctxWithRoles(models.UserRoleAdmin),
+			setupMocks: func(repo *mocks.AssetRepository) {
+				repo.On("CreateAsset", mock.Anything, asset).
+					Return(models.Asset{}, repositoryErrors.ErrAssetAlreadyExists).Once()
+			},
+			checkErr: func(t *testing.T, err error) {
+				var exists sharedErrors.ErrAssetAlreadyExists
+				require.ErrorAs(t, err, &exists)
+				assert.Equal(t, "XRP", exists.Code)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mocks.AssetRepository{}
+			tt.setupMocks(repo)
+
+			_, err := newTestAssetCatalog(repo).CreateAsset(tt.ctx, asset)
+			tt.checkErr(t, err)
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdateAsset(t *testing.T) {
+	disabled := false
+	update := models.AssetUpdate{Code: "BTC", Enabled: &disabled}
+	updated := models.Asset{Code: "BTC", Name: "Bitcoin", Precision: 8, Enabled: false}
+
+	tests := []struct {
+		name                string
+		ctx                 context.Context
+		setupMocks          func(repo *mocks.AssetRepository)
+		wantDisabledMarkets int64
+		checkErr            func(t *testing.T, err error)
+	}{
+		{
+			name: "выключение актива — возвращается число выключенных рынков",
+			ctx:  ctxWithRoles(models.UserRoleAdmin),
+			setupMocks: func(repo *mocks.AssetRepository) {
+				repo.On("UpdateAsset", mock.Anything, update).Return(updated, int64(3), nil).Once()
+			},
+			wantDisabledMarkets: 3,
+			checkErr:            func(t *testing.T, err error) { require.NoError(t, err) },
+		},
+		{
+			name: "актив не найден — ErrAssetNotFound с кодом",
+			ctx:  ctxWithRoles(models.UserRoleAdmin),
+			setupMocks: func(repo *mocks.AssetRepository) {
+				repo.On("UpdateAsset", mock.Anything, update).
+					Return(models.Asset{}, int64(0), repositoryErrors.ErrAssetNotFound).Once()
+			},
+			checkErr: func(t *testing.T, err error) {
+				var notFound sharedErrors.ErrAssetNotFound
+				require.ErrorAs(t, err, &notFound)
+				assert.Equal(t, "BTC", notFound.Code)
+			},
+		},
+		{
+			name: "ошибка БД — пробрасывается",
+			ctx:  ctxWithRoles(models.UserRoleAdmin),
+			setupMocks: func(repo *mocks.AssetRepository) {
+				repo.On("UpdateAsset", mock.Anything, update).
+					Return(models.Asset{}, int64(0), errors.New("db down")).Once()
+			},
+			checkErr: func(t *testing.T, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "db down")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mocks.AssetRepository{}
+			tt.setupMocks(repo)
+
+			got, disabledMarkets, err := newTestAssetCatalog(repo).UpdateAsset(tt.ctx, update)
+			tt.checkErr(t, err)
+			if err == nil {
+				assert.Equal(t, updated, got)
+				assert.Equal(t, tt.wantDisabledMarkets, disabledMarkets)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package spot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/otel/attributes"
+	zapLogger "github.com/nastyazhadan/spot-order-grpc/shared/interceptors/logging/zap"
+	"github.com/nastyazhadan/spot-order-grpc/shared/interceptors/tracing"
+	"github.com/nastyazhadan/spot-order-grpc/shared/metrics"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+)
+
+type CandleWriter interface {
+	ApplyTrade(
+		ctx context.Context,
+		consumerGroup string,
+		trade domainModels.Trade,
+		intervals []domainModels.CandleInterval,
+	) (bool, error)
+}
+
+// CandleAggregator добавляет сделки из order.filled в свечи всех интервалов.
+// Повторная доставка события отсекается по event_id внутри той же транзакции, что и обновление свечей.
+type CandleAggregator struct {
+	writer         CandleWriter
+	intervals      []domainModels.CandleInterval
+	serviceTimeout time.Duration
+	serviceName    string
+	logger         *zapLogger.Logger
+}
+
+func NewCandleAggregator(
+	writer CandleWriter,
+	timeout time.Duration,
+	serviceName string,
+	logger *zapLogger.Logger,
+) *CandleAggregator {
+	return &CandleAggregator{
+		writer:         writer,
+		intervals:      domainModels.CandleIntervals,
+		serviceTimeout: timeout,
+		serviceName:    serviceName,
+		logger:         logger,
+	}
+}
+
+func (a *CandleAggregator) ProcessTrade(ctx context.Context, consumerGroup string, trade domainModels.Trade) error {
+	const op = "CandleAggregator.ProcessTrade"
+
+	ctx, cancel := contextWithTimeout(ctx, a.serviceTimeout)
+	defer cancel()
+
+	ctx, span := tracing.StartSpan(ctx, "spot.process_candle_trade",
+		trace.WithAttributes(
+			attributes.EventIDValue(trade.EventID.String()),
+			attributes.MarketIDValue(trade.MarketID.String()),
+			attributes.ConsumerGroupValue(consumerGroup),
+		),
+	)
+	defer span.End()
+
+	applied, err := a.writer.ApplyTrade(ctx, consumerGroup, trade, a.intervals)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !applied {
+		metrics.CandleTradesTotal.WithLabelValues(a.serviceName, "duplicate").Inc()
+		a.logger.Info(ctx, "Candle aggregator: trade already applied, skipping",
+			zap.String("event_id", trade.EventID.String()),
+			zap.String("market_id", trade.MarketID.String()),
+		)
+		return nil
+	}
+
+	metrics.CandleTradesTotal.WithLabelValues(a.serviceName, "applied").Inc()
+	return nil
+}
@@ -0,0 +1,68 @@
+package spot
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	zapLogger "github.com/nastyazhadan/spot-order-grpc/shared/interceptors/logging/zap"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+	"github.com/nastyazhadan/spot-order-grpc/spotService/internal/services/mocks"
+)
+
+const testConsumerGroup = "spot-service-candles"
+
+func TestCandleAggregatorProcessTrade(t *testing.T) {
+	trade := makeTrade(uuid.New(), "100", 2, tickerTestNow)
+	trade.EventID = uuid.New()
+
+	tests := []struct {
+		name       string
+		setupMocks func(writer *mocks.CandleWriter)
+		expectErr  bool
+	}{
+		{
+			name: "сделка применяется ко всем интервалам",
+			setupMocks: func(writer *mocks.CandleWriter) {
+				writer.On("ApplyTrade", mock.Anything, testConsumerGroup, trade, domainModels.CandleIntervals).
+					Return(true, nil).Once()
+			},
+		},
+		{
+			name: "повторное событие пропускается без ошибки",
+			setupMocks: func(writer *mocks.CandleWriter) {
+				writer.On("ApplyTrade", mock.Anything, testConsumerGroup, trade, domainModels.CandleIntervals).
+					Return(false, nil).Once()
+			},
+		},
+		{
+			name: "ошибка записи возвращается для ретрая",
+			setupMocks: func(writer *mocks.CandleWriter) {
+				writer.On("ApplyTrade", mock.Anything, testConsumerGroup, trade, domainModels.CandleIntervals).
+					Return(false, errors.New("db down")).Once()
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			writer := mocks.NewCandleWriter(t)
+			tt.setupMocks(writer)
+
+			aggregator := NewCandleAggregator(writer, testTimeout, "spot-service", zapLogger.NewNop())
+
+			err := aggregator.ProcessTrade(context.Background(), testConsumerGroup, trade)
+			if tt.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package spot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	serviceErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/service"
+	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/otel/attributes"
+	zapLogger "github.com/nastyazhadan/spot-order-grpc/shared/interceptors/logging/zap"
+	"github.com/nastyazhadan/spot-order-grpc/shared/interceptors/tracing"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+)
+
+type CandleRepository interface {
+	GetCandles(
+		ctx context.Context,
+		marketID uuid.UUID,
+		interval domainModels.CandleInterval,
+		from, to time.Time,
+		limit uint64,
+	) ([]domainModels.Candle, error)
+}
+
+// CandleMarketReader проверяет, что рынок виден вызывающему: свечи скрытого рынка не отдаются.
+type CandleMarketReader interface {
+	GetMarketByID(ctx context.Context, id uuid.UUID) (models.Market, error)
+}
+
+// CandleViewer отдаёт свечи, накопленные CandleAggregator.
+type CandleViewer struct {
+	candleRepository CandleRepository
+	marketReader     CandleMarketReader
+	serviceTimeout   time.Duration
+	defaultLimit     uint64
+	maxLimit         uint64
+	logger           *zapLogger.Logger
+}
+
+func NewCandleViewer(
+	repo CandleRepository,
+	marketReader CandleMarketReader,
+	timeout time.Duration,
+	defaultLimit, maxLimit uint64,
+	logger *zapLogger.Logger,
+) *CandleViewer {
+	return &CandleViewer{
+		candleRepository: repo,
+		marketReader:     marketReader,
+		serviceTimeout:   timeout,
+		defaultLimit:     defaultLimit,
+		maxLimit:         maxLimit,
+		logger:           logger,
+	}
+}
+
+// GetCandles возвращает свечи с open_time в [from, to) по возрастанию времени. from выравнивается
+// вниз по границе интервала, чтобы в выдачу попала свеча, внутри которой он лежит.
+// Интервалы без сделок пропускаются: свечи не дорисовываются.
+func (s *CandleViewer) GetCandles(
+	ctx context.Context,
+	marketID uuid.UUID,
+	interval domainModels.CandleInterval,
+	from, to time.Time,
+	limit uint64,
+	pageToken string,
+) ([]domainModels.Candle, string, bool, error) {
+	const op = "CandleViewer.GetCandles"
+
+	ctx, cancel := contextWithTimeout(ctx, s.serviceTimeout)
+	defer cancel()
+
+	ctx, span := tracing.StartSpan(ctx, "spot.get_candles",
+		trace.WithAttributes(
+			attributes.MarketIDValue(marketID.String()),
+			attribute.String("candle.interval", string(interval)),
+		),
+	)
+	defer span.End()
+
+	if !interval.Valid() {
+		err := fmt.Errorf("%w: unknown interval %q", serviceErrors.ErrInvalidCandleRequest, interval)
+		tracing.RecordError(span, err)
+		return nil, "", false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if from.IsZero() || to.IsZero() || !from.Before(to) {
+		err := fmt.Errorf("%w: from must be before to", serviceErrors.ErrInvalidCandleRequest)
+		tracing.RecordError(span, err)
+		return nil, "", false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	from = interval.OpenTime(from)
+	to = to.UTC()
+
+	start, err := decodeCandlePageToken(pageToken, candlePageTokenScope(marketID, interval, from, to), from, to)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, "", false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err = s.marketReader.GetMarketByID(ctx, marketID); err != nil {
+		tracing.RecordError(span, err)
+		return nil, "", false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	limit = normalizeLimit(limit, s.defaultLimit, s.maxLimit)
+
+	candles, err := s.candleRepository.GetCandles(ctx, marketID, interval, start, to, limit+1)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, "", false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	hasMore := uint64(len(candles)) > limit
+	if !hasMore {
+		return candles, "", false, nil
+	}
+
+	candles = candles[:limit]
+	next := candles[len(candles)-1].CloseTime()
+
+	return candles, encodeCandlePageToken(next, candlePageTokenScope(marketID, interval, from, to)), true, nil
+}
@@ -0,0 +1,218 @@
+package spot
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	serviceErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/service"
+	zapLogger "github.com/nastyazhadan/spot-order-grpc/shared/interceptors/logging/zap"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+	"github.com/nastyazhadan/spot-order-grpc/spotService/internal/services/mocks"
+)
+
+const (
+	testCandleDefaultLimit = 2
+	testCandleMaxLimit     = 3
+)
+
+func newTestCandleViewer(t *testing.T) (*CandleViewer, *mocks.CandleRepository, *mocks.CandleMarketReader) {
+	repo := mocks.NewCandleRepository(t)
+	marketReader := mocks.NewCandleMarketReader(t)
+
+	return NewCandleViewer(repo, marketReader, testTimeout, testCandleDefaultLimit, testCandleMaxLimit, zapLogger.NewNop()),
+		repo, marketReader
+}
+
+func makeCandle(marketID uuid.UUID, openTime time.Time) domainModels.Candle {
+	price := decimal.RequireFromString("100")
+
+	return domainModels.Candle{
+		MarketID:    marketID,
+		Interval:    domainModels.CandleInterval1m,
+		OpenTime:    openTime,
+		Open:        price,
+		High:        price,
+		Low:         price,
+		Close:       price,
+		Volume:      decimal.NewFromInt(1),
+		QuoteVolume: price,
+		TradesCount: 1,
+	}
+}
+
+func TestGetCandles(t *testing.T) {
+	marketID := uuid.New()
+	from := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	to := from.Add(time.Hour)
+
+	tests := []struct {
+		name       string
+		interval   domainModels.CandleInterval
+		from, to   time.Time
+		setupMocks func(repo *mocks.CandleRepository, marketReader *mocks.CandleMarketReader)
+		expected   int
+		hasMore    bool
+		expectErr  error
+	}{
+		{
+			name:     "последняя страница без токена",
+			interval: domainModels.CandleInterval1m,
+			from:     from,
+			to:       to,
+			setupMocks: func(repo *mocks.CandleRepository, marketReader *mocks.CandleMarketReader) {
+				marketReader.On("GetMarketByID", mock.Anything, marketID).
+					Return(models.Market{ID: marketID, Enabled: true}, nil).Once()
+				repo.On("GetCandles", mock.Anything, marketID, domainModels.CandleInterval1m, from, to, uint64(testCandleDefaultLimit+1)).
+					Return([]domainModels.Candle{makeCandle(marketID, from)}, nil).Once()
+			},
+			expected: 1,
+		},
+		{
+			name:     "from выравнивается по границе интервала",
+			interval: domainModels.CandleInterval1h,
+			from:     from.Add(25 * time.Minute),
+			to:       to,
+			setupMocks: func(repo *mocks.CandleRepository, marketReader *mocks.CandleMarketReader) {
+				marketReader.On("GetMarketByID", mock.Anything, marketID).
+					Return(models.Market{ID: marketID, Enabled: true}, nil).Once()
+				repo.On("GetCandles", mock.Anything, marketID, domainModels.CandleInterval1h, from, to, uint64(testCandleDefaultLimit+1)).
+					Return([]domainModels.Candle{}, nil).Once()
+			},
+			expected: 0,
+		},
+		{
+			name:     "неизвестный интервал",
+			interval: domainModels.CandleInterval("2m"),
+			from:     from,
+			to:       to,
+			setupMocks: func(_ *mocks.CandleRepository, _ *mocks.CandleMarketReader) {
+			},
+			expectErr: serviceErrors.ErrInvalidCandleRequest,
+		},
+		{
+			name:     "from не раньше to",
+			interval: domainModels.CandleInterval1m,
+			from:     to,
+			to:       from,
+			setupMocks: func(_ *mocks.CandleRepository, _ *mocks.CandleMarketReader) {
+			},
+			expectErr: serviceErrors.ErrInvalidCandleRequest,
+		},
+		{
+			name:     "рынок скрыт от вызывающего — свечи не читаются",
+			interval: domainModels.CandleInterval1m,
+			from:     from,
+			to:       to,
+			setupMocks: func(_ *mocks.CandleRepository, marketReader *mocks.CandleMarketReader) {
+				marketReader.On("GetMarketByID", mock.Anything, marketID).
+					Return(models.Market{}, serviceErrors.ErrMarketNotFound).Once()
+			},
+			expectErr: serviceErrors.ErrMarketNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			viewer, repo, marketReader := newTestCandleViewer(t)
+			tt.setupMocks(repo, marketReader)
+
+			candles, nextPageToken, hasMore, err := viewer.GetCandles(
+				context.Background(), marketID, tt.interval, tt.from, tt.to, 0, "",
+			)
+			if tt.expectErr != nil {
+				require.ErrorIs(t, err, tt.expectErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Len(t, candles, tt.expected)
+			assert.Equal(t, tt.hasMore, hasMore)
+			assert.Empty(t, nextPageToken)
+		})
+	}
+}
+
+func TestGetCandlesPagination(t *testing.T) {
+	marketID := uuid.New()
+	from := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	to := from.Add(time.Hour)
+
+	viewer, repo, marketReader := newTestCandleViewer(t)
+	marketReader.On("GetMarketByID", mock.Anything, marketID).
+		Return(models.Market{ID: marketID, Enabled: true}, nil)
+
+	// Между свечами пропуск: следующая страница начинается сразу после последней отданной свечи
+	repo.On("GetCandles", mock.Anything, marketID, domainModels.CandleInterval1m, from, to, uint64(testCandleDefaultLimit+1)).
+		Return([]domainModels.Candle{
+			makeCandle(marketID, from),
+			makeCandle(marketID, from.Add(5*time.Minute)),
+			makeCandle(marketID, from.Add(9*time.Minute)),
+		}, nil).Once()
+	repo.On("GetCandles", mock.Anything, marketID, domainModels.CandleInterval1m, from.Add(6*time.Minute), to, uint64(testCandleDefaultLimit+1)).
+		Return([]domainModels.Candle{makeCandle(marketID, from.Add(9*time.Minute))}, nil).Once()
+
+	firstPage, token, hasMore, err := viewer.GetCandles(context.Background(), marketID, domainModels.CandleInterval1m, from, to, 0, "")
+	require.NoError(t, err)
+	require.True(t, hasMore)
+	require.Len(t, firstPage, 2)
+	require.NotEmpty(t, token)
+
+	secondPage, token, hasMore, err := viewer.GetCandles(context.Background(), marketID, domainModels.CandleInterval1m, from, to, 0, token)
+	require.NoError(t, err)
+	assert.False(t, hasMore)
+	assert.Empty(t, token)
+	require.Len(t, secondPage, 1)
+	assert.Equal(t, from.Add(9*time.Minute), secondPage[0].OpenTime)
+}
+
+func TestGetCandlesPageTokenMismatch(t *testing.T) {
+	marketID := uuid.New()
+	from := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	to := from.Add(time.Hour)
+
+	token := encodeCandlePageToken(from.Add(time.Minute), candlePageTokenScope(marketID, domainModels.CandleInterval1m, from, to))
+
+	tests := []struct {
+		name     string
+		interval domainModels.CandleInterval
+		to       time.Time
+		token    string
+	}{
+		{
+			name:     "токен другого интервала",
+			interval: domainModels.CandleInterval5m,
+			to:       to,
+			token:    token,
+		},
+		{
+			name:     "токен другого диапазона",
+			interval: domainModels.CandleInterval1m,
+			to:       to.Add(time.Hour),
+			token:    token,
+		},
+		{
+			name:     "битый токен",
+			interval: domainModels.CandleInterval1m,
+			to:       to,
+			token:    "not-a-token",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			viewer, _, _ := newTestCandleViewer(t)
+
+			_, _, _, err := viewer.GetCandles(context.Background(), marketID, tt.interval, from, tt.to, 0, tt.token)
+
+			require.ErrorIs(t, err, serviceErrors.ErrInvalidPagination)
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package spot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	sharedErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors"
+	repositoryErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/repository"
+	serviceErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/service"
+	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/otel/attributes"
+	zapLogger "github.com/nastyazhadan/spot-order-grpc/shared/interceptors/logging/zap"
+	"github.com/nastyazhadan/spot-order-grpc/shared/interceptors/tracing"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+)
+
+type MarketAccessRepository interface {
+	SetMarketRestricted(ctx context.Context, id uuid.UUID, restricted bool) (models.Market, error)
+	GrantMarketAccess(ctx context.Context, grant models.MarketAccessGrant) (models.MarketAccessGrant, error)
+	RevokeMarketAccess(
+		ctx context.Context,
+		marketID uuid.UUID,
+		subjectType models.MarketAccessSubjectType,
+		subjectID string,
+	) (bool, error)
+	ListMarketAccess(ctx context.Context, marketID uuid.UUID) ([]models.MarketAccessGrant, error)
+}
+
+// MarketAccessManager — списки доступа к restricted-рынкам. Право markets:admin проверяет перехватчик authz.
+// Записи market_access не кэшируются, поэтому выдача и отзыв действуют сразу;
+// смена флага restricted расходится через MarketPoller, как любое изменение рынка.
+type MarketAccessManager struct {
+	accessRepository MarketAccessRepository
+	serviceTimeout   time.Duration
+	logger           *zapLogger.Logger
+}
+
+func NewMarketAccessManager(
+	repo MarketAccessRepository,
+	timeout time.Duration,
+	logger *zapLogger.Logger,
+) *MarketAccessManager {
+	return &MarketAccessManager{
+		accessRepository: repo,
+		serviceTimeout:   timeout,
+		logger:           logger,
+	}
+}
+
+func (s *MarketAccessManager) SetMarketRestricted(
+	ctx context.Context,
+	id uuid.UUID,
+	restricted bool,
+) (models.Market, error) {
+	const op = "MarketAccessManager.SetMarketRestricted"
+
+	ctx, cancel := contextWithTimeout(ctx, s.serviceTimeout)
+	defer cancel()
+
+	ctx, span := tracing.StartSpan(ctx, "spot.set_market_restricted",
+		trace.WithAttributes(attributes.MarketIDValue(id.String())),
+	)
+	defer span.End()
+
+	market, err := s.accessRepository.SetMarketRestricted(ctx, id, restricted)
+	if err != nil {
+		if errors.Is(err, repositoryErrors.ErrMarketNotFound) {
+			return models.Market{}, sharedErrors.ErrMarketNotFound{ID: id}
+		}
+
+		tracing.RecordError(span, err)
+		return models.Market{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	s.logger.Info(ctx, "Market restriction changed",
+		zap.String("market_id", id.String()),
+		zap.Bool("restricted", market.Restricted),
+		zap.Int64("version", market.Version),
+	)
+
+	return market, nil
+}
+
+func (s *MarketAccessManager) GrantMarketAccess(
+	ctx context.Context,
+	grant models.MarketAccessGrant,
+) (models.MarketAccessGrant, error) {
+	const op = "MarketAccessManager.GrantMarketAccess"
+
+	ctx, cancel := contextWithTimeout(ctx, s.serviceTimeout)
+	defer cancel()
+
+	ctx, span := tracing.StartSpan(ctx, "spot.grant_market_access",
+		trace.WithAttributes(attributes.MarketIDValue(grant.MarketID.String())),
+	)
+	defer span.End()
+
+	subjectID, err := normalizeAccessSubject(grant.SubjectType, grant.SubjectID)
+	if err != nil {
+		return models.MarketAccessGrant{}, fmt.Errorf("%s: %w", op, err)
+	}
+	grant.SubjectID = subjectID
+
+	created, err := s.accessRepository.GrantMarketAccess(ctx, grant)
+	if err != nil {
+		if errors.Is(err, repositoryErrors.ErrMarketNotFound) {
+			return models.MarketAccessGrant{}, sharedErrors.ErrMarketNotFound{ID: grant.MarketID}
+		}
+
+		tracing.RecordError(span, err)
+		return models.MarketAccessGrant{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	s.logger.Info(ctx, "Market access granted",
+		zap.String("market_id", created.MarketID.String()),
+		zap.String("subject_type", string(created.SubjectType)),
+		zap.String("subject_id", created.SubjectID),
+	)
+
+	return created, nil
+}
+
+// RevokeMarketAccess возвращает false, если записи не было.
+func (s *MarketAccessManager) RevokeMarketAccess(
+	ctx context.Context,
+	marketID uuid.UUID,
+	subjectType models.MarketAccessSubjectType,
+	subjectID string,
+) (bool, error) {
+	const op = "MarketAccessManager.RevokeMarketAccess"
+
+	ctx, cancel := contextWithTimeout(ctx, s.serviceTimeout)
+	defer cancel()
+
+	ctx, span := tracing.StartSpan(ctx, "spot.revoke_market_access",
+		trace.WithAttributes(attributes.MarketIDValue(marketID.String())),
+	)
+	defer span.End()
+
+	subjectID, err := normalizeAccessSubject(subjectType, subjectID)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	revoked, err := s.accessRepository.RevokeMarketAccess(ctx, marketID, subjectType, subjectID)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if revoked {
+		s.logger.Info(ctx, "Market access revoked",
+			zap.String("market_id", marketID.String()),
+			zap.String("subject_type", string(subjectType)),
+			zap.String("subject_id", subjectID),
+		)
+	}
+
+	return revoked, nil
+}
+
+func (s *MarketAccessManager) ListMarketAccess(
+	ctx context.Context,
+	marketID uuid.UUID,
+) ([]models.MarketAccessGrant, error) {
+	const op = "MarketAccessManager.ListMarketAccess"
+
+	ctx, cancel := contextWithTimeout(ctx, s.serviceTimeout)
+	defer cancel()
+
+	ctx, span := tracing.StartSpan(ctx, "spot.list_market_access",
+		trace.WithAttributes(attributes.MarketIDValue(marketID.String())),
+	)
+	defer span.End()
+
+	grants, err := s.accessRepository.ListMarketAccess(ctx, marketID)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return grants, nil
+}
+
+// normalizeAccessSubject приводит субъект к виду, в котором его ищет ListGrantedMarketIDs:
+// user_id — каноничный UUID, роль — имя из JWT (ROLE_USER).
+func normalizeAccessSubject(subjectType models.MarketAccessSubjectType, subjectID string) (string, error) {
+	switch subjectType {
+	case models.MarketAccessSubjectUser:
+		userID, err := uuid.Parse(subjectID)
+		if err != nil || userID == uuid.Nil {
+			return "", serviceErrors.ErrInvalidMarketAccessSubject
+		}
+		return userID.String(), nil
+
+	case models.MarketAccessSubjectRole:
+		role, ok := models.ParseUserRole(subjectID)
+		if !ok {
+			return "", serviceErrors.ErrInvalidMarketAccessSubject
+		}
+		return role.String(), nil
+
+	default:
+		return "", serviceErrors.ErrInvalidMarketAccessSubject
+	}
+}
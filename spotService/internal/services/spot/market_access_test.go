@@ -0,0 +1,164 @@
+package spot
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	sharedErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors"
+	repositoryErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/repository"
+	serviceErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/service"
+	zapLogger "github.com/nastyazhadan/spot-order-grpc/shared/interceptors/logging/zap"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+	"github.com/nastyazhadan/spot-order-grpc/spotService/internal/services/mocks"
+)
+
+func newTestMarketAccessManager(repo *mocks.MarketAccessRepository) *MarketAccessManager {
+	return NewMarketAccessManager(repo, testTimeout, zapLogger.NewNop())
+}
+
+func TestGrantMarketAccess(t *testing.T) {
+	marketID := uuid.New()
+	userID := uuid.New()
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		grant      models.MarketAccessGrant
+		setupMocks func(repo *mocks.MarketAccessRepository)
+		wantErr    error
+		wantGrant  models.MarketAccessGrant
+	}{
+		{
+			name: "user_id не UUID — ErrInvalidMarketAccessSubject",
+			ctx:  ctxWithRoles(models.UserRoleAdmin),
+			grant: models.MarketAccessGrant{
+				MarketID: marketID, SubjectType: models.MarketAccessSubjectUser, SubjectID: "alice",
+			},
+			setupMocks: func(_ *mocks.MarketAccessRepository) {},
+			wantErr:    serviceErrors.ErrInvalidMarketAccessSubject,
+		},
+		{
+			name: "неизвестная роль — ErrInvalidMarketAccessSubject",
+			ctx:  ctxWithRoles(models.UserRoleAdmin),
+			grant: models.MarketAccessGrant{
+				MarketID: marketID, SubjectType: models.MarketAccessSubjectRole, SubjectID: "ROLE_BETA",
+			},
+			setupMocks: func(_ *mocks.MarketAccessRepository) {},
+			wantErr:    serviceErrors.ErrInvalidMarketAccessSubject,
+		},
+		{
+			name: "роль приводится к имени из JWT",
+			ctx:  ctxWithRoles(models.UserRoleAdmin),
+			grant: models.MarketAccessGrant{
+				MarketID: marketID, SubjectType: models.MarketAccessSubjectRole, SubjectID: " role_viewer ",
+			},
+			setupMocks: func(repo *mocks.MarketAccessRepository) {
+				expected := models.MarketAccessGrant{
+					MarketID: marketID, SubjectType: models.MarketAccessSubjectRole, SubjectID: "ROLE_VIEWER",
+				}
+				repo.On("GrantMarketAccess", mock.Anything, expected).Return(expected, nil).Once()
+			},
+			wantGrant: models.MarketAccessGrant{
+				MarketID: marketID, SubjectType: models.MarketAccessSubjectRole, SubjectID: "ROLE_VIEWER",
+			},
+		},
+		{
+			name: "рынок не найден — ErrMarketNotFound",
+			ctx:  ctxWithRoles(models.UserRoleAdmin),
+			grant: models.MarketAccessGrant{
+				MarketID: marketID, SubjectType: models.MarketAccessSubjectUser, SubjectID: userID.String(),
+			},
+			setupMocks: func(repo *mocks.MarketAccessRepository) {
+				repo.On("GrantMarketAccess", mock.Anything, mock.Anything).
+					Return(models.MarketAccessGrant{}, repositoryErrors.ErrMarketNotFound).Once()
+			},
+			wantErr: sharedErrors.ErrMarketNotFound{ID: marketID},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mocks.NewMarketAccessRepository(t)
+			tt.setupMocks(repo)
+
+			got, err := newTestMarketAccessManager(repo).GrantMarketAccess(tt.ctx, tt.grant)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantGrant, got)
+		})
+	}
+}
+
+func TestSetMarketRestricted(t *testing.T) {
+	marketID := uuid.New()
+	market := models.Market{ID: marketID, Name: "BETA-USDT", Enabled: true, Restricted: true, Version: 4}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		setupMocks func(repo *mocks.MarketAccessRepository)
+		wantErr    error
+	}{
+		{
+			name: "флаг выставляется",
+			ctx:  ctxWithRoles(models.UserRoleAdmin),
+			setupMocks: func(repo *mocks.MarketAccessRepository) {
+				repo.On("SetMarketRestricted", mock.Anything, marketID, true).Return(market, nil).Once()
+			},
+		},
+		{
+			name: "рынок не найден — ErrMarketNotFound",
+			ctx:  ctxWithRoles(models.UserRoleAdmin),
+			setupMocks: func(repo *mocks.MarketAccessRepository) {
+				repo.On("SetMarketRestricted", mock.Anything, marketID, true).
+					Return(models.Market{}, repositoryErrors.ErrMarketNotFound).Once()
+			},
+			wantErr: sharedErrors.ErrMarketNotFound{ID: marketID},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mocks.NewMarketAccessRepository(t)
+			tt.setupMocks(repo)
+
+			got, err := newTestMarketAccessManager(repo).SetMarketRestricted(tt.ctx, marketID, true)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, market, got)
+		})
+	}
+}
+
+func TestRevokeMarketAccess(t *testing.T) {
+	marketID := uuid.New()
+	userID := uuid.New()
+
+	repo := mocks.NewMarketAccessRepository(t)
+	repo.On("RevokeMarketAccess", mock.Anything, marketID, models.MarketAccessSubjectUser, userID.String()).
+		Return(false, nil).Once()
+
+	revoked, err := newTestMarketAccessManager(repo).RevokeMarketAccess(
+		ctxWithRoles(models.UserRoleAdmin), marketID, models.MarketAccessSubjectUser, userID.String(),
+	)
+
+	require.NoError(t, err)
+	assert.False(t, revoked, "записи не было")
+}
@@ -0,0 +1,94 @@
+package spot
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	zapLogger "github.com/nastyazhadan/spot-order-grpc/shared/interceptors/logging/zap"
+	"github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+)
+
+type MarketChangePublisher interface {
+	PublishMarketChanges(ctx context.Context, batch models.MarketChangeBatch) error
+}
+
+type MarketChangeSink interface {
+	NotifyMarketsChanged(batch models.MarketChangeBatch)
+	DisconnectAll(reason string)
+}
+
+// MarketChangeFeed доставляет батчи поллера в MarketWatchHub каждой реплики.
+// Поллер работает только на лидере и публикует батч в Redis; все реплики, включая лидера,
+// получают его из подписки. Пропуск батча виден по AfterSeq: тогда подписчики хаба
+// отключаются и догоняют изменения из PostgreSQL.
+type MarketChangeFeed struct {
+	publisher      MarketChangePublisher
+	sink           MarketChangeSink
+	publishTimeout time.Duration
+	logger         *zapLogger.Logger
+
+	mu      sync.Mutex
+	lastSeq int64
+}
+
+func NewMarketChangeFeed(
+	publisher MarketChangePublisher,
+	sink MarketChangeSink,
+	publishTimeout time.Duration,
+	logger *zapLogger.Logger,
+) *MarketChangeFeed {
+	return &MarketChangeFeed{
+		publisher:      publisher,
+		sink:           sink,
+		publishTimeout: publishTimeout,
+		logger:         logger,
+	}
+}
+
+// NotifyMarketsChanged вызывается поллером лидера после фиксации курсора.
+// Ошибка публикации не возвращается: получатели обнаружат пропуск по следующему батчу.
+func (f *MarketChangeFeed) NotifyMarketsChanged(ctx context.Context, batch models.MarketChangeBatch) {
+	publishCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), f.publishTimeout)
+	defer cancel()
+
+	if err := f.publisher.PublishMarketChanges(publishCtx, batch); err != nil {
+		f.logger.Warn(publishCtx, "Failed to publish market changes to watch feed",
+			zap.Int64("after_seq", batch.AfterSeq),
+			zap.Int64("last_seq", batch.LastSeq),
+			zap.Error(err),
+		)
+	}
+}
+
+// Reset вызывается после (пере)подписки на канал: что было опубликовано без подписки, неизвестно.
+func (f *MarketChangeFeed) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.lastSeq = 0
+	f.sink.DisconnectAll("feed_reset")
+}
+
+func (f *MarketChangeFeed) Deliver(batch models.MarketChangeBatch) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.lastSeq != 0 && batch.AfterSeq != f.lastSeq {
+		// Повтор уже доставленного отрезка журнала
+		if batch.LastSeq <= f.lastSeq {
+			return
+		}
+
+		f.logger.Warn(context.Background(), "Market watch feed gap detected, disconnecting subscribers",
+			zap.Int64("expected_after_seq", f.lastSeq),
+			zap.Int64("after_seq", batch.AfterSeq),
+		)
+		f.sink.DisconnectAll("feed_gap")
+	}
+
+	f.lastSeq = batch.LastSeq
+	f.sink.NotifyMarketsChanged(batch)
+}
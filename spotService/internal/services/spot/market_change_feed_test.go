@@ -0,0 +1,125 @@
+package spot
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+
+	zapLogger "github.com/nastyazhadan/spot-order-grpc/shared/interceptors/logging/zap"
+	sharedModels "github.com/nastyazhadan/spot-order-grpc/shared/models"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+	"github.com/nastyazhadan/spot-order-grpc/spotService/internal/services/mocks"
+)
+
+func newTestFeed(t *testing.T) (*MarketChangeFeed, *mocks.MarketChangePublisher, *mocks.MarketChangeSink) {
+	publisher := mocks.NewMarketChangePublisher(t)
+	sink := mocks.NewMarketChangeSink(t)
+
+	return NewMarketChangeFeed(publisher, sink, testTimeout, zapLogger.NewNop()), publisher, sink
+}
+
+func changeBatch(afterSeq, lastSeq int64, markets ...sharedModels.Market) domainModels.MarketChangeBatch {
+	return domainModels.MarketChangeBatch{AfterSeq: afterSeq, LastSeq: lastSeq, Markets: markets}
+}
+
+func TestMarketChangeFeedDeliver(t *testing.T) {
+	first := makeMarket(true, nil)
+	second := makeMarket(false, nil)
+
+	tests := []struct {
+		name       string
+		batches    []domainModels.MarketChangeBatch
+		setupMocks func(sink *mocks.MarketChangeSink)
+	}{
+		{
+			name:    "первый батч после подписки доставляется без проверки AfterSeq",
+			batches: []domainModels.MarketChangeBatch{changeBatch(10, 12, first)},
+			setupMocks: func(sink *mocks.MarketChangeSink) {
+				sink.On("NotifyMarketsChanged", changeBatch(10, 12, first)).Once()
+			},
+		},
+		{
+			name: "непрерывные батчи доставляются по порядку",
+			batches: []domainModels.MarketChangeBatch{
+				changeBatch(10, 12, first),
+				changeBatch(12, 13, second),
+			},
+			setupMocks: func(sink *mocks.MarketChangeSink) {
+				sink.On("NotifyMarketsChanged", changeBatch(10, 12, first)).Once()
+				sink.On("NotifyMarketsChanged", changeBatch(12, 13, second)).Once()
+			},
+		},
+		{
+			name: "пропуск батча — подписчики отключаются, новый батч всё равно доставляется",
+			batches: []domainModels.MarketChangeBatch{
+				changeBatch(10, 12, first),
+				changeBatch(15, 16, second),
+			},
+			setupMocks: func(sink *mocks.MarketChangeSink) {
+				sink.On("NotifyMarketsChanged", changeBatch(10, 12, first)).Once()
+				sink.On("DisconnectAll", "feed_gap").Once()
+				sink.On("NotifyMarketsChanged", changeBatch(15, 16, second)).Once()
+			},
+		},
+		{
+			name: "повтор уже доставленного батча отбрасывается",
+			batches: []domainModels.MarketChangeBatch{
+				changeBatch(10, 12, first),
+				changeBatch(10, 12, first),
+			},
+			setupMocks: func(sink *mocks.MarketChangeSink) {
+				sink.On("NotifyMarketsChanged", changeBatch(10, 12, first)).Once()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feed, _, sink := newTestFeed(t)
+			tt.setupMocks(sink)
+
+			for _, batch := range tt.batches {
+				feed.Deliver(batch)
+			}
+		})
+	}
+}
+
+func TestMarketChangeFeedReset(t *testing.T) {
+	first := makeMarket(true, nil)
+	second := makeMarket(true, nil)
+
+	feed, _, sink := newTestFeed(t)
+
+	sink.On("NotifyMarketsChanged", changeBatch(10, 12, first)).Once()
+	sink.On("DisconnectAll", "feed_reset").Once()
+	sink.On("NotifyMarketsChanged", changeBatch(20, 21, second)).Once()
+
+	feed.Deliver(changeBatch(10, 12, first))
+	feed.Reset()
+	// После сброса непрерывность не проверяется: предыдущий батч неизвестен
+	feed.Deliver(changeBatch(20, 21, second))
+}
+
+func TestMarketChangeFeedNotify(t *testing.T) {
+	batch := changeBatch(1, 2, makeMarket(true, nil))
+
+	tests := []struct {
+		name       string
+		publishErr error
+	}{
+		{name: "батч публикуется в канал"},
+		{name: "ошибка публикации не прерывает поллер", publishErr: errors.New("redis down")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feed, publisher, _ := newTestFeed(t)
+			publisher.On("PublishMarketChanges", mock.Anything, batch).Return(tt.publishErr).Once()
+
+			feed.NotifyMarketsChanged(context.Background(), batch)
+		})
+	}
+}
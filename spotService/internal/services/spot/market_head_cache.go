@@ -0,0 +1,140 @@
+package spot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	repositoryErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/repository"
+	serviceErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/service"
+	"github.com/nastyazhadan/spot-order-grpc/shared/metrics"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+)
+
+// tryLoadHeadPage отдаёт первую страницу из head-cache политики: cacheLimit+1 рынков без фильтров.
+// При промахе страница читается из PostgreSQL и кладётся в кэш; целиком кэш пересобирает RefreshAll
+func (s *MarketViewer) tryLoadHeadPage(
+	ctx context.Context,
+	policy domainModels.VisibilityPolicy,
+	limit uint64,
+	scope string,
+) ([]models.Market, string, bool, error) {
+	headMarkets, err := s.marketCacheRepository.GetMarkets(ctx, policy.ID)
+	if err == nil {
+		markets, nextPageToken, hasMore := buildPageResponse(headMarkets, limit, scope)
+		return markets, nextPageToken, hasMore, nil
+	}
+	cacheError := err
+
+	headMarkets, err = s.marketRepository.GetMarketsPage(
+		ctx, policy, headCacheAccess(policy), models.MarketFilter{}, nil, s.cacheLimit+1,
+	)
+	if err != nil {
+		if errors.Is(err, repositoryErrors.ErrMarketStoreIsEmpty) {
+			return nil, "", false, serviceErrors.ErrMarketsNotFound
+		}
+
+		if !errors.Is(cacheError, repositoryErrors.ErrMarketsNotFound) &&
+			!errors.Is(cacheError, repositoryErrors.ErrMarketCacheCorrupted) {
+			s.logger.Error(ctx, "head cache read failed", zap.Error(cacheError))
+		}
+
+		return nil, "", false, err
+	}
+
+	// Ошибка прогрева не мешает ответу: страница уже прочитана из PostgreSQL
+	s.warmHeadCache(ctx, policy.ID, headMarkets)
+
+	markets, nextPageToken, hasMore := buildPageResponse(headMarkets, limit, scope)
+	return markets, nextPageToken, hasMore, nil
+}
+
+func (s *MarketViewer) warmHeadCache(
+	ctx context.Context,
+	policyID string,
+	markets []models.Market,
+) {
+	if err := s.marketCacheRepository.SetMarkets(ctx, markets, policyID, s.cacheTTL); err != nil {
+		metrics.CacheWarmupsTotal.
+			WithLabelValues(s.serviceName, "view_markets_lazy_warmup", policyID, "error").
+			Inc()
+
+		s.logger.Warn(ctx, "failed to warm head cache",
+			zap.String("visibility_policy", policyID),
+			zap.Error(err),
+		)
+		return
+	}
+
+	metrics.CacheWarmupsTotal.
+		WithLabelValues(s.serviceName, "view_markets_lazy_warmup", policyID, "success").
+		Inc()
+}
+
+func (s *MarketViewer) RefreshAll(ctx context.Context) error {
+	const op = "MarketViewer.RefreshAll"
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	refreshCtx := context.WithoutCancel(ctx)
+
+	for _, policy := range s.visibilityPolicies {
+		roleCtx, cancel := contextWithTimeout(refreshCtx, s.serviceTimeout)
+
+		markets, err := s.marketRepository.GetMarketsPage(
+			roleCtx, policy, headCacheAccess(policy), models.MarketFilter{}, nil, s.cacheLimit+1,
+		)
+		if err != nil {
+			cancel()
+
+			if errors.Is(err, repositoryErrors.ErrMarketStoreIsEmpty) {
+				return s.invalidateMarketsCache(refreshCtx)
+			}
+
+			metrics.CacheWarmupsTotal.
+				WithLabelValues(s.serviceName, "refresh_all", policy.ID, "error").Inc()
+
+			return fmt.Errorf("%s: load head cache for policy %s: %w", op, policy.ID, err)
+		}
+
+		if err = s.marketCacheRepository.SetMarkets(roleCtx, markets, policy.ID, s.cacheTTL); err != nil {
+			cancel()
+
+			metrics.CacheWarmupsTotal.
+				WithLabelValues(s.serviceName, "refresh_all", policy.ID, "error").Inc()
+
+			return fmt.Errorf("%s: set cache for policy %s: %w", op, policy.ID, err)
+		}
+		cancel()
+
+		metrics.CacheWarmupsTotal.
+			WithLabelValues(s.serviceName, "refresh_all", policy.ID, "success").Inc()
+	}
+	return nil
+}
+
+// Удаление всех ключей при пустом market store
+func (s *MarketViewer) invalidateMarketsCache(ctx context.Context) error {
+	const op = "MarketViewer.invalidateMarketsCache"
+
+	for _, policy := range s.visibilityPolicies {
+		if err := s.marketCacheRepository.DeleteMarkets(ctx, policy.ID); err != nil {
+			metrics.CacheInvalidationsTotal.
+				WithLabelValues(s.serviceName, "refresh_empty_store", policy.ID, "error").
+				Inc()
+
+			return fmt.Errorf("%s: delete cache for policy %s: %w", op, policy.ID, err)
+		}
+
+		metrics.CacheInvalidationsTotal.
+			WithLabelValues(s.serviceName, "refresh_empty_store", policy.ID, "success").
+			Inc()
+	}
+
+	return nil
+}
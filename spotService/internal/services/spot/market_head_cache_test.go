@@ -0,0 +1,125 @@
+package spot
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-faster/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	repositoryErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/repository"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+	"github.com/nastyazhadan/spot-order-grpc/spotService/internal/services/mocks"
+)
+
+func TestRefreshAll(t *testing.T) {
+	tests := []struct {
+		name       string
+		useNilCtx  bool
+		setupMocks func(repo *mocks.MarketRepository, cache *mocks.MarketCacheRepository)
+		checkErr   func(t *testing.T, err error)
+	}{
+		{
+			name: "успешное обновление кэша для всех трёх ролей",
+			setupMocks: func(repo *mocks.MarketRepository, cache *mocks.MarketCacheRepository) {
+				for _, policy := range testVisibilityPolicies {
+					markets := makeMarkets(3)
+					repo.On("GetMarketsPage", mock.Anything, policy, headCacheAccess(policy), models.MarketFilter{}, (*domainModels.MarketPageKey)(nil), testCacheLimit+1).
+						Return(markets, nil).Once()
+					cache.On("SetMarkets", mock.Anything, markets, policy.ID, testCacheTTL).
+						Return(nil).Once()
+				}
+			},
+			checkErr: func(t *testing.T, err error) { require.NoError(t, err) },
+		},
+		{
+			name: "пустой store — инвалидируем кэши всех трёх ролей",
+			setupMocks: func(repo *mocks.MarketRepository, cache *mocks.MarketCacheRepository) {
+				repo.On("GetMarketsPage", mock.Anything, testAdminPolicy, testAdminAccess, models.MarketFilter{}, (*domainModels.MarketPageKey)(nil), testCacheLimit+1).
+					Return(nil, repositoryErrors.ErrMarketStoreIsEmpty).Once()
+				for _, policy := range testVisibilityPolicies {
+					cache.On("DeleteMarkets", mock.Anything, policy.ID).Return(nil).Once()
+				}
+			},
+			checkErr: func(t *testing.T, err error) { require.NoError(t, err) },
+		},
+		{
+			name: "пустой store + DeleteMarkets падает — ошибка",
+			setupMocks: func(repo *mocks.MarketRepository, cache *mocks.MarketCacheRepository) {
+				repo.On("GetMarketsPage", mock.Anything, testAdminPolicy, testAdminAccess, models.MarketFilter{}, (*domainModels.MarketPageKey)(nil), testCacheLimit+1).
+					Return(nil, repositoryErrors.ErrMarketStoreIsEmpty).Once()
+				cache.On("DeleteMarkets", mock.Anything, roleAdminKey).
+					Return(errors.New("redis down")).Once()
+			},
+			checkErr: func(t *testing.T, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "redis down")
+			},
+		},
+		{
+			name: "GetMarketsPage падает — ошибка, следующие роли не обрабатываются",
+			setupMocks: func(repo *mocks.MarketRepository, _ *mocks.MarketCacheRepository) {
+				repo.On("GetMarketsPage", mock.Anything, testAdminPolicy, testAdminAccess, models.MarketFilter{}, (*domainModels.MarketPageKey)(nil), testCacheLimit+1).
+					Return(nil, errors.New("pg timeout")).Once()
+			},
+			checkErr: func(t *testing.T, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "pg timeout")
+			},
+		},
+		{
+			name: "SetMarkets падает — ошибка",
+			setupMocks: func(repo *mocks.MarketRepository, cache *mocks.MarketCacheRepository) {
+				markets := makeMarkets(2)
+				repo.On("GetMarketsPage", mock.Anything, testAdminPolicy, testAdminAccess, models.MarketFilter{}, (*domainModels.MarketPageKey)(nil), testCacheLimit+1).
+					Return(markets, nil).Once()
+				cache.On("SetMarkets", mock.Anything, markets, roleAdminKey, testCacheTTL).
+					Return(errors.New("redis oom")).Once()
+			},
+			checkErr: func(t *testing.T, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "redis oom")
+			},
+		},
+		{
+			name:      "nil ctx — не паникует",
+			useNilCtx: true,
+			setupMocks: func(repo *mocks.MarketRepository, cache *mocks.MarketCacheRepository) {
+				for _, policy := range testVisibilityPolicies {
+					markets := makeMarkets(1)
+					repo.On("GetMarketsPage", mock.Anything, policy, headCacheAccess(policy), models.MarketFilter{}, (*domainModels.MarketPageKey)(nil), testCacheLimit+1).
+						Return(markets, nil).Once()
+					cache.On("SetMarkets", mock.Anything, markets, policy.ID, testCacheTTL).
+						Return(nil).Once()
+				}
+			},
+			checkErr: func(t *testing.T, err error) { require.NoError(t, err) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mocks.MarketRepository{}
+			cache := &mocks.MarketCacheRepository{}
+			byIDCache := &mocks.MarketByIDCacheRepository{}
+			tt.setupMocks(repo, cache)
+
+			svc := newTestViewer(repo, cache, byIDCache, &mocks.MarketBySymbolCacheRepository{})
+
+			var ctx context.Context
+			if !tt.useNilCtx {
+				ctx = context.Background()
+			}
+
+			err := svc.RefreshAll(ctx)
+			tt.checkErr(t, err)
+
+			repo.AssertExpectations(t)
+			cache.AssertExpectations(t)
+			byIDCache.AssertExpectations(t)
+		})
+	}
+}
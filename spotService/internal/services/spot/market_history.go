@@ -0,0 +1,89 @@
+package spot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/otel/attributes"
+	zapLogger "github.com/nastyazhadan/spot-order-grpc/shared/interceptors/logging/zap"
+	"github.com/nastyazhadan/spot-order-grpc/shared/interceptors/tracing"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+)
+
+type MarketHistoryRepository interface {
+	GetMarketHistory(
+		ctx context.Context,
+		marketID uuid.UUID,
+		beforeID int64,
+		limit uint64,
+	) ([]domainModels.MarketHistoryEntry, error)
+}
+
+// MarketHistory отдаёт журнал аудита market_store_history, который ведёт триггер в PostgreSQL.
+type MarketHistory struct {
+	historyRepository MarketHistoryRepository
+	serviceTimeout    time.Duration
+	defaultLimit      uint64
+	maxLimit          uint64
+	logger            *zapLogger.Logger
+}
+
+func NewMarketHistory(
+	repo MarketHistoryRepository,
+	timeout time.Duration,
+	defaultLimit, maxLimit uint64,
+	logger *zapLogger.Logger,
+) *MarketHistory {
+	return &MarketHistory{
+		historyRepository: repo,
+		serviceTimeout:    timeout,
+		defaultLimit:      defaultLimit,
+		maxLimit:          maxLimit,
+		logger:            logger,
+	}
+}
+
+// GetMarketHistory доступен только admin. Для несуществующего рынка возвращается пустая страница:
+// история переживает и soft delete, и удаление строки рынка.
+func (s *MarketHistory) GetMarketHistory(
+	ctx context.Context,
+	marketID uuid.UUID,
+	limit uint64,
+	pageToken string,
+) ([]domainModels.MarketHistoryEntry, string, bool, error) {
+	const op = "MarketHistory.GetMarketHistory"
+
+	ctx, cancel := contextWithTimeout(ctx, s.serviceTimeout)
+	defer cancel()
+
+	ctx, span := tracing.StartSpan(ctx, "spot.get_market_history",
+		trace.WithAttributes(attributes.MarketIDValue(marketID.String())),
+	)
+	defer span.End()
+
+	limit = normalizeLimit(limit, s.defaultLimit, s.maxLimit)
+
+	beforeID, err := decodeHistoryPageToken(pageToken, marketID)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, "", false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	entries, err := s.historyRepository.GetMarketHistory(ctx, marketID, beforeID, limit+1)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, "", false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	hasMore := uint64(len(entries)) > limit
+	if !hasMore {
+		return entries, "", false, nil
+	}
+
+	entries = entries[:limit]
+	return entries, encodeHistoryPageToken(entries[len(entries)-1]), true, nil
+}
@@ -0,0 +1,143 @@
+package spot
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	serviceErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/service"
+	zapLogger "github.com/nastyazhadan/spot-order-grpc/shared/interceptors/logging/zap"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+	"github.com/nastyazhadan/spot-order-grpc/spotService/internal/services/mocks"
+)
+
+func newTestMarketHistory(repo *mocks.MarketHistoryRepository) *MarketHistory {
+	return NewMarketHistory(repo, testTimeout, 2, 5, zapLogger.NewNop())
+}
+
+func makeHistoryEntries(marketID uuid.UUID, ids ...int64) []domainModels.MarketHistoryEntry {
+	entries := make([]domainModels.MarketHistoryEntry, 0, len(ids))
+	for _, id := range ids {
+		entries = append(entries, domainModels.MarketHistoryEntry{
+			ID:        id,
+			MarketID:  marketID,
+			Operation: domainModels.MarketHistoryOperationUpdate,
+		})
+	}
+	return entries
+}
+
+func TestGetMarketHistory(t *testing.T) {
+	marketID := uuid.New()
+	otherMarketID := uuid.New()
+
+	tests := []struct {
+		name          string
+		ctx           context.Context
+		limit         uint64
+		pageToken     string
+		setupMocks    func(repo *mocks.MarketHistoryRepository)
+		wantIDs       []int64
+		wantHasMore   bool
+		wantNextToken bool
+		wantErr       error
+	}{
+		{
+			name: "limit 0 — берётся default, запрашивается на одну запись больше",
+			ctx:  ctxWithRoles(models.UserRoleAdmin),
+			setupMocks: func(repo *mocks.MarketHistoryRepository) {
+				repo.On("GetMarketHistory", mock.Anything, marketID, int64(0), uint64(3)).
+					Return(makeHistoryEntries(marketID, 9, 8, 7), nil).Once()
+			},
+			wantIDs:       []int64{9, 8},
+			wantHasMore:   true,
+			wantNextToken: true,
+		},
+		{
+			name:  "limit выше максимума — обрезается до max",
+			ctx:   ctxWithRoles(models.UserRoleAdmin),
+			limit: 100,
+			setupMocks: func(repo *mocks.MarketHistoryRepository) {
+				repo.On("GetMarketHistory", mock.Anything, marketID, int64(0), uint64(6)).
+					Return(makeHistoryEntries(marketID, 3, 2, 1), nil).Once()
+			},
+			wantIDs: []int64{3, 2, 1},
+		},
+		{
+			name:      "токен продолжает выдачу после id последней записи",
+			ctx:       ctxWithRoles(models.UserRoleAdmin),
+			limit:     2,
+			pageToken: encodeHistoryPageToken(makeHistoryEntries(marketID, 8)[0]),
+			setupMocks: func(repo *mocks.MarketHistoryRepository) {
+				repo.On("GetMarketHistory", mock.Anything, marketID, int64(8), uint64(3)).
+					Return(makeHistoryEntries(marketID, 7), nil).Once()
+			},
+			wantIDs: []int64{7},
+		},
+		{
+			name:       "токен другого рынка — ErrInvalidPagination",
+			ctx:        ctxWithRoles(models.UserRoleAdmin),
+			pageToken:  encodeHistoryPageToken(makeHistoryEntries(otherMarketID, 8)[0]),
+			setupMocks: func(_ *mocks.MarketHistoryRepository) {},
+			wantErr:    serviceErrors.ErrInvalidPagination,
+		},
+		{
+			name:       "битый токен — ErrInvalidPagination",
+			ctx:        ctxWithRoles(models.UserRoleAdmin),
+			pageToken:  "not-a-token",
+			setupMocks: func(_ *mocks.MarketHistoryRepository) {},
+			wantErr:    serviceErrors.ErrInvalidPagination,
+		},
+		{
+			name: "ошибка репозитория — пробрасывается",
+			ctx:  ctxWithRoles(models.UserRoleAdmin),
+			setupMocks: func(repo *mocks.MarketHistoryRepository) {
+				repo.On("GetMarketHistory", mock.Anything, marketID, int64(0), uint64(3)).
+					Return(nil, context.DeadlineExceeded).Once()
+			},
+			wantErr: context.DeadlineExceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mocks.MarketHistoryRepository{}
+			tt.setupMocks(repo)
+
+			entries, nextToken, hasMore, err := newTestMarketHistory(repo).
+				GetMarketHistory(tt.ctx, marketID, tt.limit, tt.pageToken)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+
+				ids := make([]int64, 0, len(entries))
+				for _, entry := range entries {
+					ids = append(ids, entry.ID)
+				}
+				assert.Equal(t, tt.wantIDs, ids)
+				assert.Equal(t, tt.wantHasMore, hasMore)
+				assert.Equal(t, tt.wantNextToken, nextToken != "")
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHistoryPageTokenRoundTrip(t *testing.T) {
+	marketID := uuid.New()
+	token := encodeHistoryPageToken(domainModels.MarketHistoryEntry{ID: 42, MarketID: marketID})
+
+	beforeID, err := decodeHistoryPageToken(token, marketID)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), beforeID)
+}
@@ -0,0 +1,237 @@
+package spot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	serviceErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/service"
+	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/otel/attributes"
+	zapLogger "github.com/nastyazhadan/spot-order-grpc/shared/interceptors/logging/zap"
+	"github.com/nastyazhadan/spot-order-grpc/shared/interceptors/tracing"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+)
+
+// Совпадает с chk_asset_code в таблице assets
+var assetCodePattern = regexp.MustCompile(`^[A-Z0-9]{1,16}$`)
+
+type MarketImportRepository interface {
+	ImportMarkets(ctx context.Context, specs []domainModels.MarketSpec, dryRun bool) ([]domainModels.MarketImportChange, error)
+	ExportMarkets(ctx context.Context) ([]models.Market, error)
+}
+
+// MarketImporter — загрузка каталога рынков из файла и выгрузка обратно (cmd/markets).
+// Рынки пишутся в market_store через MarketImportRepository, дальше изменения
+// разносит MarketPoller, как при любом другом изменении рынка.
+type MarketImporter struct {
+	importRepository MarketImportRepository
+	assetRepository  AssetRepository
+	serviceTimeout   time.Duration
+	logger           *zapLogger.Logger
+}
+
+func NewMarketImporter(
+	importRepo MarketImportRepository,
+	assetRepo AssetRepository,
+	timeout time.Duration,
+	logger *zapLogger.Logger,
+) *MarketImporter {
+	return &MarketImporter{
+		importRepository: importRepo,
+		assetRepository:  assetRepo,
+		serviceTimeout:   timeout,
+		logger:           logger,
+	}
+}
+
+// ImportMarkets проверяет весь файл целиком и только потом применяет его одной
+// транзакцией: ошибка в любом рынке отменяет импорт. При dryRun возвращается план без изменений.
+func (s *MarketImporter) ImportMarkets(
+	ctx context.Context,
+	specs []domainModels.MarketSpec,
+	dryRun bool,
+) ([]domainModels.MarketImportChange, error) {
+	const op = "MarketImporter.ImportMarkets"
+
+	ctx, cancel := contextWithTimeout(ctx, s.serviceTimeout)
+	defer cancel()
+
+	ctx, span := tracing.StartSpan(ctx, "spot.import_markets",
+		trace.WithAttributes(attributes.MarketsCountValue(len(specs))),
+	)
+	defer span.End()
+
+	normalized, err := normalizeMarketSpecs(specs)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	assets, err := s.assetRepository.ListAssets(ctx, true)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = validateMarketSpecAssets(normalized, assets); err != nil {
+		tracing.RecordError(span, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	changes, err := s.importRepository.ImportMarkets(ctx, normalized, dryRun)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	created, updated := countMarketImportChanges(changes)
+	s.logger.Info(ctx, "Markets imported",
+		zap.Bool("dry_run", dryRun),
+		zap.Int("created", created),
+		zap.Int("updated", updated),
+		zap.Int("unchanged", len(changes)-created-updated),
+	)
+
+	return changes, nil
+}
+
+func (s *MarketImporter) ExportMarkets(ctx context.Context) ([]domainModels.MarketSpec, error) {
+	const op = "MarketImporter.ExportMarkets"
+
+	ctx, cancel := contextWithTimeout(ctx, s.serviceTimeout)
+	defer cancel()
+
+	ctx, span := tracing.StartSpan(ctx, "spot.export_markets")
+	defer span.End()
+
+	markets, err := s.importRepository.ExportMarkets(ctx)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	span.SetAttributes(attributes.MarketsCountValue(len(markets)))
+
+	specs := make([]domainModels.MarketSpec, 0, len(markets))
+	for _, market := range markets {
+		specs = append(specs, domainModels.MarketSpecFromMarket(market))
+	}
+
+	return specs, nil
+}
+
+// normalizeMarketSpecs приводит имена и активы к верхнему регистру и собирает все ошибки файла.
+// Имя рынка — BASE-QUOTE; не заданные в файле активы берутся из имени.
+func normalizeMarketSpecs(specs []domainModels.MarketSpec) ([]domainModels.MarketSpec, error) {
+	if len(specs) == 0 {
+		return nil, fmt.Errorf("%w: no markets to import", serviceErrors.ErrInvalidMarketSpec)
+	}
+
+	var (
+		result  = make([]domainModels.MarketSpec, 0, len(specs))
+		seen    = make(map[string]int, len(specs))
+		invalid []error
+	)
+
+	for index, spec := range specs {
+		position := index + 1
+
+		spec.Name = strings.ToUpper(strings.TrimSpace(spec.Name))
+		spec.BaseAsset = strings.ToUpper(strings.TrimSpace(spec.BaseAsset))
+		spec.QuoteAsset = strings.ToUpper(strings.TrimSpace(spec.QuoteAsset))
+
+		if err := validateMarketSpec(&spec); err != nil {
+			invalid = append(invalid, fmt.Errorf("%w: market #%d %q: %w",
+				serviceErrors.ErrInvalidMarketSpec, position, spec.Name, err))
+			continue
+		}
+
+		if previous, ok := seen[spec.Name]; ok {
+			invalid = append(invalid, fmt.Errorf("%w: market #%d %q: duplicates market #%d",
+				serviceErrors.ErrInvalidMarketSpec, position, spec.Name, previous))
+			continue
+		}
+		seen[spec.Name] = position
+
+		result = append(result, spec)
+	}
+
+	if len(invalid) > 0 {
+		return nil, errors.Join(invalid...)
+	}
+
+	return result, nil
+}
+
+func validateMarketSpec(spec *domainModels.MarketSpec) error {
+	if spec.Name == "" {
+		return errors.New("name is required")
+	}
+
+	base, quote, ok := strings.Cut(spec.Name, "-")
+	if !ok || !assetCodePattern.MatchString(base) || !assetCodePattern.MatchString(quote) {
+		return errors.New("name must look like BASE-QUOTE")
+	}
+
+	if spec.BaseAsset == "" {
+		spec.BaseAsset = base
+	}
+	if spec.QuoteAsset == "" {
+		spec.QuoteAsset = quote
+	}
+
+	if spec.BaseAsset != base || spec.QuoteAsset != quote {
+		return fmt.Errorf("assets %s/%s do not match name", spec.BaseAsset, spec.QuoteAsset)
+	}
+	if base == quote {
+		return errors.New("base and quote assets must differ")
+	}
+
+	return nil
+}
+
+// validateMarketSpecAssets требует, чтобы активы были в справочнике, а у включённого
+// неудалённого рынка — ещё и включены: UpdateAsset не оставляет таких рынков включёнными.
+func validateMarketSpecAssets(specs []domainModels.MarketSpec, assets []models.Asset) error {
+	enabledByCode := make(map[string]bool, len(assets))
+	for _, asset := range assets {
+		enabledByCode[asset.Code] = asset.Enabled
+	}
+
+	var invalid []error
+	for _, spec := range specs {
+		for _, code := range []string{spec.BaseAsset, spec.QuoteAsset} {
+			enabled, ok := enabledByCode[code]
+
+			switch {
+			case !ok:
+				invalid = append(invalid, fmt.Errorf("%w: market %q: unknown asset %s",
+					serviceErrors.ErrInvalidMarketSpec, spec.Name, code))
+			case !enabled && spec.Enabled && !spec.Deleted:
+				invalid = append(invalid, fmt.Errorf("%w: market %q: asset %s is disabled",
+					serviceErrors.ErrInvalidMarketSpec, spec.Name, code))
+			}
+		}
+	}
+
+	return errors.Join(invalid...)
+}
+
+func countMarketImportChanges(changes []domainModels.MarketImportChange) (created, updated int) {
+	for _, change := range changes {
+		switch change.Action {
+		case domainModels.MarketImportActionCreate:
+			created++
+		case domainModels.MarketImportActionUpdate:
+			updated++
+		}
+	}
+
+	return created, updated
+}
@@ -0,0 +1,187 @@
+package spot
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	serviceErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/service"
+	zapLogger "github.com/nastyazhadan/spot-order-grpc/shared/interceptors/logging/zap"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+	"github.com/nastyazhadan/spot-order-grpc/spotService/internal/services/mocks"
+)
+
+var testImportAssets = []models.Asset{
+	{Code: "BTC", Enabled: true},
+	{Code: "ETH", Enabled: true},
+	{Code: "USDT", Enabled: true},
+	{Code: "LUNA", Enabled: false},
+}
+
+func newTestMarketImporter(
+	importRepo *mocks.MarketImportRepository,
+	assetRepo *mocks.AssetRepository,
+) *MarketImporter {
+	return NewMarketImporter(importRepo, assetRepo, testTimeout, zapLogger.NewNop())
+}
+
+func TestImportMarkets(t *testing.T) {
+	tests := []struct {
+		name       string
+		specs      []domainModels.MarketSpec
+		setupMocks func(importRepo *mocks.MarketImportRepository, assetRepo *mocks.AssetRepository)
+		wantErr    error
+		wantSpecs  []domainModels.MarketSpec
+	}{
+		{
+			name:       "пустой файл — ErrInvalidMarketSpec",
+			setupMocks: func(_ *mocks.MarketImportRepository, _ *mocks.AssetRepository) {},
+			wantErr:    serviceErrors.ErrInvalidMarketSpec,
+		},
+		{
+			name:       "имя не BASE-QUOTE — ErrInvalidMarketSpec",
+			specs:      []domainModels.MarketSpec{{Name: "BTCUSDT", Enabled: true}},
+			setupMocks: func(_ *mocks.MarketImportRepository, _ *mocks.AssetRepository) {},
+			wantErr:    serviceErrors.ErrInvalidMarketSpec,
+		},
+		{
+			name:       "активы не совпадают с именем — ErrInvalidMarketSpec",
+			specs:      []domainModels.MarketSpec{{Name: "BTC-USDT", BaseAsset: "ETH", Enabled: true}},
+			setupMocks: func(_ *mocks.MarketImportRepository, _ *mocks.AssetRepository) {},
+			wantErr:    serviceErrors.ErrInvalidMarketSpec,
+		},
+		{
+			name:       "одинаковые активы — ErrInvalidMarketSpec",
+			specs:      []domainModels.MarketSpec{{Name: "USDT-USDT", Enabled: true}},
+			setupMocks: func(_ *mocks.MarketImportRepository, _ *mocks.AssetRepository) {},
+			wantErr:    serviceErrors.ErrInvalidMarketSpec,
+		},
+		{
+			name: "повтор имени без учёта регистра — ErrInvalidMarketSpec",
+			specs: []domainModels.MarketSpec{
+				{Name: "BTC-USDT", Enabled: true},
+				{Name: "btc-usdt", Enabled: false},
+			},
+			setupMocks: func(_ *mocks.MarketImportRepository, _ *mocks.AssetRepository) {},
+			wantErr:    serviceErrors.ErrInvalidMarketSpec,
+		},
+		{
+			name:  "неизвестный актив — ErrInvalidMarketSpec, импорт не вызывается",
+			specs: []domainModels.MarketSpec{{Name: "SOL-USDT", Enabled: true}},
+			setupMocks: func(_ *mocks.MarketImportRepository, assetRepo *mocks.AssetRepository) {
+				assetRepo.On("ListAssets", mock.Anything, true).Return(testImportAssets, nil).Once()
+			},
+			wantErr: serviceErrors.ErrInvalidMarketSpec,
+		},
+		{
+			name:  "включённый рынок с выключенным активом — ErrInvalidMarketSpec",
+			specs: []domainModels.MarketSpec{{Name: "LUNA-USDT", Enabled: true}},
+			setupMocks: func(_ *mocks.MarketImportRepository, assetRepo *mocks.AssetRepository) {
+				assetRepo.On("ListAssets", mock.Anything, true).Return(testImportAssets, nil).Once()
+			},
+			wantErr: serviceErrors.ErrInvalidMarketSpec,
+		},
+		{
+			name: "имена и активы нормализуются, активы берутся из имени",
+			specs: []domainModels.MarketSpec{
+				{Name: " btc-usdt ", Enabled: true},
+				{Name: "LUNA-USDT", BaseAsset: "luna", Enabled: false},
+			},
+			setupMocks: func(importRepo *mocks.MarketImportRepository, assetRepo *mocks.AssetRepository) {
+				assetRepo.On("ListAssets", mock.Anything, true).Return(testImportAssets, nil).Once()
+				importRepo.On("ImportMarkets", mock.Anything, mock.Anything, true).
+					Return(func(_ context.Context, specs []domainModels.MarketSpec, _ bool) []domainModels.MarketImportChange {
+						changes := make([]domainModels.MarketImportChange, 0, len(specs))
+						for _, spec := range specs {
+							changes = append(changes, domainModels.PlanMarketImport(nil, spec))
+						}
+						return changes
+					}, nil).Once()
+			},
+			wantSpecs: []domainModels.MarketSpec{
+				{Name: "BTC-USDT", BaseAsset: "BTC", QuoteAsset: "USDT", Enabled: true},
+				{Name: "LUNA-USDT", BaseAsset: "LUNA", QuoteAsset: "USDT", Enabled: false},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			importRepo := mocks.NewMarketImportRepository(t)
+			assetRepo := mocks.NewAssetRepository(t)
+			tt.setupMocks(importRepo, assetRepo)
+
+			changes, err := newTestMarketImporter(importRepo, assetRepo).
+				ImportMarkets(context.Background(), tt.specs, true)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, changes, len(tt.wantSpecs))
+			for i, change := range changes {
+				assert.Equal(t, domainModels.MarketImportActionCreate, change.Action)
+				assert.Equal(t, tt.wantSpecs[i], change.Spec)
+			}
+		})
+	}
+}
+
+func TestPlanMarketImport(t *testing.T) {
+	deletedAt := time.Now()
+	existing := models.Market{
+		Name: "ETH-USDT", BaseAsset: "ETH", QuoteAsset: "USDT", Enabled: false, DeletedAt: &deletedAt,
+	}
+
+	tests := []struct {
+		name       string
+		existing   *models.Market
+		spec       domainModels.MarketSpec
+		wantAction domainModels.MarketImportAction
+		wantFields []domainModels.MarketFieldChange
+	}{
+		{
+			name:       "рынка нет — create",
+			spec:       domainModels.MarketSpec{Name: "ETH-USDT", BaseAsset: "ETH", QuoteAsset: "USDT"},
+			wantAction: domainModels.MarketImportActionCreate,
+		},
+		{
+			name:     "совпадает с текущим — unchanged",
+			existing: &existing,
+			spec: domainModels.MarketSpec{
+				Name: "ETH-USDT", BaseAsset: "ETH", QuoteAsset: "USDT", Deleted: true,
+			},
+			wantAction: domainModels.MarketImportActionUnchanged,
+		},
+		{
+			name:     "восстановление и включение — update со списком полей",
+			existing: &existing,
+			spec: domainModels.MarketSpec{
+				Name: "ETH-USDT", BaseAsset: "ETH", QuoteAsset: "USDT", Enabled: true, Restricted: true,
+			},
+			wantAction: domainModels.MarketImportActionUpdate,
+			wantFields: []domainModels.MarketFieldChange{
+				{Field: models.MarketFieldEnabled, Before: "false", After: "true"},
+				{Field: domainModels.MarketImportFieldDeleted, Before: "true", After: "false"},
+				{Field: models.MarketFieldRestricted, Before: "false", After: "true"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			change := domainModels.PlanMarketImport(tt.existing, tt.spec)
+
+			assert.Equal(t, tt.wantAction, change.Action)
+			assert.Equal(t, tt.wantFields, change.Fields)
+		})
+	}
+}
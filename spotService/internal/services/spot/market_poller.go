@@ -17,56 +17,86 @@ import (
 
 const marketStateChangedPollerName = "market_state_changed_poller"
 
+// MarketPollerLeaseName — lease лидерства поллера. Имя совпадает с курсором:
+// CursorStore сверяет fencing token этого lease при каждом сдвиге курсора.
+const MarketPollerLeaseName = marketStateChangedPollerName
+
 type CursorStore interface {
 	Get(ctx context.Context, pollerName string) (models.PollerCursor, error)
 }
 
 type MarketReader interface {
-	ListUpdatedSince(ctx context.Context, since time.Time, afterID uuid.UUID, limit int) ([]sharedModels.Market, error)
+	ListChangesAfter(ctx context.Context, afterSeq int64, limit int) ([]models.MarketChangeLogEntry, error)
+}
+
+type MarketChangeListener interface {
+	Listen(ctx context.Context, onNotify func()) error
 }
 
 type MarketEventProducer interface {
-	PublishMarketStateChanged(ctx context.Context, events []sharedModels.MarketStateChangedEvent, cursor models.PollerCursor) error
+	PublishMarketUpdated(ctx context.Context, events []sharedModels.MarketUpdatedEvent, cursor models.PollerCursor) error
 }
 
 type MarketCacheRefresher interface {
 	RefreshAll(ctx context.Context) error
 	InvalidateByIDs(ctx context.Context, ids []uuid.UUID) error
+	InvalidateBySymbols(ctx context.Context, symbols []string) error
+}
+
+type MarketChangeNotifier interface {
+	NotifyMarketsChanged(ctx context.Context, batch models.MarketChangeBatch)
 }
 
+// MarketPoller разбирает журнал market_change_log по seq. Работает только на реплике-лидере. Читать журнал его будят
+// уведомления LISTEN/NOTIFY; редкий опрос по fallbackInterval страхует от потерянных
+// уведомлений, а пока LISTEN-соединение недоступно, журнал опрашивается раз в pollInterval.
 type MarketPoller struct {
 	reader            MarketReader
+	listener          MarketChangeListener
 	producer          MarketEventProducer
 	cursorStore       CursorStore
 	cacheRefresher    MarketCacheRefresher
+	changeNotifier    MarketChangeNotifier
+	policies          models.VisibilityPolicies
 	pollInterval      time.Duration
+	fallbackInterval  time.Duration
 	processingTimeout time.Duration
 	batchSize         int
 	pollerName        string
-	lastSeenAt        time.Time
-	lastSeenID        uuid.UUID
+	lastSeq           int64
+	fencingToken      int64
+	wakeups           chan struct{}
 	logger            *zapLogger.Logger
 }
 
 func NewMarketPoller(
 	reader MarketReader,
+	listener MarketChangeListener,
 	producer MarketEventProducer,
 	store CursorStore,
 	cacheRefresher MarketCacheRefresher,
+	changeNotifier MarketChangeNotifier,
+	policies models.VisibilityPolicies,
 	interval time.Duration,
+	fallbackInterval time.Duration,
 	timeout time.Duration,
 	size int,
 	logger *zapLogger.Logger,
 ) *MarketPoller {
 	return &MarketPoller{
 		reader:            reader,
+		listener:          listener,
 		producer:          producer,
 		cursorStore:       store,
 		cacheRefresher:    cacheRefresher,
+		changeNotifier:    changeNotifier,
+		policies:          policies,
 		pollInterval:      interval,
+		fallbackInterval:  fallbackInterval,
 		processingTimeout: timeout,
 		batchSize:         size,
 		pollerName:        marketStateChangedPollerName,
+		wakeups:           make(chan struct{}, 1),
 		logger:            logger,
 	}
 }
@@ -83,8 +113,7 @@ func (p *MarketPoller) Init(ctx context.Context) error {
 	}
 
 	p.logger.Info(ctx, "Market poller cursor loaded",
-		zap.Time("last_seen_at", p.lastSeenAt),
-		zap.String("last_seen_id", p.lastSeenID.String()),
+		zap.Int64("last_seq", p.lastSeq),
 	)
 
 	return nil
@@ -94,18 +123,28 @@ func (p *MarketPoller) loadCursor(ctx context.Context) error {
 	cursor, err := p.cursorStore.Get(ctx, p.pollerName)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			p.lastSeenAt = time.Time{}
-			p.lastSeenID = uuid.Nil
+			p.lastSeq = 0
 			return nil
 		}
 		return err
 	}
 
-	p.lastSeenAt = cursor.LastSeenAt.UTC()
-	p.lastSeenID = cursor.LastSeenID
+	p.lastSeq = cursor.LastSeq
 	return nil
 }
 
+// RunAsLeader — задача лидера: курсор перечитывается, потому что его мог сдвинуть
+// предыдущий лидер, и все сдвиги курсора идут под fencingToken полученного lease.
+func (p *MarketPoller) RunAsLeader(ctx context.Context, fencingToken int64) error {
+	p.fencingToken = fencingToken
+
+	if err := p.Init(ctx); err != nil {
+		return err
+	}
+
+	return p.Run(ctx)
+}
+
 func (p *MarketPoller) Run(ctx context.Context) error {
 	if ctx == nil {
 		return fmt.Errorf("market poller run: nil context")
@@ -117,15 +156,33 @@ func (p *MarketPoller) Run(ctx context.Context) error {
 		return fmt.Errorf("initial poll: %w", err)
 	}
 
-	ticker := time.NewTicker(p.pollInterval)
+	listenCtx, stopListening := context.WithCancel(ctx)
+	listenDone := make(chan struct{})
+	defer func() {
+		stopListening()
+		<-listenDone
+	}()
+
+	go func() {
+		defer close(listenDone)
+		p.listen(listenCtx)
+	}()
+
+	interval := p.pollInterval
+	if p.listener != nil {
+		interval = p.fallbackInterval
+	}
+
+	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
 
 	p.logger.Info(ctx, "Market poller started",
+		zap.Bool("listen_notify", p.listener != nil),
 		zap.Duration("poll_interval", p.pollInterval),
+		zap.Duration("fallback_interval", p.fallbackInterval),
 		zap.Duration("processing_timeout", p.processingTimeout),
 		zap.Int("batch_size", p.batchSize),
-		zap.Time("last_seen_at", p.lastSeenAt),
-		zap.String("last_seen_id", p.lastSeenID.String()),
+		zap.Int64("last_seq", p.lastSeq),
 	)
 
 	for {
@@ -140,18 +197,61 @@ func (p *MarketPoller) Run(ctx context.Context) error {
 				}
 				return fmt.Errorf("scheduled poll: %w", err)
 			}
+		case <-p.wakeups:
+			if err := p.poll(ctx); err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return fmt.Errorf("notified poll: %w", err)
+			}
+		}
+	}
+}
+
+// listen держит LISTEN-подписку и переподключается после обрыва.
+// На время переподключения poll будится раз в pollInterval.
+func (p *MarketPoller) listen(ctx context.Context) {
+	if p.listener == nil {
+		return
+	}
+
+	for {
+		err := p.listener.Listen(ctx, p.wakeUp)
+		if ctx.Err() != nil {
+			return
+		}
+
+		p.logger.Warn(ctx, "Market change listener disconnected, polling until reconnect",
+			zap.Duration("retry_after", p.pollInterval),
+			zap.Error(err),
+		)
+		p.wakeUp()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(p.pollInterval):
 		}
 	}
 }
 
+// wakeUp не блокируется: несколько уведомлений подряд схлопываются в один poll,
+// который всё равно дочитывает журнал до конца.
+func (p *MarketPoller) wakeUp() {
+	select {
+	case p.wakeups <- struct{}{}:
+	default:
+	}
+}
+
 func (p *MarketPoller) poll(ctx context.Context) error {
 	pollCtx, cancel := context.WithTimeout(ctx, p.processingTimeout)
 	defer cancel()
 
-	var updatedIDs []uuid.UUID
+	var updatedMarkets []sharedModels.Market
 
 	defer func() {
-		p.refreshCache(ctx, updatedIDs)
+		p.refreshCache(ctx, updatedMarkets)
 	}()
 
 	for {
@@ -159,28 +259,26 @@ func (p *MarketPoller) poll(ctx context.Context) error {
 			if errors.Is(err, context.DeadlineExceeded) {
 				p.logger.Warn(ctx, "Market poll timed out before completion",
 					zap.Duration("processing_timeout", p.processingTimeout),
-					zap.Int("updated_ids_count", len(updatedIDs)),
-					zap.Time("last_seen_at", p.lastSeenAt),
-					zap.String("last_seen_id", p.lastSeenID.String()),
+					zap.Int("updated_ids_count", len(updatedMarkets)),
+					zap.Int64("last_seq", p.lastSeq),
 				)
 			}
 			return err
 		}
 
-		batchUpdatedIDs, hasMore, err := p.processNextBatch(pollCtx)
+		batchUpdatedMarkets, hasMore, err := p.processNextBatch(pollCtx)
 		if err != nil {
 			if errors.Is(err, context.DeadlineExceeded) {
 				p.logger.Warn(ctx, "Market poll batch processing timed out",
 					zap.Duration("processing_timeout", p.processingTimeout),
-					zap.Int("updated_ids_count", len(updatedIDs)),
-					zap.Time("last_seen_at", p.lastSeenAt),
-					zap.String("last_seen_id", p.lastSeenID.String()),
+					zap.Int("updated_ids_count", len(updatedMarkets)),
+					zap.Int64("last_seq", p.lastSeq),
 				)
 			}
 			return err
 		}
-		if len(batchUpdatedIDs) > 0 {
-			updatedIDs = append(updatedIDs, batchUpdatedIDs...)
+		if len(batchUpdatedMarkets) > 0 {
+			updatedMarkets = append(updatedMarkets, batchUpdatedMarkets...)
 		}
 		if !hasMore {
 			return nil
@@ -190,12 +288,19 @@ func (p *MarketPoller) poll(ctx context.Context) error {
 
 func (p *MarketPoller) refreshCache(
 	ctx context.Context,
-	updatedIDs []uuid.UUID,
+	updatedMarkets []sharedModels.Market,
 ) {
-	if len(updatedIDs) == 0 || p.cacheRefresher == nil {
+	if len(updatedMarkets) == 0 || p.cacheRefresher == nil {
 		return
 	}
 
+	updatedIDs := make([]uuid.UUID, 0, len(updatedMarkets))
+	updatedSymbols := make([]string, 0, len(updatedMarkets))
+	for _, market := range updatedMarkets {
+		updatedIDs = append(updatedIDs, market.ID)
+		updatedSymbols = append(updatedSymbols, market.Name)
+	}
+
 	refreshCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), p.processingTimeout)
 	defer cancel()
 
@@ -205,6 +310,12 @@ func (p *MarketPoller) refreshCache(
 		}
 	}
 
+	if err := p.cacheRefresher.InvalidateBySymbols(refreshCtx, updatedSymbols); err != nil {
+		if !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded) {
+			p.logger.Warn(refreshCtx, "Failed to invalidate markets by symbol cache after updates", zap.Error(err))
+		}
+	}
+
 	if err := p.cacheRefresher.RefreshAll(refreshCtx); err != nil {
 		if !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded) {
 			p.logger.Warn(refreshCtx, "Failed to refresh market cache after updates", zap.Error(err))
@@ -214,64 +325,84 @@ func (p *MarketPoller) refreshCache(
 
 func (p *MarketPoller) processNextBatch(
 	ctx context.Context,
-) (updatedIDs []uuid.UUID, hasMore bool, err error) {
-	markets, err := p.reader.ListUpdatedSince(ctx, p.lastSeenAt, p.lastSeenID, p.batchSize)
+) (updatedMarkets []sharedModels.Market, hasMore bool, err error) {
+	entries, err := p.reader.ListChangesAfter(ctx, p.lastSeq, p.batchSize)
 	if err != nil {
 		if !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded) {
-			p.logger.Error(ctx, "Failed to load updated markets", zap.Error(err))
+			p.logger.Error(ctx, "Failed to load market changes", zap.Error(err))
 		}
 		return nil, false, err
 	}
 
-	if len(markets) == 0 {
+	if len(entries) == 0 {
 		return nil, false, nil
 	}
 
-	updatedIDs = make([]uuid.UUID, 0, len(markets))
-	for _, market := range markets {
-		updatedIDs = append(updatedIDs, market.ID)
+	markets := make([]sharedModels.Market, 0, len(entries))
+	for _, entry := range entries {
+		markets = append(markets, entry.Market)
 	}
 
-	events, err := p.buildMarketStateChangedEvents(ctx, markets)
+	events, err := p.buildMarketUpdatedEvents(ctx, entries)
 	if err != nil {
 		return nil, false, err
 	}
 
-	nextCursor := p.buildNextPollerCursor(markets)
+	nextCursor := p.buildNextPollerCursor(entries)
+	afterSeq := p.lastSeq
 
-	if err = p.producer.PublishMarketStateChanged(ctx, events, nextCursor); err != nil {
-		p.logger.Error(ctx, "Failed to enqueue market state changed batch",
+	if err = p.producer.PublishMarketUpdated(ctx, events, nextCursor); err != nil {
+		p.logger.Error(ctx, "Failed to enqueue market updated batch",
 			zap.Int("markets_count", len(markets)),
-			zap.Time("last_seen_at", nextCursor.LastSeenAt),
-			zap.String("last_seen_id", nextCursor.LastSeenID.String()),
+			zap.Int64("last_seq", nextCursor.LastSeq),
 			zap.Error(err),
 		)
 		return nil, false, err
 	}
 
-	p.lastSeenAt = nextCursor.LastSeenAt
-	p.lastSeenID = nextCursor.LastSeenID
+	p.lastSeq = nextCursor.LastSeq
+
+	// Подписчики WatchMarkets получают батч только после фиксации курсора в outbox-транзакции
+	if p.changeNotifier != nil {
+		p.changeNotifier.NotifyMarketsChanged(ctx, models.MarketChangeBatch{
+			AfterSeq: afterSeq,
+			LastSeq:  nextCursor.LastSeq,
+			Markets:  markets,
+		})
+	}
 
-	return updatedIDs, len(markets) == p.batchSize, nil
+	return markets, len(entries) == p.batchSize, nil
 }
 
-func (p *MarketPoller) buildMarketStateChangedEvents(
+func (p *MarketPoller) buildMarketUpdatedEvents(
 	ctx context.Context,
-	markets []sharedModels.Market,
-) ([]sharedModels.MarketStateChangedEvent, error) {
-	events := make([]sharedModels.MarketStateChangedEvent, 0, len(markets))
+	entries []models.MarketChangeLogEntry,
+) ([]sharedModels.MarketUpdatedEvent, error) {
+	events := make([]sharedModels.MarketUpdatedEvent, 0, len(entries))
 
-	for _, market := range markets {
+	for _, entry := range entries {
 		if err := ctx.Err(); err != nil {
 			return nil, err
 		}
 
-		event := sharedModels.MarketStateChangedEvent{
-			EventID:   uuid.New(),
-			MarketID:  market.ID,
-			Enabled:   market.Enabled,
-			DeletedAt: market.DeletedAt,
-			UpdatedAt: market.UpdatedAt.UTC(),
+		market := entry.Market
+		changedFields, previous := diffMarketFields(entry.Previous, market)
+
+		event := sharedModels.MarketUpdatedEvent{
+			EventID:       uuid.New(),
+			MarketID:      market.ID,
+			Version:       market.Version,
+			Name:          market.Name,
+			BaseAsset:     market.BaseAsset,
+			QuoteAsset:    market.QuoteAsset,
+			Enabled:       market.Enabled,
+			DeletedAt:     market.DeletedAt,
+			UpdatedAt:     market.UpdatedAt.UTC(),
+			Restricted:    market.Restricted,
+			ChangedFields: changedFields,
+			Previous:      previous,
+
+			PublicToAllRoles: p.policies.PublicToAllRoles(market),
 		}
 
 		events = append(events, event)
@@ -280,12 +411,60 @@ func (p *MarketPoller) buildMarketStateChangedEvents(
 	return events, nil
 }
 
-func (p *MarketPoller) buildNextPollerCursor(markets []sharedModels.Market) models.PollerCursor {
-	last := markets[len(markets)-1]
+// diffMarketFields сравнивает состояния до и после изменения. Без previous (INSERT) изменений нет.
+func diffMarketFields(
+	previous *sharedModels.Market,
+	current sharedModels.Market,
+) ([]sharedModels.MarketField, sharedModels.MarketPreviousValues) {
+	var (
+		changedFields  []sharedModels.MarketField
+		previousValues sharedModels.MarketPreviousValues
+	)
+
+	if previous == nil {
+		return changedFields, previousValues
+	}
+
+	if previous.Name != current.Name {
+		changedFields = append(changedFields, sharedModels.MarketFieldName)
+		previousValues.Name = &previous.Name
+	}
+	if previous.BaseAsset != current.BaseAsset {
+		changedFields = append(changedFields, sharedModels.MarketFieldBaseAsset)
+		previousValues.BaseAsset = &previous.BaseAsset
+	}
+	if previous.QuoteAsset != current.QuoteAsset {
+		changedFields = append(changedFields, sharedModels.MarketFieldQuoteAsset)
+		previousValues.QuoteAsset = &previous.QuoteAsset
+	}
+	if previous.Enabled != current.Enabled {
+		changedFields = append(changedFields, sharedModels.MarketFieldEnabled)
+		previousValues.Enabled = &previous.Enabled
+	}
+	if !equalOptionalTime(previous.DeletedAt, current.DeletedAt) {
+		changedFields = append(changedFields, sharedModels.MarketFieldDeletedAt)
+		previousValues.DeletedAt = &sharedModels.OptionalTime{Value: previous.DeletedAt}
+	}
+	if previous.Restricted != current.Restricted {
+		changedFields = append(changedFields, sharedModels.MarketFieldRestricted)
+		previousValues.Restricted = &previous.Restricted
+	}
+
+	return changedFields, previousValues
+}
+
+func equalOptionalTime(left, right *time.Time) bool {
+	if left == nil || right == nil {
+		return left == right
+	}
+
+	return left.Equal(*right)
+}
 
+func (p *MarketPoller) buildNextPollerCursor(entries []models.MarketChangeLogEntry) models.PollerCursor {
 	return models.PollerCursor{
-		PollerName: p.pollerName,
-		LastSeenAt: last.UpdatedAt.UTC(),
-		LastSeenID: last.ID,
+		PollerName:   p.pollerName,
+		LastSeq:      entries[len(entries)-1].Seq,
+		FencingToken: p.fencingToken,
 	}
 }
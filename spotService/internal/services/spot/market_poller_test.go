@@ -52,9 +52,13 @@ func newTestPoller(
 
 	return NewMarketPoller(
 		r,
+		nil,
 		p,
 		c,
 		cr,
+		nil,
+		testVisibilityPolicies,
+		testPollInterval,
 		testPollInterval,
 		testProcessingTimeout,
 		testBatchSize,
@@ -80,18 +84,29 @@ func makeMarketsForMarketPoller(n int) []sharedModels.Market {
 	return markets
 }
 
-func TestInit(t *testing.T) {
-	fixedAt := time.Now().UTC().Truncate(time.Millisecond)
-	fixedID := uuid.New()
+func makeChangeLogEntries(firstSeq int64, markets ...sharedModels.Market) []domainModels.MarketChangeLogEntry {
+	entries := make([]domainModels.MarketChangeLogEntry, len(markets))
+	for i, market := range markets {
+		entries[i] = domainModels.MarketChangeLogEntry{
+			Seq:    firstSeq + int64(i),
+			Market: market,
+		}
+	}
+	return entries
+}
 
+func makeEntriesForMarketPoller(n int) []domainModels.MarketChangeLogEntry {
+	return makeChangeLogEntries(1, makeMarketsForMarketPoller(n)...)
+}
+
+func TestInit(t *testing.T) {
 	tests := []struct {
-		name           string
-		ctx            context.Context
-		setupMocks     func(store *mocks.CursorStore)
-		wantErr        bool
-		checkErr       func(t *testing.T, err error)
-		wantLastSeenAt time.Time
-		wantLastSeenID uuid.UUID
+		name        string
+		ctx         context.Context
+		setupMocks  func(store *mocks.CursorStore)
+		wantErr     bool
+		checkErr    func(t *testing.T, err error)
+		wantLastSeq int64
 	}{
 		{
 			name:       "nil ctx — ошибка без паники",
@@ -110,22 +125,19 @@ func TestInit(t *testing.T) {
 				store.On("Get", mock.Anything, marketStateChangedPollerName).
 					Return(domainModels.PollerCursor{}, pgx.ErrNoRows)
 			},
-			wantLastSeenAt: time.Time{},
-			wantLastSeenID: uuid.Nil,
+			wantLastSeq: 0,
 		},
 		{
-			name: "курсор найден — lastSeenAt и lastSeenID выставляются корректно",
+			name: "курсор найден — lastSeq выставляется корректно",
 			ctx:  context.Background(),
 			setupMocks: func(store *mocks.CursorStore) {
 				store.On("Get", mock.Anything, marketStateChangedPollerName).
 					Return(domainModels.PollerCursor{
 						PollerName: marketStateChangedPollerName,
-						LastSeenAt: fixedAt,
-						LastSeenID: fixedID,
+						LastSeq:    42,
 					}, nil)
 			},
-			wantLastSeenAt: fixedAt,
-			wantLastSeenID: fixedID,
+			wantLastSeq: 42,
 		},
 		{
 			name: "неизвестная ошибка хранилища — возвращается ошибка",
@@ -159,8 +171,7 @@ func TestInit(t *testing.T) {
 				require.Error(t, err)
 			} else {
 				require.NoError(t, err)
-				assert.Equal(t, tt.wantLastSeenAt, p.lastSeenAt)
-				assert.Equal(t, tt.wantLastSeenID, p.lastSeenID)
+				assert.Equal(t, tt.wantLastSeq, p.lastSeq)
 			}
 
 			store.AssertExpectations(t)
@@ -169,91 +180,95 @@ func TestInit(t *testing.T) {
 }
 
 func TestProcessNextBatch(t *testing.T) {
-	markets := makeMarketsForMarketPoller(2)
+	entries := makeEntriesForMarketPoller(2)
 
 	tests := []struct {
 		name           string
-		initialAt      time.Time
-		initialID      uuid.UUID
+		initialSeq     int64
 		setupMocks     func(reader *mocks.MarketReader, producer *mocks.MarketEventProducer)
 		wantUpdatedIDs int
 		wantHasMore    bool
 		wantErr        bool
 		checkErr       func(t *testing.T, err error)
 		checkCursor    func(t *testing.T, p *MarketPoller)
-		checkEvents    func(t *testing.T, producer *mocks.MarketEventProducer)
 	}{
 		{
 			name: "пустой результат — nil, false, nil, курсор не меняется",
 			setupMocks: func(reader *mocks.MarketReader, _ *mocks.MarketEventProducer) {
-				reader.On("ListUpdatedSince", mock.Anything, mock.Anything, mock.Anything, testBatchSize).
+				reader.On("ListChangesAfter", mock.Anything, mock.Anything, testBatchSize).
 					Return(nil, nil)
 			},
 			wantUpdatedIDs: 0,
 			wantHasMore:    false,
+			checkCursor: func(t *testing.T, p *MarketPoller) {
+				assert.Equal(t, int64(0), p.lastSeq)
+			},
 		},
 		{
-			name: "меньше batchSize маркетов — hasMore=false",
+			name: "меньше batchSize записей — hasMore=false",
 			setupMocks: func(reader *mocks.MarketReader, producer *mocks.MarketEventProducer) {
-				markets := makeMarketsForMarketPoller(2)
-				reader.On("ListUpdatedSince", mock.Anything, mock.Anything, mock.Anything, testBatchSize).
-					Return(markets, nil)
-				producer.On("PublishMarketStateChanged", mock.Anything, mock.Anything, mock.Anything).
+				reader.On("ListChangesAfter", mock.Anything, mock.Anything, testBatchSize).
+					Return(makeEntriesForMarketPoller(2), nil)
+				producer.On("PublishMarketUpdated", mock.Anything, mock.Anything, mock.Anything).
 					Return(nil)
 			},
 			wantUpdatedIDs: 2,
 			wantHasMore:    false,
 		},
 		{
-			name: "ровно batchSize маркетов — hasMore=true",
+			name: "ровно batchSize записей — hasMore=true",
 			setupMocks: func(reader *mocks.MarketReader, producer *mocks.MarketEventProducer) {
-				markets := makeMarketsForMarketPoller(testBatchSize)
-				reader.On("ListUpdatedSince", mock.Anything, mock.Anything, mock.Anything, testBatchSize).
-					Return(markets, nil)
-				producer.On("PublishMarketStateChanged", mock.Anything, mock.Anything, mock.Anything).
+				reader.On("ListChangesAfter", mock.Anything, mock.Anything, testBatchSize).
+					Return(makeEntriesForMarketPoller(testBatchSize), nil)
+				producer.On("PublishMarketUpdated", mock.Anything, mock.Anything, mock.Anything).
 					Return(nil)
 			},
 			wantUpdatedIDs: testBatchSize,
 			wantHasMore:    true,
 		},
 		{
-			name: "курсор обновляется до последнего маркета в батче",
+			name:       "курсор сдвигается до seq последней записи и сохраняется вместе с событиями",
+			initialSeq: 10,
 			setupMocks: func(reader *mocks.MarketReader, producer *mocks.MarketEventProducer) {
-				last := markets[len(markets)-1]
-
-				reader.On("ListUpdatedSince", mock.Anything, mock.Anything, mock.Anything, testBatchSize).
-					Return(markets, nil)
-				producer.On("PublishMarketStateChanged", mock.Anything, mock.Anything, mock.Anything).
-					Return(nil)
-
-				_ = last
+				batch := makeChangeLogEntries(11, makeMarketsForMarketPoller(2)...)
+				reader.On("ListChangesAfter", mock.Anything, int64(10), testBatchSize).
+					Return(batch, nil)
+				producer.On("PublishMarketUpdated", mock.Anything, mock.Anything,
+					domainModels.PollerCursor{PollerName: marketStateChangedPollerName, LastSeq: 12},
+				).Return(nil)
 			},
 			wantUpdatedIDs: 2,
 			wantHasMore:    false,
 			checkCursor: func(t *testing.T, p *MarketPoller) {
-				last := markets[len(markets)-1]
-
-				require.Equal(t, last.UpdatedAt.UTC(), p.lastSeenAt)
-				require.Equal(t, last.ID, p.lastSeenID)
+				assert.Equal(t, int64(12), p.lastSeq)
 			},
 		},
 		{
-			name:      "reader передаёт в ListUpdatedSince начальный курсор поллера",
-			initialAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
-			initialID: uuid.MustParse("11111111-1111-1111-1111-111111111111"),
-			setupMocks: func(reader *mocks.MarketReader, _ *mocks.MarketEventProducer) {
-				expectedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
-				expectedID := uuid.MustParse("11111111-1111-1111-1111-111111111111")
-				reader.On("ListUpdatedSince", mock.Anything, expectedAt, expectedID, testBatchSize).
-					Return(nil, nil)
+			name: "несколько изменений одного рынка — событие на каждое изменение",
+			setupMocks: func(reader *mocks.MarketReader, producer *mocks.MarketEventProducer) {
+				market := makeMarket(true, nil)
+				disabled := market
+				disabled.Enabled = false
+
+				reader.On("ListChangesAfter", mock.Anything, mock.Anything, testBatchSize).
+					Return(makeChangeLogEntries(1, market, disabled), nil)
+				producer.On("PublishMarketUpdated", mock.Anything,
+					mock.MatchedBy(func(events []sharedModels.MarketUpdatedEvent) bool {
+						return len(events) == 2 &&
+							events[0].MarketID == market.ID && events[0].Enabled &&
+							events[1].MarketID == market.ID && !events[1].Enabled
+					}),
+					mock.Anything,
+				).Return(nil)
 			},
-			wantHasMore: false,
+			wantUpdatedIDs: 2,
+			wantHasMore:    false,
 		},
 		{
-			name:      "ошибка reader — возвращается ошибка, курсор не меняется",
-			initialID: uuid.MustParse("22222222-2222-2222-2222-222222222222"),
+			name:       "ошибка reader — возвращается ошибка, курсор не меняется",
+			initialSeq: 22,
 			setupMocks: func(reader *mocks.MarketReader, _ *mocks.MarketEventProducer) {
-				reader.On("ListUpdatedSince", mock.Anything, mock.Anything, mock.Anything, testBatchSize).
+				reader.On("ListChangesAfter", mock.Anything, mock.Anything, testBatchSize).
 					Return(nil, errors.New("db timeout"))
 			},
 			wantErr: true,
@@ -262,17 +277,16 @@ func TestProcessNextBatch(t *testing.T) {
 				assert.Contains(t, err.Error(), "db timeout")
 			},
 			checkCursor: func(t *testing.T, p *MarketPoller) {
-				assert.Equal(t, uuid.MustParse("22222222-2222-2222-2222-222222222222"), p.lastSeenID)
+				assert.Equal(t, int64(22), p.lastSeq)
 			},
 		},
 		{
-			name:      "ошибка producer — возвращается ошибка, курсор не меняется",
-			initialID: uuid.MustParse("33333333-3333-3333-3333-333333333333"),
+			name:       "ошибка producer — возвращается ошибка, курсор не меняется",
+			initialSeq: 33,
 			setupMocks: func(reader *mocks.MarketReader, producer *mocks.MarketEventProducer) {
-				markets := makeMarketsForMarketPoller(1)
-				reader.On("ListUpdatedSince", mock.Anything, mock.Anything, mock.Anything, testBatchSize).
-					Return(markets, nil)
-				producer.On("PublishMarketStateChanged", mock.Anything, mock.Anything, mock.Anything).
+				reader.On("ListChangesAfter", mock.Anything, mock.Anything, testBatchSize).
+					Return(makeChangeLogEntries(34, makeMarket(true, nil)), nil)
+				producer.On("PublishMarketUpdated", mock.Anything, mock.Anything, mock.Anything).
 					Return(errors.New("kafka unavailable"))
 			},
 			wantErr: true,
@@ -281,7 +295,7 @@ func TestProcessNextBatch(t *testing.T) {
 				assert.Contains(t, err.Error(), "kafka unavailable")
 			},
 			checkCursor: func(t *testing.T, p *MarketPoller) {
-				assert.Equal(t, uuid.MustParse("33333333-3333-3333-3333-333333333333"), p.lastSeenID)
+				assert.Equal(t, int64(33), p.lastSeq)
 			},
 		},
 		{
@@ -295,11 +309,11 @@ func TestProcessNextBatch(t *testing.T) {
 					UpdatedAt: time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC),
 				}
 
-				reader.On("ListUpdatedSince", mock.Anything, mock.Anything, mock.Anything, testBatchSize).
-					Return([]sharedModels.Market{market}, nil)
+				reader.On("ListChangesAfter", mock.Anything, mock.Anything, testBatchSize).
+					Return(makeChangeLogEntries(1, market), nil)
 
-				producer.On("PublishMarketStateChanged", mock.Anything,
-					mock.MatchedBy(func(events []sharedModels.MarketStateChangedEvent) bool {
+				producer.On("PublishMarketUpdated", mock.Anything,
+					mock.MatchedBy(func(events []sharedModels.MarketUpdatedEvent) bool {
 						if len(events) != 1 {
 							return false
 						}
@@ -317,18 +331,17 @@ func TestProcessNextBatch(t *testing.T) {
 			wantHasMore:    false,
 		},
 		{
-			name: "updatedIDs содержат ID всех маркетов из батча",
+			name: "события идут в порядке seq",
 			setupMocks: func(reader *mocks.MarketReader, producer *mocks.MarketEventProducer) {
-				markets := makeMarketsForMarketPoller(2)
-				reader.On("ListUpdatedSince", mock.Anything, mock.Anything, mock.Anything, testBatchSize).
-					Return(markets, nil)
-				producer.On("PublishMarketStateChanged", mock.Anything,
-					mock.MatchedBy(func(events []sharedModels.MarketStateChangedEvent) bool {
+				reader.On("ListChangesAfter", mock.Anything, mock.Anything, testBatchSize).
+					Return(entries, nil)
+				producer.On("PublishMarketUpdated", mock.Anything,
+					mock.MatchedBy(func(events []sharedModels.MarketUpdatedEvent) bool {
 						if len(events) != 2 {
 							return false
 						}
-						return events[0].MarketID == markets[0].ID &&
-							events[1].MarketID == markets[1].ID
+						return events[0].MarketID == entries[0].Market.ID &&
+							events[1].MarketID == entries[1].Market.ID
 					}),
 					mock.Anything,
 				).Return(nil)
@@ -347,14 +360,9 @@ func TestProcessNextBatch(t *testing.T) {
 			tt.setupMocks(reader, producer)
 
 			p := newTestPoller(reader, producer, cursor, refresher)
-			if tt.initialID != uuid.Nil {
-				p.lastSeenID = tt.initialID
-			}
-			if !tt.initialAt.IsZero() {
-				p.lastSeenAt = tt.initialAt
-			}
+			p.lastSeq = tt.initialSeq
 
-			updatedIDs, hasMore, err := p.processNextBatch(context.Background())
+			updatedMarkets, hasMore, err := p.processNextBatch(context.Background())
 
 			if tt.checkErr != nil {
 				tt.checkErr(t, err)
@@ -363,7 +371,7 @@ func TestProcessNextBatch(t *testing.T) {
 			} else {
 				require.NoError(t, err)
 				assert.Equal(t, tt.wantHasMore, hasMore)
-				assert.Len(t, updatedIDs, tt.wantUpdatedIDs)
+				assert.Len(t, updatedMarkets, tt.wantUpdatedIDs)
 			}
 
 			if tt.checkCursor != nil {
@@ -378,127 +386,175 @@ func TestProcessNextBatch(t *testing.T) {
 
 func TestBuildNextPollerCursor(t *testing.T) {
 	tests := []struct {
-		name           string
-		markets        []sharedModels.Market
-		wantPollerName string
-		wantLastSeenID uuid.UUID
-		wantLastSeenAt time.Time
+		name        string
+		entries     []domainModels.MarketChangeLogEntry
+		wantLastSeq int64
 	}{
 		{
-			name: "один маркет — курсор берётся из него",
-			markets: []sharedModels.Market{
-				{
-					ID:        uuid.MustParse("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"),
-					UpdatedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
-				},
-			},
-			wantPollerName: marketStateChangedPollerName,
-			wantLastSeenID: uuid.MustParse("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"),
-			wantLastSeenAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+			name:        "одна запись — курсор берётся из неё",
+			entries:     makeChangeLogEntries(7, makeMarket(true, nil)),
+			wantLastSeq: 7,
 		},
 		{
-			name: "несколько маркетов — курсор берётся из последнего",
-			markets: []sharedModels.Market{
-				{ID: uuid.New(), UpdatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
-				{ID: uuid.New(), UpdatedAt: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)},
-				{
-					ID:        uuid.MustParse("bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"),
-					UpdatedAt: time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC),
-				},
-			},
-			wantPollerName: marketStateChangedPollerName,
-			wantLastSeenID: uuid.MustParse("bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"),
-			wantLastSeenAt: time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC),
-		},
-		{
-			name: "UpdatedAt в не-UTC таймзоне — конвертируется в UTC",
-			markets: []sharedModels.Market{
-				{
-					ID:        uuid.New(),
-					UpdatedAt: time.Date(2025, 6, 1, 12, 0, 0, 0, time.FixedZone("MSK", 3*3600)),
-				},
-			},
-			wantPollerName: marketStateChangedPollerName,
-			wantLastSeenAt: time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC),
+			name:        "несколько записей — курсор берётся из последней",
+			entries:     makeChangeLogEntries(100, makeMarketsForMarketPoller(3)...),
+			wantLastSeq: 102,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			p := newTestPoller(nil, nil, nil, nil)
-			cursor := p.buildNextPollerCursor(tt.markets)
-
-			assert.Equal(t, tt.wantPollerName, cursor.PollerName)
-			assert.Equal(t, time.UTC, cursor.LastSeenAt.Location())
+			cursor := p.buildNextPollerCursor(tt.entries)
 
-			if tt.wantLastSeenID != uuid.Nil {
-				assert.Equal(t, tt.wantLastSeenID, cursor.LastSeenID)
-			}
-			if !tt.wantLastSeenAt.IsZero() {
-				assert.True(t, tt.wantLastSeenAt.Equal(cursor.LastSeenAt),
-					"got %v, want %v", cursor.LastSeenAt, tt.wantLastSeenAt)
-			}
+			assert.Equal(t, marketStateChangedPollerName, cursor.PollerName)
+			assert.Equal(t, tt.wantLastSeq, cursor.LastSeq)
 		})
 	}
 }
 
-func TestBuildMarketStateChangedEvents(t *testing.T) {
+func TestBuildMarketUpdatedEvents(t *testing.T) {
 	deletedAt := time.Now().UTC()
 
 	tests := []struct {
 		name        string
 		ctx         context.Context
-		markets     []sharedModels.Market
+		entries     []domainModels.MarketChangeLogEntry
+		policies    domainModels.VisibilityPolicies
 		wantLen     int
 		wantErr     bool
-		checkEvents func(t *testing.T, events []sharedModels.MarketStateChangedEvent, markets []sharedModels.Market)
+		checkEvents func(t *testing.T, events []sharedModels.MarketUpdatedEvent, entries []domainModels.MarketChangeLogEntry)
 	}{
 		{
-			name:    "пустой список маркетов — пустой список событий",
+			name:    "пустой журнал — пустой список событий",
 			ctx:     context.Background(),
-			markets: []sharedModels.Market{},
+			entries: []domainModels.MarketChangeLogEntry{},
 			wantLen: 0,
 		},
 		{
-			name: "маркеты конвертируются в события с правильными полями",
+			name: "записи журнала конвертируются в полные снимки рынков",
 			ctx:  context.Background(),
-			markets: []sharedModels.Market{
-				{
-					ID:        uuid.MustParse("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"),
-					Enabled:   true,
-					DeletedAt: nil,
-					UpdatedAt: time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC),
+			entries: makeChangeLogEntries(1,
+				sharedModels.Market{
+					ID:         uuid.MustParse("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"),
+					Name:       "BTC-USDT",
+					BaseAsset:  "BTC",
+					QuoteAsset: "USDT",
+					Enabled:    true,
+					DeletedAt:  nil,
+					UpdatedAt:  time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC),
+					Version:    1,
 				},
-				{
-					ID:        uuid.MustParse("bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"),
-					Enabled:   false,
-					DeletedAt: &deletedAt,
-					UpdatedAt: time.Date(2025, 3, 2, 10, 0, 0, 0, time.UTC),
+				sharedModels.Market{
+					ID:         uuid.MustParse("bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"),
+					Name:       "ETH-USDT",
+					BaseAsset:  "ETH",
+					QuoteAsset: "USDT",
+					Enabled:    false,
+					DeletedAt:  &deletedAt,
+					UpdatedAt:  time.Date(2025, 3, 2, 10, 0, 0, 0, time.UTC),
+					Version:    7,
 				},
-			},
+			),
 			wantLen: 2,
-			checkEvents: func(t *testing.T, events []sharedModels.MarketStateChangedEvent, markets []sharedModels.Market) {
+			checkEvents: func(t *testing.T, events []sharedModels.MarketUpdatedEvent, entries []domainModels.MarketChangeLogEntry) {
 				for i, e := range events {
-					m := markets[i]
+					m := entries[i].Market
 					assert.NotEqual(t, uuid.Nil, e.EventID, "EventID должен быть непустым")
 					assert.Equal(t, m.ID, e.MarketID)
+					assert.Equal(t, m.Version, e.Version)
+					assert.Equal(t, m.Name, e.Name)
+					assert.Equal(t, m.BaseAsset, e.BaseAsset)
+					assert.Equal(t, m.QuoteAsset, e.QuoteAsset)
 					assert.Equal(t, m.Enabled, e.Enabled)
 					assert.Equal(t, m.DeletedAt, e.DeletedAt)
 					assert.True(t, m.UpdatedAt.Equal(e.UpdatedAt))
 					assert.Equal(t, time.UTC, e.UpdatedAt.Location(), "UpdatedAt должен быть в UTC")
+					assert.Empty(t, e.ChangedFields, "без previous изменённых полей нет")
+					assert.Equal(t, sharedModels.MarketPreviousValues{}, e.Previous)
 				}
 			},
 		},
 		{
-			name: "каждое событие получает уникальный EventID",
+			name: "UPDATE — изменённые поля и их прежние значения",
+			ctx:  context.Background(),
+			entries: []domainModels.MarketChangeLogEntry{{
+				Seq: 1,
+				Market: sharedModels.Market{
+					ID: uuid.MustParse("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"), Name: "XBT-USDT",
+					BaseAsset: "BTC", QuoteAsset: "USDT", Enabled: false, DeletedAt: &deletedAt, Version: 3,
+				},
+				Previous: &sharedModels.Market{
+					ID: uuid.MustParse("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"), Name: "BTC-USDT",
+					BaseAsset: "BTC", QuoteAsset: "USDT", Enabled: true, Version: 2,
+				},
+			}},
+			wantLen: 1,
+			checkEvents: func(t *testing.T, events []sharedModels.MarketUpdatedEvent, _ []domainModels.MarketChangeLogEntry) {
+				e := events[0]
+				assert.Equal(t, int64(3), e.Version)
+				assert.Equal(t, []sharedModels.MarketField{
+					sharedModels.MarketFieldName,
+					sharedModels.MarketFieldEnabled,
+					sharedModels.MarketFieldDeletedAt,
+				}, e.ChangedFields)
+				require.NotNil(t, e.Previous.Name)
+				assert.Equal(t, "BTC-USDT", *e.Previous.Name)
+				require.NotNil(t, e.Previous.Enabled)
+				assert.True(t, *e.Previous.Enabled)
+				require.NotNil(t, e.Previous.DeletedAt, "deleted_at менялся")
+				assert.Nil(t, e.Previous.DeletedAt.Value, "до удаления deleted_at был пуст")
+				assert.Nil(t, e.Previous.BaseAsset)
+				assert.Nil(t, e.Previous.QuoteAsset)
+			},
+		},
+		{
+			name: "UPDATE restricted — флаг и прежнее значение в событии",
 			ctx:  context.Background(),
-			markets: []sharedModels.Market{
-				{ID: uuid.New(), UpdatedAt: time.Now().UTC()},
-				{ID: uuid.New(), UpdatedAt: time.Now().UTC()},
-				{ID: uuid.New(), UpdatedAt: time.Now().UTC()},
+			entries: []domainModels.MarketChangeLogEntry{{
+				Seq: 1,
+				Market: sharedModels.Market{
+					ID: uuid.MustParse("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"), Name: "BETA-USDT",
+					Enabled: true, Restricted: true, Version: 5,
+				},
+				Previous: &sharedModels.Market{
+					ID: uuid.MustParse("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"), Name: "BETA-USDT",
+					Enabled: true, Version: 4,
+				},
+			}},
+			wantLen: 1,
+			checkEvents: func(t *testing.T, events []sharedModels.MarketUpdatedEvent, _ []domainModels.MarketChangeLogEntry) {
+				e := events[0]
+				assert.True(t, e.Restricted)
+				assert.Equal(t, []sharedModels.MarketField{sharedModels.MarketFieldRestricted}, e.ChangedFields)
+				require.NotNil(t, e.Previous.Restricted)
+				assert.False(t, *e.Previous.Restricted)
+				assert.Nil(t, e.Previous.DeletedAt, "deleted_at не менялся")
+			},
+		},
+		{
+			name:     "PublicToAllRoles вычисляется по политикам видимости",
+			ctx:      context.Background(),
+			policies: testPublicPolicies,
+			entries: makeChangeLogEntries(1,
+				sharedModels.Market{ID: uuid.New(), BaseAsset: "BTC", QuoteAsset: "USDT", UpdatedAt: time.Now().UTC()},
+			),
+			wantLen: 1,
+			checkEvents: func(t *testing.T, events []sharedModels.MarketUpdatedEvent, _ []domainModels.MarketChangeLogEntry) {
+				assert.True(t, events[0].PublicToAllRoles)
+				assert.Empty(t, events[0].ChangedFields)
 			},
+		},
+		{
+			name: "каждое событие получает уникальный EventID",
+			ctx:  context.Background(),
+			entries: makeChangeLogEntries(1,
+				sharedModels.Market{ID: uuid.New(), UpdatedAt: time.Now().UTC()},
+				sharedModels.Market{ID: uuid.New(), UpdatedAt: time.Now().UTC()},
+				sharedModels.Market{ID: uuid.New(), UpdatedAt: time.Now().UTC()},
+			),
 			wantLen: 3,
-			checkEvents: func(t *testing.T, events []sharedModels.MarketStateChangedEvent, _ []sharedModels.Market) {
+			checkEvents: func(t *testing.T, events []sharedModels.MarketUpdatedEvent, _ []domainModels.MarketChangeLogEntry) {
 				ids := make(map[uuid.UUID]struct{}, len(events))
 				for _, e := range events {
 					ids[e.EventID] = struct{}{}
@@ -513,9 +569,7 @@ func TestBuildMarketStateChangedEvents(t *testing.T) {
 				cancel()
 				return ctx
 			}(),
-			markets: []sharedModels.Market{
-				{ID: uuid.New(), UpdatedAt: time.Now().UTC()},
-			},
+			entries: makeChangeLogEntries(1, sharedModels.Market{ID: uuid.New(), UpdatedAt: time.Now().UTC()}),
 			wantErr: true,
 		},
 	}
@@ -523,7 +577,10 @@ func TestBuildMarketStateChangedEvents(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			p := newTestPoller(nil, nil, nil, nil)
-			events, err := p.buildMarketStateChangedEvents(tt.ctx, tt.markets)
+			if tt.policies != nil {
+				p.policies = tt.policies
+			}
+			events, err := p.buildMarketUpdatedEvents(tt.ctx, tt.entries)
 
 			if tt.wantErr {
 				require.Error(t, err)
@@ -534,79 +591,101 @@ func TestBuildMarketStateChangedEvents(t *testing.T) {
 			assert.Len(t, events, tt.wantLen)
 
 			if tt.checkEvents != nil {
-				tt.checkEvents(t, events, tt.markets)
+				tt.checkEvents(t, events, tt.entries)
 			}
 		})
 	}
 }
 
 func TestRefreshCache(t *testing.T) {
-	id1 := uuid.New()
-	id2 := uuid.New()
+	market1 := sharedModels.Market{ID: uuid.New(), Name: "BTC-USDT"}
+	market2 := sharedModels.Market{ID: uuid.New(), Name: "ETH-USDT"}
 
 	tests := []struct {
 		name         string
-		ids          []uuid.UUID
+		markets      []sharedModels.Market
 		nilRefresher bool
 		setupMocks   func(refresher *mocks.MarketCacheRefresher)
 	}{
 		{
-			name:       "пустой список IDs — никакие методы не вызываются",
-			ids:        []uuid.UUID{},
+			name:       "пустой список рынков — никакие методы не вызываются",
+			markets:    []sharedModels.Market{},
 			setupMocks: func(_ *mocks.MarketCacheRefresher) {},
 		},
 		{
 			name:         "nil cacheRefresher — нет паники, нет вызовов",
-			ids:          []uuid.UUID{id1},
+			markets:      []sharedModels.Market{market1},
 			nilRefresher: true,
 			setupMocks:   func(_ *mocks.MarketCacheRefresher) {},
 		},
 		{
-			name: "InvalidateByIDs и RefreshAll вызываются в правильном порядке",
-			ids:  []uuid.UUID{id1, id2},
+			name:    "InvalidateByIDs, InvalidateBySymbols и RefreshAll вызываются в правильном порядке",
+			markets: []sharedModels.Market{market1, market2},
 			setupMocks: func(refresher *mocks.MarketCacheRefresher) {
-				call1 := refresher.On("InvalidateByIDs", mock.Anything, []uuid.UUID{id1, id2}).
+				call1 := refresher.On("InvalidateByIDs", mock.Anything, []uuid.UUID{market1.ID, market2.ID}).
 					Return(nil).Once()
-				refresher.On("RefreshAll", mock.Anything).
+				call2 := refresher.On("InvalidateBySymbols", mock.Anything, []string{"BTC-USDT", "ETH-USDT"}).
 					Return(nil).Once().NotBefore(call1)
+				refresher.On("RefreshAll", mock.Anything).
+					Return(nil).Once().NotBefore(call2)
 			},
 		},
 		{
-			name: "ошибка InvalidateByIDs — не фатальна, RefreshAll всё равно вызывается",
-			ids:  []uuid.UUID{id1},
+			name:    "ошибка InvalidateByIDs — не фатальна, остальные шаги всё равно вызываются",
+			markets: []sharedModels.Market{market1},
 			setupMocks: func(refresher *mocks.MarketCacheRefresher) {
 				refresher.On("InvalidateByIDs", mock.Anything, mock.Anything).
 					Return(errors.New("redis timeout")).Once()
+				refresher.On("InvalidateBySymbols", mock.Anything, mock.Anything).
+					Return(nil).Once()
 				refresher.On("RefreshAll", mock.Anything).
 					Return(nil).Once()
 			},
 		},
 		{
-			name: "ошибка RefreshAll — не фатальна, вызов не паникует",
-			ids:  []uuid.UUID{id1},
+			name:    "ошибка InvalidateBySymbols — не фатальна, RefreshAll всё равно вызывается",
+			markets: []sharedModels.Market{market1},
 			setupMocks: func(refresher *mocks.MarketCacheRefresher) {
 				refresher.On("InvalidateByIDs", mock.Anything, mock.Anything).
 					Return(nil).Once()
+				refresher.On("InvalidateBySymbols", mock.Anything, mock.Anything).
+					Return(errors.New("redis timeout")).Once()
+				refresher.On("RefreshAll", mock.Anything).
+					Return(nil).Once()
+			},
+		},
+		{
+			name:    "ошибка RefreshAll — не фатальна, вызов не паникует",
+			markets: []sharedModels.Market{market1},
+			setupMocks: func(refresher *mocks.MarketCacheRefresher) {
+				refresher.On("InvalidateByIDs", mock.Anything, mock.Anything).
+					Return(nil).Once()
+				refresher.On("InvalidateBySymbols", mock.Anything, mock.Anything).
+					Return(nil).Once()
 				refresher.On("RefreshAll", mock.Anything).
 					Return(errors.New("redis oom")).Once()
 			},
 		},
 		{
-			name: "context.Canceled от InvalidateByIDs — RefreshAll всё равно вызывается",
-			ids:  []uuid.UUID{id1},
+			name:    "context.Canceled от InvalidateByIDs — RefreshAll всё равно вызывается",
+			markets: []sharedModels.Market{market1},
 			setupMocks: func(refresher *mocks.MarketCacheRefresher) {
 				refresher.On("InvalidateByIDs", mock.Anything, mock.Anything).
 					Return(context.Canceled).Once()
+				refresher.On("InvalidateBySymbols", mock.Anything, mock.Anything).
+					Return(nil).Once()
 				refresher.On("RefreshAll", mock.Anything).
 					Return(nil).Once()
 			},
 		},
 		{
-			name: "context.DeadlineExceeded от RefreshAll — не фатальна",
-			ids:  []uuid.UUID{id1},
+			name:    "context.DeadlineExceeded от RefreshAll — не фатальна",
+			markets: []sharedModels.Market{market1},
 			setupMocks: func(refresher *mocks.MarketCacheRefresher) {
 				refresher.On("InvalidateByIDs", mock.Anything, mock.Anything).
 					Return(nil).Once()
+				refresher.On("InvalidateBySymbols", mock.Anything, mock.Anything).
+					Return(nil).Once()
 				refresher.On("RefreshAll", mock.Anything).
 					Return(context.DeadlineExceeded).Once()
 			},
@@ -628,7 +707,7 @@ func TestRefreshCache(t *testing.T) {
 				p = newTestPoller(reader, producer, cursorStore, refresher)
 			}
 
-			p.refreshCache(context.Background(), tt.ids)
+			p.refreshCache(context.Background(), tt.markets)
 
 			refresher.AssertExpectations(t)
 		})
@@ -645,22 +724,21 @@ func TestPoll(t *testing.T) {
 		{
 			name: "пустой репо — нет событий, нет обновления курсора",
 			setupMocks: func(reader *mocks.MarketReader, _ *mocks.MarketEventProducer, _ *mocks.MarketCacheRefresher) {
-				reader.On("ListUpdatedSince", mock.Anything, mock.Anything, mock.Anything, testBatchSize).
+				reader.On("ListChangesAfter", mock.Anything, mock.Anything, testBatchSize).
 					Return(nil, nil)
 			},
 			checkErr: func(t *testing.T, err error) { require.NoError(t, err) },
 			checkState: func(t *testing.T, p *MarketPoller) {
-				assert.Equal(t, uuid.Nil, p.lastSeenID)
-				assert.True(t, p.lastSeenAt.IsZero())
+				assert.Equal(t, int64(0), p.lastSeq)
 			},
 		},
 		{
 			name: "один батч меньше batchSize — один вызов reader, курсор обновлён",
 			setupMocks: func(reader *mocks.MarketReader, producer *mocks.MarketEventProducer, refresher *mocks.MarketCacheRefresher) {
 				markets := makeMarketsForMarketPoller(2)
-				reader.On("ListUpdatedSince", mock.Anything, mock.Anything, mock.Anything, testBatchSize).
-					Return(markets, nil).Once()
-				producer.On("PublishMarketStateChanged", mock.Anything, mock.Anything, mock.Anything).
+				reader.On("ListChangesAfter", mock.Anything, mock.Anything, testBatchSize).
+					Return(makeChangeLogEntries(1, markets...), nil).Once()
+				producer.On("PublishMarketUpdated", mock.Anything, mock.Anything, mock.Anything).
 					Return(nil).Once()
 				refresher.On(
 					"InvalidateByIDs",
@@ -672,30 +750,31 @@ func TestPoll(t *testing.T) {
 					}),
 				).Return(nil).Once()
 
+				refresher.On("InvalidateBySymbols", mock.Anything, mock.Anything).Return(nil).Once()
 				refresher.On("RefreshAll", mock.Anything).Return(nil).Once()
 			},
 			checkErr: func(t *testing.T, err error) {
 				require.NoError(t, err)
 			},
 			checkState: func(t *testing.T, p *MarketPoller) {
-				assert.NotEqual(t, uuid.Nil, p.lastSeenID)
+				assert.Equal(t, int64(2), p.lastSeq)
 			},
 		},
 		{
 			name: "два батча: первый полный (hasMore=true), второй пустой (hasMore=false)",
 			setupMocks: func(reader *mocks.MarketReader, producer *mocks.MarketEventProducer, refresher *mocks.MarketCacheRefresher) {
-				batch1 := makeMarketsForMarketPoller(testBatchSize)
-				reader.On("ListUpdatedSince", mock.Anything, mock.Anything, mock.Anything, testBatchSize).
-					Return(batch1, nil).Once()
-				producer.On("PublishMarketStateChanged", mock.Anything, mock.Anything, mock.Anything).
+				reader.On("ListChangesAfter", mock.Anything, mock.Anything, testBatchSize).
+					Return(makeEntriesForMarketPoller(testBatchSize), nil).Once()
+				producer.On("PublishMarketUpdated", mock.Anything, mock.Anything, mock.Anything).
 					Return(nil).Once()
 
-				reader.On("ListUpdatedSince", mock.Anything, mock.Anything, mock.Anything, testBatchSize).
+				reader.On("ListChangesAfter", mock.Anything, mock.Anything, testBatchSize).
 					Return(nil, nil).Once()
 
 				refresher.On("InvalidateByIDs", mock.Anything,
 					mock.MatchedBy(func(ids []uuid.UUID) bool { return len(ids) == testBatchSize }),
 				).Return(nil).Once()
+				refresher.On("InvalidateBySymbols", mock.Anything, mock.Anything).Return(nil).Once()
 				refresher.On("RefreshAll", mock.Anything).Return(nil).Once()
 			},
 			checkErr: func(t *testing.T, err error) { require.NoError(t, err) },
@@ -704,18 +783,19 @@ func TestPoll(t *testing.T) {
 			name: "два полных батча, потом один пустой — reader вызывается трижды",
 			setupMocks: func(reader *mocks.MarketReader, producer *mocks.MarketEventProducer, refresher *mocks.MarketCacheRefresher) {
 				for i := 0; i < 2; i++ {
-					batch := makeMarketsForMarketPoller(testBatchSize)
-					reader.On("ListUpdatedSince", mock.Anything, mock.Anything, mock.Anything, testBatchSize).
+					batch := makeChangeLogEntries(int64(i*testBatchSize+1), makeMarketsForMarketPoller(testBatchSize)...)
+					reader.On("ListChangesAfter", mock.Anything, mock.Anything, testBatchSize).
 						Return(batch, nil).Once()
-					producer.On("PublishMarketStateChanged", mock.Anything, mock.Anything, mock.Anything).
+					producer.On("PublishMarketUpdated", mock.Anything, mock.Anything, mock.Anything).
 						Return(nil).Once()
 				}
-				reader.On("ListUpdatedSince", mock.Anything, mock.Anything, mock.Anything, testBatchSize).
+				reader.On("ListChangesAfter", mock.Anything, mock.Anything, testBatchSize).
 					Return(nil, nil).Once()
 
 				refresher.On("InvalidateByIDs", mock.Anything,
 					mock.MatchedBy(func(ids []uuid.UUID) bool { return len(ids) == testBatchSize*2 }),
 				).Return(nil).Once()
+				refresher.On("InvalidateBySymbols", mock.Anything, mock.Anything).Return(nil).Once()
 				refresher.On("RefreshAll", mock.Anything).Return(nil).Once()
 			},
 			checkErr: func(t *testing.T, err error) { require.NoError(t, err) },
@@ -723,7 +803,7 @@ func TestPoll(t *testing.T) {
 		{
 			name: "ошибка reader — poll возвращает ошибку, refreshCache с пустыми IDs (no-op)",
 			setupMocks: func(reader *mocks.MarketReader, _ *mocks.MarketEventProducer, _ *mocks.MarketCacheRefresher) {
-				reader.On("ListUpdatedSince", mock.Anything, mock.Anything, mock.Anything, testBatchSize).
+				reader.On("ListChangesAfter", mock.Anything, mock.Anything, testBatchSize).
 					Return(nil, errors.New("db error"))
 			},
 			checkErr: func(t *testing.T, err error) {
@@ -734,18 +814,18 @@ func TestPoll(t *testing.T) {
 		{
 			name: "ошибка reader после успешного батча — refreshCache вызывается с уже накопленными IDs",
 			setupMocks: func(reader *mocks.MarketReader, producer *mocks.MarketEventProducer, refresher *mocks.MarketCacheRefresher) {
-				batch1 := makeMarketsForMarketPoller(testBatchSize)
-				reader.On("ListUpdatedSince", mock.Anything, mock.Anything, mock.Anything, testBatchSize).
-					Return(batch1, nil).Once()
-				producer.On("PublishMarketStateChanged", mock.Anything, mock.Anything, mock.Anything).
+				reader.On("ListChangesAfter", mock.Anything, mock.Anything, testBatchSize).
+					Return(makeEntriesForMarketPoller(testBatchSize), nil).Once()
+				producer.On("PublishMarketUpdated", mock.Anything, mock.Anything, mock.Anything).
 					Return(nil).Once()
 
-				reader.On("ListUpdatedSince", mock.Anything, mock.Anything, mock.Anything, testBatchSize).
+				reader.On("ListChangesAfter", mock.Anything, mock.Anything, testBatchSize).
 					Return(nil, errors.New("db error")).Once()
 
 				refresher.On("InvalidateByIDs", mock.Anything,
 					mock.MatchedBy(func(ids []uuid.UUID) bool { return len(ids) == testBatchSize }),
 				).Return(nil).Once()
+				refresher.On("InvalidateBySymbols", mock.Anything, mock.Anything).Return(nil).Once()
 				refresher.On("RefreshAll", mock.Anything).Return(nil).Once()
 			},
 			checkErr: func(t *testing.T, err error) {
@@ -756,9 +836,9 @@ func TestPoll(t *testing.T) {
 		{
 			name: "ошибка producer — poll возвращает ошибку",
 			setupMocks: func(reader *mocks.MarketReader, producer *mocks.MarketEventProducer, _ *mocks.MarketCacheRefresher) {
-				reader.On("ListUpdatedSince", mock.Anything, mock.Anything, mock.Anything, testBatchSize).
-					Return(makeMarketsForMarketPoller(1), nil)
-				producer.On("PublishMarketStateChanged", mock.Anything, mock.Anything, mock.Anything).
+				reader.On("ListChangesAfter", mock.Anything, mock.Anything, testBatchSize).
+					Return(makeEntriesForMarketPoller(1), nil)
+				producer.On("PublishMarketUpdated", mock.Anything, mock.Anything, mock.Anything).
 					Return(errors.New("kafka down"))
 			},
 			checkErr: func(t *testing.T, err error) {
@@ -769,7 +849,7 @@ func TestPoll(t *testing.T) {
 		{
 			name: "отменённый контекст — poll возвращает context.Canceled",
 			setupMocks: func(reader *mocks.MarketReader, _ *mocks.MarketEventProducer, _ *mocks.MarketCacheRefresher) {
-				reader.On("ListUpdatedSince", mock.Anything, mock.Anything, mock.Anything, testBatchSize).
+				reader.On("ListChangesAfter", mock.Anything, mock.Anything, testBatchSize).
 					Return(nil, context.Canceled)
 			},
 			checkErr: func(t *testing.T, err error) {
@@ -824,7 +904,7 @@ func TestRun(t *testing.T) {
 			setupMocks: func(reader *mocks.MarketReader, _ *mocks.MarketEventProducer, _ *mocks.MarketCacheRefresher) context.Context {
 				ctx, cancel := context.WithCancel(context.Background())
 
-				reader.On("ListUpdatedSince", mock.Anything, mock.Anything, mock.Anything, testBatchSize).
+				reader.On("ListChangesAfter", mock.Anything, mock.Anything, testBatchSize).
 					Return(nil, nil).Maybe()
 
 				cancel()
@@ -835,7 +915,7 @@ func TestRun(t *testing.T) {
 		{
 			name: "ошибка в начальном poll (не отменённый ctx) — Run возвращает ошибку с префиксом",
 			setupMocks: func(reader *mocks.MarketReader, _ *mocks.MarketEventProducer, _ *mocks.MarketCacheRefresher) context.Context {
-				reader.On("ListUpdatedSince", mock.Anything, mock.Anything, mock.Anything, testBatchSize).
+				reader.On("ListChangesAfter", mock.Anything, mock.Anything, testBatchSize).
 					Return(nil, errors.New("initial db error"))
 				return context.Background()
 			},
@@ -851,7 +931,7 @@ func TestRun(t *testing.T) {
 				ctx, cancel := context.WithCancel(context.Background())
 				cancel()
 
-				reader.On("ListUpdatedSince", mock.Anything, mock.Anything, mock.Anything, testBatchSize).
+				reader.On("ListChangesAfter", mock.Anything, mock.Anything, testBatchSize).
 					Return(nil, context.Canceled).Maybe()
 				return ctx
 			},
@@ -860,10 +940,10 @@ func TestRun(t *testing.T) {
 		{
 			name: "ошибка в плановом poll (не отменённый ctx) — Run возвращает ошибку с префиксом",
 			setupMocks: func(reader *mocks.MarketReader, _ *mocks.MarketEventProducer, _ *mocks.MarketCacheRefresher) context.Context {
-				reader.On("ListUpdatedSince", mock.Anything, mock.Anything, mock.Anything, testBatchSize).
+				reader.On("ListChangesAfter", mock.Anything, mock.Anything, testBatchSize).
 					Return(nil, nil).Once()
 
-				reader.On("ListUpdatedSince", mock.Anything, mock.Anything, mock.Anything, testBatchSize).
+				reader.On("ListChangesAfter", mock.Anything, mock.Anything, testBatchSize).
 					Return(nil, errors.New("scheduled db error")).Once()
 				return context.Background()
 			},
@@ -878,10 +958,10 @@ func TestRun(t *testing.T) {
 			setupMocks: func(reader *mocks.MarketReader, _ *mocks.MarketEventProducer, _ *mocks.MarketCacheRefresher) context.Context {
 				ctx, cancel := context.WithCancel(context.Background())
 
-				reader.On("ListUpdatedSince", mock.Anything, mock.Anything, mock.Anything, testBatchSize).
+				reader.On("ListChangesAfter", mock.Anything, mock.Anything, testBatchSize).
 					Return(nil, nil).Once()
 
-				reader.On("ListUpdatedSince", mock.Anything, mock.Anything, mock.Anything, testBatchSize).
+				reader.On("ListChangesAfter", mock.Anything, mock.Anything, testBatchSize).
 					Run(func(_ mock.Arguments) { cancel() }).
 					Return(nil, context.Canceled).Maybe()
 				return ctx
@@ -911,3 +991,120 @@ func TestRun(t *testing.T) {
 		})
 	}
 }
+
+func TestRunAsLeader(t *testing.T) {
+	const fencingToken = int64(7)
+
+	tests := []struct {
+		name       string
+		setupMocks func(reader *mocks.MarketReader, producer *mocks.MarketEventProducer, cursorStore *mocks.CursorStore, cancel context.CancelFunc)
+		checkErr   func(t *testing.T, err error)
+	}{
+		{
+			name: "курсор перечитывается, а сдвигается под fencing token лидера",
+			setupMocks: func(reader *mocks.MarketReader, producer *mocks.MarketEventProducer, cursorStore *mocks.CursorStore, cancel context.CancelFunc) {
+				cursorStore.On("Get", mock.Anything, marketStateChangedPollerName).
+					Return(domainModels.PollerCursor{PollerName: marketStateChangedPollerName, LastSeq: 40}, nil).Once()
+				reader.On("ListChangesAfter", mock.Anything, int64(40), testBatchSize).
+					Return(makeChangeLogEntries(41, makeMarket(true, nil)), nil).Once()
+				producer.On("PublishMarketUpdated", mock.Anything, mock.Anything, domainModels.PollerCursor{
+					PollerName:   marketStateChangedPollerName,
+					LastSeq:      41,
+					FencingToken: fencingToken,
+				}).Run(func(_ mock.Arguments) { cancel() }).Return(nil).Once()
+			},
+			checkErr: func(t *testing.T, err error) { require.NoError(t, err) },
+		},
+		{
+			name: "ошибка чтения курсора — задача лидера завершается с ошибкой",
+			setupMocks: func(_ *mocks.MarketReader, _ *mocks.MarketEventProducer, cursorStore *mocks.CursorStore, _ context.CancelFunc) {
+				cursorStore.On("Get", mock.Anything, marketStateChangedPollerName).
+					Return(domainModels.PollerCursor{}, errors.New("db down")).Once()
+			},
+			checkErr: func(t *testing.T, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "db down")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader := mocks.NewMarketReader(t)
+			producer := mocks.NewMarketEventProducer(t)
+			cursorStore := mocks.NewCursorStore(t)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			tt.setupMocks(reader, producer, cursorStore, cancel)
+
+			p := newTestPoller(reader, producer, cursorStore, nil)
+
+			tt.checkErr(t, p.RunAsLeader(ctx, fencingToken))
+		})
+	}
+}
+
+func TestRunWithListener(t *testing.T) {
+	tests := []struct {
+		name          string
+		setupListener func(listener *mocks.MarketChangeListener)
+	}{
+		{
+			name: "уведомление LISTEN будит poll раньше fallback-таймера",
+			setupListener: func(listener *mocks.MarketChangeListener) {
+				listener.On("Listen", mock.Anything, mock.Anything).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(func())()
+						<-args.Get(0).(context.Context).Done()
+					}).
+					Return(context.Canceled)
+			},
+		},
+		{
+			name: "обрыв LISTEN-соединения — poll будится по pollInterval до переподключения",
+			setupListener: func(listener *mocks.MarketChangeListener) {
+				listener.On("Listen", mock.Anything, mock.Anything).
+					Return(errors.New("conn reset"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			reader := &mocks.MarketReader{}
+			listener := &mocks.MarketChangeListener{}
+			tt.setupListener(listener)
+
+			reader.On("ListChangesAfter", mock.Anything, int64(0), testBatchSize).
+				Return(nil, nil).Once()
+			reader.On("ListChangesAfter", mock.Anything, int64(0), testBatchSize).
+				Run(func(_ mock.Arguments) { cancel() }).
+				Return(nil, nil)
+
+			p := NewMarketPoller(
+				reader,
+				listener,
+				&mocks.MarketEventProducer{},
+				&mocks.CursorStore{},
+				&mocks.MarketCacheRefresher{},
+				nil,
+				testVisibilityPolicies,
+				5*time.Millisecond,
+				time.Hour,
+				testProcessingTimeout,
+				testBatchSize,
+				zapLogger.NewNop(),
+			)
+
+			err := p.Run(ctx)
+			require.NoError(t, err)
+
+			reader.AssertExpectations(t)
+			listener.AssertExpectations(t)
+		})
+	}
+}
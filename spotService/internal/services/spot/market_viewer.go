@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"math"
 	"time"
 
 	"github.com/google/uuid"
@@ -20,39 +19,89 @@ import (
 	"github.com/nastyazhadan/spot-order-grpc/shared/interceptors/tracing"
 	"github.com/nastyazhadan/spot-order-grpc/shared/metrics"
 	"github.com/nastyazhadan/spot-order-grpc/shared/models"
-	"github.com/nastyazhadan/spot-order-grpc/shared/requestctx"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
 )
 
 const (
-	singleFlightKeyPrefix = "market_by_id:"
-	roleAdminKey          = "admin"
-	roleViewerKey         = "viewer"
-	roleUserKey           = "user"
-)
+	cacheTierLocal = "local"
+	cacheTierRedis = "redis"
 
-var cacheRoleKeys = []string{roleAdminKey, roleViewerKey, roleUserKey}
+	singleFlightKeyPrefix       = "market_by_id:"
+	symbolSingleFlightKeyPrefix = "market_by_symbol:"
+)
 
 type MarketRepository interface {
-	GetMarketsPage(ctx context.Context, roleKey string, limit, offset uint64) ([]models.Market, error)
+	GetMarketsPage(
+		ctx context.Context,
+		policy domainModels.VisibilityPolicy,
+		access domainModels.MarketAccess,
+		filter models.MarketFilter,
+		after *domainModels.MarketPageKey,
+		limit uint64,
+	) ([]models.Market, error)
+	GetMarketsPageByOffset(
+		ctx context.Context,
+		policy domainModels.VisibilityPolicy,
+		access domainModels.MarketAccess,
+		filter models.MarketFilter,
+		offset, limit uint64,
+	) ([]models.Market, error)
 	GetMarketByID(ctx context.Context, id uuid.UUID) (models.Market, error)
+	GetMarketsByIDs(ctx context.Context, ids []uuid.UUID) ([]models.Market, error)
+	GetMarketBySymbol(ctx context.Context, symbol string) (models.Market, error)
 }
 
+// MarketCacheRepository — role-based head-cache, ключ — id политики видимости
 type MarketCacheRepository interface {
-	GetMarkets(ctx context.Context, roleKey string) ([]models.Market, error)
-	SetMarkets(ctx context.Context, market []models.Market, roleKey string, ttl time.Duration) error
-	DeleteMarkets(ctx context.Context, roleKey string) error
+	GetMarkets(ctx context.Context, policyID string) ([]models.Market, error)
+	SetMarkets(ctx context.Context, market []models.Market, policyID string, ttl time.Duration) error
+	DeleteMarkets(ctx context.Context, policyID string) error
 }
 
 type MarketByIDCacheRepository interface {
 	GetMarketByID(ctx context.Context, id uuid.UUID) (models.Market, error)
 	SetMarketByID(ctx context.Context, market models.Market, ttl time.Duration) error
+	GetMarketsByIDs(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]models.Market, error)
+	SetMarketsByIDs(ctx context.Context, markets []models.Market, ttl time.Duration) error
 	DeleteMarketByID(ctx context.Context, id uuid.UUID) error
 }
 
+type MarketBySymbolCacheRepository interface {
+	GetMarketIDBySymbol(ctx context.Context, symbol string) (uuid.UUID, error)
+	SetMarketIDBySymbol(ctx context.Context, symbol string, id uuid.UUID, ttl time.Duration) error
+	DeleteBySymbols(ctx context.Context, symbols []string) error
+}
+
+// MarketLocalCache — кэш рынков по id внутри процесса, первый уровень перед Redis
+type MarketLocalCache interface {
+	GetMarket(id uuid.UUID) (models.Market, bool)
+	SetMarket(market models.Market)
+	DeleteMarkets(ids []uuid.UUID)
+}
+
+// MarketAccessReader ищет записи market_access вызывающего; пустой marketIDs — по всем рынкам
+type MarketAccessReader interface {
+	ListGrantedMarketIDs(
+		ctx context.Context,
+		subject domainModels.MarketAccessSubject,
+		marketIDs []uuid.UUID,
+	) ([]uuid.UUID, error)
+}
+
+// MarketInvalidationPublisher рассылает инвалидацию локальных кэшей остальным репликам
+type MarketInvalidationPublisher interface {
+	PublishInvalidation(ctx context.Context, ids []uuid.UUID) error
+}
+
 type MarketViewer struct {
 	marketRepository          MarketRepository
 	marketCacheRepository     MarketCacheRepository
 	marketByIDCacheRepository MarketByIDCacheRepository
+	marketBySymbolCache       MarketBySymbolCacheRepository
+	localCache                MarketLocalCache
+	invalidationPublisher     MarketInvalidationPublisher
+	visibilityPolicies        domainModels.VisibilityPolicies
+	accessReader              MarketAccessReader
 	cacheTTL                  time.Duration
 	serviceTimeout            time.Duration
 	defaultLimit              uint64
@@ -67,6 +116,11 @@ func NewMarketViewer(
 	repo MarketRepository,
 	cacheRepo MarketCacheRepository,
 	byIDCacheRepo MarketByIDCacheRepository,
+	bySymbolCacheRepo MarketBySymbolCacheRepository,
+	localCache MarketLocalCache,
+	invalidationPublisher MarketInvalidationPublisher,
+	visibilityPolicies domainModels.VisibilityPolicies,
+	accessReader MarketAccessReader,
 	ttl, timeout time.Duration,
 	defaultLimit, maxLimit, cacheLimit uint64,
 	serviceName string,
@@ -76,6 +130,11 @@ func NewMarketViewer(
 		marketRepository:          repo,
 		marketCacheRepository:     cacheRepo,
 		marketByIDCacheRepository: byIDCacheRepo,
+		marketBySymbolCache:       bySymbolCacheRepo,
+		localCache:                localCache,
+		invalidationPublisher:     invalidationPublisher,
+		visibilityPolicies:        visibilityPolicies,
+		accessReader:              accessReader,
 		cacheTTL:                  ttl,
 		serviceTimeout:            timeout,
 		defaultLimit:              defaultLimit,
@@ -88,8 +147,10 @@ func NewMarketViewer(
 
 func (s *MarketViewer) ViewMarkets(
 	ctx context.Context,
-	limit, offset uint64,
-) ([]models.Market, uint64, bool, error) {
+	limit uint64,
+	pageToken string,
+	filter models.MarketFilter,
+) ([]models.Market, string, bool, error) {
 	const op = "MarketViewer.ViewMarkets"
 
 	ctx, cancel := contextWithTimeout(ctx, s.serviceTimeout)
@@ -98,146 +159,414 @@ func (s *MarketViewer) ViewMarkets(
 	ctx, span := tracing.StartSpan(ctx, "spot.view_markets")
 	defer span.End()
 
-	roleKey, err := getRoleKeyFromContext(ctx)
+	policy, err := resolveVisibilityPolicy(ctx, s.visibilityPolicies)
 	if err != nil {
 		tracing.RecordError(span, err)
-		return nil, 0, false, fmt.Errorf("%s: %w", op, err)
+		return nil, "", false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	access, err := loadMarketAccess(ctx, s.accessReader, policy, nil)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, "", false, fmt.Errorf("%s: %w", op, err)
 	}
 
 	limit = normalizeLimit(limit, s.defaultLimit, s.maxLimit)
-	if offset > math.MaxInt {
-		return nil, 0, false, fmt.Errorf("%s: %w", op, serviceErrors.ErrInvalidPagination)
+
+	scope := pageTokenScope(policy.ID, filter)
+	after, err := decodePageToken(pageToken, scope)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, "", false, fmt.Errorf("%s: %w", op, err)
 	}
 
-	if offset == 0 && limit <= s.cacheLimit {
-		markets, nextOffset, hasMore, headError := s.tryLoadHeadPage(ctx, roleKey, limit)
+	// Head-cache хранит только первую страницу без фильтров и без рынков,
+	// выданных вызывающему персонально через market_access
+	if after == nil && filter.IsEmpty() && limit <= s.cacheLimit && access.SharesHeadCache() {
+		markets, nextPageToken, hasMore, headError := s.tryLoadHeadPage(ctx, policy, limit, scope)
 		if headError == nil {
 			span.SetAttributes(attributes.MarketsCountValue(len(markets)))
-			return markets, nextOffset, hasMore, nil
+			return s.visibilityPolicies.WithPublicToAllRoles(markets), nextPageToken, hasMore, nil
 		}
 
 		tracing.RecordError(span, headError)
 		s.logger.Warn(ctx, "failed to load head page", zap.Error(headError))
-		return nil, 0, false, fmt.Errorf("%s: %w", op, headError)
+		return nil, "", false, fmt.Errorf("%s: %w", op, headError)
 	}
 
-	markets, pageError := s.marketRepository.GetMarketsPage(ctx, roleKey, limit, offset)
+	markets, pageError := s.marketRepository.GetMarketsPage(ctx, policy, access, filter, after, limit)
 	if pageError != nil {
 		if errors.Is(pageError, repositoryErrors.ErrMarketStoreIsEmpty) {
 			pageError = serviceErrors.ErrMarketsNotFound
 		}
 
 		tracing.RecordError(span, pageError)
-		return nil, 0, false, fmt.Errorf("%s: %w", op, pageError)
+		return nil, "", false, fmt.Errorf("%s: %w", op, pageError)
 	}
 
-	markets, nextOffset, hasMore, buildError := buildPageResponse(markets, limit, offset)
-	if buildError != nil {
-		tracing.RecordError(span, buildError)
-		return nil, 0, false, fmt.Errorf("%s: %w", op, buildError)
-	}
+	markets, nextPageToken, hasMore := buildPageResponse(markets, limit, scope)
 	span.SetAttributes(attributes.MarketsCountValue(len(markets)))
 
-	return markets, nextOffset, hasMore, nil
+	return s.visibilityPolicies.WithPublicToAllRoles(markets), nextPageToken, hasMore, nil
 }
 
-func (s *MarketViewer) tryLoadHeadPage(
+// ViewMarketsByOffset обслуживает устаревший ViewMarketsRequest.offset. Head-cache не используется:
+// первая страница без page_token приходит в ViewMarkets. Токен следующей страницы тоже возвращается,
+// чтобы клиент мог перейти на курсор с любого места
+func (s *MarketViewer) ViewMarketsByOffset(
 	ctx context.Context,
-	roleKey string,
-	limit uint64,
-) ([]models.Market, uint64, bool, error) {
-	headMarkets, err := s.marketCacheRepository.GetMarkets(ctx, roleKey)
-	if err == nil {
-		markets, nextOffset, hasMore, buildError := buildPageResponse(headMarkets, limit, 0)
-		if buildError != nil {
-			return markets, nextOffset, hasMore, buildError
-		}
-		return markets, nextOffset, hasMore, nil
+	limit, offset uint64,
+	filter models.MarketFilter,
+) ([]models.Market, string, bool, error) {
+	const op = "MarketViewer.ViewMarketsByOffset"
+
+	ctx, cancel := contextWithTimeout(ctx, s.serviceTimeout)
+	defer cancel()
+
+	ctx, span := tracing.StartSpan(ctx, "spot.view_markets_by_offset")
+	defer span.End()
+
+	policy, err := resolveVisibilityPolicy(ctx, s.visibilityPolicies)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, "", false, fmt.Errorf("%s: %w", op, err)
 	}
-	cacheError := err
 
-	headMarkets, err = s.marketRepository.GetMarketsPage(ctx, roleKey, s.cacheLimit+1, 0)
+	access, err := loadMarketAccess(ctx, s.accessReader, policy, nil)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, "", false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	limit = normalizeLimit(limit, s.defaultLimit, s.maxLimit)
+
+	markets, err := s.marketRepository.GetMarketsPageByOffset(ctx, policy, access, filter, offset, limit)
 	if err != nil {
 		if errors.Is(err, repositoryErrors.ErrMarketStoreIsEmpty) {
-			return nil, 0, false, serviceErrors.ErrMarketsNotFound
+			err = serviceErrors.ErrMarketsNotFound
 		}
 
-		if !errors.Is(cacheError, repositoryErrors.ErrMarketsNotFound) &&
-			!errors.Is(cacheError, repositoryErrors.ErrMarketCacheCorrupted) {
-			s.logger.Error(ctx, "head cache read failed", zap.Error(cacheError))
-		}
+		tracing.RecordError(span, err)
+		return nil, "", false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	markets, nextPageToken, hasMore := buildPageResponse(markets, limit, pageTokenScope(policy.ID, filter))
+	span.SetAttributes(attributes.MarketsCountValue(len(markets)))
+
+	return s.visibilityPolicies.WithPublicToAllRoles(markets), nextPageToken, hasMore, nil
+}
+
+func (s *MarketViewer) GetMarketByID(
+	ctx context.Context,
+	id uuid.UUID,
+) (models.Market, error) {
+	const op = "MarketViewer.GetMarketByID"
+
+	ctx, cancel := contextWithTimeout(ctx, s.serviceTimeout)
+	defer cancel()
+
+	ctx, span := tracing.StartSpan(ctx, "spot.get_market_by_id",
+		trace.WithAttributes(attributes.MarketIDValue(id.String())),
+	)
+	defer span.End()
+
+	policy, err := resolveVisibilityPolicy(ctx, s.visibilityPolicies)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return models.Market{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	market, err := s.getMarketActual(ctx, id)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return models.Market{}, err
+	}
+
+	access, err := s.restrictedMarketAccess(ctx, policy, []models.Market{market})
+	if err != nil {
+		tracing.RecordError(span, err)
+		return models.Market{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = validateMarketAccess(policy, access, market, id); err != nil {
+		tracing.RecordError(span, err)
+		return models.Market{}, fmt.Errorf("%s: %w", op, err)
+	}
+	market.PublicToAllRoles = s.visibilityPolicies.PublicToAllRoles(market)
 
-		return nil, 0, false, err
+	return market, nil
+}
+
+// GetMarketBySymbol ищет неудалённый рынок по имени (BTC-USDT).
+// Symbol cache хранит только market_id: данные берутся из by-id cache,
+// а устаревшее соответствие (рынок переименован или удалён) сбрасывается.
+func (s *MarketViewer) GetMarketBySymbol(
+	ctx context.Context,
+	symbol string,
+) (models.Market, error) {
+	const op = "MarketViewer.GetMarketBySymbol"
+
+	ctx, cancel := contextWithTimeout(ctx, s.serviceTimeout)
+	defer cancel()
+
+	ctx, span := tracing.StartSpan(ctx, "spot.get_market_by_symbol")
+	defer span.End()
+
+	policy, err := resolveVisibilityPolicy(ctx, s.visibilityPolicies)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return models.Market{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	markets, nextOffset, hasMore, buildError := buildPageResponse(headMarkets, limit, 0)
-	if buildError != nil {
-		return markets, nextOffset, hasMore, buildError
+	market, err := s.getMarketBySymbolActual(ctx, symbol)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return models.Market{}, fmt.Errorf("%s: %w", op, err)
+	}
+	span.SetAttributes(attributes.MarketIDValue(market.ID.String()))
+
+	access, err := s.restrictedMarketAccess(ctx, policy, []models.Market{market})
+	if err != nil {
+		tracing.RecordError(span, err)
+		return models.Market{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = validateMarketAccess(policy, access, market, market.ID); err != nil {
+		tracing.RecordError(span, err)
+		return models.Market{}, fmt.Errorf("%s: %w", op, err)
+	}
+	market.PublicToAllRoles = s.visibilityPolicies.PublicToAllRoles(market)
+
+	return market, nil
+}
+
+func (s *MarketViewer) getMarketBySymbolActual(
+	ctx context.Context,
+	symbol string,
+) (models.Market, error) {
+	const op = "MarketViewer.getMarketBySymbolActual"
+
+	id, err := s.marketBySymbolCache.GetMarketIDBySymbol(ctx, symbol)
+	switch {
+	case err == nil:
+		market, lookupError := s.getMarketActual(ctx, id)
+		if lookupError == nil && market.Name == symbol && market.DeletedAt == nil {
+			return market, nil
+		}
+		if lookupError != nil && !errors.Is(lookupError, serviceErrors.ErrMarketNotFound) {
+			return models.Market{}, fmt.Errorf("%s: %w", op, lookupError)
+		}
+
+		s.dropStaleSymbol(ctx, symbol)
+
+	case errors.Is(err, repositoryErrors.ErrMarketCacheCorrupted):
+		s.dropStaleSymbol(ctx, symbol)
+
+	case !errors.Is(err, repositoryErrors.ErrMarketNotFound):
+		s.logger.Error(ctx, "failed to read market id by symbol from cache", zap.Error(err))
 	}
 
-	if warmError := s.warmHeadCache(ctx, roleKey, headMarkets); warmError != nil {
-		return markets, nextOffset, hasMore, nil
+	market, err := s.getMarketBySymbolWithSingleFlight(ctx, symbol)
+	if err != nil {
+		if errors.Is(err, repositoryErrors.ErrMarketNotFound) {
+			return models.Market{}, sharedErrors.ErrMarketSymbolNotFound{Symbol: symbol}
+		}
+
+		return models.Market{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return markets, nextOffset, hasMore, nil
+	return market, nil
 }
 
-func (s *MarketViewer) warmHeadCache(
+func (s *MarketViewer) getMarketBySymbolWithSingleFlight(
 	ctx context.Context,
-	roleKey string,
-	markets []models.Market,
-) error {
-	if err := s.marketCacheRepository.SetMarkets(ctx, markets, roleKey, s.cacheTTL); err != nil {
+	symbol string,
+) (models.Market, error) {
+	const op = "MarketViewer.getMarketBySymbolWithSingleFlight"
+
+	result, err, _ := s.singleFlight.Do(symbolSingleFlightKeyPrefix+symbol, func() (any, error) {
+		loadCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), s.serviceTimeout)
+		defer cancel()
+
+		market, loadError := s.marketRepository.GetMarketBySymbol(loadCtx, symbol)
+		if loadError != nil {
+			return nil, loadError
+		}
+
+		s.warmSymbolCache(loadCtx, symbol, market)
+		return market, nil
+	})
+	if err != nil {
+		return models.Market{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	market, ok := result.(models.Market)
+	if !ok {
+		return models.Market{}, fmt.Errorf("%s: unexpected result type %T", op, result)
+	}
+
+	return market, nil
+}
+
+// Прогреваем оба кэша, чтобы следующий запрос по символу не ходил в PostgreSQL.
+func (s *MarketViewer) warmSymbolCache(ctx context.Context, symbol string, market models.Market) {
+	if err := s.marketByIDCacheRepository.SetMarketByID(ctx, market, s.cacheTTL); err != nil {
+		s.logger.Warn(ctx, "failed to update market by id cache",
+			zap.String("market_id", market.ID.String()),
+			zap.Error(err),
+		)
+		return
+	}
+
+	if err := s.marketBySymbolCache.SetMarketIDBySymbol(ctx, symbol, market.ID, s.cacheTTL); err != nil {
 		metrics.CacheWarmupsTotal.
-			WithLabelValues(s.serviceName, "view_markets_lazy_warmup", roleKey, "error").
-			Inc()
+			WithLabelValues(s.serviceName, "load_and_warm_cache", "market_by_symbol", "error").Inc()
 
-		s.logger.Warn(ctx, "failed to warm head cache",
-			zap.String("role_key", roleKey),
+		s.logger.Warn(ctx, "failed to update market by symbol cache",
+			zap.String("symbol", symbol),
 			zap.Error(err),
 		)
-		return err
+		return
 	}
 
 	metrics.CacheWarmupsTotal.
-		WithLabelValues(s.serviceName, "view_markets_lazy_warmup", roleKey, "success").
-		Inc()
+		WithLabelValues(s.serviceName, "load_and_warm_cache", "market_by_symbol", "success").Inc()
+}
 
-	return nil
+func (s *MarketViewer) dropStaleSymbol(ctx context.Context, symbol string) {
+	if err := s.marketBySymbolCache.DeleteBySymbols(ctx, []string{symbol}); err != nil {
+		s.logger.Warn(ctx, "failed to remove stale market by symbol cache",
+			zap.String("symbol", symbol),
+			zap.Error(err),
+		)
+	}
 }
 
-func (s *MarketViewer) GetMarketByID(
+// GetMarketsByIDs разрешает рынки пачкой: by-id cache читается одним MGET,
+// промахи догружаются из PostgreSQL одним запросом и прогревают кэш.
+// Результаты идут в порядке ids, повторяющиеся id схлопываются.
+func (s *MarketViewer) GetMarketsByIDs(
 	ctx context.Context,
-	id uuid.UUID,
-) (models.Market, error) {
-	const op = "MarketViewer.GetMarketByID"
+	ids []uuid.UUID,
+) ([]models.MarketLookup, error) {
+	const op = "MarketViewer.GetMarketsByIDs"
 
 	ctx, cancel := contextWithTimeout(ctx, s.serviceTimeout)
 	defer cancel()
 
-	ctx, span := tracing.StartSpan(ctx, "spot.get_market_by_id",
-		trace.WithAttributes(attributes.MarketIDValue(id.String())),
+	ctx, span := tracing.StartSpan(ctx, "spot.get_markets_by_ids",
+		trace.WithAttributes(attributes.BatchSizeValue(len(ids))),
 	)
 	defer span.End()
 
-	roleKey, err := getRoleKeyFromContext(ctx)
+	policy, err := resolveVisibilityPolicy(ctx, s.visibilityPolicies)
 	if err != nil {
 		tracing.RecordError(span, err)
-		return models.Market{}, fmt.Errorf("%s: %w", op, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	market, err := s.getMarketActual(ctx, id)
+	uniqueIDs := uniqueMarketIDs(ids)
+
+	markets, err := s.loadMarketsByIDs(ctx, uniqueIDs)
 	if err != nil {
 		tracing.RecordError(span, err)
-		return models.Market{}, err
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	loaded := make([]models.Market, 0, len(markets))
+	for _, market := range markets {
+		loaded = append(loaded, market)
 	}
 
-	if err = s.validateMarketAccess(roleKey, market, id); err != nil {
+	access, err := s.restrictedMarketAccess(ctx, policy, loaded)
+	if err != nil {
 		tracing.RecordError(span, err)
-		return models.Market{}, fmt.Errorf("%s: %w", op, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return market, nil
+	lookups := make([]models.MarketLookup, 0, len(uniqueIDs))
+	for _, id := range uniqueIDs {
+		lookup := buildMarketLookup(policy, access, id, markets)
+		if lookup.Status == models.MarketLookupStatusFound {
+			lookup.Market.PublicToAllRoles = s.visibilityPolicies.PublicToAllRoles(lookup.Market)
+		}
+		lookups = append(lookups, lookup)
+	}
+
+	return lookups, nil
+}
+
+func (s *MarketViewer) loadMarketsByIDs(
+	ctx context.Context,
+	ids []uuid.UUID,
+) (map[uuid.UUID]models.Market, error) {
+	const op = "MarketViewer.loadMarketsByIDs"
+
+	markets, err := s.marketByIDCacheRepository.GetMarketsByIDs(ctx, ids)
+	if err != nil {
+		s.logger.Error(ctx, "failed to read markets by ids from cache", zap.Error(err))
+		markets = make(map[uuid.UUID]models.Market, len(ids))
+	}
+
+	missing := make([]uuid.UUID, 0, len(ids)-len(markets))
+	for _, id := range ids {
+		if _, ok := markets[id]; !ok {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) == 0 {
+		return markets, nil
+	}
+
+	loaded, err := s.marketRepository.GetMarketsByIDs(ctx, missing)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(loaded) == 0 {
+		return markets, nil
+	}
+
+	for _, market := range loaded {
+		markets[market.ID] = market
+	}
+
+	if err = s.marketByIDCacheRepository.SetMarketsByIDs(ctx, loaded, s.cacheTTL); err != nil {
+		metrics.CacheWarmupsTotal.
+			WithLabelValues(s.serviceName, "load_and_warm_cache", "markets_by_ids", "error").Inc()
+
+		s.logger.Warn(ctx, "failed to update markets by ids cache",
+			zap.Int("markets_count", len(loaded)),
+			zap.Error(err),
+		)
+		return markets, nil
+	}
+
+	metrics.CacheWarmupsTotal.
+		WithLabelValues(s.serviceName, "load_and_warm_cache", "markets_by_ids", "success").Inc()
+
+	return markets, nil
+}
+
+func buildMarketLookup(
+	policy domainModels.VisibilityPolicy,
+	access domainModels.MarketAccess,
+	id uuid.UUID,
+	markets map[uuid.UUID]models.Market,
+) models.MarketLookup {
+	market, ok := markets[id]
+	if !ok {
+		return models.MarketLookup{ID: id, Status: models.MarketLookupStatusNotFound}
+	}
+
+	err := validateMarketAccess(policy, access, market, id)
+	switch {
+	case err == nil:
+		return models.MarketLookup{ID: id, Status: models.MarketLookupStatusFound, Market: market}
+	case errors.Is(err, serviceErrors.ErrMarketDisabled):
+		return models.MarketLookup{ID: id, Status: models.MarketLookupStatusDisabled}
+	default:
+		return models.MarketLookup{ID: id, Status: models.MarketLookupStatusNotFound}
+	}
 }
 
 func (s *MarketViewer) getMarketActual(
@@ -246,10 +575,17 @@ func (s *MarketViewer) getMarketActual(
 ) (models.Market, error) {
 	const op = "MarketViewer.getMarketActual"
 
+	if market, ok := s.getLocalMarket(id); ok {
+		return market, nil
+	}
+
 	market, err := s.marketByIDCacheRepository.GetMarketByID(ctx, id)
 	if err == nil {
+		metrics.MarketCacheTierLookupsTotal.WithLabelValues(s.serviceName, cacheTierRedis, "hit").Inc()
+		s.setLocalMarket(market)
 		return market, nil
 	}
+	metrics.MarketCacheTierLookupsTotal.WithLabelValues(s.serviceName, cacheTierRedis, "miss").Inc()
 
 	cleanupCorruptedCache := false
 	if errors.Is(err, repositoryErrors.ErrMarketCacheCorrupted) {
@@ -267,35 +603,28 @@ func (s *MarketViewer) getMarketActual(
 		return models.Market{}, fmt.Errorf("%s: %w", op, err)
 	}
 
+	s.setLocalMarket(market)
 	return market, nil
 }
 
-func (s *MarketViewer) validateMarketAccess(
-	roleKey string,
-	market models.Market,
-	id uuid.UUID,
-) error {
-	switch roleKey {
-	case roleAdminKey:
-		return nil
+func (s *MarketViewer) getLocalMarket(id uuid.UUID) (models.Market, bool) {
+	if s.localCache == nil {
+		return models.Market{}, false
+	}
 
-	case roleViewerKey:
-		if market.DeletedAt != nil {
-			return sharedErrors.ErrMarketNotFound{ID: id}
-		}
-		return nil
+	market, ok := s.localCache.GetMarket(id)
+	if !ok {
+		metrics.MarketCacheTierLookupsTotal.WithLabelValues(s.serviceName, cacheTierLocal, "miss").Inc()
+		return models.Market{}, false
+	}
 
-	case roleUserKey:
-		if market.DeletedAt != nil {
-			return sharedErrors.ErrMarketNotFound{ID: id}
-		}
-		if !market.Enabled {
-			return serviceErrors.ErrDisabled{ID: id}
-		}
-		return nil
+	metrics.MarketCacheTierLookupsTotal.WithLabelValues(s.serviceName, cacheTierLocal, "hit").Inc()
+	return market, true
+}
 
-	default:
-		return serviceErrors.ErrUserRoleNotSpecified
+func (s *MarketViewer) setLocalMarket(market models.Market) {
+	if s.localCache != nil {
+		s.localCache.SetMarket(market)
 	}
 }
 
@@ -407,69 +736,6 @@ func (s *MarketViewer) handleWarmupSetError(
 	return market
 }
 
-func (s *MarketViewer) RefreshAll(ctx context.Context) error {
-	const op = "MarketViewer.RefreshAll"
-
-	if ctx == nil {
-		ctx = context.Background()
-	}
-
-	refreshCtx := context.WithoutCancel(ctx)
-
-	for _, roleKey := range cacheRoleKeys {
-		roleCtx, cancel := contextWithTimeout(refreshCtx, s.serviceTimeout)
-
-		markets, err := s.marketRepository.GetMarketsPage(roleCtx, roleKey, s.cacheLimit+1, 0)
-		if err != nil {
-			cancel()
-
-			if errors.Is(err, repositoryErrors.ErrMarketStoreIsEmpty) {
-				return s.invalidateMarketsCache(refreshCtx)
-			}
-
-			metrics.CacheWarmupsTotal.
-				WithLabelValues(s.serviceName, "refresh_all", roleKey, "error").Inc()
-
-			return fmt.Errorf("%s: load head cache for role %s: %w", op, roleKey, err)
-		}
-
-		if err = s.marketCacheRepository.SetMarkets(roleCtx, markets, roleKey, s.cacheTTL); err != nil {
-			cancel()
-
-			metrics.CacheWarmupsTotal.
-				WithLabelValues(s.serviceName, "refresh_all", roleKey, "error").Inc()
-
-			return fmt.Errorf("%s: set cache for role %s: %w", op, roleKey, err)
-		}
-		cancel()
-
-		metrics.CacheWarmupsTotal.
-			WithLabelValues(s.serviceName, "refresh_all", roleKey, "success").Inc()
-	}
-	return nil
-}
-
-// Удаление всех ключей при пустом market store
-func (s *MarketViewer) invalidateMarketsCache(ctx context.Context) error {
-	const op = "MarketViewer.invalidateMarketsCache"
-
-	for _, roleKey := range cacheRoleKeys {
-		if err := s.marketCacheRepository.DeleteMarkets(ctx, roleKey); err != nil {
-			metrics.CacheInvalidationsTotal.
-				WithLabelValues(s.serviceName, "refresh_empty_store", roleKey, "error").
-				Inc()
-
-			return fmt.Errorf("%s: delete cache for role %s: %w", op, roleKey, err)
-		}
-
-		metrics.CacheInvalidationsTotal.
-			WithLabelValues(s.serviceName, "refresh_empty_store", roleKey, "success").
-			Inc()
-	}
-
-	return nil
-}
-
 func (s *MarketViewer) InvalidateByIDs(ctx context.Context, ids []uuid.UUID) error {
 	const op = "MarketViewer.InvalidateByIDs"
 
@@ -477,15 +743,10 @@ func (s *MarketViewer) InvalidateByIDs(ctx context.Context, ids []uuid.UUID) err
 		return nil
 	}
 
-	seen := make(map[uuid.UUID]struct{}, len(ids))
+	uniqueIDs := uniqueMarketIDs(ids)
 	var invalidateErrs []error
 
-	for _, id := range ids {
-		if _, ok := seen[id]; ok {
-			continue
-		}
-		seen[id] = struct{}{}
-
+	for _, id := range uniqueIDs {
 		if err := s.marketByIDCacheRepository.DeleteMarketByID(ctx, id); err != nil {
 			metrics.CacheInvalidationsTotal.
 				WithLabelValues(s.serviceName, "market_updated", "market_by_id", "error").
@@ -500,6 +761,10 @@ func (s *MarketViewer) InvalidateByIDs(ctx context.Context, ids []uuid.UUID) err
 			Inc()
 	}
 
+	if err := s.invalidateLocalCaches(ctx, uniqueIDs); err != nil {
+		invalidateErrs = append(invalidateErrs, fmt.Errorf("%s: %w", op, err))
+	}
+
 	if len(invalidateErrs) > 0 {
 		return errors.Join(invalidateErrs...)
 	}
@@ -507,54 +772,77 @@ func (s *MarketViewer) InvalidateByIDs(ctx context.Context, ids []uuid.UUID) err
 	return nil
 }
 
-func buildPageResponse(markets []models.Market, limit, offset uint64) ([]models.Market, uint64, bool, error) {
-	hasMore := len(markets) > int(limit)
-	if hasMore && offset > math.MaxUint64-limit {
-		return nil, 0, false, fmt.Errorf("%w: next offset overflow", serviceErrors.ErrInvalidPagination)
+// invalidateLocalCaches сбрасывает рынки из своего локального кэша после Redis,
+// чтобы повторное чтение не подняло из Redis старую версию, и оповещает остальные реплики.
+func (s *MarketViewer) invalidateLocalCaches(ctx context.Context, ids []uuid.UUID) error {
+	if s.localCache != nil {
+		s.localCache.DeleteMarkets(ids)
 	}
-	if hasMore {
-		markets = markets[:limit]
+
+	if s.invalidationPublisher == nil {
+		return nil
 	}
 
-	var nextOffset uint64
-	if hasMore {
-		nextOffset = offset + limit
+	if err := s.invalidationPublisher.PublishInvalidation(ctx, ids); err != nil {
+		metrics.CacheInvalidationsTotal.
+			WithLabelValues(s.serviceName, "market_updated", "market_by_id_local", "error").
+			Inc()
+
+		return fmt.Errorf("publish local cache invalidation: %w", err)
 	}
 
-	return markets, nextOffset, hasMore, nil
+	metrics.CacheInvalidationsTotal.
+		WithLabelValues(s.serviceName, "market_updated", "market_by_id_local", "success").
+		Inc()
+
+	return nil
 }
 
-func getRoleKeyFromContext(ctx context.Context) (string, error) {
-	userRoles, ok := requestctx.UserRolesFromContext(ctx)
-	if !ok {
-		return "", serviceErrors.ErrUserRoleNotSpecified
+func (s *MarketViewer) InvalidateBySymbols(ctx context.Context, symbols []string) error {
+	const op = "MarketViewer.InvalidateBySymbols"
+
+	if len(symbols) == 0 {
+		return nil
 	}
 
-	roleKey, ok := effectiveUserRole(userRoles)
-	if !ok {
-		return "", serviceErrors.ErrUserRoleNotSpecified
+	if err := s.marketBySymbolCache.DeleteBySymbols(ctx, symbols); err != nil {
+		metrics.CacheInvalidationsTotal.
+			WithLabelValues(s.serviceName, "market_updated", "market_by_symbol", "error").
+			Inc()
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	metrics.CacheInvalidationsTotal.
+		WithLabelValues(s.serviceName, "market_updated", "market_by_symbol", "success").
+		Inc()
+
+	return nil
+}
+
+func buildPageResponse(markets []models.Market, limit uint64, scope string) ([]models.Market, string, bool) {
+	hasMore := uint64(len(markets)) > limit
+	if !hasMore {
+		return markets, "", false
 	}
 
-	return roleKey, nil
+	markets = markets[:limit]
+	return markets, encodePageToken(markets[len(markets)-1], scope), true
 }
 
-func effectiveUserRole(roles []models.UserRole) (string, bool) {
-	resultRole := ""
+func uniqueMarketIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]struct{}, len(ids))
+	unique := make([]uuid.UUID, 0, len(ids))
 
-	for _, role := range roles {
-		switch role {
-		case models.UserRoleAdmin:
-			return roleAdminKey, true
-		case models.UserRoleViewer:
-			resultRole = roleViewerKey
-		case models.UserRoleUser:
-			if resultRole == "" {
-				resultRole = roleUserKey
-			}
-		default:
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
 		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
 	}
-	return resultRole, resultRole != ""
+
+	return unique
 }
 
 func normalizeLimit(limit, defaultLimit, maxLimit uint64) uint64 {
@@ -2,7 +2,6 @@ package spot
 
 import (
 	"context"
-	"math"
 	"testing"
 	"time"
 
@@ -19,6 +18,7 @@ import (
 	zapLogger "github.com/nastyazhadan/spot-order-grpc/shared/interceptors/logging/zap"
 	"github.com/nastyazhadan/spot-order-grpc/shared/models"
 	"github.com/nastyazhadan/spot-order-grpc/shared/requestctx"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
 	"github.com/nastyazhadan/spot-order-grpc/spotService/internal/services/mocks"
 )
 
@@ -27,6 +27,10 @@ const (
 	testMaxLimit     = uint64(100)
 	testCacheLimit   = uint64(50)
 	testServiceName  = "spot-test"
+
+	roleAdminKey  = "admin"
+	roleViewerKey = "viewer"
+	roleUserKey   = "user"
 )
 
 var (
@@ -34,15 +38,75 @@ var (
 	testTimeout  = 10 * time.Second
 )
 
+// Политики совпадают с config.yaml
+var (
+	testAdminPolicy = domainModels.NewVisibilityPolicy(
+		roleAdminKey,
+		[]models.UserRole{models.UserRoleAdmin},
+		[]models.MarketStatus{models.MarketStatusEnabled, models.MarketStatusDisabled, models.MarketStatusDeleted},
+		nil, nil, true,
+	)
+	testViewerPolicy = domainModels.NewVisibilityPolicy(
+		roleViewerKey,
+		[]models.UserRole{models.UserRoleViewer},
+		[]models.MarketStatus{models.MarketStatusEnabled, models.MarketStatusDisabled},
+		nil, nil, false,
+	)
+	testUserPolicy = domainModels.NewVisibilityPolicy(
+		roleUserKey,
+		[]models.UserRole{models.UserRoleUser},
+		[]models.MarketStatus{models.MarketStatusEnabled},
+		nil, nil, false,
+	)
+	testVisibilityPolicies = domainModels.VisibilityPolicies{testAdminPolicy, testViewerPolicy, testUserPolicy}
+
+	// testPublicPolicies покрывают и роль сервиса, как политика admin в config.yaml
+	testPublicAdminPolicy = domainModels.NewVisibilityPolicy(
+		roleAdminKey,
+		[]models.UserRole{models.UserRoleAdmin, models.UserRoleService},
+		[]models.MarketStatus{models.MarketStatusEnabled, models.MarketStatusDisabled, models.MarketStatusDeleted},
+		nil, nil, true,
+	)
+	testPublicPolicies = domainModels.VisibilityPolicies{testPublicAdminPolicy, testViewerPolicy, testUserPolicy}
+
+	testAdminAccess  = domainModels.MarketAccess{BypassAccessLists: true}
+	testPublicAccess = domainModels.MarketAccess{}
+)
+
+// noGrantsReader — у вызывающего нет записей в market_access
+func noGrantsReader() *mocks.MarketAccessReader {
+	reader := &mocks.MarketAccessReader{}
+	reader.On("ListGrantedMarketIDs", mock.Anything, mock.Anything, mock.Anything).
+		Return([]uuid.UUID(nil), nil).Maybe()
+
+	return reader
+}
+
 func newTestViewer(
 	repo *mocks.MarketRepository,
 	cache *mocks.MarketCacheRepository,
 	byIDCache *mocks.MarketByIDCacheRepository,
+	bySymbolCache *mocks.MarketBySymbolCacheRepository,
+) *MarketViewer {
+	return newTestViewerWithAccess(repo, cache, byIDCache, bySymbolCache, noGrantsReader())
+}
+
+func newTestViewerWithAccess(
+	repo *mocks.MarketRepository,
+	cache *mocks.MarketCacheRepository,
+	byIDCache *mocks.MarketByIDCacheRepository,
+	bySymbolCache *mocks.MarketBySymbolCacheRepository,
+	accessReader *mocks.MarketAccessReader,
 ) *MarketViewer {
 	return NewMarketViewer(
 		repo,
 		cache,
 		byIDCache,
+		bySymbolCache,
+		nil,
+		nil,
+		testVisibilityPolicies,
+		accessReader,
 		testCacheTTL,
 		testTimeout,
 		testDefaultLimit,
@@ -73,14 +137,20 @@ func makeMarkets(n int) []models.Market {
 func TestViewMarkets(t *testing.T) {
 	gofakeit.Seed(time.Now().UnixNano())
 
+	noFilter := models.MarketFilter{}
+	btcFilter := models.MarketFilter{BaseAsset: "BTC"}
+	lastSeen := models.Market{ID: uuid.New(), Name: "ETH-USDT"}
+	adminToken := encodePageToken(lastSeen, pageTokenScope(roleAdminKey, noFilter))
+	adminAfter := &domainModels.MarketPageKey{Name: lastSeen.Name, ID: lastSeen.ID}
+
 	tests := []struct {
 		name        string
 		ctx         context.Context
 		limit       uint64
-		offset      uint64
+		pageToken   string
+		filter      models.MarketFilter
 		setupMocks  func(repo *mocks.MarketRepository, cache *mocks.MarketCacheRepository)
 		wantHasMore bool
-		wantOffset  uint64
 		wantErr     error
 		checkErr    func(t *testing.T, err error)
 		checkResult func(t *testing.T, markets []models.Market)
@@ -89,7 +159,6 @@ func TestViewMarkets(t *testing.T) {
 			name:       "нет роли в контексте — ErrUserRoleNotSpecified",
 			ctx:        context.Background(),
 			limit:      10,
-			offset:     0,
 			setupMocks: func(_ *mocks.MarketRepository, _ *mocks.MarketCacheRepository) {},
 			wantErr:    serviceErrors.ErrUserRoleNotSpecified,
 		},
@@ -97,7 +166,6 @@ func TestViewMarkets(t *testing.T) {
 			name:       "пустой срез ролей — ErrUserRoleNotSpecified",
 			ctx:        ctxWithRoles(),
 			limit:      10,
-			offset:     0,
 			setupMocks: func(_ *mocks.MarketRepository, _ *mocks.MarketCacheRepository) {},
 			wantErr:    serviceErrors.ErrUserRoleNotSpecified,
 		},
@@ -105,138 +173,142 @@ func TestViewMarkets(t *testing.T) {
 			name:       "неизвестная роль — ErrUserRoleNotSpecified",
 			ctx:        ctxWithRoles(models.UserRoleUnspecified),
 			limit:      10,
-			offset:     0,
 			setupMocks: func(_ *mocks.MarketRepository, _ *mocks.MarketCacheRepository) {},
 			wantErr:    serviceErrors.ErrUserRoleNotSpecified,
 		},
 		{
-			name:   "limit=0 заменяется на defaultLimit",
-			ctx:    ctxWithRoles(models.UserRoleAdmin),
-			limit:  0,
-			offset: 5,
+			name:      "limit=0 заменяется на defaultLimit",
+			ctx:       ctxWithRoles(models.UserRoleAdmin),
+			limit:     0,
+			pageToken: adminToken,
 			setupMocks: func(repo *mocks.MarketRepository, _ *mocks.MarketCacheRepository) {
-				repo.On("GetMarketsPage", mock.Anything, roleAdminKey, testDefaultLimit, uint64(5)).
+				repo.On("GetMarketsPage", mock.Anything, testAdminPolicy, testAdminAccess, noFilter, adminAfter, testDefaultLimit).
 					Return(makeMarkets(3), nil)
 			},
 		},
 		{
-			name:   "limit > maxLimit — обрезается до maxLimit",
-			ctx:    ctxWithRoles(models.UserRoleAdmin),
-			limit:  testMaxLimit + 50,
-			offset: 5,
+			name:      "limit > maxLimit — обрезается до maxLimit",
+			ctx:       ctxWithRoles(models.UserRoleAdmin),
+			limit:     testMaxLimit + 50,
+			pageToken: adminToken,
 			setupMocks: func(repo *mocks.MarketRepository, _ *mocks.MarketCacheRepository) {
-				repo.On("GetMarketsPage", mock.Anything, roleAdminKey, testMaxLimit, uint64(5)).
+				repo.On("GetMarketsPage", mock.Anything, testAdminPolicy, testAdminAccess, noFilter, adminAfter, testMaxLimit).
 					Return(makeMarkets(5), nil)
 			},
 		},
 		{
-			name:       "offset > math.MaxInt — ErrInvalidPagination",
+			name:       "битый page_token — ErrInvalidPagination",
 			ctx:        ctxWithRoles(models.UserRoleAdmin),
 			limit:      10,
-			offset:     math.MaxUint64,
+			pageToken:  "%%%not-base64",
 			setupMocks: func(_ *mocks.MarketRepository, _ *mocks.MarketCacheRepository) {},
 			wantErr:    serviceErrors.ErrInvalidPagination,
 		},
 		{
-			name:   "cache hit — head page возвращается напрямую, hasMore=true",
-			ctx:    ctxWithRoles(models.UserRoleAdmin),
-			limit:  10,
-			offset: 0,
+			name:       "page_token от другой роли — ErrInvalidPagination",
+			ctx:        ctxWithRoles(models.UserRoleUser),
+			limit:      10,
+			pageToken:  adminToken,
+			setupMocks: func(_ *mocks.MarketRepository, _ *mocks.MarketCacheRepository) {},
+			wantErr:    serviceErrors.ErrInvalidPagination,
+		},
+		{
+			name:       "page_token с другими фильтрами — ErrInvalidPagination",
+			ctx:        ctxWithRoles(models.UserRoleAdmin),
+			limit:      10,
+			pageToken:  adminToken,
+			filter:     btcFilter,
+			setupMocks: func(_ *mocks.MarketRepository, _ *mocks.MarketCacheRepository) {},
+			wantErr:    serviceErrors.ErrInvalidPagination,
+		},
+		{
+			name:  "cache hit — head page возвращается напрямую, hasMore=true",
+			ctx:   ctxWithRoles(models.UserRoleAdmin),
+			limit: 10,
 			setupMocks: func(_ *mocks.MarketRepository, cache *mocks.MarketCacheRepository) {
 				cache.On("GetMarkets", mock.Anything, roleAdminKey).
 					Return(makeMarkets(30), nil)
 			},
 			wantHasMore: true,
-			wantOffset:  10,
 		},
 		{
-			name:   "cache hit — ровно limit записей, hasMore=false",
-			ctx:    ctxWithRoles(models.UserRoleUser),
-			limit:  10,
-			offset: 0,
+			name:  "cache hit — ровно limit записей, hasMore=false",
+			ctx:   ctxWithRoles(models.UserRoleUser),
+			limit: 10,
 			setupMocks: func(_ *mocks.MarketRepository, cache *mocks.MarketCacheRepository) {
 				cache.On("GetMarkets", mock.Anything, roleUserKey).
 					Return(makeMarkets(10), nil)
 			},
 			wantHasMore: false,
-			wantOffset:  0,
 		},
 		{
-			name:   "cache miss (ErrMarketsNotFound) — запрос в репо, прогрев кэша",
-			ctx:    ctxWithRoles(models.UserRoleViewer),
-			limit:  10,
-			offset: 0,
+			name:  "cache miss (ErrMarketsNotFound) — запрос в репо, прогрев кэша",
+			ctx:   ctxWithRoles(models.UserRoleViewer),
+			limit: 10,
 			setupMocks: func(repo *mocks.MarketRepository, cache *mocks.MarketCacheRepository) {
 				cache.On("GetMarkets", mock.Anything, roleViewerKey).
 					Return(nil, repositoryErrors.ErrMarketsNotFound)
 
 				repoMarkets := makeMarkets(int(testCacheLimit) + 1)
-				repo.On("GetMarketsPage", mock.Anything, roleViewerKey, testCacheLimit+1, uint64(0)).
+				repo.On("GetMarketsPage", mock.Anything, testViewerPolicy, testPublicAccess, noFilter, (*domainModels.MarketPageKey)(nil), testCacheLimit+1).
 					Return(repoMarkets, nil)
 				cache.On("SetMarkets", mock.Anything, repoMarkets, roleViewerKey, testCacheTTL).
 					Return(nil)
 			},
 			wantHasMore: true,
-			wantOffset:  10,
 		},
 		{
-			name:   "cache miss (ErrMarketCacheCorrupted) — запрос в репо, прогрев кэша",
-			ctx:    ctxWithRoles(models.UserRoleAdmin),
-			limit:  5,
-			offset: 0,
+			name:  "cache miss (ErrMarketCacheCorrupted) — запрос в репо, прогрев кэша",
+			ctx:   ctxWithRoles(models.UserRoleAdmin),
+			limit: 5,
 			setupMocks: func(repo *mocks.MarketRepository, cache *mocks.MarketCacheRepository) {
 				cache.On("GetMarkets", mock.Anything, roleAdminKey).
 					Return(nil, repositoryErrors.ErrMarketCacheCorrupted)
 
 				repoMarkets := makeMarkets(5)
-				repo.On("GetMarketsPage", mock.Anything, roleAdminKey, testCacheLimit+1, uint64(0)).
+				repo.On("GetMarketsPage", mock.Anything, testAdminPolicy, testAdminAccess, noFilter, (*domainModels.MarketPageKey)(nil), testCacheLimit+1).
 					Return(repoMarkets, nil)
 				cache.On("SetMarkets", mock.Anything, repoMarkets, roleAdminKey, testCacheTTL).
 					Return(nil)
 			},
 			wantHasMore: false,
-			wantOffset:  0,
 		},
 		{
-			name:   "cache miss + ошибка прогрева кэша — данные всё равно возвращаются",
-			ctx:    ctxWithRoles(models.UserRoleUser),
-			limit:  10,
-			offset: 0,
+			name:  "cache miss + ошибка прогрева кэша — данные всё равно возвращаются",
+			ctx:   ctxWithRoles(models.UserRoleUser),
+			limit: 10,
 			setupMocks: func(repo *mocks.MarketRepository, cache *mocks.MarketCacheRepository) {
 				cache.On("GetMarkets", mock.Anything, roleUserKey).
 					Return(nil, repositoryErrors.ErrMarketsNotFound)
 
 				repoMarkets := makeMarkets(3)
-				repo.On("GetMarketsPage", mock.Anything, roleUserKey, testCacheLimit+1, uint64(0)).
+				repo.On("GetMarketsPage", mock.Anything, testUserPolicy, testPublicAccess, noFilter, (*domainModels.MarketPageKey)(nil), testCacheLimit+1).
 					Return(repoMarkets, nil)
 				cache.On("SetMarkets", mock.Anything, repoMarkets, roleUserKey, testCacheTTL).
 					Return(errors.New("redis unavailable"))
 			},
 			wantHasMore: false,
-			wantOffset:  0,
 		},
 		{
-			name:   "cache miss + пустой репо — ErrMarketsNotFound",
-			ctx:    ctxWithRoles(models.UserRoleUser),
-			limit:  10,
-			offset: 0,
+			name:  "cache miss + пустой репо — ErrMarketsNotFound",
+			ctx:   ctxWithRoles(models.UserRoleUser),
+			limit: 10,
 			setupMocks: func(repo *mocks.MarketRepository, cache *mocks.MarketCacheRepository) {
 				cache.On("GetMarkets", mock.Anything, roleUserKey).
 					Return(nil, repositoryErrors.ErrMarketsNotFound)
-				repo.On("GetMarketsPage", mock.Anything, roleUserKey, testCacheLimit+1, uint64(0)).
+				repo.On("GetMarketsPage", mock.Anything, testUserPolicy, testPublicAccess, noFilter, (*domainModels.MarketPageKey)(nil), testCacheLimit+1).
 					Return(nil, repositoryErrors.ErrMarketStoreIsEmpty)
 			},
 			wantErr: serviceErrors.ErrMarketsNotFound,
 		},
 		{
-			name:   "cache miss + репо недоступен — ошибка пробрасывается",
-			ctx:    ctxWithRoles(models.UserRoleUser),
-			limit:  10,
-			offset: 0,
+			name:  "cache miss + репо недоступен — ошибка пробрасывается",
+			ctx:   ctxWithRoles(models.UserRoleUser),
+			limit: 10,
 			setupMocks: func(repo *mocks.MarketRepository, cache *mocks.MarketCacheRepository) {
 				cache.On("GetMarkets", mock.Anything, roleUserKey).
 					Return(nil, repositoryErrors.ErrMarketsNotFound)
-				repo.On("GetMarketsPage", mock.Anything, roleUserKey, testCacheLimit+1, uint64(0)).
+				repo.On("GetMarketsPage", mock.Anything, testUserPolicy, testPublicAccess, noFilter, (*domainModels.MarketPageKey)(nil), testCacheLimit+1).
 					Return(nil, errors.New("connection refused"))
 			},
 			checkErr: func(t *testing.T, err error) {
@@ -245,45 +317,55 @@ func TestViewMarkets(t *testing.T) {
 			},
 		},
 		{
-			name:   "offset>0 — кэш не трогается, запрос в репо с правильными параметрами",
-			ctx:    ctxWithRoles(models.UserRoleAdmin),
-			limit:  10,
-			offset: 20,
+			name:      "page_token — кэш не трогается, keyset-запрос в репо",
+			ctx:       ctxWithRoles(models.UserRoleAdmin),
+			limit:     10,
+			pageToken: adminToken,
 			setupMocks: func(repo *mocks.MarketRepository, _ *mocks.MarketCacheRepository) {
-				repo.On("GetMarketsPage", mock.Anything, roleAdminKey, uint64(10), uint64(20)).
+				repo.On("GetMarketsPage", mock.Anything, testAdminPolicy, testAdminAccess, noFilter, adminAfter, uint64(10)).
 					Return(makeMarkets(11), nil)
 			},
 			wantHasMore: true,
-			wantOffset:  30,
 		},
 		{
-			name:   "limit > cacheLimit — кэш не трогается, запрос в репо",
-			ctx:    ctxWithRoles(models.UserRoleAdmin),
-			limit:  testCacheLimit + 1,
-			offset: 0,
+			name:   "фильтр на первой странице — кэш не трогается",
+			ctx:    ctxWithRoles(models.UserRoleUser),
+			limit:  10,
+			filter: btcFilter,
+			setupMocks: func(repo *mocks.MarketRepository, _ *mocks.MarketCacheRepository) {
+				repo.On("GetMarketsPage", mock.Anything, testUserPolicy, testPublicAccess, btcFilter, (*domainModels.MarketPageKey)(nil), uint64(10)).
+					Return([]models.Market{}, nil)
+			},
+			checkResult: func(t *testing.T, markets []models.Market) {
+				assert.Empty(t, markets)
+			},
+		},
+		{
+			name:  "limit > cacheLimit — кэш не трогается, запрос в репо",
+			ctx:   ctxWithRoles(models.UserRoleAdmin),
+			limit: testCacheLimit + 1,
 			setupMocks: func(repo *mocks.MarketRepository, _ *mocks.MarketCacheRepository) {
-				repo.On("GetMarketsPage", mock.Anything, roleAdminKey, testCacheLimit+1, uint64(0)).
+				repo.On("GetMarketsPage", mock.Anything, testAdminPolicy, testAdminAccess, noFilter, (*domainModels.MarketPageKey)(nil), testCacheLimit+1).
 					Return(makeMarkets(10), nil)
 			},
 		},
 		{
-			name:   "прямой путь, репо пустой — ErrMarketsNotFound",
-			ctx:    ctxWithRoles(models.UserRoleUser),
-			limit:  10,
-			offset: 20,
+			name:  "прямой путь, репо пустой — ErrMarketsNotFound",
+			ctx:   ctxWithRoles(models.UserRoleAdmin),
+			limit: testCacheLimit + 1,
 			setupMocks: func(repo *mocks.MarketRepository, _ *mocks.MarketCacheRepository) {
-				repo.On("GetMarketsPage", mock.Anything, roleUserKey, uint64(10), uint64(20)).
+				repo.On("GetMarketsPage", mock.Anything, testAdminPolicy, testAdminAccess, noFilter, (*domainModels.MarketPageKey)(nil), testCacheLimit+1).
 					Return(nil, repositoryErrors.ErrMarketStoreIsEmpty)
 			},
 			wantErr: serviceErrors.ErrMarketsNotFound,
 		},
 		{
-			name:   "прямой путь, репо недоступен — ошибка пробрасывается",
-			ctx:    ctxWithRoles(models.UserRoleUser),
-			limit:  10,
-			offset: 20,
+			name:      "прямой путь, репо недоступен — ошибка пробрасывается",
+			ctx:       ctxWithRoles(models.UserRoleAdmin),
+			limit:     10,
+			pageToken: adminToken,
 			setupMocks: func(repo *mocks.MarketRepository, _ *mocks.MarketCacheRepository) {
-				repo.On("GetMarketsPage", mock.Anything, roleUserKey, uint64(10), uint64(20)).
+				repo.On("GetMarketsPage", mock.Anything, testAdminPolicy, testAdminAccess, noFilter, adminAfter, uint64(10)).
 					Return(nil, errors.New("db error"))
 			},
 			checkErr: func(t *testing.T, err error) {
@@ -292,39 +374,37 @@ func TestViewMarkets(t *testing.T) {
 			},
 		},
 		{
-			name:   "репо вернул limit+1 элементов — hasMore=true, nextOffset=offset+limit",
-			ctx:    ctxWithRoles(models.UserRoleAdmin),
-			limit:  5,
-			offset: 10,
+			name:      "репо вернул limit+1 элементов — hasMore=true, токен указывает на последний рынок страницы",
+			ctx:       ctxWithRoles(models.UserRoleAdmin),
+			limit:     5,
+			pageToken: adminToken,
 			setupMocks: func(repo *mocks.MarketRepository, _ *mocks.MarketCacheRepository) {
-				repo.On("GetMarketsPage", mock.Anything, roleAdminKey, uint64(5), uint64(10)).
+				repo.On("GetMarketsPage", mock.Anything, testAdminPolicy, testAdminAccess, noFilter, adminAfter, uint64(5)).
 					Return(makeMarkets(6), nil)
 			},
 			wantHasMore: true,
-			wantOffset:  15,
 			checkResult: func(t *testing.T, markets []models.Market) {
 				assert.Len(t, markets, 5, "должно быть обрезано до limit")
 			},
 		},
 		{
-			name:   "репо вернул ровно limit элементов — hasMore=false, nextOffset=0",
-			ctx:    ctxWithRoles(models.UserRoleAdmin),
-			limit:  5,
-			offset: 10,
+			name:      "репо вернул ровно limit элементов — hasMore=false, пустой токен",
+			ctx:       ctxWithRoles(models.UserRoleAdmin),
+			limit:     5,
+			pageToken: adminToken,
 			setupMocks: func(repo *mocks.MarketRepository, _ *mocks.MarketCacheRepository) {
-				repo.On("GetMarketsPage", mock.Anything, roleAdminKey, uint64(5), uint64(10)).
+				repo.On("GetMarketsPage", mock.Anything, testAdminPolicy, testAdminAccess, noFilter, adminAfter, uint64(5)).
 					Return(makeMarkets(5), nil)
 			},
 			wantHasMore: false,
-			wantOffset:  0,
 		},
 		{
-			name:   "admin + user — запрос идёт с ключом admin",
-			ctx:    ctxWithRoles(models.UserRoleAdmin, models.UserRoleUser),
-			limit:  5,
-			offset: 5,
+			name:      "admin + user — запрос идёт с ключом admin",
+			ctx:       ctxWithRoles(models.UserRoleAdmin, models.UserRoleUser),
+			limit:     5,
+			pageToken: adminToken,
 			setupMocks: func(repo *mocks.MarketRepository, _ *mocks.MarketCacheRepository) {
-				repo.On("GetMarketsPage", mock.Anything, roleAdminKey, uint64(5), uint64(5)).
+				repo.On("GetMarketsPage", mock.Anything, testAdminPolicy, testAdminAccess, noFilter, adminAfter, uint64(5)).
 					Return(makeMarkets(2), nil)
 			},
 		},
@@ -332,9 +412,9 @@ func TestViewMarkets(t *testing.T) {
 			name:   "viewer + user — запрос идёт с ключом viewer",
 			ctx:    ctxWithRoles(models.UserRoleViewer, models.UserRoleUser),
 			limit:  5,
-			offset: 5,
+			filter: btcFilter,
 			setupMocks: func(repo *mocks.MarketRepository, _ *mocks.MarketCacheRepository) {
-				repo.On("GetMarketsPage", mock.Anything, roleViewerKey, uint64(5), uint64(5)).
+				repo.On("GetMarketsPage", mock.Anything, testViewerPolicy, testPublicAccess, btcFilter, (*domainModels.MarketPageKey)(nil), uint64(5)).
 					Return(makeMarkets(2), nil)
 			},
 		},
@@ -347,9 +427,9 @@ func TestViewMarkets(t *testing.T) {
 			byIDCache := &mocks.MarketByIDCacheRepository{}
 			tt.setupMocks(repo, cache)
 
-			svc := newTestViewer(repo, cache, byIDCache)
+			svc := newTestViewer(repo, cache, byIDCache, &mocks.MarketBySymbolCacheRepository{})
 
-			markets, nextOffset, hasMore, err := svc.ViewMarkets(tt.ctx, tt.limit, tt.offset)
+			markets, nextPageToken, hasMore, err := svc.ViewMarkets(tt.ctx, tt.limit, tt.pageToken, tt.filter)
 
 			if tt.checkErr != nil {
 				tt.checkErr(t, err)
@@ -360,7 +440,7 @@ func TestViewMarkets(t *testing.T) {
 			} else {
 				require.NoError(t, err)
 				assert.Equal(t, tt.wantHasMore, hasMore)
-				assert.Equal(t, tt.wantOffset, nextOffset)
+				assert.Equal(t, tt.wantHasMore, nextPageToken != "")
 			}
 
 			if tt.checkResult != nil && err == nil {
@@ -722,7 +802,7 @@ func TestGetMarketByID(t *testing.T) {
 			byIDCache := &mocks.MarketByIDCacheRepository{}
 			tt.setupMocks(repo, byIDCache)
 
-			svc := newTestViewer(repo, cache, byIDCache)
+			svc := newTestViewer(repo, cache, byIDCache, &mocks.MarketBySymbolCacheRepository{})
 
 			got, err := svc.GetMarketByID(tt.ctx, tt.market.ID)
 
@@ -745,91 +825,570 @@ func TestGetMarketByID(t *testing.T) {
 	}
 }
 
-func TestRefreshAll(t *testing.T) {
-	allRoles := []string{roleAdminKey, roleViewerKey, roleUserKey}
+func TestGetMarketByIDCacheTiers(t *testing.T) {
+	market := models.Market{ID: uuid.New(), Name: "BTC-USDT", Enabled: true}
 
 	tests := []struct {
 		name       string
-		useNilCtx  bool
-		setupMocks func(repo *mocks.MarketRepository, cache *mocks.MarketCacheRepository)
-		checkErr   func(t *testing.T, err error)
+		setupMocks func(repo *mocks.MarketRepository, byIDCache *mocks.MarketByIDCacheRepository, local *mocks.MarketLocalCache)
+		wantErr    bool
 	}{
 		{
-			name: "успешное обновление кэша для всех трёх ролей",
-			setupMocks: func(repo *mocks.MarketRepository, cache *mocks.MarketCacheRepository) {
-				for _, role := range allRoles {
-					markets := makeMarkets(3)
-					repo.On("GetMarketsPage", mock.Anything, role, testCacheLimit+1, uint64(0)).
-						Return(markets, nil).Once()
-					cache.On("SetMarkets", mock.Anything, markets, role, testCacheTTL).
-						Return(nil).Once()
-				}
+			name: "hit в локальном кэше — Redis и репо не вызываются",
+			setupMocks: func(_ *mocks.MarketRepository, _ *mocks.MarketByIDCacheRepository, local *mocks.MarketLocalCache) {
+				local.On("GetMarket", market.ID).Return(market, true).Once()
 			},
-			checkErr: func(t *testing.T, err error) { require.NoError(t, err) },
 		},
 		{
-			name: "пустой store — инвалидируем кэши всех трёх ролей",
-			setupMocks: func(repo *mocks.MarketRepository, cache *mocks.MarketCacheRepository) {
-				repo.On("GetMarketsPage", mock.Anything, roleAdminKey, testCacheLimit+1, uint64(0)).
-					Return(nil, repositoryErrors.ErrMarketStoreIsEmpty).Once()
-				for _, role := range allRoles {
-					cache.On("DeleteMarkets", mock.Anything, role).Return(nil).Once()
-				}
+			name: "промах локального кэша, hit в Redis — рынок кладётся в локальный кэш",
+			setupMocks: func(_ *mocks.MarketRepository, byIDCache *mocks.MarketByIDCacheRepository, local *mocks.MarketLocalCache) {
+				local.On("GetMarket", market.ID).Return(models.Market{}, false).Once()
+				byIDCache.On("GetMarketByID", mock.Anything, market.ID).Return(market, nil).Once()
+				local.On("SetMarket", market).Once()
 			},
+		},
+		{
+			name: "промах обоих уровней — рынок из репо прогревает Redis и локальный кэш",
+			setupMocks: func(repo *mocks.MarketRepository, byIDCache *mocks.MarketByIDCacheRepository, local *mocks.MarketLocalCache) {
+				local.On("GetMarket", market.ID).Return(models.Market{}, false).Once()
+				byIDCache.On("GetMarketByID", mock.Anything, market.ID).
+					Return(models.Market{}, repositoryErrors.ErrMarketNotFound).Twice()
+				repo.On("GetMarketByID", mock.Anything, market.ID).Return(market, nil).Once()
+				byIDCache.On("SetMarketByID", mock.Anything, market, testCacheTTL).Return(nil).Once()
+				local.On("SetMarket", market).Once()
+			},
+		},
+		{
+			name: "рынка нет нигде — в локальный кэш ничего не кладётся",
+			setupMocks: func(repo *mocks.MarketRepository, byIDCache *mocks.MarketByIDCacheRepository, local *mocks.MarketLocalCache) {
+				local.On("GetMarket", market.ID).Return(models.Market{}, false).Once()
+				byIDCache.On("GetMarketByID", mock.Anything, market.ID).
+					Return(models.Market{}, repositoryErrors.ErrMarketNotFound).Twice()
+				repo.On("GetMarketByID", mock.Anything, market.ID).
+					Return(models.Market{}, repositoryErrors.ErrMarketNotFound).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mocks.NewMarketRepository(t)
+			byIDCache := mocks.NewMarketByIDCacheRepository(t)
+			local := mocks.NewMarketLocalCache(t)
+			tt.setupMocks(repo, byIDCache, local)
+
+			svc := newTestViewer(repo, &mocks.MarketCacheRepository{}, byIDCache, &mocks.MarketBySymbolCacheRepository{})
+			svc.localCache = local
+
+			got, err := svc.GetMarketByID(ctxWithRoles(models.UserRoleAdmin), market.ID)
+
+			if tt.wantErr {
+				var notFound sharedErrors.ErrMarketNotFound
+				assert.ErrorAs(t, err, &notFound)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, market, got)
+		})
+	}
+}
+
+func TestGetMarketByIDVisibilityPolicy(t *testing.T) {
+	deletedAt := time.Now().UTC()
+
+	btcUsdt := models.Market{ID: uuid.New(), Name: "BTC-USDT", BaseAsset: "BTC", QuoteAsset: "USDT", Enabled: true}
+	ethBtc := models.Market{ID: uuid.New(), Name: "ETH-BTC", BaseAsset: "ETH", QuoteAsset: "BTC", Enabled: true}
+	disabledEthUsdt := models.Market{ID: uuid.New(), Name: "ETH-USDT", BaseAsset: "ETH", QuoteAsset: "USDT"}
+	deletedSolUsdt := models.Market{
+		ID: uuid.New(), Name: "SOL-USDT", BaseAsset: "SOL", QuoteAsset: "USDT", Enabled: true, DeletedAt: &deletedAt,
+	}
+
+	// Пользователи видят только рынки к USDT; у viewer приоритет над user
+	policies := domainModels.VisibilityPolicies{
+		testViewerPolicy,
+		domainModels.NewVisibilityPolicy(
+			"usdt_user",
+			[]models.UserRole{models.UserRoleUser},
+			[]models.MarketStatus{models.MarketStatusEnabled},
+			nil,
+			[]string{"USDT"},
+			false,
+		),
+	}
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		market   models.Market
+		checkErr func(t *testing.T, err error)
+	}{
+		{
+			name:     "рынок из allowlist виден",
+			ctx:      ctxWithRoles(models.UserRoleUser),
+			market:   btcUsdt,
 			checkErr: func(t *testing.T, err error) { require.NoError(t, err) },
 		},
 		{
-			name: "пустой store + DeleteMarkets падает — ошибка",
-			setupMocks: func(repo *mocks.MarketRepository, cache *mocks.MarketCacheRepository) {
-				repo.On("GetMarketsPage", mock.Anything, roleAdminKey, testCacheLimit+1, uint64(0)).
-					Return(nil, repositoryErrors.ErrMarketStoreIsEmpty).Once()
-				cache.On("DeleteMarkets", mock.Anything, roleAdminKey).
-					Return(errors.New("redis down")).Once()
+			name:   "рынок вне allowlist — NotFound",
+			ctx:    ctxWithRoles(models.UserRoleUser),
+			market: ethBtc,
+			checkErr: func(t *testing.T, err error) {
+				var notFound sharedErrors.ErrMarketNotFound
+				assert.ErrorAs(t, err, &notFound)
 			},
+		},
+		{
+			name:   "выключенный рынок из allowlist — ErrDisabled",
+			ctx:    ctxWithRoles(models.UserRoleUser),
+			market: disabledEthUsdt,
 			checkErr: func(t *testing.T, err error) {
-				require.Error(t, err)
-				assert.Contains(t, err.Error(), "redis down")
+				assert.ErrorIs(t, err, serviceErrors.ErrMarketDisabled)
 			},
 		},
 		{
-			name: "GetMarketsPage падает — ошибка, следующие роли не обрабатываются",
-			setupMocks: func(repo *mocks.MarketRepository, _ *mocks.MarketCacheRepository) {
-				repo.On("GetMarketsPage", mock.Anything, roleAdminKey, testCacheLimit+1, uint64(0)).
-					Return(nil, errors.New("pg timeout")).Once()
+			name:   "удалённый рынок из allowlist — NotFound",
+			ctx:    ctxWithRoles(models.UserRoleUser),
+			market: deletedSolUsdt,
+			checkErr: func(t *testing.T, err error) {
+				var notFound sharedErrors.ErrMarketNotFound
+				assert.ErrorAs(t, err, &notFound)
 			},
+		},
+		{
+			name:     "viewer с ролью user получает политику viewer",
+			ctx:      ctxWithRoles(models.UserRoleUser, models.UserRoleViewer),
+			market:   ethBtc,
+			checkErr: func(t *testing.T, err error) { require.NoError(t, err) },
+		},
+		{
+			name:   "роли нет ни в одной политике — ErrUserRoleNotSpecified",
+			ctx:    ctxWithRoles(models.UserRoleAdmin),
+			market: btcUsdt,
 			checkErr: func(t *testing.T, err error) {
-				require.Error(t, err)
-				assert.Contains(t, err.Error(), "pg timeout")
+				assert.ErrorIs(t, err, serviceErrors.ErrUserRoleNotSpecified)
 			},
 		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			byIDCache := &mocks.MarketByIDCacheRepository{}
+			byIDCache.On("GetMarketByID", mock.Anything, tt.market.ID).Return(tt.market, nil).Maybe()
+
+			svc := newTestViewer(&mocks.MarketRepository{}, &mocks.MarketCacheRepository{}, byIDCache, &mocks.MarketBySymbolCacheRepository{})
+			svc.visibilityPolicies = policies
+
+			_, err := svc.GetMarketByID(tt.ctx, tt.market.ID)
+			tt.checkErr(t, err)
+		})
+	}
+}
+
+func TestPublicToAllRoles(t *testing.T) {
+	deletedAt := time.Now().UTC()
+	btcUsdt := models.Market{ID: uuid.New(), Name: "BTC-USDT", BaseAsset: "BTC", QuoteAsset: "USDT", Enabled: true}
+	ethBtc := models.Market{ID: uuid.New(), Name: "ETH-BTC", BaseAsset: "ETH", QuoteAsset: "BTC", Enabled: true}
+
+	usdtUserPolicy := domainModels.NewVisibilityPolicy(
+		roleUserKey,
+		[]models.UserRole{models.UserRoleUser},
+		[]models.MarketStatus{models.MarketStatusEnabled},
+		nil,
+		[]string{"USDT"},
+		false,
+	)
+	disabledOnlyUserPolicy := domainModels.NewVisibilityPolicy(
+		roleUserKey,
+		[]models.UserRole{models.UserRoleUser},
+		[]models.MarketStatus{models.MarketStatusDisabled},
+		nil, nil, false,
+	)
+
+	tests := []struct {
+		name     string
+		policies domainModels.VisibilityPolicies
+		market   models.Market
+		want     bool
+	}{
+		{
+			name:     "рынок виден всем ролям",
+			policies: testPublicPolicies,
+			market:   btcUsdt,
+			want:     true,
+		},
 		{
-			name: "SetMarkets падает — ошибка",
-			setupMocks: func(repo *mocks.MarketRepository, cache *mocks.MarketCacheRepository) {
-				markets := makeMarkets(2)
-				repo.On("GetMarketsPage", mock.Anything, roleAdminKey, testCacheLimit+1, uint64(0)).
-					Return(markets, nil).Once()
-				cache.On("SetMarkets", mock.Anything, markets, roleAdminKey, testCacheTTL).
-					Return(errors.New("redis oom")).Once()
+			name:     "выключенный и удалённый рынок оценивается как включённый",
+			policies: testPublicPolicies,
+			market:   models.Market{ID: btcUsdt.ID, BaseAsset: "BTC", QuoteAsset: "USDT", DeletedAt: &deletedAt},
+			want:     true,
+		},
+		{
+			name:     "актив вне allowlist одной из политик",
+			policies: domainModels.VisibilityPolicies{testPublicAdminPolicy, testViewerPolicy, usdtUserPolicy},
+			market:   ethBtc,
+			want:     false,
+		},
+		{
+			name:     "актив из allowlist всех политик",
+			policies: domainModels.VisibilityPolicies{testPublicAdminPolicy, testViewerPolicy, usdtUserPolicy},
+			market:   btcUsdt,
+			want:     true,
+		},
+		{
+			name:     "политика роли не показывает включённые рынки",
+			policies: domainModels.VisibilityPolicies{testPublicAdminPolicy, testViewerPolicy, disabledOnlyUserPolicy},
+			market:   btcUsdt,
+			want:     false,
+		},
+		{
+			name:     "у роли нет политики",
+			policies: testVisibilityPolicies,
+			market:   btcUsdt,
+			want:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.policies.PublicToAllRoles(tt.market))
+		})
+	}
+}
+
+func TestGetMarketByIDPublicToAllRoles(t *testing.T) {
+	market := models.Market{ID: uuid.New(), Name: "BTC-USDT", BaseAsset: "BTC", QuoteAsset: "USDT", Enabled: true}
+
+	byIDCache := &mocks.MarketByIDCacheRepository{}
+	byIDCache.On("GetMarketByID", mock.Anything, market.ID).Return(market, nil)
+
+	svc := newTestViewer(&mocks.MarketRepository{}, &mocks.MarketCacheRepository{}, byIDCache, &mocks.MarketBySymbolCacheRepository{})
+	svc.visibilityPolicies = testPublicPolicies
+
+	got, err := svc.GetMarketByID(ctxWithRoles(models.UserRoleUser), market.ID)
+	require.NoError(t, err)
+	assert.True(t, got.PublicToAllRoles)
+}
+
+func ctxWithUser(userID uuid.UUID, roles ...models.UserRole) context.Context {
+	ctx, _ := requestctx.ContextWithUserID(ctxWithRoles(roles...), userID)
+	return ctx
+}
+
+func TestGetMarketByIDRestricted(t *testing.T) {
+	userID := uuid.New()
+	restricted := models.Market{ID: uuid.New(), Name: "BETA-USDT", Enabled: true, Restricted: true}
+	public := models.Market{ID: uuid.New(), Name: "BTC-USDT", Enabled: true}
+	userSubject := domainModels.MarketAccessSubject{UserID: userID, Roles: []models.UserRole{models.UserRoleUser}}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		market     models.Market
+		setupMocks func(reader *mocks.MarketAccessReader)
+		checkErr   func(t *testing.T, err error)
+	}{
+		{
+			name:   "restricted-рынок без записи в market_access — NotFound",
+			ctx:    ctxWithUser(userID, models.UserRoleUser),
+			market: restricted,
+			setupMocks: func(reader *mocks.MarketAccessReader) {
+				reader.On("ListGrantedMarketIDs", mock.Anything, userSubject, []uuid.UUID{restricted.ID}).
+					Return([]uuid.UUID(nil), nil).Once()
 			},
 			checkErr: func(t *testing.T, err error) {
-				require.Error(t, err)
-				assert.Contains(t, err.Error(), "redis oom")
+				var notFound sharedErrors.ErrMarketNotFound
+				assert.ErrorAs(t, err, &notFound)
 			},
 		},
 		{
-			name:      "nil ctx — не паникует",
-			useNilCtx: true,
-			setupMocks: func(repo *mocks.MarketRepository, cache *mocks.MarketCacheRepository) {
-				for _, role := range allRoles {
-					markets := makeMarkets(1)
-					repo.On("GetMarketsPage", mock.Anything, role, testCacheLimit+1, uint64(0)).
-						Return(markets, nil).Once()
-					cache.On("SetMarkets", mock.Anything, markets, role, testCacheTTL).
-						Return(nil).Once()
-				}
+			name:   "restricted-рынок с записью в market_access виден",
+			ctx:    ctxWithUser(userID, models.UserRoleUser),
+			market: restricted,
+			setupMocks: func(reader *mocks.MarketAccessReader) {
+				reader.On("ListGrantedMarketIDs", mock.Anything, userSubject, []uuid.UUID{restricted.ID}).
+					Return([]uuid.UUID{restricted.ID}, nil).Once()
 			},
 			checkErr: func(t *testing.T, err error) { require.NoError(t, err) },
 		},
+		{
+			name:       "политика с bypass_access_lists не читает market_access",
+			ctx:        ctxWithUser(userID, models.UserRoleAdmin),
+			market:     restricted,
+			setupMocks: func(_ *mocks.MarketAccessReader) {},
+			checkErr:   func(t *testing.T, err error) { require.NoError(t, err) },
+		},
+		{
+			name:       "обычный рынок не читает market_access",
+			ctx:        ctxWithUser(userID, models.UserRoleUser),
+			market:     public,
+			setupMocks: func(_ *mocks.MarketAccessReader) {},
+			checkErr:   func(t *testing.T, err error) { require.NoError(t, err) },
+		},
+		{
+			name:   "ошибка чтения market_access — ошибка",
+			ctx:    ctxWithUser(userID, models.UserRoleUser),
+			market: restricted,
+			setupMocks: func(reader *mocks.MarketAccessReader) {
+				reader.On("ListGrantedMarketIDs", mock.Anything, userSubject, []uuid.UUID{restricted.ID}).
+					Return(nil, errors.New("db down")).Once()
+			},
+			checkErr: func(t *testing.T, err error) { require.Error(t, err) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			byIDCache := &mocks.MarketByIDCacheRepository{}
+			byIDCache.On("GetMarketByID", mock.Anything, tt.market.ID).Return(tt.market, nil).Once()
+
+			reader := mocks.NewMarketAccessReader(t)
+			tt.setupMocks(reader)
+
+			svc := newTestViewerWithAccess(
+				&mocks.MarketRepository{}, &mocks.MarketCacheRepository{}, byIDCache, &mocks.MarketBySymbolCacheRepository{}, reader,
+			)
+
+			_, err := svc.GetMarketByID(tt.ctx, tt.market.ID)
+			tt.checkErr(t, err)
+		})
+	}
+}
+
+func TestViewMarketsRestricted(t *testing.T) {
+	userID := uuid.New()
+	grantedID := uuid.New()
+	ctx := ctxWithUser(userID, models.UserRoleUser)
+	userSubject := domainModels.MarketAccessSubject{UserID: userID, Roles: []models.UserRole{models.UserRoleUser}}
+	markets := makeMarkets(3)
+
+	tests := []struct {
+		name       string
+		setupMocks func(repo *mocks.MarketRepository, cache *mocks.MarketCacheRepository, reader *mocks.MarketAccessReader)
+	}{
+		{
+			name: "без записей в market_access — общий head-cache политики",
+			setupMocks: func(_ *mocks.MarketRepository, cache *mocks.MarketCacheRepository, reader *mocks.MarketAccessReader) {
+				reader.On("ListGrantedMarketIDs", mock.Anything, userSubject, []uuid.UUID(nil)).
+					Return([]uuid.UUID(nil), nil).Once()
+				cache.On("GetMarkets", mock.Anything, roleUserKey).Return(markets, nil).Once()
+			},
+		},
+		{
+			name: "с записями в market_access — head-cache не используется",
+			setupMocks: func(repo *mocks.MarketRepository, _ *mocks.MarketCacheRepository, reader *mocks.MarketAccessReader) {
+				reader.On("ListGrantedMarketIDs", mock.Anything, userSubject, []uuid.UUID(nil)).
+					Return([]uuid.UUID{grantedID}, nil).Once()
+				repo.On("GetMarketsPage", mock.Anything, testUserPolicy,
+					domainModels.MarketAccess{GrantedMarketIDs: []uuid.UUID{grantedID}},
+					models.MarketFilter{}, (*domainModels.MarketPageKey)(nil), testDefaultLimit,
+				).Return(markets, nil).Once()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mocks.NewMarketRepository(t)
+			cache := mocks.NewMarketCacheRepository(t)
+			reader := mocks.NewMarketAccessReader(t)
+			tt.setupMocks(repo, cache, reader)
+
+			svc := newTestViewerWithAccess(repo, cache, &mocks.MarketByIDCacheRepository{}, &mocks.MarketBySymbolCacheRepository{}, reader)
+
+			got, _, hasMore, err := svc.ViewMarkets(ctx, 0, "", models.MarketFilter{})
+			require.NoError(t, err)
+			assert.Equal(t, markets, got)
+			assert.False(t, hasMore)
+		})
+	}
+}
+
+func TestViewMarketsByOffset(t *testing.T) {
+	ctx := ctxWithRoles(models.UserRoleViewer)
+	markets := makeMarkets(3)
+
+	tests := []struct {
+		name        string
+		limit       uint64
+		repoMarkets []models.Market
+		repoErr     error
+		wantCount   int
+		wantHasMore bool
+		wantErr     error
+	}{
+		{name: "есть следующая страница", limit: 2, repoMarkets: markets, wantCount: 2, wantHasMore: true},
+		{name: "последняя страница", limit: 5, repoMarkets: markets, wantCount: 3},
+		{name: "пустой market store", limit: 2, repoErr: repositoryErrors.ErrMarketStoreIsEmpty, wantErr: serviceErrors.ErrMarketsNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mocks.NewMarketRepository(t)
+			cache := mocks.NewMarketCacheRepository(t)
+			repo.On("GetMarketsPageByOffset", mock.Anything, testViewerPolicy, testPublicAccess,
+				models.MarketFilter{}, uint64(10), tt.limit,
+			).Return(tt.repoMarkets, tt.repoErr).Once()
+
+			svc := newTestViewer(repo, cache, &mocks.MarketByIDCacheRepository{}, &mocks.MarketBySymbolCacheRepository{})
+
+			got, nextPageToken, hasMore, err := svc.ViewMarketsByOffset(ctx, tt.limit, 10, models.MarketFilter{})
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Len(t, got, tt.wantCount)
+			assert.Equal(t, tt.wantHasMore, hasMore)
+			assert.Equal(t, tt.wantHasMore, nextPageToken != "")
+		})
+	}
+}
+
+func TestGetMarketBySymbol(t *testing.T) {
+	const symbol = "BTC-USDT"
+
+	activeMarket := models.Market{ID: uuid.New(), Name: symbol, Enabled: true}
+	disabledMarket := models.Market{ID: uuid.New(), Name: symbol, Enabled: false}
+	renamedMarket := models.Market{ID: uuid.New(), Name: "BTC-USDC", Enabled: true}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		setupMocks func(
+			repo *mocks.MarketRepository,
+			byIDCache *mocks.MarketByIDCacheRepository,
+			bySymbolCache *mocks.MarketBySymbolCacheRepository,
+		)
+		wantMarket *models.Market
+		wantErr    error
+	}{
+		{
+			name: "нет роли в контексте — ErrUserRoleNotSpecified",
+			ctx:  context.Background(),
+			setupMocks: func(_ *mocks.MarketRepository, _ *mocks.MarketByIDCacheRepository,
+				_ *mocks.MarketBySymbolCacheRepository,
+			) {
+			},
+			wantErr: serviceErrors.ErrUserRoleNotSpecified,
+		},
+		{
+			name: "symbol cache hit и by-id cache hit — репо не вызывается",
+			ctx:  ctxWithRoles(models.UserRoleUser),
+			setupMocks: func(_ *mocks.MarketRepository, byIDCache *mocks.MarketByIDCacheRepository,
+				bySymbolCache *mocks.MarketBySymbolCacheRepository,
+			) {
+				bySymbolCache.On("GetMarketIDBySymbol", mock.Anything, symbol).
+					Return(activeMarket.ID, nil).Once()
+				byIDCache.On("GetMarketByID", mock.Anything, activeMarket.ID).
+					Return(activeMarket, nil).Once()
+			},
+			wantMarket: &activeMarket,
+		},
+		{
+			name: "symbol cache указывает на переименованный рынок — запись удаляется, рынок грузится из репо",
+			ctx:  ctxWithRoles(models.UserRoleUser),
+			setupMocks: func(repo *mocks.MarketRepository, byIDCache *mocks.MarketByIDCacheRepository,
+				bySymbolCache *mocks.MarketBySymbolCacheRepository,
+			) {
+				bySymbolCache.On("GetMarketIDBySymbol", mock.Anything, symbol).
+					Return(renamedMarket.ID, nil).Once()
+				byIDCache.On("GetMarketByID", mock.Anything, renamedMarket.ID).
+					Return(renamedMarket, nil).Once()
+				bySymbolCache.On("DeleteBySymbols", mock.Anything, []string{symbol}).
+					Return(nil).Once()
+
+				repo.On("GetMarketBySymbol", mock.Anything, symbol).
+					Return(activeMarket, nil).Once()
+				byIDCache.On("SetMarketByID", mock.Anything, activeMarket, testCacheTTL).
+					Return(nil).Once()
+				bySymbolCache.On("SetMarketIDBySymbol", mock.Anything, symbol, activeMarket.ID, testCacheTTL).
+					Return(nil).Once()
+			},
+			wantMarket: &activeMarket,
+		},
+		{
+			name: "битая запись в symbol cache — удаляется, рынок грузится из репо",
+			ctx:  ctxWithRoles(models.UserRoleUser),
+			setupMocks: func(repo *mocks.MarketRepository, byIDCache *mocks.MarketByIDCacheRepository,
+				bySymbolCache *mocks.MarketBySymbolCacheRepository,
+			) {
+				bySymbolCache.On("GetMarketIDBySymbol", mock.Anything, symbol).
+					Return(uuid.Nil, repositoryErrors.ErrMarketCacheCorrupted).Once()
+				bySymbolCache.On("DeleteBySymbols", mock.Anything, []string{symbol}).
+					Return(nil).Once()
+
+				repo.On("GetMarketBySymbol", mock.Anything, symbol).
+					Return(activeMarket, nil).Once()
+				byIDCache.On("SetMarketByID", mock.Anything, activeMarket, testCacheTTL).
+					Return(nil).Once()
+				bySymbolCache.On("SetMarketIDBySymbol", mock.Anything, symbol, activeMarket.ID, testCacheTTL).
+					Return(nil).Once()
+			},
+			wantMarket: &activeMarket,
+		},
+		{
+			name: "cache miss — load from repo, прогрев обоих кэшей",
+			ctx:  ctxWithRoles(models.UserRoleAdmin),
+			setupMocks: func(repo *mocks.MarketRepository, byIDCache *mocks.MarketByIDCacheRepository,
+				bySymbolCache *mocks.MarketBySymbolCacheRepository,
+			) {
+				bySymbolCache.On("GetMarketIDBySymbol", mock.Anything, symbol).
+					Return(uuid.Nil, repositoryErrors.ErrMarketNotFound).Once()
+
+				repo.On("GetMarketBySymbol", mock.Anything, symbol).
+					Return(activeMarket, nil).Once()
+				byIDCache.On("SetMarketByID", mock.Anything, activeMarket, testCacheTTL).
+					Return(nil).Once()
+				bySymbolCache.On("SetMarketIDBySymbol", mock.Anything, symbol, activeMarket.ID, testCacheTTL).
+					Return(nil).Once()
+			},
+			wantMarket: &activeMarket,
+		},
+		{
+			name: "ошибка прогрева by-id cache — symbol cache не прогревается, рынок возвращается",
+			ctx:  ctxWithRoles(models.UserRoleAdmin),
+			setupMocks: func(repo *mocks.MarketRepository, byIDCache *mocks.MarketByIDCacheRepository,
+				bySymbolCache *mocks.MarketBySymbolCacheRepository,
+			) {
+				bySymbolCache.On("GetMarketIDBySymbol", mock.Anything, symbol).
+					Return(uuid.Nil, repositoryErrors.ErrMarketNotFound).Once()
+
+				repo.On("GetMarketBySymbol", mock.Anything, symbol).
+					Return(activeMarket, nil).Once()
+				byIDCache.On("SetMarketByID", mock.Anything, activeMarket, testCacheTTL).
+					Return(errors.New("redis timeout")).Once()
+			},
+			wantMarket: &activeMarket,
+		},
+		{
+			name: "рынка нет в репо — ErrMarketSymbolNotFound",
+			ctx:  ctxWithRoles(models.UserRoleAdmin),
+			setupMocks: func(repo *mocks.MarketRepository, _ *mocks.MarketByIDCacheRepository,
+				bySymbolCache *mocks.MarketBySymbolCacheRepository,
+			) {
+				bySymbolCache.On("GetMarketIDBySymbol", mock.Anything, symbol).
+					Return(uuid.Nil, repositoryErrors.ErrMarketNotFound).Once()
+				repo.On("GetMarketBySymbol", mock.Anything, symbol).
+					Return(models.Market{}, repositoryErrors.ErrMarketNotFound).Once()
+			},
+			wantErr: serviceErrors.ErrMarketSymbolNotFound,
+		},
+		{
+			name: "user, disabled маркет — ErrDisabled",
+			ctx:  ctxWithRoles(models.UserRoleUser),
+			setupMocks: func(_ *mocks.MarketRepository, byIDCache *mocks.MarketByIDCacheRepository,
+				bySymbolCache *mocks.MarketBySymbolCacheRepository,
+			) {
+				bySymbolCache.On("GetMarketIDBySymbol", mock.Anything, symbol).
+					Return(disabledMarket.ID, nil).Once()
+				byIDCache.On("GetMarketByID", mock.Anything, disabledMarket.ID).
+					Return(disabledMarket, nil).Once()
+			},
+			wantErr: serviceErrors.ErrMarketDisabled,
+		},
 	}
 
 	for _, tt := range tests {
@@ -837,17 +1396,204 @@ func TestRefreshAll(t *testing.T) {
 			repo := &mocks.MarketRepository{}
 			cache := &mocks.MarketCacheRepository{}
 			byIDCache := &mocks.MarketByIDCacheRepository{}
-			tt.setupMocks(repo, cache)
+			bySymbolCache := &mocks.MarketBySymbolCacheRepository{}
+			tt.setupMocks(repo, byIDCache, bySymbolCache)
+
+			svc := newTestViewer(repo, cache, byIDCache, bySymbolCache)
 
-			svc := newTestViewer(repo, cache, byIDCache)
+			got, err := svc.GetMarketBySymbol(tt.ctx, symbol)
 
-			var ctx context.Context
-			if !tt.useNilCtx {
-				ctx = context.Background()
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, tt.wantMarket)
+				assert.Equal(t, tt.wantMarket.ID, got.ID)
+				assert.Equal(t, symbol, got.Name)
 			}
 
-			err := svc.RefreshAll(ctx)
-			tt.checkErr(t, err)
+			repo.AssertExpectations(t)
+			cache.AssertExpectations(t)
+			byIDCache.AssertExpectations(t)
+			bySymbolCache.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetMarketsByIDs(t *testing.T) {
+	gofakeit.Seed(time.Now().UnixNano())
+
+	deletedAt := time.Now().UTC()
+
+	activeMarket := models.Market{ID: uuid.New(), Name: gofakeit.Company(), Enabled: true}
+	disabledMarket := models.Market{ID: uuid.New(), Name: gofakeit.Company(), Enabled: false}
+	deletedMarket := models.Market{ID: uuid.New(), Name: gofakeit.Company(), Enabled: true, DeletedAt: &deletedAt}
+	missingID := uuid.New()
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		ids         []uuid.UUID
+		setupMocks  func(repo *mocks.MarketRepository, byIDCache *mocks.MarketByIDCacheRepository)
+		wantLookups []models.MarketLookup
+		wantErr     error
+		checkErr    func(t *testing.T, err error)
+	}{
+		{
+			name:       "нет роли в контексте — ErrUserRoleNotSpecified",
+			ctx:        context.Background(),
+			ids:        []uuid.UUID{activeMarket.ID},
+			setupMocks: func(_ *mocks.MarketRepository, _ *mocks.MarketByIDCacheRepository) {},
+			wantErr:    serviceErrors.ErrUserRoleNotSpecified,
+		},
+		{
+			name: "все id в кэше — репо не вызывается",
+			ctx:  ctxWithRoles(models.UserRoleAdmin),
+			ids:  []uuid.UUID{activeMarket.ID, deletedMarket.ID},
+			setupMocks: func(_ *mocks.MarketRepository, byIDCache *mocks.MarketByIDCacheRepository) {
+				byIDCache.On("GetMarketsByIDs", mock.Anything, []uuid.UUID{activeMarket.ID, deletedMarket.ID}).
+					Return(map[uuid.UUID]models.Market{
+						activeMarket.ID:  activeMarket,
+						deletedMarket.ID: deletedMarket,
+					}, nil).Once()
+			},
+			wantLookups: []models.MarketLookup{
+				{ID: activeMarket.ID, Status: models.MarketLookupStatusFound, Market: activeMarket},
+				{ID: deletedMarket.ID, Status: models.MarketLookupStatusFound, Market: deletedMarket},
+			},
+		},
+		{
+			name: "промахи догружаются одним запросом и прогревают кэш, порядок id сохраняется",
+			ctx:  ctxWithRoles(models.UserRoleViewer),
+			ids:  []uuid.UUID{missingID, activeMarket.ID, disabledMarket.ID},
+			setupMocks: func(repo *mocks.MarketRepository, byIDCache *mocks.MarketByIDCacheRepository) {
+				byIDCache.On("GetMarketsByIDs", mock.Anything, []uuid.UUID{missingID, activeMarket.ID, disabledMarket.ID}).
+					Return(map[uuid.UUID]models.Market{activeMarket.ID: activeMarket}, nil).Once()
+				repo.On("GetMarketsByIDs", mock.Anything, []uuid.UUID{missingID, disabledMarket.ID}).
+					Return([]models.Market{disabledMarket}, nil).Once()
+				byIDCache.On("SetMarketsByIDs", mock.Anything, []models.Market{disabledMarket}, testCacheTTL).
+					Return(nil).Once()
+			},
+			wantLookups: []models.MarketLookup{
+				{ID: missingID, Status: models.MarketLookupStatusNotFound},
+				{ID: activeMarket.ID, Status: models.MarketLookupStatusFound, Market: activeMarket},
+				{ID: disabledMarket.ID, Status: models.MarketLookupStatusFound, Market: disabledMarket},
+			},
+		},
+		{
+			name: "user — disabled маркет DISABLED, deleted маркет NOT_FOUND без данных",
+			ctx:  ctxWithRoles(models.UserRoleUser),
+			ids:  []uuid.UUID{activeMarket.ID, disabledMarket.ID, deletedMarket.ID},
+			setupMocks: func(_ *mocks.MarketRepository, byIDCache *mocks.MarketByIDCacheRepository) {
+				byIDCache.On("GetMarketsByIDs", mock.Anything, mock.Anything).
+					Return(map[uuid.UUID]models.Market{
+						activeMarket.ID:   activeMarket,
+						disabledMarket.ID: disabledMarket,
+						deletedMarket.ID:  deletedMarket,
+					}, nil).Once()
+			},
+			wantLookups: []models.MarketLookup{
+				{ID: activeMarket.ID, Status: models.MarketLookupStatusFound, Market: activeMarket},
+				{ID: disabledMarket.ID, Status: models.MarketLookupStatusDisabled},
+				{ID: deletedMarket.ID, Status: models.MarketLookupStatusNotFound},
+			},
+		},
+		{
+			name: "повторяющиеся id схлопываются",
+			ctx:  ctxWithRoles(models.UserRoleAdmin),
+			ids:  []uuid.UUID{activeMarket.ID, activeMarket.ID},
+			setupMocks: func(_ *mocks.MarketRepository, byIDCache *mocks.MarketByIDCacheRepository) {
+				byIDCache.On("GetMarketsByIDs", mock.Anything, []uuid.UUID{activeMarket.ID}).
+					Return(map[uuid.UUID]models.Market{activeMarket.ID: activeMarket}, nil).Once()
+			},
+			wantLookups: []models.MarketLookup{
+				{ID: activeMarket.ID, Status: models.MarketLookupStatusFound, Market: activeMarket},
+			},
+		},
+		{
+			name: "кэш недоступен — все id читаются из репо",
+			ctx:  ctxWithRoles(models.UserRoleAdmin),
+			ids:  []uuid.UUID{activeMarket.ID},
+			setupMocks: func(repo *mocks.MarketRepository, byIDCache *mocks.MarketByIDCacheRepository) {
+				byIDCache.On("GetMarketsByIDs", mock.Anything, []uuid.UUID{activeMarket.ID}).
+					Return(nil, errors.New("redis unavailable")).Once()
+				repo.On("GetMarketsByIDs", mock.Anything, []uuid.UUID{activeMarket.ID}).
+					Return([]models.Market{activeMarket}, nil).Once()
+				byIDCache.On("SetMarketsByIDs", mock.Anything, []models.Market{activeMarket}, testCacheTTL).
+					Return(nil).Once()
+			},
+			wantLookups: []models.MarketLookup{
+				{ID: activeMarket.ID, Status: models.MarketLookupStatusFound, Market: activeMarket},
+			},
+		},
+		{
+			name: "ошибка прогрева кэша — данные всё равно возвращаются",
+			ctx:  ctxWithRoles(models.UserRoleAdmin),
+			ids:  []uuid.UUID{activeMarket.ID},
+			setupMocks: func(repo *mocks.MarketRepository, byIDCache *mocks.MarketByIDCacheRepository) {
+				byIDCache.On("GetMarketsByIDs", mock.Anything, []uuid.UUID{activeMarket.ID}).
+					Return(map[uuid.UUID]models.Market{}, nil).Once()
+				repo.On("GetMarketsByIDs", mock.Anything, []uuid.UUID{activeMarket.ID}).
+					Return([]models.Market{activeMarket}, nil).Once()
+				byIDCache.On("SetMarketsByIDs", mock.Anything, []models.Market{activeMarket}, testCacheTTL).
+					Return(errors.New("redis unavailable")).Once()
+			},
+			wantLookups: []models.MarketLookup{
+				{ID: activeMarket.ID, Status: models.MarketLookupStatusFound, Market: activeMarket},
+			},
+		},
+		{
+			name: "ни один id не найден в репо — кэш не прогревается",
+			ctx:  ctxWithRoles(models.UserRoleAdmin),
+			ids:  []uuid.UUID{missingID},
+			setupMocks: func(repo *mocks.MarketRepository, byIDCache *mocks.MarketByIDCacheRepository) {
+				byIDCache.On("GetMarketsByIDs", mock.Anything, []uuid.UUID{missingID}).
+					Return(map[uuid.UUID]models.Market{}, nil).Once()
+				repo.On("GetMarketsByIDs", mock.Anything, []uuid.UUID{missingID}).
+					Return([]models.Market{}, nil).Once()
+			},
+			wantLookups: []models.MarketLookup{
+				{ID: missingID, Status: models.MarketLookupStatusNotFound},
+			},
+		},
+		{
+			name: "репо недоступен — ошибка пробрасывается",
+			ctx:  ctxWithRoles(models.UserRoleAdmin),
+			ids:  []uuid.UUID{missingID},
+			setupMocks: func(repo *mocks.MarketRepository, byIDCache *mocks.MarketByIDCacheRepository) {
+				byIDCache.On("GetMarketsByIDs", mock.Anything, []uuid.UUID{missingID}).
+					Return(map[uuid.UUID]models.Market{}, nil).Once()
+				repo.On("GetMarketsByIDs", mock.Anything, []uuid.UUID{missingID}).
+					Return(nil, errors.New("db error")).Once()
+			},
+			checkErr: func(t *testing.T, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "db error")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mocks.MarketRepository{}
+			cache := &mocks.MarketCacheRepository{}
+			byIDCache := &mocks.MarketByIDCacheRepository{}
+			tt.setupMocks(repo, byIDCache)
+
+			svc := newTestViewer(repo, cache, byIDCache, &mocks.MarketBySymbolCacheRepository{})
+
+			got, err := svc.GetMarketsByIDs(tt.ctx, tt.ids)
+
+			if tt.checkErr != nil {
+				tt.checkErr(t, err)
+			} else if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantLookups, got)
+			}
 
 			repo.AssertExpectations(t)
 			cache.AssertExpectations(t)
@@ -956,7 +1702,7 @@ func TestInvalidateByIDs(t *testing.T) {
 			byIDCache := &mocks.MarketByIDCacheRepository{}
 			tt.setupMocks(byIDCache)
 
-			svc := newTestViewer(repo, cache, byIDCache)
+			svc := newTestViewer(repo, cache, byIDCache, &mocks.MarketBySymbolCacheRepository{})
 			err := svc.InvalidateByIDs(context.Background(), tt.ids)
 			tt.checkErr(t, err)
 
@@ -967,85 +1713,181 @@ func TestInvalidateByIDs(t *testing.T) {
 	}
 }
 
+func TestInvalidateByIDsLocalCache(t *testing.T) {
+	id1 := uuid.New()
+	id2 := uuid.New()
+
+	tests := []struct {
+		name       string
+		ids        []uuid.UUID
+		setupMocks func(byIDCache *mocks.MarketByIDCacheRepository, local *mocks.MarketLocalCache, publisher *mocks.MarketInvalidationPublisher)
+		checkErr   func(t *testing.T, err error)
+	}{
+		{
+			name: "уникальные id сбрасываются локально и рассылаются остальным репликам",
+			ids:  []uuid.UUID{id1, id2, id1},
+			setupMocks: func(byIDCache *mocks.MarketByIDCacheRepository, local *mocks.MarketLocalCache, publisher *mocks.MarketInvalidationPublisher) {
+				byIDCache.On("DeleteMarketByID", mock.Anything, id1).Return(nil).Once()
+				byIDCache.On("DeleteMarketByID", mock.Anything, id2).Return(nil).Once()
+				local.On("DeleteMarkets", []uuid.UUID{id1, id2}).Once()
+				publisher.On("PublishInvalidation", mock.Anything, []uuid.UUID{id1, id2}).Return(nil).Once()
+			},
+			checkErr: func(t *testing.T, err error) { require.NoError(t, err) },
+		},
+		{
+			name: "ошибка Redis не мешает локальной инвалидации и рассылке",
+			ids:  []uuid.UUID{id1},
+			setupMocks: func(byIDCache *mocks.MarketByIDCacheRepository, local *mocks.MarketLocalCache, publisher *mocks.MarketInvalidationPublisher) {
+				byIDCache.On("DeleteMarketByID", mock.Anything, id1).Return(errors.New("redis timeout")).Once()
+				local.On("DeleteMarkets", []uuid.UUID{id1}).Once()
+				publisher.On("PublishInvalidation", mock.Anything, []uuid.UUID{id1}).Return(nil).Once()
+			},
+			checkErr: func(t *testing.T, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "redis timeout")
+			},
+		},
+		{
+			name: "ошибка рассылки возвращается вызывающему",
+			ids:  []uuid.UUID{id1},
+			setupMocks: func(byIDCache *mocks.MarketByIDCacheRepository, local *mocks.MarketLocalCache, publisher *mocks.MarketInvalidationPublisher) {
+				byIDCache.On("DeleteMarketByID", mock.Anything, id1).Return(nil).Once()
+				local.On("DeleteMarkets", []uuid.UUID{id1}).Once()
+				publisher.On("PublishInvalidation", mock.Anything, []uuid.UUID{id1}).
+					Return(errors.New("publish failed")).Once()
+			},
+			checkErr: func(t *testing.T, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "publish failed")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			byIDCache := mocks.NewMarketByIDCacheRepository(t)
+			local := mocks.NewMarketLocalCache(t)
+			publisher := mocks.NewMarketInvalidationPublisher(t)
+			tt.setupMocks(byIDCache, local, publisher)
+
+			svc := newTestViewer(&mocks.MarketRepository{}, &mocks.MarketCacheRepository{}, byIDCache, &mocks.MarketBySymbolCacheRepository{})
+			svc.localCache = local
+			svc.invalidationPublisher = publisher
+
+			tt.checkErr(t, svc.InvalidateByIDs(context.Background(), tt.ids))
+		})
+	}
+}
+
+func TestInvalidateBySymbols(t *testing.T) {
+	tests := []struct {
+		name       string
+		symbols    []string
+		setupMocks func(bySymbolCache *mocks.MarketBySymbolCacheRepository)
+		checkErr   func(t *testing.T, err error)
+	}{
+		{
+			name:       "пустой срез — nil, моки не вызываются",
+			symbols:    []string{},
+			setupMocks: func(_ *mocks.MarketBySymbolCacheRepository) {},
+			checkErr:   func(t *testing.T, err error) { require.NoError(t, err) },
+		},
+		{
+			name:    "символы удаляются одним вызовом",
+			symbols: []string{"BTC-USDT", "ETH-USDT"},
+			setupMocks: func(bySymbolCache *mocks.MarketBySymbolCacheRepository) {
+				bySymbolCache.On("DeleteBySymbols", mock.Anything, []string{"BTC-USDT", "ETH-USDT"}).
+					Return(nil).Once()
+			},
+			checkErr: func(t *testing.T, err error) { require.NoError(t, err) },
+		},
+		{
+			name:    "ошибка удаления — пробрасывается",
+			symbols: []string{"BTC-USDT"},
+			setupMocks: func(bySymbolCache *mocks.MarketBySymbolCacheRepository) {
+				bySymbolCache.On("DeleteBySymbols", mock.Anything, []string{"BTC-USDT"}).
+					Return(errors.New("redis timeout")).Once()
+			},
+			checkErr: func(t *testing.T, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "redis timeout")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mocks.MarketRepository{}
+			cache := &mocks.MarketCacheRepository{}
+			byIDCache := &mocks.MarketByIDCacheRepository{}
+			bySymbolCache := &mocks.MarketBySymbolCacheRepository{}
+			tt.setupMocks(bySymbolCache)
+
+			svc := newTestViewer(repo, cache, byIDCache, bySymbolCache)
+			err := svc.InvalidateBySymbols(context.Background(), tt.symbols)
+			tt.checkErr(t, err)
+
+			bySymbolCache.AssertExpectations(t)
+		})
+	}
+}
+
 func TestBuildPageResponse(t *testing.T) {
 	markets5 := makeMarkets(5)
+	scope := pageTokenScope(roleAdminKey, models.MarketFilter{})
 
 	tests := []struct {
 		name        string
 		markets     []models.Market
 		limit       uint64
-		offset      uint64
 		wantLen     int
 		wantHasMore bool
-		wantOffset  uint64
-		wantErr     bool
 	}{
 		{
 			name:        "markets > limit — trim до limit, hasMore=true",
 			markets:     markets5,
 			limit:       3,
-			offset:      0,
 			wantLen:     3,
 			wantHasMore: true,
-			wantOffset:  3,
 		},
 		{
-			name:        "markets == limit — нет trim, hasMore=false, nextOffset=0",
+			name:        "markets == limit — нет trim, hasMore=false, пустой токен",
 			markets:     markets5,
 			limit:       5,
-			offset:      10,
 			wantLen:     5,
 			wantHasMore: false,
-			wantOffset:  0,
 		},
 		{
 			name:        "markets < limit — нет trim, hasMore=false",
 			markets:     markets5,
 			limit:       10,
-			offset:      0,
 			wantLen:     5,
 			wantHasMore: false,
-			wantOffset:  0,
 		},
 		{
-			name:        "пустой список — hasMore=false, nextOffset=0",
+			name:        "пустой список — hasMore=false, пустой токен",
 			markets:     []models.Market{},
 			limit:       10,
-			offset:      0,
 			wantLen:     0,
 			wantHasMore: false,
-			wantOffset:  0,
-		},
-		{
-			name:    "overflow nextOffset — ErrInvalidPagination",
-			markets: markets5,
-			limit:   3,
-			offset:  math.MaxUint64 - 2,
-			wantErr: true,
-		},
-		{
-			name:        "nextOffset ровно на границе MaxUint64 — не overflow",
-			markets:     markets5,
-			limit:       3,
-			offset:      math.MaxUint64 - 3,
-			wantLen:     3,
-			wantHasMore: true,
-			wantOffset:  math.MaxUint64,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, nextOffset, hasMore, err := buildPageResponse(tt.markets, tt.limit, tt.offset)
-			if tt.wantErr {
-				require.Error(t, err)
-				assert.ErrorIs(t, err, serviceErrors.ErrInvalidPagination)
+			got, nextPageToken, hasMore := buildPageResponse(tt.markets, tt.limit, scope)
+			assert.Len(t, got, tt.wantLen)
+			assert.Equal(t, tt.wantHasMore, hasMore)
+
+			if !tt.wantHasMore {
+				assert.Empty(t, nextPageToken)
 				return
 			}
+
+			after, err := decodePageToken(nextPageToken, scope)
 			require.NoError(t, err)
-			assert.Len(t, got, tt.wantLen)
-			assert.Equal(t, tt.wantHasMore, hasMore)
-			assert.Equal(t, tt.wantOffset, nextOffset)
+			last := got[len(got)-1]
+			assert.Equal(t, &domainModels.MarketPageKey{Name: last.Name, ID: last.ID}, after)
 		})
 	}
 }
@@ -1072,32 +1914,3 @@ func TestNormalizeLimit(t *testing.T) {
 		})
 	}
 }
-
-func TestEffectiveUserRole(t *testing.T) {
-	tests := []struct {
-		name    string
-		roles   []models.UserRole
-		wantKey string
-		wantOk  bool
-	}{
-		{name: "одна роль admin", roles: []models.UserRole{models.UserRoleAdmin}, wantKey: roleAdminKey, wantOk: true},
-		{name: "одна роль viewer", roles: []models.UserRole{models.UserRoleViewer}, wantKey: roleViewerKey, wantOk: true},
-		{name: "одна роль user", roles: []models.UserRole{models.UserRoleUser}, wantKey: roleUserKey, wantOk: true},
-		{name: "пустой список", roles: []models.UserRole{}, wantKey: "", wantOk: false},
-		{name: "только unspecified", roles: []models.UserRole{models.UserRoleUnspecified}, wantKey: "", wantOk: false},
-		{name: "admin + user → admin", roles: []models.UserRole{models.UserRoleAdmin, models.UserRoleUser}, wantKey: roleAdminKey, wantOk: true},
-		{name: "user + admin → admin (порядок не важен)", roles: []models.UserRole{models.UserRoleUser, models.UserRoleAdmin}, wantKey: roleAdminKey, wantOk: true},
-		{name: "viewer + user → viewer", roles: []models.UserRole{models.UserRoleViewer, models.UserRoleUser}, wantKey: roleViewerKey, wantOk: true},
-		{name: "unspecified + user → user", roles: []models.UserRole{models.UserRoleUnspecified, models.UserRoleUser}, wantKey: roleUserKey, wantOk: true},
-		{name: "user затем viewer — viewer выигрывает", roles: []models.UserRole{models.UserRoleUser, models.UserRoleViewer}, wantKey: roleViewerKey, wantOk: true},
-		{name: "admin + viewer + user → admin", roles: []models.UserRole{models.UserRoleAdmin, models.UserRoleViewer, models.UserRoleUser}, wantKey: roleAdminKey, wantOk: true},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			gotKey, gotOk := effectiveUserRole(tt.roles)
-			assert.Equal(t, tt.wantKey, gotKey)
-			assert.Equal(t, tt.wantOk, gotOk)
-		})
-	}
-}
@@ -0,0 +1,100 @@
+package spot
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	sharedErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors"
+	serviceErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/service"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+	"github.com/nastyazhadan/spot-order-grpc/shared/requestctx"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+)
+
+func resolveVisibilityPolicy(
+	ctx context.Context,
+	policies domainModels.VisibilityPolicies,
+) (domainModels.VisibilityPolicy, error) {
+	userRoles, ok := requestctx.UserRolesFromContext(ctx)
+	if !ok {
+		return domainModels.VisibilityPolicy{}, serviceErrors.ErrUserRoleNotSpecified
+	}
+
+	policy, ok := policies.Resolve(userRoles)
+	if !ok {
+		return domainModels.VisibilityPolicy{}, serviceErrors.ErrUserRoleNotSpecified
+	}
+
+	return policy, nil
+}
+
+// restrictedMarketAccess ищет записи market_access только для restricted-рынков из markets:
+// для обычных рынков поиск в PostgreSQL не нужен.
+func (s *MarketViewer) restrictedMarketAccess(
+	ctx context.Context,
+	policy domainModels.VisibilityPolicy,
+	markets []models.Market,
+) (domainModels.MarketAccess, error) {
+	var restrictedIDs []uuid.UUID
+	for _, market := range markets {
+		if market.Restricted {
+			restrictedIDs = append(restrictedIDs, market.ID)
+		}
+	}
+
+	if len(restrictedIDs) == 0 {
+		return headCacheAccess(policy), nil
+	}
+
+	return loadMarketAccess(ctx, s.accessReader, policy, restrictedIDs)
+}
+
+func loadMarketAccess(
+	ctx context.Context,
+	reader MarketAccessReader,
+	policy domainModels.VisibilityPolicy,
+	marketIDs []uuid.UUID,
+) (domainModels.MarketAccess, error) {
+	if policy.BypassAccessLists {
+		return headCacheAccess(policy), nil
+	}
+
+	subject := domainModels.MarketAccessSubject{}
+	subject.UserID, _ = requestctx.UserIDFromContext(ctx)
+	subject.Roles, _ = requestctx.UserRolesFromContext(ctx)
+
+	grantedIDs, err := reader.ListGrantedMarketIDs(ctx, subject, marketIDs)
+	if err != nil {
+		return domainModels.MarketAccess{}, fmt.Errorf("load market access: %w", err)
+	}
+
+	return domainModels.MarketAccess{GrantedMarketIDs: grantedIDs}, nil
+}
+
+// headCacheAccess — доступ, общий для всех пользователей политики: без персональных записей market_access
+func headCacheAccess(policy domainModels.VisibilityPolicy) domainModels.MarketAccess {
+	return domainModels.MarketAccess{BypassAccessLists: policy.BypassAccessLists}
+}
+
+// Restricted-рынок без записи в market_access неотличим от несуществующего
+func validateMarketAccess(
+	policy domainModels.VisibilityPolicy,
+	access domainModels.MarketAccess,
+	market models.Market,
+	id uuid.UUID,
+) error {
+	if !access.Allows(market) {
+		return sharedErrors.ErrMarketNotFound{ID: id}
+	}
+
+	switch policy.Check(market) {
+	case domainModels.MarketVisible:
+		return nil
+	case domainModels.MarketHiddenDisabled:
+		return serviceErrors.ErrDisabled{ID: id}
+	default:
+		return sharedErrors.ErrMarketNotFound{ID: id}
+	}
+}
@@ -0,0 +1,140 @@
+package spot
+
+import (
+	"sync"
+
+	serviceErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/service"
+	"github.com/nastyazhadan/spot-order-grpc/shared/metrics"
+	"github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+)
+
+// MarketSubscription получает батчи изменений рынков, обработанные поллером, вместе с их отрезком (AfterSeq, LastSeq].
+// Канал закрывается хабом при переполнении буфера или остановке сервиса, причину возвращает Err.
+type MarketSubscription struct {
+	id      uint64
+	batches chan models.MarketChangeBatch
+	err     error
+}
+
+func (s *MarketSubscription) Batches() <-chan models.MarketChangeBatch {
+	return s.batches
+}
+
+// Err вызывается только после закрытия канала Batches.
+func (s *MarketSubscription) Err() error {
+	return s.err
+}
+
+// MarketWatchHub раздаёт батчи изменений от MarketPoller подписчикам WatchMarkets этой реплики.
+// Публикация никогда не блокирует поллер: отстающий подписчик отключается и
+// должен переподключиться с последним полученным курсором.
+type MarketWatchHub struct {
+	mu             sync.Mutex
+	subscribers    map[uint64]*MarketSubscription
+	nextID         uint64
+	bufferSize     int
+	maxSubscribers int
+	closed         bool
+	serviceName    string
+}
+
+func NewMarketWatchHub(bufferSize, maxSubscribers int, serviceName string) *MarketWatchHub {
+	return &MarketWatchHub{
+		subscribers:    make(map[uint64]*MarketSubscription),
+		bufferSize:     bufferSize,
+		maxSubscribers: maxSubscribers,
+		serviceName:    serviceName,
+	}
+}
+
+func (h *MarketWatchHub) Subscribe() (*MarketSubscription, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return nil, serviceErrors.ErrMarketWatchClosed
+	}
+	if h.maxSubscribers > 0 && len(h.subscribers) >= h.maxSubscribers {
+		return nil, serviceErrors.ErrMarketWatchLimitExceeded
+	}
+
+	h.nextID++
+	subscription := &MarketSubscription{
+		id:      h.nextID,
+		batches: make(chan models.MarketChangeBatch, h.bufferSize),
+	}
+	h.subscribers[subscription.id] = subscription
+
+	metrics.MarketWatchSubscribers.WithLabelValues(h.serviceName).Inc()
+
+	return subscription, nil
+}
+
+func (h *MarketWatchHub) Unsubscribe(subscription *MarketSubscription) {
+	if subscription == nil {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.removeLocked(subscription, nil)
+}
+
+func (h *MarketWatchHub) NotifyMarketsChanged(batch models.MarketChangeBatch) {
+	if len(batch.Markets) == 0 {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, subscription := range h.subscribers {
+		select {
+		case subscription.batches <- batch:
+		default:
+			metrics.MarketWatchDisconnectsTotal.WithLabelValues(h.serviceName, "lagged").Inc()
+			h.removeLocked(subscription, serviceErrors.ErrMarketWatchLagged)
+		}
+	}
+}
+
+// DisconnectAll отключает всех подписчиков как отставших, когда хаб мог пропустить батч:
+// клиенты переподключатся и догонят изменения из PostgreSQL по своему курсору.
+func (h *MarketWatchHub) DisconnectAll(reason string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, subscription := range h.subscribers {
+		metrics.MarketWatchDisconnectsTotal.WithLabelValues(h.serviceName, reason).Inc()
+		h.removeLocked(subscription, serviceErrors.ErrMarketWatchLagged)
+	}
+}
+
+// Close отключает всех подписчиков, чтобы GracefulStop не ждал бесконечные стримы.
+func (h *MarketWatchHub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return
+	}
+	h.closed = true
+
+	for _, subscription := range h.subscribers {
+		metrics.MarketWatchDisconnectsTotal.WithLabelValues(h.serviceName, "shutdown").Inc()
+		h.removeLocked(subscription, serviceErrors.ErrMarketWatchClosed)
+	}
+}
+
+func (h *MarketWatchHub) removeLocked(subscription *MarketSubscription, reason error) {
+	if _, ok := h.subscribers[subscription.id]; !ok {
+		return
+	}
+
+	delete(h.subscribers, subscription.id)
+	subscription.err = reason
+	close(subscription.batches)
+
+	metrics.MarketWatchSubscribers.WithLabelValues(h.serviceName).Dec()
+}
@@ -0,0 +1,315 @@
+package spot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	repositoryErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/repository"
+	serviceErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/service"
+	zapLogger "github.com/nastyazhadan/spot-order-grpc/shared/interceptors/logging/zap"
+	"github.com/nastyazhadan/spot-order-grpc/shared/interceptors/tracing"
+	sharedModels "github.com/nastyazhadan/spot-order-grpc/shared/models"
+	"github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+)
+
+type MarketChangeReader interface {
+	ListChangesAfter(ctx context.Context, afterSeq int64, limit int) ([]models.MarketChangeLogEntry, error)
+	GetChangeLogBounds(ctx context.Context) (models.MarketChangeLogBounds, error)
+}
+
+type MarketChangeSubscriber interface {
+	Subscribe() (*MarketSubscription, error)
+	Unsubscribe(subscription *MarketSubscription)
+}
+
+type MarketWatcher struct {
+	marketRepository MarketRepository
+	changeReader     MarketChangeReader
+	subscriber       MarketChangeSubscriber
+	policies         models.VisibilityPolicies
+	accessReader     MarketAccessReader
+	serviceTimeout   time.Duration
+	pageSize         uint64
+	logger           *zapLogger.Logger
+}
+
+func NewMarketWatcher(
+	repo MarketRepository,
+	changeReader MarketChangeReader,
+	subscriber MarketChangeSubscriber,
+	policies models.VisibilityPolicies,
+	accessReader MarketAccessReader,
+	timeout time.Duration,
+	pageSize uint64,
+	logger *zapLogger.Logger,
+) *MarketWatcher {
+	return &MarketWatcher{
+		marketRepository: repo,
+		changeReader:     changeReader,
+		subscriber:       subscriber,
+		policies:         policies,
+		accessReader:     accessReader,
+		serviceTimeout:   timeout,
+		pageSize:         pageSize,
+		logger:           logger,
+	}
+}
+
+// WatchMarkets отправляет snapshot видимых роли рынков (если resumeFrom == nil),
+// затем догоняет изменения из журнала market_change_log после курсора и переходит на батчи поллера.
+// Курсор — seq журнала: порядок seq совпадает с порядком коммитов, поэтому изменение,
+// закоммиченное позже с более ранним updated_at, не оказывается позади курсора.
+// Подписка на хаб оформляется до чтения snapshot, поэтому изменения между фазами не теряются,
+// а дубликаты отбрасываются по seq. Записи market_access читаются один раз при подписке:
+// выданный или отозванный позже доступ подхватывается переподключением.
+func (w *MarketWatcher) WatchMarkets(
+	ctx context.Context,
+	resumeFrom *models.MarketCursor,
+	send func(event models.MarketWatchEvent) error,
+) error {
+	const op = "MarketWatcher.WatchMarkets"
+
+	ctx, span := tracing.StartSpan(ctx, "spot.watch_markets")
+	defer span.End()
+
+	policy, err := resolveVisibilityPolicy(ctx, w.policies)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	access, err := loadMarketAccess(ctx, w.accessReader, policy, nil)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	subscription, err := w.subscriber.Subscribe()
+	if err != nil {
+		tracing.RecordError(span, err)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer w.subscriber.Unsubscribe(subscription)
+
+	var cursor models.MarketCursor
+	if resumeFrom != nil {
+		cursor = *resumeFrom
+	} else {
+		if cursor, err = w.sendSnapshot(ctx, policy, access, send); err != nil {
+			tracing.RecordError(span, err)
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if cursor, err = w.catchUp(ctx, policy, access, cursor, send); err != nil {
+		tracing.RecordError(span, err)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case batch, ok := <-subscription.Batches():
+			if !ok {
+				closeReason := subscription.Err()
+				if closeReason == nil {
+					closeReason = serviceErrors.ErrMarketWatchClosed
+				}
+
+				w.logger.Info(ctx, "market watch subscription closed",
+					zap.String("visibility_policy", policy.ID),
+					zap.Int64("last_seq", cursor.Seq),
+					zap.Error(closeReason),
+				)
+				return fmt.Errorf("%s: %w", op, closeReason)
+			}
+
+			if cursor, err = w.applyBatch(ctx, policy, access, cursor, batch, send); err != nil {
+				tracing.RecordError(span, err)
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+	}
+}
+
+// sendSnapshot фиксирует позицию потока изменений до чтения страниц,
+// чтобы последующий catch-up покрыл всё, что изменилось во время snapshot.
+func (w *MarketWatcher) sendSnapshot(
+	ctx context.Context,
+	policy models.VisibilityPolicy,
+	access models.MarketAccess,
+	send func(event models.MarketWatchEvent) error,
+) (models.MarketCursor, error) {
+	boundsCtx, cancel := contextWithTimeout(ctx, w.serviceTimeout)
+	bounds, err := w.changeReader.GetChangeLogBounds(boundsCtx)
+	cancel()
+	if err != nil {
+		return models.MarketCursor{}, fmt.Errorf("load change log bounds: %w", err)
+	}
+	cursor := models.MarketCursor{Seq: bounds.LatestSeq}
+
+	var after *models.MarketPageKey
+	for {
+		pageCtx, pageCancel := contextWithTimeout(ctx, w.serviceTimeout)
+		markets, pageError := w.marketRepository.GetMarketsPage(pageCtx, policy, access, sharedModels.MarketFilter{}, after, w.pageSize)
+		pageCancel()
+		if pageError != nil && !errors.Is(pageError, repositoryErrors.ErrMarketStoreIsEmpty) {
+			return models.MarketCursor{}, fmt.Errorf("load snapshot page: %w", pageError)
+		}
+
+		hasMore := uint64(len(markets)) > w.pageSize
+		if hasMore {
+			markets = markets[:w.pageSize]
+		}
+
+		event := models.MarketWatchEvent{
+			Type:         models.MarketWatchEventTypeSnapshot,
+			Snapshot:     w.policies.WithPublicToAllRoles(markets),
+			SnapshotLast: !hasMore,
+			Cursor:       cursor,
+		}
+		if err = send(event); err != nil {
+			return models.MarketCursor{}, err
+		}
+
+		if !hasMore {
+			return cursor, nil
+		}
+
+		last := markets[len(markets)-1]
+		after = &models.MarketPageKey{Name: last.Name, ID: last.ID}
+	}
+}
+
+// applyBatch продолжает поток батчем из хаба, только если батч начинается ровно с курсора.
+// Уже отправленный отрезок пропускается, а разрыв (батчи, пришедшие до конца snapshot
+// и catch-up, или частичное пересечение) закрывается чтением журнала.
+func (w *MarketWatcher) applyBatch(
+	ctx context.Context,
+	policy models.VisibilityPolicy,
+	access models.MarketAccess,
+	cursor models.MarketCursor,
+	batch models.MarketChangeBatch,
+	send func(event models.MarketWatchEvent) error,
+) (models.MarketCursor, error) {
+	switch {
+	case batch.LastSeq <= cursor.Seq:
+		return cursor, nil
+	case batch.AfterSeq == cursor.Seq:
+		next := models.MarketCursor{Seq: batch.LastSeq}
+		if err := w.sendChanges(policy, access, batch.Markets, next, send); err != nil {
+			return cursor, err
+		}
+		return next, nil
+	default:
+		return w.catchUp(ctx, policy, access, cursor, send)
+	}
+}
+
+func (w *MarketWatcher) catchUp(
+	ctx context.Context,
+	policy models.VisibilityPolicy,
+	access models.MarketAccess,
+	cursor models.MarketCursor,
+	send func(event models.MarketWatchEvent) error,
+) (models.MarketCursor, error) {
+	for firstPage := true; ; firstPage = false {
+		pageCtx, cancel := contextWithTimeout(ctx, w.serviceTimeout)
+		entries, err := w.changeReader.ListChangesAfter(pageCtx, cursor.Seq, int(w.pageSize))
+		cancel()
+		if err != nil {
+			return cursor, fmt.Errorf("load market changes: %w", err)
+		}
+
+		// Граница очистки проверяется после чтения: очистка, закоммиченная до него, уже видна в границе,
+		// а закоммиченная после не могла удалить прочитанные записи
+		if firstPage {
+			if err = w.checkRetention(ctx, cursor); err != nil {
+				return cursor, err
+			}
+		}
+
+		if len(entries) == 0 {
+			return cursor, nil
+		}
+
+		markets := make([]sharedModels.Market, 0, len(entries))
+		for _, entry := range entries {
+			markets = append(markets, entry.Market)
+		}
+
+		next := models.MarketCursor{Seq: entries[len(entries)-1].Seq}
+		if err = w.sendChanges(policy, access, markets, next, send); err != nil {
+			return cursor, err
+		}
+		cursor = next
+
+		if uint64(len(entries)) < w.pageSize {
+			return cursor, nil
+		}
+	}
+}
+
+// checkRetention отклоняет курсор за границей очистки журнала: пропущенные после него
+// изменения уже удалены, и восстановить копию клиента может только новый snapshot.
+func (w *MarketWatcher) checkRetention(ctx context.Context, cursor models.MarketCursor) error {
+	boundsCtx, cancel := contextWithTimeout(ctx, w.serviceTimeout)
+	bounds, err := w.changeReader.GetChangeLogBounds(boundsCtx)
+	cancel()
+	if err != nil {
+		return fmt.Errorf("load change log bounds: %w", err)
+	}
+
+	if cursor.Seq < bounds.PrunedSeq {
+		return serviceErrors.ErrMarketWatchCursorExpired
+	}
+
+	return nil
+}
+
+func (w *MarketWatcher) sendChanges(
+	policy models.VisibilityPolicy,
+	access models.MarketAccess,
+	markets []sharedModels.Market,
+	next models.MarketCursor,
+	send func(event models.MarketWatchEvent) error,
+) error {
+	changes := make([]models.MarketChange, 0, len(markets))
+	for _, market := range markets {
+		market.PublicToAllRoles = w.policies.PublicToAllRoles(market)
+		changes = append(changes, buildMarketChange(policy, access, market))
+	}
+
+	return send(models.MarketWatchEvent{
+		Type:    models.MarketWatchEventTypeChanges,
+		Changes: changes,
+		Cursor:  next,
+	})
+}
+
+// Рынок, ставший невидимым для роли или закрытый через restricted, отдаётся как REMOVED без данных,
+// чтобы клиент удалил его из своей копии, не получая скрытых полей.
+func buildMarketChange(
+	policy models.VisibilityPolicy,
+	access models.MarketAccess,
+	market sharedModels.Market,
+) models.MarketChange {
+	if !policy.Visible(market) || !access.Allows(market) {
+		return models.MarketChange{
+			Type:     models.MarketChangeTypeRemoved,
+			MarketID: market.ID,
+		}
+	}
+
+	return models.MarketChange{
+		Type:     models.MarketChangeTypeUpserted,
+		MarketID: market.ID,
+		Market:   market,
+	}
+}
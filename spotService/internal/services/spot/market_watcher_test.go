@@ -0,0 +1,385 @@
+package spot
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	repositoryErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/repository"
+	serviceErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/service"
+	zapLogger "github.com/nastyazhadan/spot-order-grpc/shared/interceptors/logging/zap"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+	"github.com/nastyazhadan/spot-order-grpc/spotService/internal/services/mocks"
+)
+
+const testWatchPageSize = uint64(2)
+
+func newTestWatcher(
+	repo *mocks.MarketRepository,
+	reader *mocks.MarketChangeReader,
+	hub *MarketWatchHub,
+) *MarketWatcher {
+	return NewMarketWatcher(
+		repo, reader, hub, testVisibilityPolicies, noGrantsReader(), testTimeout, testWatchPageSize, zapLogger.NewNop(),
+	)
+}
+
+func makeUpdatedMarket(updatedAt time.Time, enabled bool) models.Market {
+	return models.Market{
+		ID:        uuid.New(),
+		Name:      "BTC-USDT",
+		Enabled:   enabled,
+		UpdatedAt: updatedAt,
+	}
+}
+
+// collectEvents останавливает watcher после получения n событий.
+func collectEvents(
+	cancel context.CancelFunc,
+	n int,
+	events *[]domainModels.MarketWatchEvent,
+) func(domainModels.MarketWatchEvent) error {
+	return func(event domainModels.MarketWatchEvent) error {
+		*events = append(*events, event)
+		if len(*events) >= n {
+			cancel()
+		}
+		return nil
+	}
+}
+
+func TestWatchMarkets(t *testing.T) {
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	latest := domainModels.MarketCursor{Seq: 10}
+	bounds := domainModels.MarketChangeLogBounds{PrunedSeq: 4, LatestSeq: latest.Seq}
+
+	t.Run("нет роли в контексте — ErrUserRoleNotSpecified", func(t *testing.T) {
+		watcher := newTestWatcher(mocks.NewMarketRepository(t), mocks.NewMarketChangeReader(t), NewMarketWatchHub(1, 1, testServiceName))
+
+		err := watcher.WatchMarkets(context.Background(), nil, func(domainModels.MarketWatchEvent) error { return nil })
+
+		require.ErrorIs(t, err, serviceErrors.ErrUserRoleNotSpecified)
+	})
+
+	t.Run("snapshot постранично, затем catch-up после курсора", func(t *testing.T) {
+		repo := mocks.NewMarketRepository(t)
+		reader := mocks.NewMarketChangeReader(t)
+
+		page1 := []models.Market{makeUpdatedMarket(base, true), makeUpdatedMarket(base, true), makeUpdatedMarket(base, true)}
+		page2 := []models.Market{makeUpdatedMarket(base, true)}
+		changed := makeUpdatedMarket(base.Add(time.Second), true)
+
+		reader.On("GetChangeLogBounds", mock.Anything).Return(bounds, nil).Twice()
+		repo.On("GetMarketsPage", mock.Anything, testUserPolicy, testPublicAccess, models.MarketFilter{}, (*domainModels.MarketPageKey)(nil), testWatchPageSize).
+			Return(page1, nil).Once()
+		repo.On("GetMarketsPage", mock.Anything, testUserPolicy, testPublicAccess, models.MarketFilter{},
+			&domainModels.MarketPageKey{Name: page1[1].Name, ID: page1[1].ID}, testWatchPageSize).
+			Return(page2, nil).Once()
+		reader.On("ListChangesAfter", mock.Anything, latest.Seq, int(testWatchPageSize)).
+			Return(makeChangeLogEntries(11, changed), nil).Once()
+
+		ctx, cancel := context.WithCancel(ctxWithRoles(models.UserRoleUser))
+		defer cancel()
+
+		var events []domainModels.MarketWatchEvent
+		err := newTestWatcher(repo, reader, NewMarketWatchHub(1, 1, testServiceName)).
+			WatchMarkets(ctx, nil, collectEvents(cancel, 3, &events))
+
+		require.ErrorIs(t, err, context.Canceled)
+		require.Len(t, events, 3)
+
+		assert.Equal(t, domainModels.MarketWatchEventTypeSnapshot, events[0].Type)
+		assert.Len(t, events[0].Snapshot, int(testWatchPageSize))
+		assert.False(t, events[0].SnapshotLast)
+		assert.Equal(t, latest, events[0].Cursor)
+
+		assert.True(t, events[1].SnapshotLast)
+		assert.Equal(t, page2, events[1].Snapshot)
+
+		assert.Equal(t, domainModels.MarketWatchEventTypeChanges, events[2].Type)
+		require.Len(t, events[2].Changes, 1)
+		assert.Equal(t, domainModels.MarketChangeTypeUpserted, events[2].Changes[0].Type)
+		assert.Equal(t, domainModels.MarketCursor{Seq: 11}, events[2].Cursor)
+	})
+
+	t.Run("пустой market store — пустой последний snapshot", func(t *testing.T) {
+		repo := mocks.NewMarketRepository(t)
+		reader := mocks.NewMarketChangeReader(t)
+
+		reader.On("GetChangeLogBounds", mock.Anything).Return(domainModels.MarketChangeLogBounds{}, nil).Twice()
+		repo.On("GetMarketsPage", mock.Anything, testAdminPolicy, testAdminAccess, models.MarketFilter{}, (*domainModels.MarketPageKey)(nil), testWatchPageSize).
+			Return(nil, repositoryErrors.ErrMarketStoreIsEmpty).Once()
+		reader.On("ListChangesAfter", mock.Anything, int64(0), int(testWatchPageSize)).
+			Return([]domainModels.MarketChangeLogEntry{}, nil).Once()
+
+		ctx, cancel := context.WithCancel(ctxWithRoles(models.UserRoleAdmin))
+		defer cancel()
+
+		var events []domainModels.MarketWatchEvent
+		err := newTestWatcher(repo, reader, NewMarketWatchHub(1, 1, testServiceName)).
+			WatchMarkets(ctx, nil, collectEvents(cancel, 1, &events))
+
+		require.ErrorIs(t, err, context.Canceled)
+		require.Len(t, events, 1)
+		assert.Empty(t, events[0].Snapshot)
+		assert.True(t, events[0].SnapshotLast)
+	})
+
+	t.Run("resume — snapshot пропускается, выключенный рынок для user приходит как REMOVED", func(t *testing.T) {
+		reader := mocks.NewMarketChangeReader(t)
+		disabled := makeUpdatedMarket(base.Add(time.Second), false)
+
+		reader.On("ListChangesAfter", mock.Anything, latest.Seq, int(testWatchPageSize)).
+			Return(makeChangeLogEntries(11, disabled), nil).Once()
+		reader.On("GetChangeLogBounds", mock.Anything).Return(bounds, nil).Once()
+
+		ctx, cancel := context.WithCancel(ctxWithRoles(models.UserRoleUser))
+		defer cancel()
+
+		var events []domainModels.MarketWatchEvent
+		resumeFrom := latest
+		err := newTestWatcher(mocks.NewMarketRepository(t), reader, NewMarketWatchHub(1, 1, testServiceName)).
+			WatchMarkets(ctx, &resumeFrom, collectEvents(cancel, 1, &events))
+
+		require.ErrorIs(t, err, context.Canceled)
+		require.Len(t, events, 1)
+		require.Len(t, events[0].Changes, 1)
+		assert.Equal(t, domainModels.MarketChangeTypeRemoved, events[0].Changes[0].Type)
+		assert.Equal(t, disabled.ID, events[0].Changes[0].MarketID)
+		assert.Empty(t, events[0].Changes[0].Market.Name)
+	})
+
+	t.Run("изменение с меньшим updated_at, закоммиченное позже, не теряется", func(t *testing.T) {
+		reader := mocks.NewMarketChangeReader(t)
+		hub := NewMarketWatchHub(4, 1, testServiceName)
+
+		// seq 11 закоммичен первым с updated_at = base+2s, seq 12 — позже, но его транзакция началась раньше
+		later := makeUpdatedMarket(base.Add(2*time.Second), true)
+		earlier := makeUpdatedMarket(base.Add(time.Second), true)
+		oldest := makeUpdatedMarket(base, true)
+
+		reader.On("ListChangesAfter", mock.Anything, latest.Seq, int(testWatchPageSize)).
+			Return(makeChangeLogEntries(11, later, earlier), nil).Once()
+		reader.On("ListChangesAfter", mock.Anything, int64(12), int(testWatchPageSize)).
+			Run(func(mock.Arguments) {
+				hub.NotifyMarketsChanged(changeBatch(12, 13, oldest))
+			}).
+			Return([]domainModels.MarketChangeLogEntry{}, nil).Once()
+		reader.On("GetChangeLogBounds", mock.Anything).Return(bounds, nil).Once()
+
+		ctx, cancel := context.WithCancel(ctxWithRoles(models.UserRoleUser))
+		defer cancel()
+
+		var events []domainModels.MarketWatchEvent
+		resumeFrom := latest
+		err := newTestWatcher(mocks.NewMarketRepository(t), reader, hub).
+			WatchMarkets(ctx, &resumeFrom, collectEvents(cancel, 2, &events))
+
+		require.ErrorIs(t, err, context.Canceled)
+		require.Len(t, events, 2)
+
+		require.Len(t, events[0].Changes, 2)
+		assert.Equal(t, later.ID, events[0].Changes[0].MarketID)
+		assert.Equal(t, earlier.ID, events[0].Changes[1].MarketID)
+		assert.Equal(t, domainModels.MarketCursor{Seq: 12}, events[0].Cursor)
+
+		require.Len(t, events[1].Changes, 1)
+		assert.Equal(t, oldest.ID, events[1].Changes[0].MarketID)
+		assert.Equal(t, domainModels.MarketCursor{Seq: 13}, events[1].Cursor)
+	})
+
+	t.Run("live батч от хаба — уже отправленный отрезок отбрасывается", func(t *testing.T) {
+		reader := mocks.NewMarketChangeReader(t)
+		hub := NewMarketWatchHub(4, 1, testServiceName)
+
+		stale := makeUpdatedMarket(base, true)
+		fresh := makeUpdatedMarket(base, true)
+
+		reader.On("ListChangesAfter", mock.Anything, latest.Seq, int(testWatchPageSize)).
+			Run(func(mock.Arguments) {
+				hub.NotifyMarketsChanged(changeBatch(8, 10, stale))
+				hub.NotifyMarketsChanged(changeBatch(10, 11, fresh))
+			}).
+			Return([]domainModels.MarketChangeLogEntry{}, nil).Once()
+		reader.On("GetChangeLogBounds", mock.Anything).Return(bounds, nil).Once()
+
+		ctx, cancel := context.WithCancel(ctxWithRoles(models.UserRoleViewer))
+		defer cancel()
+
+		var events []domainModels.MarketWatchEvent
+		resumeFrom := latest
+		err := newTestWatcher(mocks.NewMarketRepository(t), reader, hub).
+			WatchMarkets(ctx, &resumeFrom, collectEvents(cancel, 1, &events))
+
+		require.ErrorIs(t, err, context.Canceled)
+		require.Len(t, events, 1)
+		require.Len(t, events[0].Changes, 1)
+		assert.Equal(t, fresh.ID, events[0].Changes[0].MarketID)
+		assert.Equal(t, domainModels.MarketCursor{Seq: 11}, events[0].Cursor)
+	})
+
+	t.Run("разрыв между курсором и батчем хаба догоняется из журнала", func(t *testing.T) {
+		reader := mocks.NewMarketChangeReader(t)
+		hub := NewMarketWatchHub(4, 1, testServiceName)
+
+		missed := makeUpdatedMarket(base, true)
+		live := makeUpdatedMarket(base, true)
+
+		reader.On("ListChangesAfter", mock.Anything, latest.Seq, int(testWatchPageSize)).
+			Run(func(mock.Arguments) {
+				hub.NotifyMarketsChanged(changeBatch(11, 12, live))
+			}).
+			Return([]domainModels.MarketChangeLogEntry{}, nil).Once()
+		reader.On("ListChangesAfter", mock.Anything, latest.Seq, int(testWatchPageSize)).
+			Return(makeChangeLogEntries(11, missed, live), nil).Once()
+		reader.On("ListChangesAfter", mock.Anything, int64(12), int(testWatchPageSize)).
+			Return([]domainModels.MarketChangeLogEntry{}, nil).Once()
+		reader.On("GetChangeLogBounds", mock.Anything).Return(bounds, nil).Twice()
+
+		ctx, cancel := context.WithCancel(ctxWithRoles(models.UserRoleUser))
+		defer cancel()
+
+		var events []domainModels.MarketWatchEvent
+		resumeFrom := latest
+		err := newTestWatcher(mocks.NewMarketRepository(t), reader, hub).
+			WatchMarkets(ctx, &resumeFrom, collectEvents(cancel, 1, &events))
+
+		require.ErrorIs(t, err, context.Canceled)
+		require.Len(t, events, 1)
+		require.Len(t, events[0].Changes, 2)
+		assert.Equal(t, missed.ID, events[0].Changes[0].MarketID)
+		assert.Equal(t, domainModels.MarketCursor{Seq: 12}, events[0].Cursor)
+	})
+
+	t.Run("курсор за границей очистки журнала — ErrMarketWatchCursorExpired", func(t *testing.T) {
+		reader := mocks.NewMarketChangeReader(t)
+
+		reader.On("ListChangesAfter", mock.Anything, int64(3), int(testWatchPageSize)).
+			Return(makeChangeLogEntries(5, makeUpdatedMarket(base, true)), nil).Once()
+		reader.On("GetChangeLogBounds", mock.Anything).Return(bounds, nil).Once()
+
+		resumeFrom := domainModels.MarketCursor{Seq: 3}
+		err := newTestWatcher(mocks.NewMarketRepository(t), reader, NewMarketWatchHub(1, 1, testServiceName)).
+			WatchMarkets(ctxWithRoles(models.UserRoleUser), &resumeFrom, func(domainModels.MarketWatchEvent) error {
+				t.Fatal("изменения после устаревшего курсора не отправляются")
+				return nil
+			})
+
+		require.ErrorIs(t, err, serviceErrors.ErrMarketWatchCursorExpired)
+	})
+
+	t.Run("хаб закрыт — ErrMarketWatchClosed", func(t *testing.T) {
+		reader := mocks.NewMarketChangeReader(t)
+		hub := NewMarketWatchHub(1, 1, testServiceName)
+
+		reader.On("ListChangesAfter", mock.Anything, latest.Seq, int(testWatchPageSize)).
+			Run(func(mock.Arguments) { hub.Close() }).
+			Return([]domainModels.MarketChangeLogEntry{}, nil).Once()
+		reader.On("GetChangeLogBounds", mock.Anything).Return(bounds, nil).Once()
+
+		resumeFrom := latest
+		err := newTestWatcher(mocks.NewMarketRepository(t), reader, hub).
+			WatchMarkets(ctxWithRoles(models.UserRoleUser), &resumeFrom, func(domainModels.MarketWatchEvent) error { return nil })
+
+		require.ErrorIs(t, err, serviceErrors.ErrMarketWatchClosed)
+	})
+
+	t.Run("ошибка чтения изменений — пробрасывается", func(t *testing.T) {
+		reader := mocks.NewMarketChangeReader(t)
+		dbErr := errors.New("db down")
+
+		reader.On("ListChangesAfter", mock.Anything, latest.Seq, int(testWatchPageSize)).
+			Return(nil, dbErr).Once()
+
+		resumeFrom := latest
+		err := newTestWatcher(mocks.NewMarketRepository(t), reader, NewMarketWatchHub(1, 1, testServiceName)).
+			WatchMarkets(ctxWithRoles(models.UserRoleUser), &resumeFrom, func(domainModels.MarketWatchEvent) error { return nil })
+
+		require.ErrorIs(t, err, dbErr)
+	})
+}
+
+func TestMarketWatchHub(t *testing.T) {
+	t.Run("превышен лимит подписчиков", func(t *testing.T) {
+		hub := NewMarketWatchHub(1, 1, testServiceName)
+
+		_, err := hub.Subscribe()
+		require.NoError(t, err)
+
+		_, err = hub.Subscribe()
+		require.ErrorIs(t, err, serviceErrors.ErrMarketWatchLimitExceeded)
+	})
+
+	t.Run("переполнение буфера отключает подписчика с ErrMarketWatchLagged", func(t *testing.T) {
+		hub := NewMarketWatchHub(1, 2, testServiceName)
+
+		subscription, err := hub.Subscribe()
+		require.NoError(t, err)
+
+		hub.NotifyMarketsChanged(changeBatch(0, 1, makeMarkets(1)...))
+		hub.NotifyMarketsChanged(changeBatch(1, 2, makeMarkets(1)...))
+
+		_, ok := <-subscription.Batches()
+		require.True(t, ok)
+		_, ok = <-subscription.Batches()
+		require.False(t, ok)
+		assert.ErrorIs(t, subscription.Err(), serviceErrors.ErrMarketWatchLagged)
+
+		_, err = hub.Subscribe()
+		require.NoError(t, err, "место отключённого подписчика освобождается")
+	})
+
+	t.Run("после Close новые подписки отклоняются", func(t *testing.T) {
+		hub := NewMarketWatchHub(1, 1, testServiceName)
+		hub.Close()
+
+		_, err := hub.Subscribe()
+		require.ErrorIs(t, err, serviceErrors.ErrMarketWatchClosed)
+	})
+}
+
+func TestBuildMarketChangeRestricted(t *testing.T) {
+	restricted := models.Market{ID: uuid.New(), Name: "BETA-USDT", Enabled: true, Restricted: true}
+
+	tests := []struct {
+		name   string
+		policy domainModels.VisibilityPolicy
+		access domainModels.MarketAccess
+		want   domainModels.MarketChangeType
+	}{
+		{
+			name:   "restricted-рынок без записи в market_access — REMOVED",
+			policy: testUserPolicy,
+			access: testPublicAccess,
+			want:   domainModels.MarketChangeTypeRemoved,
+		},
+		{
+			name:   "restricted-рынок с записью в market_access — UPSERTED",
+			policy: testUserPolicy,
+			access: domainModels.MarketAccess{GrantedMarketIDs: []uuid.UUID{restricted.ID}},
+			want:   domainModels.MarketChangeTypeUpserted,
+		},
+		{
+			name:   "политика с bypass_access_lists — UPSERTED",
+			policy: testAdminPolicy,
+			access: testAdminAccess,
+			want:   domainModels.MarketChangeTypeUpserted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			change := buildMarketChange(tt.policy, tt.access, restricted)
+
+			assert.Equal(t, tt.want, change.Type)
+			assert.Equal(t, restricted.ID, change.MarketID)
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package spot
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	serviceErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/service"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+)
+
+// pageToken — keyset-позиция (name, id), привязанная к роли и фильтрам запроса,
+// чтобы токен нельзя было переиспользовать с другим набором условий.
+type pageToken struct {
+	Name  string    `json:"n"`
+	ID    uuid.UUID `json:"i"`
+	Scope string    `json:"s"`
+}
+
+func encodePageToken(market models.Market, scope string) string {
+	payload, err := json.Marshal(pageToken{
+		Name:  market.Name,
+		ID:    market.ID,
+		Scope: scope,
+	})
+	if err != nil {
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString(payload)
+}
+
+func decodePageToken(token, scope string) (*domainModels.MarketPageKey, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed page token", serviceErrors.ErrInvalidPagination)
+	}
+
+	var decoded pageToken
+	if err = json.Unmarshal(payload, &decoded); err != nil {
+		return nil, fmt.Errorf("%w: malformed page token", serviceErrors.ErrInvalidPagination)
+	}
+
+	if decoded.Scope != scope {
+		return nil, fmt.Errorf("%w: page token does not match request filters", serviceErrors.ErrInvalidPagination)
+	}
+
+	return &domainModels.MarketPageKey{
+		Name: decoded.Name,
+		ID:   decoded.ID,
+	}, nil
+}
+
+func pageTokenScope(policyID string, filter models.MarketFilter) string {
+	hash := sha256.Sum256([]byte(strings.Join([]string{
+		policyID,
+		filter.NamePrefix,
+		filter.BaseAsset,
+		filter.QuoteAsset,
+		strconv.Itoa(int(filter.Status)),
+	}, "\x00")))
+
+	return hex.EncodeToString(hash[:8])
+}
+
+// historyPageToken — id последней отданной записи истории, привязанный к рынку.
+type historyPageToken struct {
+	ID       int64     `json:"i"`
+	MarketID uuid.UUID `json:"m"`
+}
+
+func encodeHistoryPageToken(entry domainModels.MarketHistoryEntry) string {
+	payload, err := json.Marshal(historyPageToken{
+		ID:       entry.ID,
+		MarketID: entry.MarketID,
+	})
+	if err != nil {
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString(payload)
+}
+
+// decodeHistoryPageToken возвращает id, с которого (не включительно) продолжается выдача; 0 — с начала.
+func decodeHistoryPageToken(token string, marketID uuid.UUID) (int64, error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, fmt.Errorf("%w: malformed page token", serviceErrors.ErrInvalidPagination)
+	}
+
+	var decoded historyPageToken
+	if err = json.Unmarshal(payload, &decoded); err != nil || decoded.ID <= 0 {
+		return 0, fmt.Errorf("%w: malformed page token", serviceErrors.ErrInvalidPagination)
+	}
+
+	if decoded.MarketID != marketID {
+		return 0, fmt.Errorf("%w: page token does not match market_id", serviceErrors.ErrInvalidPagination)
+	}
+
+	return decoded.ID, nil
+}
+
+// candlePageToken — open_time, с которого продолжается выдача свечей, привязанный к параметрам запроса.
+type candlePageToken struct {
+	From  time.Time `json:"f"`
+	Scope string    `json:"s"`
+}
+
+func encodeCandlePageToken(from time.Time, scope string) string {
+	payload, err := json.Marshal(candlePageToken{
+		From:  from,
+		Scope: scope,
+	})
+	if err != nil {
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString(payload)
+}
+
+// decodeCandlePageToken возвращает начало следующей страницы; без токена — from запроса.
+func decodeCandlePageToken(token, scope string, from, to time.Time) (time.Time, error) {
+	if token == "" {
+		return from, nil
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: malformed page token", serviceErrors.ErrInvalidPagination)
+	}
+
+	var decoded candlePageToken
+	if err = json.Unmarshal(payload, &decoded); err != nil {
+		return time.Time{}, fmt.Errorf("%w: malformed page token", serviceErrors.ErrInvalidPagination)
+	}
+
+	if decoded.Scope != scope {
+		return time.Time{}, fmt.Errorf("%w: page token does not match request", serviceErrors.ErrInvalidPagination)
+	}
+
+	if decoded.From.Before(from) || !decoded.From.Before(to) {
+		return time.Time{}, fmt.Errorf("%w: malformed page token", serviceErrors.ErrInvalidPagination)
+	}
+
+	return decoded.From, nil
+}
+
+func candlePageTokenScope(marketID uuid.UUID, interval domainModels.CandleInterval, from, to time.Time) string {
+	hash := sha256.Sum256([]byte(strings.Join([]string{
+		marketID.String(),
+		string(interval),
+		strconv.FormatInt(from.UnixNano(), 10),
+		strconv.FormatInt(to.UnixNano(), 10),
+	}, "\x00")))
+
+	return hex.EncodeToString(hash[:8])
+}
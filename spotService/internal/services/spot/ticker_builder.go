@@ -0,0 +1,237 @@
+package spot
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/kafka"
+	zapLogger "github.com/nastyazhadan/spot-order-grpc/shared/interceptors/logging/zap"
+	"github.com/nastyazhadan/spot-order-grpc/shared/metrics"
+	mapper "github.com/nastyazhadan/spot-order-grpc/spotService/internal/application/dto/inbound/kafka"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+)
+
+// TickerBuilderLeaseName — имя lease в leader_leases: тикеры строит одна реплика.
+const TickerBuilderLeaseName = "ticker_builder"
+
+type TradeSource interface {
+	ReadFrom(
+		ctx context.Context,
+		from time.Time,
+		handle func(ctx context.Context, message kafka.Message),
+		caughtUp func(),
+	) error
+}
+
+type TickerWriter interface {
+	SaveTickers(ctx context.Context, tickers []domainModels.Ticker) error
+	ReplaceTickers(ctx context.Context, tickers []domainModels.Ticker) error
+}
+
+// TickerBuilder считает статистику рынков за скользящее окно и пишет её в Redis.
+// Состояние живёт только в памяти лидера: при каждом получении lease топик перечитывается
+// с начала окна, поэтому тикеры восстанавливаются и после сброса Redis, и после смены лидера.
+type TickerBuilder struct {
+	source        TradeSource
+	writer        TickerWriter
+	window        time.Duration
+	bucketSize    time.Duration
+	flushInterval time.Duration
+	serviceName   string
+	logger        *zapLogger.Logger
+	now           func() time.Time
+}
+
+func NewTickerBuilder(
+	source TradeSource,
+	writer TickerWriter,
+	window time.Duration,
+	bucketSize time.Duration,
+	flushInterval time.Duration,
+	serviceName string,
+	logger *zapLogger.Logger,
+) *TickerBuilder {
+	return &TickerBuilder{
+		source:        source,
+		writer:        writer,
+		window:        window,
+		bucketSize:    bucketSize,
+		flushInterval: flushInterval,
+		serviceName:   serviceName,
+		logger:        logger,
+		now:           time.Now,
+	}
+}
+
+// tickerRun — состояние одного срока лидерства.
+type tickerRun struct {
+	mu     sync.Mutex
+	window *tickerWindow
+	dirty  map[uuid.UUID]struct{}
+	// Шаг окна, для которого тикеры уже переписаны целиком
+	replacedBucket time.Time
+	caughtUp       bool
+}
+
+func (b *TickerBuilder) RunAsLeader(ctx context.Context, _ int64) error {
+	run := &tickerRun{
+		window: newTickerWindow(b.window, b.bucketSize),
+		dirty:  make(map[uuid.UUID]struct{}),
+	}
+
+	from := run.window.windowStart(b.now())
+	b.logger.Info(ctx, "Ticker builder: replaying trades", zap.Time("from", from))
+
+	readCtx, cancel := context.WithCancel(ctx)
+	readErr := make(chan error, 1)
+	readDone := make(chan struct{})
+	defer func() {
+		cancel()
+		<-readDone
+	}()
+
+	go func() {
+		defer close(readDone)
+		readErr <- b.source.ReadFrom(readCtx, from,
+			func(ctx context.Context, message kafka.Message) {
+				b.apply(ctx, run, message)
+			},
+			func() {
+				run.mu.Lock()
+				run.caughtUp = true
+				run.mu.Unlock()
+				b.logger.Info(ctx, "Ticker builder: replay finished")
+			},
+		)
+	}()
+
+	ticker := time.NewTicker(b.flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-readErr:
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("read trades: %w", err)
+		case <-ticker.C:
+			if err := b.flush(ctx, run); err != nil && ctx.Err() == nil {
+				b.logger.Warn(ctx, "Ticker builder: failed to write tickers, retrying on next flush",
+					zap.Error(err),
+				)
+			}
+		}
+	}
+}
+
+func (b *TickerBuilder) apply(ctx context.Context, run *tickerRun, message kafka.Message) {
+	trade, err := mapper.UnmarshalOrderFilled(message.Value)
+	if err != nil {
+		metrics.TickerTradesTotal.WithLabelValues(b.serviceName, "invalid").Inc()
+		b.logger.Warn(ctx, "Ticker builder: skipping invalid trade event",
+			zap.Int32("partition", message.Partition),
+			zap.Int64("offset", message.Offset),
+			zap.Error(err),
+		)
+		return
+	}
+
+	run.mu.Lock()
+	result := run.window.apply(trade, b.now())
+	if result == tradeApplied {
+		run.dirty[trade.MarketID] = struct{}{}
+	}
+	run.mu.Unlock()
+
+	switch result {
+	case tradeApplied:
+		metrics.TickerTradesTotal.WithLabelValues(b.serviceName, "applied").Inc()
+	case tradeStale:
+		metrics.TickerTradesTotal.WithLabelValues(b.serviceName, "stale").Inc()
+	case tradeDuplicate:
+		metrics.TickerTradesTotal.WithLabelValues(b.serviceName, "duplicate").Inc()
+	}
+}
+
+// flush пишет изменившиеся тикеры, а на каждом новом шаге окна — все тикеры целиком.
+// До конца перечитывания топика ничего не пишется, чтобы не затереть тикеры прошлого лидера неполными.
+func (b *TickerBuilder) flush(ctx context.Context, run *tickerRun) error {
+	now := b.now()
+	bucket := now.Truncate(b.bucketSize)
+
+	run.mu.Lock()
+	if !run.caughtUp {
+		run.mu.Unlock()
+		return nil
+	}
+
+	for _, marketID := range run.window.expire(now) {
+		run.dirty[marketID] = struct{}{}
+	}
+
+	replace := !bucket.Equal(run.replacedBucket)
+
+	var marketIDs []uuid.UUID
+	if replace {
+		marketIDs = run.window.marketIDs()
+	} else {
+		marketIDs = make([]uuid.UUID, 0, len(run.dirty))
+		for marketID := range run.dirty {
+			marketIDs = append(marketIDs, marketID)
+		}
+	}
+
+	// Рынки, выпавшие из окна, бывают только на новом шаге, а его запись — полная замена
+	tickers := make([]domainModels.Ticker, 0, len(marketIDs))
+	for _, marketID := range marketIDs {
+		if snapshot, ok := run.window.snapshot(marketID, now); ok {
+			tickers = append(tickers, snapshot)
+		}
+	}
+
+	dirty := run.dirty
+	run.dirty = make(map[uuid.UUID]struct{})
+	run.mu.Unlock()
+
+	if !replace && len(marketIDs) == 0 {
+		return nil
+	}
+
+	mode := "incremental"
+	var err error
+	if replace {
+		mode = "replace"
+		err = b.writer.ReplaceTickers(ctx, tickers)
+	} else {
+		err = b.writer.SaveTickers(ctx, tickers)
+	}
+
+	if err != nil {
+		metrics.TickerFlushesTotal.WithLabelValues(b.serviceName, mode, "error").Inc()
+
+		run.mu.Lock()
+		for marketID := range dirty {
+			run.dirty[marketID] = struct{}{}
+		}
+		run.mu.Unlock()
+
+		return err
+	}
+
+	metrics.TickerFlushesTotal.WithLabelValues(b.serviceName, mode, "success").Inc()
+	if replace {
+		run.mu.Lock()
+		run.replacedBucket = bucket
+		run.mu.Unlock()
+	}
+
+	return nil
+}
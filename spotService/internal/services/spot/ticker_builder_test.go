@@ -0,0 +1,272 @@
+package spot
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/decimal"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	protoEvent "github.com/nastyazhadan/spot-order-grpc/protos/gen/go/events/v1"
+	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/kafka"
+	zapLogger "github.com/nastyazhadan/spot-order-grpc/shared/interceptors/logging/zap"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+	"github.com/nastyazhadan/spot-order-grpc/spotService/internal/services/mocks"
+)
+
+func newTestTickerBuilder(source *mocks.TradeSource, writer *mocks.TickerWriter) *TickerBuilder {
+	builder := NewTickerBuilder(source, writer, 3*time.Minute, time.Minute, 10*time.Millisecond,
+		"spot-service", zapLogger.NewNop())
+	builder.now = func() time.Time { return tickerTestNow }
+
+	return builder
+}
+
+func newTestTickerRun(caughtUp bool, trades ...domainModels.Trade) *tickerRun {
+	run := &tickerRun{
+		window:   newTestTickerWindow(),
+		dirty:    make(map[uuid.UUID]struct{}),
+		caughtUp: caughtUp,
+	}
+	for _, trade := range trades {
+		run.window.apply(trade, tickerTestNow)
+		run.dirty[trade.MarketID] = struct{}{}
+	}
+
+	return run
+}
+
+func tradeMessage(t *testing.T, trade domainModels.Trade) kafka.Message {
+	t.Helper()
+
+	value, err := proto.Marshal(&protoEvent.OrderFilledEvent{
+		EventId:  trade.EventID.String(),
+		OrderId:  uuid.NewString(),
+		MarketId: trade.MarketID.String(),
+		Price:    &decimal.Decimal{Value: trade.Price.String()},
+		Quantity: trade.Quantity.IntPart(),
+		FilledAt: timestamppb.New(trade.ExecutedAt),
+	})
+	require.NoError(t, err)
+
+	return kafka.Message{Value: value}
+}
+
+func tickersOf(marketIDs ...uuid.UUID) interface{} {
+	return mock.MatchedBy(func(tickers []domainModels.Ticker) bool {
+		if len(tickers) != len(marketIDs) {
+			return false
+		}
+
+		expected := make(map[uuid.UUID]struct{}, len(marketIDs))
+		for _, marketID := range marketIDs {
+			expected[marketID] = struct{}{}
+		}
+		for _, ticker := range tickers {
+			if _, ok := expected[ticker.MarketID]; !ok {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+func TestTickerBuilderFlush(t *testing.T) {
+	first := uuid.New()
+	second := uuid.New()
+	currentBucket := tickerTestNow.Truncate(time.Minute)
+
+	tests := []struct {
+		name           string
+		run            func() *tickerRun
+		setupMocks     func(writer *mocks.TickerWriter)
+		expectedErr    bool
+		expectedDirty  []uuid.UUID
+		replacedBucket time.Time
+	}{
+		{
+			name: "до конца перечитывания топика ничего не пишется",
+			run: func() *tickerRun {
+				return newTestTickerRun(false, makeTrade(first, "100", 1, tickerTestNow))
+			},
+			setupMocks:    func(_ *mocks.TickerWriter) {},
+			expectedDirty: []uuid.UUID{first},
+		},
+		{
+			name: "на новом шаге окна тикеры переписываются целиком",
+			run: func() *tickerRun {
+				run := newTestTickerRun(true,
+					makeTrade(first, "100", 1, tickerTestNow),
+					makeTrade(second, "50", 1, tickerTestNow.Add(-time.Minute)),
+				)
+				run.dirty = make(map[uuid.UUID]struct{})
+				return run
+			},
+			setupMocks: func(writer *mocks.TickerWriter) {
+				writer.On("ReplaceTickers", mock.Anything, tickersOf(first, second)).Return(nil).Once()
+			},
+			replacedBucket: currentBucket,
+		},
+		{
+			name: "в пределах шага пишутся только изменившиеся тикеры",
+			run: func() *tickerRun {
+				run := newTestTickerRun(true,
+					makeTrade(second, "50", 1, tickerTestNow.Add(-time.Minute)),
+				)
+				run.dirty = make(map[uuid.UUID]struct{})
+				run.window.apply(makeTrade(first, "100", 1, tickerTestNow), tickerTestNow)
+				run.dirty[first] = struct{}{}
+				run.replacedBucket = currentBucket
+				return run
+			},
+			setupMocks: func(writer *mocks.TickerWriter) {
+				writer.On("SaveTickers", mock.Anything, tickersOf(first)).Return(nil).Once()
+			},
+			replacedBucket: currentBucket,
+		},
+		{
+			name: "без изменений в пределах шага записи нет",
+			run: func() *tickerRun {
+				run := newTestTickerRun(true, makeTrade(first, "100", 1, tickerTestNow))
+				run.dirty = make(map[uuid.UUID]struct{})
+				run.replacedBucket = currentBucket
+				return run
+			},
+			setupMocks:     func(_ *mocks.TickerWriter) {},
+			replacedBucket: currentBucket,
+		},
+		{
+			name: "ошибка записи — рынки остаются изменёнными до следующего flush",
+			run: func() *tickerRun {
+				run := newTestTickerRun(true, makeTrade(first, "100", 1, tickerTestNow))
+				run.replacedBucket = currentBucket
+				return run
+			},
+			setupMocks: func(writer *mocks.TickerWriter) {
+				writer.On("SaveTickers", mock.Anything, tickersOf(first)).Return(errors.New("redis down")).Once()
+			},
+			expectedErr:    true,
+			expectedDirty:  []uuid.UUID{first},
+			replacedBucket: currentBucket,
+		},
+		{
+			name: "ошибка полной записи — шаг будет переписан повторно",
+			run: func() *tickerRun {
+				return newTestTickerRun(true, makeTrade(first, "100", 1, tickerTestNow))
+			},
+			setupMocks: func(writer *mocks.TickerWriter) {
+				writer.On("ReplaceTickers", mock.Anything, tickersOf(first)).Return(errors.New("redis down")).Once()
+			},
+			expectedErr:   true,
+			expectedDirty: []uuid.UUID{first},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			writer := mocks.NewTickerWriter(t)
+			tt.setupMocks(writer)
+
+			builder := newTestTickerBuilder(mocks.NewTradeSource(t), writer)
+			run := tt.run()
+
+			err := builder.flush(context.Background(), run)
+			if tt.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			dirty := make([]uuid.UUID, 0, len(run.dirty))
+			for marketID := range run.dirty {
+				dirty = append(dirty, marketID)
+			}
+			assert.ElementsMatch(t, tt.expectedDirty, dirty)
+			assert.True(t, tt.replacedBucket.Equal(run.replacedBucket))
+		})
+	}
+}
+
+func TestTickerBuilderRunAsLeader(t *testing.T) {
+	marketID := uuid.New()
+	windowStart := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		setupMocks func(t *testing.T, source *mocks.TradeSource, writer *mocks.TickerWriter, cancel context.CancelFunc)
+		checkErr   func(t *testing.T, err error)
+	}{
+		{
+			name: "топик перечитывается с начала окна, тикеры пишутся после перечитывания",
+			setupMocks: func(t *testing.T, source *mocks.TradeSource, writer *mocks.TickerWriter, cancel context.CancelFunc) {
+				message := tradeMessage(t, makeTrade(marketID, "100", 2, tickerTestNow))
+
+				source.On("ReadFrom", mock.Anything, windowStart, mock.Anything, mock.Anything).
+					Run(func(args mock.Arguments) {
+						ctx := args.Get(0).(context.Context)
+						args.Get(2).(func(context.Context, kafka.Message))(ctx, kafka.Message{Value: []byte("garbage")})
+						args.Get(2).(func(context.Context, kafka.Message))(ctx, message)
+						args.Get(3).(func())()
+						<-ctx.Done()
+					}).
+					Return(context.Canceled).Once()
+				writer.On("ReplaceTickers", mock.Anything, tickersOf(marketID)).
+					Run(func(_ mock.Arguments) { cancel() }).
+					Return(nil).Once()
+			},
+			checkErr: func(t *testing.T, err error) { require.NoError(t, err) },
+		},
+		{
+			name: "повторная доставка события не удваивает статистику",
+			setupMocks: func(t *testing.T, source *mocks.TradeSource, writer *mocks.TickerWriter, cancel context.CancelFunc) {
+				message := tradeMessage(t, makeTrade(marketID, "100", 2, tickerTestNow))
+
+				source.On("ReadFrom", mock.Anything, windowStart, mock.Anything, mock.Anything).
+					Run(func(args mock.Arguments) {
+						ctx := args.Get(0).(context.Context)
+						args.Get(2).(func(context.Context, kafka.Message))(ctx, message)
+						args.Get(2).(func(context.Context, kafka.Message))(ctx, message)
+						args.Get(3).(func())()
+						<-ctx.Done()
+					}).
+					Return(context.Canceled).Once()
+				writer.On("ReplaceTickers", mock.Anything, mock.MatchedBy(func(tickers []domainModels.Ticker) bool {
+					return len(tickers) == 1 && tickers[0].TradesCount == 1 && tickers[0].Volume.IntPart() == 2
+				})).
+					Run(func(_ mock.Arguments) { cancel() }).
+					Return(nil).Once()
+			},
+			checkErr: func(t *testing.T, err error) { require.NoError(t, err) },
+		},
+		{
+			name: "ошибка чтения топика — задача лидера завершается с ошибкой",
+			setupMocks: func(_ *testing.T, source *mocks.TradeSource, _ *mocks.TickerWriter, _ context.CancelFunc) {
+				source.On("ReadFrom", mock.Anything, windowStart, mock.Anything, mock.Anything).
+					Return(errors.New("kafka down")).Once()
+			},
+			checkErr: func(t *testing.T, err error) { require.Error(t, err) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+			defer cancel()
+
+			source := mocks.NewTradeSource(t)
+			writer := mocks.NewTickerWriter(t)
+			tt.setupMocks(t, source, writer, cancel)
+
+			builder := newTestTickerBuilder(source, writer)
+
+			tt.checkErr(t, builder.RunAsLeader(ctx, 1))
+		})
+	}
+}
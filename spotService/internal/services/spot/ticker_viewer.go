@@ -0,0 +1,236 @@
+package spot
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+
+	serviceErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/service"
+	"github.com/nastyazhadan/spot-order-grpc/shared/infrastructure/otel/attributes"
+	zapLogger "github.com/nastyazhadan/spot-order-grpc/shared/interceptors/logging/zap"
+	"github.com/nastyazhadan/spot-order-grpc/shared/interceptors/tracing"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+)
+
+type TickerReader interface {
+	GetTickers(ctx context.Context, marketIDs []uuid.UUID) (map[uuid.UUID]domainModels.Ticker, error)
+	ListTickers(ctx context.Context) ([]domainModels.Ticker, error)
+}
+
+// TickerMarketReader проверяет, что рынок виден вызывающему: тикер скрытого рынка не отдаётся.
+type TickerMarketReader interface {
+	GetMarketByID(ctx context.Context, id uuid.UUID) (models.Market, error)
+	GetMarketsByIDs(ctx context.Context, ids []uuid.UUID) ([]models.MarketLookup, error)
+}
+
+// TickerViewer отдаёт тикеры, построенные TickerBuilder, с учётом видимости рынков.
+type TickerViewer struct {
+	tickerReader   TickerReader
+	marketReader   TickerMarketReader
+	watchInterval  time.Duration
+	serviceTimeout time.Duration
+	logger         *zapLogger.Logger
+
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func NewTickerViewer(
+	tickerReader TickerReader,
+	marketReader TickerMarketReader,
+	watchInterval time.Duration,
+	timeout time.Duration,
+	logger *zapLogger.Logger,
+) *TickerViewer {
+	return &TickerViewer{
+		tickerReader:   tickerReader,
+		marketReader:   marketReader,
+		watchInterval:  watchInterval,
+		serviceTimeout: timeout,
+		logger:         logger,
+		closed:         make(chan struct{}),
+	}
+}
+
+// Close завершает все WatchTickers: сами они не заканчиваются и держали бы GracefulStop.
+func (s *TickerViewer) Close() {
+	s.closeOnce.Do(func() {
+		close(s.closed)
+	})
+}
+
+// GetTicker возвращает пустой тикер, если у видимого рынка не было сделок в окне.
+func (s *TickerViewer) GetTicker(ctx context.Context, marketID uuid.UUID) (domainModels.Ticker, error) {
+	const op = "TickerViewer.GetTicker"
+
+	ctx, cancel := contextWithTimeout(ctx, s.serviceTimeout)
+	defer cancel()
+
+	ctx, span := tracing.StartSpan(ctx, "spot.get_ticker",
+		trace.WithAttributes(attributes.MarketIDValue(marketID.String())),
+	)
+	defer span.End()
+
+	if _, err := s.marketReader.GetMarketByID(ctx, marketID); err != nil {
+		tracing.RecordError(span, err)
+		return domainModels.Ticker{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	tickers, err := s.tickerReader.GetTickers(ctx, []uuid.UUID{marketID})
+	if err != nil {
+		tracing.RecordError(span, err)
+		return domainModels.Ticker{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if ticker, ok := tickers[marketID]; ok {
+		return ticker, nil
+	}
+
+	return domainModels.EmptyTicker(marketID), nil
+}
+
+// ListTickers возвращает тикеры видимых вызывающему рынков, у которых были сделки в окне,
+// в порядке имён рынков.
+func (s *TickerViewer) ListTickers(ctx context.Context) ([]domainModels.Ticker, error) {
+	const op = "TickerViewer.ListTickers"
+
+	ctx, cancel := contextWithTimeout(ctx, s.serviceTimeout)
+	defer cancel()
+
+	ctx, span := tracing.StartSpan(ctx, "spot.list_tickers")
+	defer span.End()
+
+	tickers, err := s.tickerReader.ListTickers(ctx)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if len(tickers) == 0 {
+		return []domainModels.Ticker{}, nil
+	}
+
+	marketIDs := make([]uuid.UUID, 0, len(tickers))
+	for _, ticker := range tickers {
+		marketIDs = append(marketIDs, ticker.MarketID)
+	}
+
+	lookups, err := s.marketReader.GetMarketsByIDs(ctx, marketIDs)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	names := make(map[uuid.UUID]string, len(lookups))
+	for _, lookup := range lookups {
+		if lookup.Status == models.MarketLookupStatusFound {
+			names[lookup.ID] = lookup.Market.Name
+		}
+	}
+
+	visible := make([]domainModels.Ticker, 0, len(names))
+	for _, ticker := range tickers {
+		if _, ok := names[ticker.MarketID]; ok {
+			visible = append(visible, ticker)
+		}
+	}
+
+	sort.Slice(visible, func(i, j int) bool {
+		return names[visible[i].MarketID] < names[visible[j].MarketID]
+	})
+	span.SetAttributes(attributes.MarketsCountValue(len(visible)))
+
+	return visible, nil
+}
+
+// WatchTickers сразу отправляет текущие тикеры рынков, затем раз в watchInterval —
+// только изменившиеся. Видимость рынков проверяется на каждом шаге: если рынок скрыли,
+// поток завершается той же ошибкой, что вернул бы GetTicker.
+func (s *TickerViewer) WatchTickers(
+	ctx context.Context,
+	marketIDs []uuid.UUID,
+	send func(ticker domainModels.Ticker) error,
+) error {
+	const op = "TickerViewer.WatchTickers"
+
+	marketIDs = uniqueMarketIDs(marketIDs)
+	if len(marketIDs) == 0 {
+		return fmt.Errorf("%s: %w", op, serviceErrors.ErrInvalidMarketIDs)
+	}
+
+	sent := make(map[uuid.UUID]domainModels.Ticker, len(marketIDs))
+
+	ticker := time.NewTicker(s.watchInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.sendChangedTickers(ctx, marketIDs, sent, send); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.closed:
+			return fmt.Errorf("%s: %w", op, serviceErrors.ErrTickerWatchClosed)
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *TickerViewer) sendChangedTickers(
+	ctx context.Context,
+	marketIDs []uuid.UUID,
+	sent map[uuid.UUID]domainModels.Ticker,
+	send func(ticker domainModels.Ticker) error,
+) error {
+	readCtx, cancel := contextWithTimeout(ctx, s.serviceTimeout)
+	defer cancel()
+
+	lookups, err := s.marketReader.GetMarketsByIDs(readCtx, marketIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, lookup := range lookups {
+		switch lookup.Status {
+		case models.MarketLookupStatusFound:
+		case models.MarketLookupStatusDisabled:
+			return serviceErrors.ErrDisabled{ID: lookup.ID}
+		default:
+			return serviceErrors.ErrMarketsNotFound
+		}
+	}
+
+	tickers, err := s.tickerReader.GetTickers(readCtx, marketIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, marketID := range marketIDs {
+		current, ok := tickers[marketID]
+		if !ok {
+			current = domainModels.EmptyTicker(marketID)
+		}
+
+		if previous, wasSent := sent[marketID]; wasSent && previous.Equal(current) {
+			continue
+		}
+
+		if err = send(current); err != nil {
+			return err
+		}
+		sent[marketID] = current
+	}
+
+	return nil
+}
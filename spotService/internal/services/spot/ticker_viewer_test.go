@@ -0,0 +1,243 @@
+package spot
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	serviceErrors "github.com/nastyazhadan/spot-order-grpc/shared/errors/service"
+	zapLogger "github.com/nastyazhadan/spot-order-grpc/shared/interceptors/logging/zap"
+	"github.com/nastyazhadan/spot-order-grpc/shared/models"
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+	"github.com/nastyazhadan/spot-order-grpc/spotService/internal/services/mocks"
+)
+
+const testWatchInterval = 10 * time.Millisecond
+
+func newTestTickerViewer(t *testing.T) (*TickerViewer, *mocks.TickerReader, *mocks.TickerMarketReader) {
+	tickerReader := mocks.NewTickerReader(t)
+	marketReader := mocks.NewTickerMarketReader(t)
+
+	return NewTickerViewer(tickerReader, marketReader, testWatchInterval, testTimeout, zapLogger.NewNop()),
+		tickerReader, marketReader
+}
+
+func makeTicker(marketID uuid.UUID, lastPrice string, tradesCount int64) domainModels.Ticker {
+	return domainModels.Ticker{
+		MarketID:    marketID,
+		LastPrice:   decimal.RequireFromString(lastPrice),
+		TradesCount: tradesCount,
+	}
+}
+
+func foundLookup(id uuid.UUID, name string) models.MarketLookup {
+	return models.MarketLookup{
+		ID:     id,
+		Status: models.MarketLookupStatusFound,
+		Market: models.Market{ID: id, Name: name, Enabled: true},
+	}
+}
+
+func TestGetTicker(t *testing.T) {
+	marketID := uuid.New()
+	ticker := makeTicker(marketID, "100", 3)
+
+	tests := []struct {
+		name       string
+		setupMocks func(tickerReader *mocks.TickerReader, marketReader *mocks.TickerMarketReader)
+		expected   domainModels.Ticker
+		expectErr  error
+	}{
+		{
+			name: "тикер видимого рынка возвращается",
+			setupMocks: func(tickerReader *mocks.TickerReader, marketReader *mocks.TickerMarketReader) {
+				marketReader.On("GetMarketByID", mock.Anything, marketID).
+					Return(models.Market{ID: marketID, Enabled: true}, nil).Once()
+				tickerReader.On("GetTickers", mock.Anything, []uuid.UUID{marketID}).
+					Return(map[uuid.UUID]domainModels.Ticker{marketID: ticker}, nil).Once()
+			},
+			expected: ticker,
+		},
+		{
+			name: "сделок в окне не было — пустой тикер",
+			setupMocks: func(tickerReader *mocks.TickerReader, marketReader *mocks.TickerMarketReader) {
+				marketReader.On("GetMarketByID", mock.Anything, marketID).
+					Return(models.Market{ID: marketID, Enabled: true}, nil).Once()
+				tickerReader.On("GetTickers", mock.Anything, []uuid.UUID{marketID}).
+					Return(map[uuid.UUID]domainModels.Ticker{}, nil).Once()
+			},
+			expected: domainModels.EmptyTicker(marketID),
+		},
+		{
+			name: "рынок скрыт от вызывающего — тикер не читается",
+			setupMocks: func(_ *mocks.TickerReader, marketReader *mocks.TickerMarketReader) {
+				marketReader.On("GetMarketByID", mock.Anything, marketID).
+					Return(models.Market{}, serviceErrors.ErrMarketNotFound).Once()
+			},
+			expectErr: serviceErrors.ErrMarketNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			viewer, tickerReader, marketReader := newTestTickerViewer(t)
+			tt.setupMocks(tickerReader, marketReader)
+
+			result, err := viewer.GetTicker(context.Background(), marketID)
+			if tt.expectErr != nil {
+				require.ErrorIs(t, err, tt.expectErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equal(result))
+		})
+	}
+}
+
+func TestListTickers(t *testing.T) {
+	alpha := uuid.New()
+	beta := uuid.New()
+	hidden := uuid.New()
+
+	tests := []struct {
+		name       string
+		setupMocks func(tickerReader *mocks.TickerReader, marketReader *mocks.TickerMarketReader)
+		expected   []uuid.UUID
+		expectErr  bool
+	}{
+		{
+			name: "тикеры скрытых рынков отфильтровываются, остальные — по имени рынка",
+			setupMocks: func(tickerReader *mocks.TickerReader, marketReader *mocks.TickerMarketReader) {
+				tickerReader.On("ListTickers", mock.Anything).Return([]domainModels.Ticker{
+					makeTicker(beta, "2", 1),
+					makeTicker(hidden, "3", 1),
+					makeTicker(alpha, "1", 1),
+				}, nil).Once()
+				marketReader.On("GetMarketsByIDs", mock.Anything, []uuid.UUID{beta, hidden, alpha}).
+					Return([]models.MarketLookup{
+						foundLookup(beta, "BTC-USDT"),
+						{ID: hidden, Status: models.MarketLookupStatusNotFound},
+						foundLookup(alpha, "ADA-USDT"),
+					}, nil).Once()
+			},
+			expected: []uuid.UUID{alpha, beta},
+		},
+		{
+			name: "тикеров нет — рынки не запрашиваются",
+			setupMocks: func(tickerReader *mocks.TickerReader, _ *mocks.TickerMarketReader) {
+				tickerReader.On("ListTickers", mock.Anything).Return([]domainModels.Ticker{}, nil).Once()
+			},
+			expected: []uuid.UUID{},
+		},
+		{
+			name: "ошибка чтения тикеров пробрасывается",
+			setupMocks: func(tickerReader *mocks.TickerReader, _ *mocks.TickerMarketReader) {
+				tickerReader.On("ListTickers", mock.Anything).Return(nil, errors.New("redis down")).Once()
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			viewer, tickerReader, marketReader := newTestTickerViewer(t)
+			tt.setupMocks(tickerReader, marketReader)
+
+			result, err := viewer.ListTickers(context.Background())
+			if tt.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			ids := make([]uuid.UUID, 0, len(result))
+			for _, ticker := range result {
+				ids = append(ids, ticker.MarketID)
+			}
+			assert.Equal(t, tt.expected, ids)
+		})
+	}
+}
+
+func TestWatchTickers(t *testing.T) {
+	marketID := uuid.New()
+	first := makeTicker(marketID, "100", 1)
+	second := makeTicker(marketID, "110", 2)
+
+	t.Run("сначала текущий тикер, затем только изменения", func(t *testing.T) {
+		viewer, tickerReader, marketReader := newTestTickerViewer(t)
+		ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+		defer cancel()
+
+		marketReader.On("GetMarketsByIDs", mock.Anything, []uuid.UUID{marketID}).
+			Return([]models.MarketLookup{foundLookup(marketID, "BTC-USDT")}, nil)
+		tickerReader.On("GetTickers", mock.Anything, []uuid.UUID{marketID}).
+			Return(map[uuid.UUID]domainModels.Ticker{marketID: first}, nil).Twice()
+		tickerReader.On("GetTickers", mock.Anything, []uuid.UUID{marketID}).
+			Return(map[uuid.UUID]domainModels.Ticker{marketID: second}, nil)
+
+		var sent []domainModels.Ticker
+		err := viewer.WatchTickers(ctx, []uuid.UUID{marketID, marketID}, func(ticker domainModels.Ticker) error {
+			sent = append(sent, ticker)
+			if len(sent) == 2 {
+				cancel()
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Len(t, sent, 2)
+		assert.True(t, first.Equal(sent[0]))
+		assert.True(t, second.Equal(sent[1]))
+	})
+
+	t.Run("рынок отключили — поток завершается ошибкой", func(t *testing.T) {
+		viewer, _, marketReader := newTestTickerViewer(t)
+
+		marketReader.On("GetMarketsByIDs", mock.Anything, []uuid.UUID{marketID}).
+			Return([]models.MarketLookup{{ID: marketID, Status: models.MarketLookupStatusDisabled}}, nil).Once()
+
+		err := viewer.WatchTickers(context.Background(), []uuid.UUID{marketID}, func(domainModels.Ticker) error {
+			t.Fatal("unexpected send")
+			return nil
+		})
+
+		require.ErrorIs(t, err, serviceErrors.ErrMarketDisabled)
+	})
+
+	t.Run("Close завершает поток ошибкой переподписки", func(t *testing.T) {
+		viewer, tickerReader, marketReader := newTestTickerViewer(t)
+
+		marketReader.On("GetMarketsByIDs", mock.Anything, []uuid.UUID{marketID}).
+			Return([]models.MarketLookup{foundLookup(marketID, "BTC-USDT")}, nil).Once()
+		tickerReader.On("GetTickers", mock.Anything, []uuid.UUID{marketID}).
+			Return(map[uuid.UUID]domainModels.Ticker{}, nil).Once()
+
+		var sent []domainModels.Ticker
+		err := viewer.WatchTickers(context.Background(), []uuid.UUID{marketID}, func(ticker domainModels.Ticker) error {
+			sent = append(sent, ticker)
+			viewer.Close()
+			return nil
+		})
+
+		require.ErrorIs(t, err, serviceErrors.ErrTickerWatchClosed)
+		require.Len(t, sent, 1)
+		assert.True(t, domainModels.EmptyTicker(marketID).Equal(sent[0]))
+	})
+
+	t.Run("пустой список рынков отклоняется", func(t *testing.T) {
+		viewer, _, _ := newTestTickerViewer(t)
+
+		err := viewer.WatchTickers(context.Background(), nil, func(domainModels.Ticker) error { return nil })
+
+		require.ErrorIs(t, err, serviceErrors.ErrInvalidMarketIDs)
+	})
+}
@@ -0,0 +1,198 @@
+package spot
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+)
+
+// Точность процента изменения цены
+const tickerChangePercentScale = 4
+
+var hundred = decimal.NewFromInt(100)
+
+// Результат применения сделки к окну
+type tradeApplyResult int
+
+const (
+	tradeApplied tradeApplyResult = iota
+	// Сделка старше окна
+	tradeStale
+	// Событие с этим EventID уже учтено: order.filled доставляется at-least-once
+	tradeDuplicate
+)
+
+// tickerBucket — сделки рынка за один шаг окна (bucketSize).
+// Open и close берутся по времени сделки, а не по порядку чтения: партиции топика
+// читаются параллельно и сделки приходят не по порядку.
+// eventIDs живут столько же, сколько бакет, поэтому набор ограничен окном:
+// повтор события попадает в тот же бакет, ведь время сделки берётся из события.
+type tickerBucket struct {
+	start       time.Time
+	eventIDs    map[uuid.UUID]struct{}
+	open        decimal.Decimal
+	openAt      time.Time
+	close       decimal.Decimal
+	closeAt     time.Time
+	high        decimal.Decimal
+	low         decimal.Decimal
+	volume      decimal.Decimal
+	quoteVolume decimal.Decimal
+	trades      int64
+}
+
+func (b *tickerBucket) add(trade domainModels.Trade) {
+	b.eventIDs[trade.EventID] = struct{}{}
+
+	if b.trades == 0 {
+		b.open, b.openAt = trade.Price, trade.ExecutedAt
+		b.close, b.closeAt = trade.Price, trade.ExecutedAt
+		b.high, b.low = trade.Price, trade.Price
+	}
+
+	if trade.ExecutedAt.Before(b.openAt) {
+		b.open, b.openAt = trade.Price, trade.ExecutedAt
+	}
+	// При равном времени побеждает прочитанная позже
+	if !trade.ExecutedAt.Before(b.closeAt) {
+		b.close, b.closeAt = trade.Price, trade.ExecutedAt
+	}
+
+	b.high = decimal.Max(b.high, trade.Price)
+	b.low = decimal.Min(b.low, trade.Price)
+	b.volume = b.volume.Add(trade.Quantity)
+	b.quoteVolume = b.quoteVolume.Add(trade.Price.Mul(trade.Quantity))
+	b.trades++
+}
+
+// tickerWindow — скользящее окно сделок по рынкам. Окно сдвигается шагами по bucketSize:
+// в момент now оно покрывает [windowStart(now), начало следующего шага).
+// Не потокобезопасно, синхронизация — на вызывающем.
+type tickerWindow struct {
+	size       time.Duration
+	bucketSize time.Duration
+	// Бакеты каждого рынка отсортированы по start
+	markets map[uuid.UUID][]*tickerBucket
+}
+
+func newTickerWindow(size, bucketSize time.Duration) *tickerWindow {
+	return &tickerWindow{
+		size:       size,
+		bucketSize: bucketSize,
+		markets:    make(map[uuid.UUID][]*tickerBucket),
+	}
+}
+
+func (w *tickerWindow) windowStart(now time.Time) time.Time {
+	return w.windowEnd(now).Add(-w.size)
+}
+
+func (w *tickerWindow) windowEnd(now time.Time) time.Time {
+	return now.Truncate(w.bucketSize).Add(w.bucketSize)
+}
+
+// apply добавляет сделку; сделка старше окна и повторно доставленное событие отбрасываются.
+func (w *tickerWindow) apply(trade domainModels.Trade, now time.Time) tradeApplyResult {
+	start := trade.ExecutedAt.Truncate(w.bucketSize)
+	if start.Before(w.windowStart(now)) {
+		return tradeStale
+	}
+
+	buckets := w.markets[trade.MarketID]
+	index := sort.Search(len(buckets), func(i int) bool {
+		return !buckets[i].start.Before(start)
+	})
+
+	if index == len(buckets) || !buckets[index].start.Equal(start) {
+		buckets = append(buckets, nil)
+		copy(buckets[index+1:], buckets[index:])
+		buckets[index] = &tickerBucket{start: start, eventIDs: make(map[uuid.UUID]struct{})}
+		w.markets[trade.MarketID] = buckets
+	}
+
+	if _, seen := buckets[index].eventIDs[trade.EventID]; seen {
+		return tradeDuplicate
+	}
+
+	buckets[index].add(trade)
+	return tradeApplied
+}
+
+// expire выбрасывает бакеты, вышедшие из окна, и возвращает рынки, чья статистика от этого изменилась.
+// Рынки без бакетов удаляются целиком.
+func (w *tickerWindow) expire(now time.Time) []uuid.UUID {
+	start := w.windowStart(now)
+
+	var changed []uuid.UUID
+	for marketID, buckets := range w.markets {
+		index := sort.Search(len(buckets), func(i int) bool {
+			return !buckets[i].start.Before(start)
+		})
+		if index == 0 {
+			continue
+		}
+
+		changed = append(changed, marketID)
+		if index == len(buckets) {
+			delete(w.markets, marketID)
+			continue
+		}
+		w.markets[marketID] = buckets[index:]
+	}
+
+	return changed
+}
+
+// snapshot собирает тикер рынка; false, если сделок в окне нет.
+// Перед вызовом окно должно быть сдвинуто через expire.
+func (w *tickerWindow) snapshot(marketID uuid.UUID, now time.Time) (domainModels.Ticker, bool) {
+	buckets := w.markets[marketID]
+	if len(buckets) == 0 {
+		return domainModels.Ticker{}, false
+	}
+
+	ticker := domainModels.Ticker{
+		MarketID:    marketID,
+		HighPrice:   buckets[0].high,
+		LowPrice:    buckets[0].low,
+		WindowStart: w.windowStart(now),
+		WindowEnd:   w.windowEnd(now),
+	}
+
+	var openAt, closeAt time.Time
+	for _, bucket := range buckets {
+		if ticker.TradesCount == 0 || bucket.openAt.Before(openAt) {
+			ticker.OpenPrice, openAt = bucket.open, bucket.openAt
+		}
+		if !bucket.closeAt.Before(closeAt) {
+			ticker.LastPrice, closeAt = bucket.close, bucket.closeAt
+		}
+
+		ticker.HighPrice = decimal.Max(ticker.HighPrice, bucket.high)
+		ticker.LowPrice = decimal.Min(ticker.LowPrice, bucket.low)
+		ticker.Volume = ticker.Volume.Add(bucket.volume)
+		ticker.QuoteVolume = ticker.QuoteVolume.Add(bucket.quoteVolume)
+		ticker.TradesCount += bucket.trades
+	}
+
+	ticker.LastTradeAt = closeAt
+	ticker.PriceChange = ticker.LastPrice.Sub(ticker.OpenPrice)
+	if ticker.OpenPrice.IsPositive() {
+		ticker.PriceChangePercent = ticker.PriceChange.Mul(hundred).DivRound(ticker.OpenPrice, tickerChangePercentScale)
+	}
+
+	return ticker, true
+}
+
+func (w *tickerWindow) marketIDs() []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(w.markets))
+	for marketID := range w.markets {
+		ids = append(ids, marketID)
+	}
+
+	return ids
+}
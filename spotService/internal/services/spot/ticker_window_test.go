@@ -0,0 +1,168 @@
+package spot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainModels "github.com/nastyazhadan/spot-order-grpc/spotService/internal/domain/models"
+)
+
+// Окно тестов: 3 шага по минуте, now — середина шага 12:02
+var tickerTestNow = time.Date(2026, 1, 1, 12, 2, 30, 0, time.UTC)
+
+func newTestTickerWindow() *tickerWindow {
+	return newTickerWindow(3*time.Minute, time.Minute)
+}
+
+func makeTrade(marketID uuid.UUID, price string, quantity int64, executedAt time.Time) domainModels.Trade {
+	return domainModels.Trade{
+		EventID:    uuid.New(),
+		MarketID:   marketID,
+		Price:      decimal.RequireFromString(price),
+		Quantity:   decimal.NewFromInt(quantity),
+		ExecutedAt: executedAt,
+	}
+}
+
+func TestTickerWindowSnapshot(t *testing.T) {
+	marketID := uuid.New()
+
+	tests := []struct {
+		name     string
+		trades   []domainModels.Trade
+		expected domainModels.Ticker
+	}{
+		{
+			name: "статистика по сделкам из нескольких шагов",
+			trades: []domainModels.Trade{
+				makeTrade(marketID, "100", 2, tickerTestNow.Add(-2*time.Minute)),
+				makeTrade(marketID, "120", 1, tickerTestNow.Add(-time.Minute)),
+				makeTrade(marketID, "90", 3, tickerTestNow.Add(-time.Minute+time.Second)),
+				makeTrade(marketID, "110", 1, tickerTestNow),
+			},
+			expected: domainModels.Ticker{
+				MarketID:           marketID,
+				LastPrice:          decimal.RequireFromString("110"),
+				OpenPrice:          decimal.RequireFromString("100"),
+				HighPrice:          decimal.RequireFromString("120"),
+				LowPrice:           decimal.RequireFromString("90"),
+				Volume:             decimal.NewFromInt(7),
+				QuoteVolume:        decimal.RequireFromString("700"),
+				PriceChange:        decimal.RequireFromString("10"),
+				PriceChangePercent: decimal.RequireFromString("10"),
+				TradesCount:        4,
+				LastTradeAt:        tickerTestNow,
+			},
+		},
+		{
+			name: "сделки не по порядку: open и last берутся по времени сделки",
+			trades: []domainModels.Trade{
+				makeTrade(marketID, "110", 1, tickerTestNow),
+				makeTrade(marketID, "300", 1, tickerTestNow.Add(-2*time.Second)),
+				makeTrade(marketID, "100", 1, tickerTestNow.Add(-2*time.Minute)),
+				makeTrade(marketID, "150", 1, tickerTestNow.Add(-2*time.Minute+time.Second)),
+			},
+			expected: domainModels.Ticker{
+				MarketID:           marketID,
+				LastPrice:          decimal.RequireFromString("110"),
+				OpenPrice:          decimal.RequireFromString("100"),
+				HighPrice:          decimal.RequireFromString("300"),
+				LowPrice:           decimal.RequireFromString("100"),
+				Volume:             decimal.NewFromInt(4),
+				QuoteVolume:        decimal.RequireFromString("660"),
+				PriceChange:        decimal.RequireFromString("10"),
+				PriceChangePercent: decimal.RequireFromString("10"),
+				TradesCount:        4,
+				LastTradeAt:        tickerTestNow,
+			},
+		},
+		{
+			name: "процент изменения округляется до 4 знаков",
+			trades: []domainModels.Trade{
+				makeTrade(marketID, "3", 1, tickerTestNow.Add(-time.Minute)),
+				makeTrade(marketID, "2", 1, tickerTestNow),
+			},
+			expected: domainModels.Ticker{
+				MarketID:           marketID,
+				LastPrice:          decimal.RequireFromString("2"),
+				OpenPrice:          decimal.RequireFromString("3"),
+				HighPrice:          decimal.RequireFromString("3"),
+				LowPrice:           decimal.RequireFromString("2"),
+				Volume:             decimal.NewFromInt(2),
+				QuoteVolume:        decimal.RequireFromString("5"),
+				PriceChange:        decimal.RequireFromString("-1"),
+				PriceChangePercent: decimal.RequireFromString("-33.3333"),
+				TradesCount:        2,
+				LastTradeAt:        tickerTestNow,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			window := newTestTickerWindow()
+			for _, trade := range tt.trades {
+				require.Equal(t, tradeApplied, window.apply(trade, tickerTestNow))
+			}
+
+			ticker, ok := window.snapshot(marketID, tickerTestNow)
+			require.True(t, ok)
+
+			tt.expected.WindowStart = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+			tt.expected.WindowEnd = time.Date(2026, 1, 1, 12, 3, 0, 0, time.UTC)
+			assert.True(t, tt.expected.Equal(ticker), "expected %+v, got %+v", tt.expected, ticker)
+		})
+	}
+}
+
+func TestTickerWindowApplyStaleTrade(t *testing.T) {
+	window := newTestTickerWindow()
+	marketID := uuid.New()
+
+	assert.Equal(t, tradeStale, window.apply(makeTrade(marketID, "100", 1, tickerTestNow.Add(-3*time.Minute)), tickerTestNow))
+
+	_, ok := window.snapshot(marketID, tickerTestNow)
+	assert.False(t, ok)
+}
+
+func TestTickerWindowApplyRedeliveredTrade(t *testing.T) {
+	window := newTestTickerWindow()
+	marketID := uuid.New()
+	trade := makeTrade(marketID, "100", 2, tickerTestNow)
+
+	require.Equal(t, tradeApplied, window.apply(trade, tickerTestNow))
+	assert.Equal(t, tradeDuplicate, window.apply(trade, tickerTestNow))
+
+	ticker, ok := window.snapshot(marketID, tickerTestNow)
+	require.True(t, ok)
+	assert.Equal(t, int64(1), ticker.TradesCount)
+	assert.True(t, decimal.NewFromInt(2).Equal(ticker.Volume))
+}
+
+func TestTickerWindowExpire(t *testing.T) {
+	window := newTestTickerWindow()
+	expiring := uuid.New()
+	shrinking := uuid.New()
+	untouched := uuid.New()
+
+	require.Equal(t, tradeApplied, window.apply(makeTrade(expiring, "100", 1, tickerTestNow.Add(-2*time.Minute)), tickerTestNow))
+	require.Equal(t, tradeApplied, window.apply(makeTrade(shrinking, "100", 1, tickerTestNow.Add(-2*time.Minute)), tickerTestNow))
+	require.Equal(t, tradeApplied, window.apply(makeTrade(shrinking, "120", 1, tickerTestNow), tickerTestNow))
+	require.Equal(t, tradeApplied, window.apply(makeTrade(untouched, "50", 1, tickerTestNow), tickerTestNow))
+
+	next := tickerTestNow.Add(time.Minute)
+	assert.ElementsMatch(t, []uuid.UUID{expiring, shrinking}, window.expire(next))
+	assert.ElementsMatch(t, []uuid.UUID{shrinking, untouched}, window.marketIDs())
+
+	ticker, ok := window.snapshot(shrinking, next)
+	require.True(t, ok)
+	assert.True(t, decimal.RequireFromString("120").Equal(ticker.OpenPrice))
+	assert.Equal(t, int64(1), ticker.TradesCount)
+
+	assert.Empty(t, window.expire(next))
+}
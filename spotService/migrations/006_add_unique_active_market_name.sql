@@ -0,0 +1,32 @@
+-- +goose Up
+-- Дубликаты активных имён не разрешаются автоматически: какой из рынков оставить, решает оператор
+-- (удалить лишние или переименовать), после чего миграция запускается снова
+-- +goose StatementBegin
+DO $$
+DECLARE
+    duplicates TEXT;
+BEGIN
+    SELECT string_agg(format('%s (%s)', name, ids), '; ' ORDER BY name)
+    INTO duplicates
+    FROM (
+        SELECT name, string_agg(id::TEXT, ', ' ORDER BY id) AS ids
+        FROM market_store
+        WHERE deleted_at IS NULL
+        GROUP BY name
+        HAVING count(*) > 1
+    ) AS duplicated;
+
+    IF duplicates IS NOT NULL THEN
+        RAISE EXCEPTION 'active market names are not unique: %', duplicates
+            USING HINT = 'soft-delete or rename the extra markets and rerun the migration';
+    END IF;
+END;
+$$;
+-- +goose StatementEnd
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_market_store_active_name
+    ON market_store (name)
+    WHERE deleted_at IS NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS uq_market_store_active_name;
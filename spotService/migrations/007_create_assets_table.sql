@@ -0,0 +1,105 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS assets
+(
+    code       TEXT PRIMARY KEY,
+    name       TEXT        NOT NULL,
+    precision  SMALLINT    NOT NULL,
+    enabled    BOOLEAN     NOT NULL DEFAULT TRUE,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chk_asset_code CHECK (code ~ '^[A-Z0-9]{1,16}$'),
+    CONSTRAINT chk_asset_name CHECK (length(trim(name)) > 0),
+    CONSTRAINT chk_asset_precision CHECK (precision BETWEEN 0 AND 18)
+);
+
+-- Функция из миграции 002 не зависит от таблицы, переиспользуем её
+DROP TRIGGER IF EXISTS trg_set_asset_updated_at ON assets;
+
+CREATE TRIGGER trg_set_asset_updated_at
+BEFORE UPDATE ON assets
+FOR EACH ROW
+EXECUTE FUNCTION set_market_updated_at();
+
+INSERT INTO assets (code, name, precision) VALUES
+    ('BTC',  'Bitcoin',  8),
+    ('ETH',  'Ethereum', 8),
+    ('DOGE', 'Dogecoin', 8),
+    ('SOL',  'Solana',   8),
+    ('ADA',  'Cardano',  6),
+    ('USDT', 'Tether',   6)
+ON CONFLICT (code) DO NOTHING;
+
+ALTER TABLE market_store
+    ADD COLUMN IF NOT EXISTS base_asset  TEXT,
+    ADD COLUMN IF NOT EXISTS quote_asset TEXT;
+
+-- Активы существующих рынков выводятся из имени BASE-QUOTE. Имена другого вида дали бы
+-- пустой или обрезанный код, а BTC-BTC — рынок с одинаковыми активами, поэтому такие рынки
+-- перечисляются и миграция останавливается: их нужно переименовать до повторного запуска
+-- +goose StatementBegin
+DO $$
+DECLARE
+    invalid TEXT;
+BEGIN
+    SELECT string_agg(format('%s (%s)', name, id), '; ' ORDER BY name, id)
+    INTO invalid
+    FROM market_store
+    WHERE (base_asset IS NULL OR quote_asset IS NULL)
+      AND (name !~ '^[A-Z0-9]{1,16}-[A-Z0-9]{1,16}$'
+           OR split_part(name, '-', 1) = split_part(name, '-', 2));
+
+    IF invalid IS NOT NULL THEN
+        RAISE EXCEPTION 'cannot derive base/quote assets from market names: %', invalid
+            USING HINT = 'rename the markets to BASE-QUOTE with different assets and rerun the migration';
+    END IF;
+END;
+$$;
+-- +goose StatementEnd
+
+-- Рынки, созданные до появления справочника, могут ссылаться на неизвестные активы:
+-- заводим их с точностью по умолчанию, чтобы backfill не нарушил внешние ключи
+INSERT INTO assets (code, name, precision)
+SELECT DISTINCT asset_code, asset_code, 8
+FROM market_store,
+     LATERAL (VALUES (split_part(name, '-', 1)), (split_part(name, '-', 2))) AS parts(asset_code)
+WHERE asset_code ~ '^[A-Z0-9]{1,16}$'
+ON CONFLICT (code) DO NOTHING;
+
+-- Backfill не должен сдвигать updated_at: иначе poller разошлёт события по всем рынкам
+ALTER TABLE market_store DISABLE TRIGGER trg_set_market_updated_at;
+
+UPDATE market_store
+SET base_asset  = split_part(name, '-', 1),
+    quote_asset = split_part(name, '-', 2)
+WHERE base_asset IS NULL OR quote_asset IS NULL;
+
+ALTER TABLE market_store ENABLE TRIGGER trg_set_market_updated_at;
+
+ALTER TABLE market_store
+    ALTER COLUMN base_asset SET NOT NULL,
+    ALTER COLUMN quote_asset SET NOT NULL,
+    ADD CONSTRAINT fk_market_store_base_asset
+        FOREIGN KEY (base_asset) REFERENCES assets (code),
+    ADD CONSTRAINT fk_market_store_quote_asset
+        FOREIGN KEY (quote_asset) REFERENCES assets (code),
+    ADD CONSTRAINT chk_market_assets_differ CHECK (base_asset <> quote_asset);
+
+CREATE INDEX IF NOT EXISTS idx_market_store_base_asset
+    ON market_store (base_asset);
+
+CREATE INDEX IF NOT EXISTS idx_market_store_quote_asset
+    ON market_store (quote_asset);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_market_store_quote_asset;
+DROP INDEX IF EXISTS idx_market_store_base_asset;
+
+ALTER TABLE market_store
+    DROP CONSTRAINT IF EXISTS chk_market_assets_differ,
+    DROP CONSTRAINT IF EXISTS fk_market_store_quote_asset,
+    DROP CONSTRAINT IF EXISTS fk_market_store_base_asset,
+    DROP COLUMN IF EXISTS quote_asset,
+    DROP COLUMN IF EXISTS base_asset;
+
+DROP TRIGGER IF EXISTS trg_set_asset_updated_at ON assets;
+DROP TABLE IF EXISTS assets;
@@ -0,0 +1,66 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS market_change_log
+(
+    seq         BIGSERIAL PRIMARY KEY,
+    market_id   UUID        NOT NULL,
+    name        TEXT        NOT NULL,
+    base_asset  TEXT        NOT NULL,
+    quote_asset TEXT        NOT NULL,
+    enabled     BOOLEAN     NOT NULL,
+    deleted_at  TIMESTAMPTZ,
+    updated_at  TIMESTAMPTZ NOT NULL,
+    logged_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION log_market_change()
+RETURNS TRIGGER AS $$
+BEGIN
+    -- Блокировка держится до конца транзакции: следующий seq выдаётся только после
+    -- COMMIT/ROLLBACK предыдущего писателя, поэтому порядок seq совпадает с порядком
+    -- коммитов и курсор по seq не пропускает изменений
+    PERFORM pg_advisory_xact_lock(hashtext('market_change_log'));
+
+    INSERT INTO market_change_log (market_id, name, base_asset, quote_asset, enabled, deleted_at, updated_at)
+    VALUES (NEW.id, NEW.name, NEW.base_asset, NEW.quote_asset, NEW.enabled, NEW.deleted_at, NEW.updated_at);
+
+    -- Одинаковые уведомления в рамках транзакции схлопываются и доставляются после COMMIT
+    PERFORM pg_notify('market_changes', '');
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+DROP TRIGGER IF EXISTS trg_log_market_change ON market_store;
+
+CREATE TRIGGER trg_log_market_change
+AFTER INSERT OR UPDATE ON market_store
+FOR EACH ROW
+EXECUTE FUNCTION log_market_change();
+
+-- Изменения, которые старый поллер ещё не обработал, переносим в журнал,
+-- чтобы переход на курсор по seq их не потерял
+INSERT INTO market_change_log (market_id, name, base_asset, quote_asset, enabled, deleted_at, updated_at)
+SELECT m.id, m.name, m.base_asset, m.quote_asset, m.enabled, m.deleted_at, m.updated_at
+FROM market_store m
+LEFT JOIN market_poller_cursor c ON c.poller_name = 'market_state_changed_poller'
+WHERE c.poller_name IS NULL
+   OR (m.updated_at, m.id) > (c.last_seen_at, c.last_seen_id)
+ORDER BY m.updated_at, m.id;
+
+ALTER TABLE market_poller_cursor
+    ADD COLUMN IF NOT EXISTS last_seq BIGINT NOT NULL DEFAULT 0,
+    DROP COLUMN IF EXISTS last_seen_at,
+    DROP COLUMN IF EXISTS last_seen_id;
+
+-- +goose Down
+-- Курсор откатывается в начало: старый поллер заново разошлёт состояние всех рынков (at-least-once)
+ALTER TABLE market_poller_cursor
+    ADD COLUMN IF NOT EXISTS last_seen_at TIMESTAMPTZ NOT NULL DEFAULT '1970-01-01T00:00:00Z',
+    ADD COLUMN IF NOT EXISTS last_seen_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000',
+    DROP COLUMN IF EXISTS last_seq;
+
+DROP TRIGGER IF EXISTS trg_log_market_change ON market_store;
+DROP FUNCTION IF EXISTS log_market_change();
+DROP TABLE IF EXISTS market_change_log;
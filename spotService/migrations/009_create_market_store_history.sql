@@ -0,0 +1,52 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS market_store_history
+(
+    id         BIGSERIAL PRIMARY KEY,
+    market_id  UUID        NOT NULL,
+    operation  TEXT        NOT NULL,
+    old_values JSONB,
+    new_values JSONB,
+    actor      TEXT,
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chk_market_history_operation CHECK (operation IN ('INSERT', 'UPDATE', 'DELETE'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_market_store_history_market_id_id
+    ON market_store_history (market_id, id DESC);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION record_market_history()
+RETURNS TRIGGER AS $$
+DECLARE
+    -- Приложение выставляет spot.actor через set_config(..., true) в транзакции изменения;
+    -- NULL означает изменение напрямую в БД
+    change_actor TEXT := NULLIF(current_setting('spot.actor', true), '');
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        INSERT INTO market_store_history (market_id, operation, new_values, actor)
+        VALUES (NEW.id, TG_OP, to_jsonb(NEW), change_actor);
+    ELSIF TG_OP = 'UPDATE' THEN
+        INSERT INTO market_store_history (market_id, operation, old_values, new_values, actor)
+        VALUES (NEW.id, TG_OP, to_jsonb(OLD), to_jsonb(NEW), change_actor);
+    ELSE
+        INSERT INTO market_store_history (market_id, operation, old_values, actor)
+        VALUES (OLD.id, TG_OP, to_jsonb(OLD), change_actor);
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+DROP TRIGGER IF EXISTS trg_record_market_history ON market_store;
+
+CREATE TRIGGER trg_record_market_history
+AFTER INSERT OR UPDATE OR DELETE ON market_store
+FOR EACH ROW
+EXECUTE FUNCTION record_market_history();
+
+-- +goose Down
+DROP TRIGGER IF EXISTS trg_record_market_history ON market_store;
+DROP FUNCTION IF EXISTS record_market_history();
+DROP TABLE IF EXISTS market_store_history;
@@ -0,0 +1,79 @@
+-- +goose Up
+ALTER TABLE market_store
+    ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION bump_market_version()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.version = OLD.version + 1;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+DROP TRIGGER IF EXISTS trg_bump_market_version ON market_store;
+
+CREATE TRIGGER trg_bump_market_version
+BEFORE UPDATE ON market_store
+FOR EACH ROW
+EXECUTE FUNCTION bump_market_version();
+
+-- previous — состояние строки до UPDATE, по нему MarketPoller вычисляет изменившиеся поля.
+-- Для записей до миграции version = 0: консьюмеры сравнивают их как самые старые
+ALTER TABLE market_change_log
+    ADD COLUMN IF NOT EXISTS version  BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS previous JSONB;
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION log_market_change()
+RETURNS TRIGGER AS $$
+DECLARE
+    previous_state JSONB;
+BEGIN
+    -- Блокировка держится до конца транзакции: следующий seq выдаётся только после
+    -- COMMIT/ROLLBACK предыдущего писателя, поэтому порядок seq совпадает с порядком
+    -- коммитов и курсор по seq не пропускает изменений
+    PERFORM pg_advisory_xact_lock(hashtext('market_change_log'));
+
+    IF TG_OP = 'UPDATE' THEN
+        previous_state = to_jsonb(OLD);
+    END IF;
+
+    INSERT INTO market_change_log (market_id, name, base_asset, quote_asset, enabled, deleted_at, updated_at, version, previous)
+    VALUES (NEW.id, NEW.name, NEW.base_asset, NEW.quote_asset, NEW.enabled, NEW.deleted_at, NEW.updated_at, NEW.version, previous_state);
+
+    -- Одинаковые уведомления в рамках транзакции схлопываются и доставляются после COMMIT
+    PERFORM pg_notify('market_changes', '');
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION log_market_change()
+RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM pg_advisory_xact_lock(hashtext('market_change_log'));
+
+    INSERT INTO market_change_log (market_id, name, base_asset, quote_asset, enabled, deleted_at, updated_at)
+    VALUES (NEW.id, NEW.name, NEW.base_asset, NEW.quote_asset, NEW.enabled, NEW.deleted_at, NEW.updated_at);
+
+    PERFORM pg_notify('market_changes', '');
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+ALTER TABLE market_change_log
+    DROP COLUMN IF EXISTS previous,
+    DROP COLUMN IF EXISTS version;
+
+DROP TRIGGER IF EXISTS trg_bump_market_version ON market_store;
+DROP FUNCTION IF EXISTS bump_market_version();
+
+ALTER TABLE market_store
+    DROP COLUMN IF EXISTS version;
@@ -0,0 +1,15 @@
+-- +goose Up
+-- Lease singleton-задач (MarketPoller и др.): задачу выполняет только владелец lease.
+-- fencing_token растёт при каждой смене владельца; запись, сделанная под старым
+-- токеном, отклоняется (см. CursorStore.SaveCursorTransaction)
+CREATE TABLE IF NOT EXISTS leader_leases
+(
+    name          TEXT PRIMARY KEY,
+    holder_id     TEXT        NOT NULL,
+    fencing_token BIGINT      NOT NULL,
+    expires_at    TIMESTAMPTZ NOT NULL,
+    updated_at    TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS leader_leases;
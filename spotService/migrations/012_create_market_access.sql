@@ -0,0 +1,81 @@
+-- +goose Up
+-- restricted-рынок видят и торгуют им только субъекты из market_access
+-- (и политики видимости с bypass_access_lists)
+ALTER TABLE market_store
+    ADD COLUMN IF NOT EXISTS restricted BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Группа — роль пользователя из JWT: отдельных групп в системе нет
+CREATE TABLE IF NOT EXISTS market_access
+(
+    market_id    UUID        NOT NULL REFERENCES market_store (id) ON DELETE CASCADE,
+    subject_type TEXT        NOT NULL,
+    subject_id   TEXT        NOT NULL,
+    granted_by   TEXT,
+    granted_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (market_id, subject_type, subject_id),
+    CONSTRAINT chk_market_access_subject_type CHECK (subject_type IN ('user', 'role'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_market_access_subject
+    ON market_access (subject_type, subject_id);
+
+ALTER TABLE market_change_log
+    ADD COLUMN IF NOT EXISTS restricted BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION log_market_change()
+RETURNS TRIGGER AS $$
+DECLARE
+    previous_state JSONB;
+BEGIN
+    -- Блокировка держится до конца транзакции: следующий seq выдаётся только после
+    -- COMMIT/ROLLBACK предыдущего писателя, поэтому порядок seq совпадает с порядком
+    -- коммитов и курсор по seq не пропускает изменений
+    PERFORM pg_advisory_xact_lock(hashtext('market_change_log'));
+
+    IF TG_OP = 'UPDATE' THEN
+        previous_state = to_jsonb(OLD);
+    END IF;
+
+    INSERT INTO market_change_log (market_id, name, base_asset, quote_asset, enabled, deleted_at, updated_at, version, restricted, previous)
+    VALUES (NEW.id, NEW.name, NEW.base_asset, NEW.quote_asset, NEW.enabled, NEW.deleted_at, NEW.updated_at, NEW.version, NEW.restricted, previous_state);
+
+    -- Одинаковые уведомления в рамках транзакции схлопываются и доставляются после COMMIT
+    PERFORM pg_notify('market_changes', '');
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION log_market_change()
+RETURNS TRIGGER AS $$
+DECLARE
+    previous_state JSONB;
+BEGIN
+    PERFORM pg_advisory_xact_lock(hashtext('market_change_log'));
+
+    IF TG_OP = 'UPDATE' THEN
+        previous_state = to_jsonb(OLD);
+    END IF;
+
+    INSERT INTO market_change_log (market_id, name, base_asset, quote_asset, enabled, deleted_at, updated_at, version, previous)
+    VALUES (NEW.id, NEW.name, NEW.base_asset, NEW.quote_asset, NEW.enabled, NEW.deleted_at, NEW.updated_at, NEW.version, previous_state);
+
+    PERFORM pg_notify('market_changes', '');
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+ALTER TABLE market_change_log
+    DROP COLUMN IF EXISTS restricted;
+
+DROP TABLE IF EXISTS market_access;
+
+ALTER TABLE market_store
+    DROP COLUMN IF EXISTS restricted;
@@ -0,0 +1,40 @@
+-- +goose Up
+-- OHLCV-свечи рынков. first_trade_at / last_trade_at — время самой ранней и самой поздней
+-- сделки свечи: по ним опоздавшая сделка меняет open/close, даже если свеча уже закрыта
+CREATE TABLE IF NOT EXISTS candles
+(
+    market_id      UUID        NOT NULL,
+    interval       TEXT        NOT NULL,
+    open_time      TIMESTAMPTZ NOT NULL,
+    open           NUMERIC     NOT NULL,
+    high           NUMERIC     NOT NULL,
+    low            NUMERIC     NOT NULL,
+    close          NUMERIC     NOT NULL,
+    volume         NUMERIC     NOT NULL,
+    quote_volume   NUMERIC     NOT NULL,
+    trades_count   BIGINT      NOT NULL,
+    first_trade_at TIMESTAMPTZ NOT NULL,
+    last_trade_at  TIMESTAMPTZ NOT NULL,
+    updated_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (market_id, interval, open_time),
+    CONSTRAINT chk_candles_interval CHECK (interval IN ('1m', '5m', '1h', '1d'))
+);
+
+-- Уже учтённые в свечах события, как inbox в order-service: повторная доставка
+-- того же event_id не увеличивает объёмы
+CREATE TABLE IF NOT EXISTS candle_inbox
+(
+    event_id       UUID        NOT NULL,
+    consumer_group TEXT        NOT NULL,
+    processed_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (event_id, consumer_group)
+);
+
+CREATE INDEX IF NOT EXISTS idx_candle_inbox_processed_at
+    ON candle_inbox (processed_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS candle_inbox;
+DROP TABLE IF EXISTS candles;
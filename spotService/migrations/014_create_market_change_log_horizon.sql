@@ -0,0 +1,20 @@
+-- +goose Up
+-- Граница очистки журнала: записи с seq <= pruned_seq удалены. Записи хранятся
+-- market_watch.change_log_retention, чтобы WatchMarkets мог догнать изменения по курсору resume;
+-- курсор за границей отклоняется, и клиент запрашивает новый snapshot
+CREATE TABLE IF NOT EXISTS market_change_log_horizon
+(
+    id         BOOLEAN PRIMARY KEY DEFAULT TRUE,
+    pruned_seq BIGINT NOT NULL,
+
+    CONSTRAINT chk_market_change_log_horizon_singleton CHECK (id)
+);
+
+-- До миграции журнал очищался сразу после обработки всеми поллерами
+INSERT INTO market_change_log_horizon (pruned_seq)
+SELECT COALESCE(MIN(last_seq), 0)
+FROM market_poller_cursor
+ON CONFLICT (id) DO NOTHING;
+
+-- +goose Down
+DROP TABLE IF EXISTS market_change_log_horizon;
@@ -0,0 +1,25 @@
+-- +goose Up
+-- Демо-рынки из миграции 001 теперь загружает cmd/markets из seed/markets.yaml.
+-- Удаляем их только в новой БД, где поллер ещё ни разу не запускался: в работающей
+-- установке рынки уже разошлись по репликам order, а удаление строк в журнал не попадает
+DELETE FROM market_change_log
+WHERE market_id IN (
+    SELECT id
+    FROM market_store
+    WHERE name IN ('BTC-USDT', 'ETH-USDT', 'DOGE-USDT', 'SOL-USDT', 'ADA-USDT')
+      AND version = 1
+)
+  AND NOT EXISTS (SELECT 1 FROM market_poller_cursor);
+
+DELETE FROM market_store
+WHERE name IN ('BTC-USDT', 'ETH-USDT', 'DOGE-USDT', 'SOL-USDT', 'ADA-USDT')
+  AND version = 1
+  AND NOT EXISTS (SELECT 1 FROM market_poller_cursor);
+
+-- Триггер истории записал удаление; в новой БД история этих рынков не нужна
+DELETE FROM market_store_history h
+WHERE NOT EXISTS (SELECT 1 FROM market_store m WHERE m.id = h.market_id)
+  AND NOT EXISTS (SELECT 1 FROM market_poller_cursor);
+
+-- +goose Down
+-- Демо-рынки не возвращаются: их загружает cmd/markets